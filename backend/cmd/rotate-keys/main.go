@@ -0,0 +1,100 @@
+// Command rotate-keys re-encrypts the secret settings of every family.
+//
+// Set SECRETS_MASTER_KEY to the new master key and SECRETS_PREVIOUS_MASTER_KEYS
+// to the old ones (comma separated). Each family gets a new data key wrapped
+// by the new master key and its secrets are sealed again, so the previous
+// keys can be removed from the server afterwards. Plaintext values of known
+// secret settings left from before encryption are encrypted on the way.
+//
+// Run with -generate to print a new random master key.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"expenses-backend/internal/database"
+	"expenses-backend/internal/database/migrations"
+	"expenses-backend/internal/database/sql/familydb"
+	"expenses-backend/internal/database/sql/masterdb"
+	"expenses-backend/internal/database/turso"
+	"expenses-backend/internal/family"
+	"expenses-backend/internal/logger"
+	"expenses-backend/internal/secrets"
+
+	"github.com/joho/godotenv"
+)
+
+func main() {
+	generate := flag.Bool("generate", false, "print a new random master key and exit")
+	flag.Parse()
+
+	if *generate {
+		key, err := secrets.GenerateKey()
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		fmt.Println(key)
+		return
+	}
+
+	godotenv.Load()
+	log := logger.New(nil)
+	ctx := context.Background()
+
+	keyring, err := secrets.NewKeyring(os.Getenv("SECRETS_MASTER_KEY"), strings.Split(os.Getenv("SECRETS_PREVIOUS_MASTER_KEYS"), ",")...)
+	if err != nil {
+		log.Fatal("Failed to load master keys", err)
+	}
+
+	tursoClient := turso.NewClient(turso.Config{
+		AuthToken:    os.Getenv("TURSO_AUTH_TOKEN"),
+		ApiToken:     os.Getenv("TURSO_API_TOKEN"),
+		Organization: os.Getenv("TURSO_ORGANIZATION"),
+	})
+
+	masterDB, err := tursoClient.Connect(ctx, os.Getenv("TURSO_MASTER_DB_URL"))
+	if err != nil {
+		log.Fatal("Failed to connect to master database", err)
+	}
+	familyDB, err := tursoClient.Connect(ctx, os.Getenv("TURSO_FAMILY_SEED_URL"))
+	if err != nil {
+		log.Fatal("Failed to connect to family seed database", err)
+	}
+	defer familyDB.Close()
+
+	migrationManager := migrations.NewMigrationManager(log, masterdb.New(masterDB), familydb.New(familyDB))
+	dbManager := database.New(masterDB, tursoClient, migrationManager, log)
+	defer dbManager.Close()
+
+	if err := dbManager.LoadExistingFamilyDatabases(ctx); err != nil {
+		log.Fatal("Failed to load family databases", err)
+	}
+
+	failed := 0
+	for _, familyID := range dbManager.FamilyIDs() {
+		var sealed int
+		err := dbManager.WithFamilyTx(ctx, int(familyID), func(q *familydb.Queries) error {
+			var err error
+			sealed, err = keyring.Rotate(ctx, q, family.SecretSettingKeys)
+			return err
+		})
+		if err != nil {
+			log.Error("Failed to rotate family keys", err, logger.Int64("family_id", familyID))
+			failed++
+			continue
+		}
+		log.Info("Rotated family keys",
+			logger.Int64("family_id", familyID),
+			logger.Int("secrets", sealed),
+			logger.Str("master_key_id", keyring.CurrentKeyID()))
+	}
+
+	if failed > 0 {
+		log.Fatal("Key rotation incomplete", fmt.Errorf("%d families failed", failed))
+	}
+}
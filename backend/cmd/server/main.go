@@ -2,7 +2,9 @@ package main
 
 import (
 	"context"
+	"errors"
 	"expenses-backend/internal/auth"
+	"expenses-backend/internal/category"
 	"expenses-backend/internal/database"
 	"expenses-backend/internal/database/migrations"
 	"expenses-backend/internal/database/sql/familydb"
@@ -10,14 +12,21 @@ import (
 	"expenses-backend/internal/database/turso"
 	"expenses-backend/internal/expense"
 	"expenses-backend/internal/family"
+	"expenses-backend/internal/forecast"
 	"expenses-backend/internal/middleware"
+	"expenses-backend/internal/pagination"
+	"expenses-backend/internal/secrets"
 	"expenses-backend/internal/transaction"
 	"expenses-backend/pkg/auth/v1/authv1connect"
+	"expenses-backend/pkg/category/v1/categoryv1connect"
 	"expenses-backend/pkg/expense/v1/expensev1connect"
 	"expenses-backend/pkg/family/v1/familyv1connect"
+	"expenses-backend/pkg/forecast/v1/forecastv1connect"
 	"expenses-backend/pkg/transaction/v1/transactionv1connect"
 	"net/http"
 	"os"
+	"strings"
+	"time"
 
 	"expenses-backend/internal/logger"
 
@@ -67,10 +76,37 @@ func main() {
 		log.Warn("Failed to load existing family databases", err)
 	}
 
-	familyService := family.NewService(dbManager, log)
+	// Master keys for secret family settings; previous keys stay readable until
+	// the rotate-keys command re-encrypts every family
+	keyring, err := secrets.NewKeyring(os.Getenv("SECRETS_MASTER_KEY"), strings.Split(os.Getenv("SECRETS_PREVIOUS_MASTER_KEYS"), ",")...)
+	if err != nil {
+		if !errors.Is(err, secrets.ErrNoMasterKey) {
+			panic(err)
+		}
+		log.Warn("SECRETS_MASTER_KEY is not set, secret settings are disabled", err)
+	}
+
+	familyService := family.NewService(dbManager, keyring, log)
 	authService := auth.NewService(dbManager, familyService, log)
-	expenseService := expense.NewService(dbManager, familyService, log)
-	transactionService := transaction.NewService(dbManager, log)
+	pageTokens := pagination.NewCodec([]byte(os.Getenv("PAGE_TOKEN_SECRET")))
+	expenseService := expense.NewService(dbManager, familyService, pageTokens, log)
+	transactionService := transaction.NewService(dbManager, familyService, expenseService, pageTokens, log)
+	categoryService := category.NewService(dbManager, expenseService, log)
+	forecastService := forecast.NewService(dbManager, familyService, expenseService, log)
+
+	// Sync linked bank accounts in the background; an interval of 0 disables it
+	syncInterval := transaction.DefaultSyncInterval
+	if v := os.Getenv("SIMPLEFIN_SYNC_INTERVAL"); v != "" {
+		syncInterval, err = time.ParseDuration(v)
+		if err != nil {
+			panic(err)
+		}
+	}
+	if syncInterval > 0 {
+		transactionService.StartSyncScheduler(context.Background(), syncInterval)
+	}
+
+	expenseService.StartAutopayScheduler(context.Background(), expense.DefaultAutopayInterval)
 
 	// Initialize middleware
 	authInterceptor := middleware.NewAuthInterceptor(authService, dbManager, log)
@@ -103,11 +139,19 @@ func main() {
 	familyServicePath, familyServiceHandler := familyv1connect.NewFamilySettingsServiceHandler(familyService, interceptors)
 	mux.Handle(familyServicePath, familyServiceHandler)
 
+	categoryServicePath, categoryServiceHandler := categoryv1connect.NewCategoryServiceHandler(categoryService, interceptors)
+	mux.Handle(categoryServicePath, categoryServiceHandler)
+
+	forecastServicePath, forecastServiceHandler := forecastv1connect.NewForecastServiceHandler(forecastService, interceptors)
+	mux.Handle(forecastServicePath, forecastServiceHandler)
+
 	reflector := grpcreflect.NewStaticReflector(
 		"expense.v1.ExpenseService",
 		"auth.v1.AuthService",
 		"transaction.v1.TransactionService",
 		"family.v1.FamilySettingsService",
+		"category.v1.CategoryService",
+		"forecast.v1.ForecastService",
 	)
 
 	mux.Handle(grpcreflect.NewHandlerV1(reflector))
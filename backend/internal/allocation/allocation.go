@@ -0,0 +1,142 @@
+// Package allocation assigns bills to the paychecks that pay them.
+package allocation
+
+import (
+	"cmp"
+	"slices"
+	"time"
+)
+
+// Paycheck is one payday of an income source
+type Paycheck struct {
+	SourceID  int64
+	Scheduled time.Time // Pay date before business day adjustment; identifies the paycheck
+	Date      time.Time // When the money arrives
+	Cents     int64
+}
+
+// PaycheckKey identifies a paycheck across recomputations
+type PaycheckKey struct {
+	SourceID  int64
+	Scheduled time.Time
+}
+
+func (p Paycheck) Key() PaycheckKey {
+	return PaycheckKey{SourceID: p.SourceID, Scheduled: p.Scheduled}
+}
+
+// Bill is one occurrence of an expense
+type Bill struct {
+	ExpenseID int64
+	Scheduled time.Time // Due date before business day adjustment; identifies the bill
+	Due       time.Time
+	Cents     int64
+}
+
+// BillKey identifies a bill across recomputations
+type BillKey struct {
+	ExpenseID int64
+	Scheduled time.Time
+}
+
+func (b Bill) Key() BillKey {
+	return BillKey{ExpenseID: b.ExpenseID, Scheduled: b.Scheduled}
+}
+
+// Assignment is a bill paid from a paycheck
+type Assignment struct {
+	Bill
+	Pinned bool // Assigned by hand rather than by due date
+}
+
+// Allocation is a paycheck with the bills assigned to it
+type Allocation struct {
+	Paycheck Paycheck
+	Bills    []Assignment
+	Total    int64
+	Leftover int64 // What the paycheck has left after its bills; negative when short
+}
+
+// Result is the outcome of allocating a set of bills
+type Result struct {
+	Paychecks  []Allocation // Ordered by arrival date
+	Unassigned []Bill       // Bills due before any paycheck arrives
+}
+
+// Allocate assigns every bill to the latest paycheck that arrives before its
+// due date. When several paychecks arrive that day, the one with the most
+// left over gets it. Pinned bills go to their pinned paycheck instead; pins
+// to paychecks that are not in the list are ignored.
+func Allocate(paychecks []Paycheck, bills []Bill, pins map[BillKey]PaycheckKey) Result {
+	allocations := make([]Allocation, len(paychecks))
+	for i, p := range paychecks {
+		allocations[i] = Allocation{Paycheck: p, Leftover: p.Cents}
+	}
+	slices.SortStableFunc(allocations, func(a, b Allocation) int {
+		if c := a.Paycheck.Date.Compare(b.Paycheck.Date); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Paycheck.SourceID, b.Paycheck.SourceID)
+	})
+	byKey := make(map[PaycheckKey]int, len(allocations))
+	for i, a := range allocations {
+		byKey[a.Paycheck.Key()] = i
+	}
+
+	bills = slices.Clone(bills)
+	slices.SortStableFunc(bills, func(a, b Bill) int {
+		if c := a.Due.Compare(b.Due); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.ExpenseID, b.ExpenseID)
+	})
+
+	assign := func(i int, b Bill, pinned bool) {
+		a := &allocations[i]
+		a.Bills = append(a.Bills, Assignment{Bill: b, Pinned: pinned})
+		a.Total += b.Cents
+		a.Leftover -= b.Cents
+	}
+
+	// Pins first, so automatic assignment sees what they leave over
+	var unpinned []Bill
+	for _, b := range bills {
+		if key, ok := pins[b.Key()]; ok {
+			if i, ok := byKey[key]; ok {
+				assign(i, b, true)
+				continue
+			}
+		}
+		unpinned = append(unpinned, b)
+	}
+
+	var result Result
+	for _, b := range unpinned {
+		best := -1
+		for i := range allocations {
+			date := allocations[i].Paycheck.Date
+			if !date.Before(b.Due) {
+				break
+			}
+			if best < 0 || date.After(allocations[best].Paycheck.Date) || allocations[i].Leftover > allocations[best].Leftover {
+				best = i
+			}
+		}
+		if best < 0 {
+			result.Unassigned = append(result.Unassigned, b)
+			continue
+		}
+		assign(best, b, false)
+	}
+
+	for i := range allocations {
+		slices.SortStableFunc(allocations[i].Bills, func(a, b Assignment) int {
+			if c := a.Due.Compare(b.Due); c != 0 {
+				return c
+			}
+			return cmp.Compare(a.ExpenseID, b.ExpenseID)
+		})
+	}
+	result.Paychecks = allocations
+	return result
+}
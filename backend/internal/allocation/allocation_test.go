@@ -0,0 +1,109 @@
+package allocation
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"expenses-backend/internal/recurrence"
+)
+
+func date(s string) time.Time {
+	d, err := recurrence.ParseDate(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+func paycheck(sourceID int64, d string, cents int64) Paycheck {
+	return Paycheck{SourceID: sourceID, Scheduled: date(d), Date: date(d), Cents: cents}
+}
+
+func bill(expenseID int64, due string, cents int64) Bill {
+	return Bill{ExpenseID: expenseID, Scheduled: date(due), Due: date(due), Cents: cents}
+}
+
+// billsOf lists the expense IDs assigned to each paycheck
+func billsOf(r Result) [][]int64 {
+	out := make([][]int64, len(r.Paychecks))
+	for i, a := range r.Paychecks {
+		out[i] = []int64{}
+		for _, b := range a.Bills {
+			out[i] = append(out[i], b.ExpenseID)
+		}
+	}
+	return out
+}
+
+func TestAllocate(t *testing.T) {
+	paychecks := []Paycheck{
+		paycheck(1, "2025-03-14", 200000),
+		paycheck(1, "2025-02-28", 200000),
+		paycheck(1, "2025-03-28", 200000),
+	}
+	bills := []Bill{
+		bill(10, "2025-03-01", 150000), // Rent
+		bill(11, "2025-03-14", 10000),  // Due the day the paycheck arrives
+		bill(12, "2025-03-20", 5000),
+		bill(13, "2025-02-27", 2000), // Before any paycheck
+	}
+
+	r := Allocate(paychecks, bills, nil)
+
+	if got, want := billsOf(r), [][]int64{{10, 11}, {12}, {}}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("assignments = %v, want %v", got, want)
+	}
+	if len(r.Unassigned) != 1 || r.Unassigned[0].ExpenseID != 13 {
+		t.Errorf("unassigned = %+v, want expense 13", r.Unassigned)
+	}
+	if a := r.Paychecks[0]; a.Total != 160000 || a.Leftover != 40000 {
+		t.Errorf("2025-02-28 total %d, leftover %d", a.Total, a.Leftover)
+	}
+	if a := r.Paychecks[2]; a.Total != 0 || a.Leftover != 200000 {
+		t.Errorf("2025-03-28 total %d, leftover %d", a.Total, a.Leftover)
+	}
+}
+
+func TestAllocatePins(t *testing.T) {
+	paychecks := []Paycheck{
+		paycheck(1, "2025-03-01", 100000),
+		paycheck(1, "2025-03-15", 100000),
+	}
+	bills := []Bill{
+		bill(10, "2025-03-20", 30000),
+		bill(11, "2025-03-25", 20000),
+	}
+	pins := map[BillKey]PaycheckKey{
+		bills[0].Key(): paychecks[0].Key(),
+		bills[1].Key(): {SourceID: 9, Scheduled: date("2025-03-15")}, // No such paycheck
+	}
+
+	r := Allocate(paychecks, bills, pins)
+
+	first, second := r.Paychecks[0], r.Paychecks[1]
+	if len(first.Bills) != 1 || first.Bills[0].ExpenseID != 10 || !first.Bills[0].Pinned {
+		t.Errorf("first paycheck bills = %+v, want pinned expense 10", first.Bills)
+	}
+	if len(second.Bills) != 1 || second.Bills[0].ExpenseID != 11 || second.Bills[0].Pinned {
+		t.Errorf("second paycheck bills = %+v, want expense 11 assigned by due date", second.Bills)
+	}
+}
+
+func TestAllocateSameDay(t *testing.T) {
+	// Two earners paid the same day; bills go to whoever has more left
+	paychecks := []Paycheck{
+		paycheck(1, "2025-03-14", 100000),
+		paycheck(2, "2025-03-14", 150000),
+	}
+	bills := []Bill{
+		bill(10, "2025-03-20", 80000),
+		bill(11, "2025-03-21", 50000),
+	}
+
+	r := Allocate(paychecks, bills, nil)
+
+	if got, want := billsOf(r), [][]int64{{11}, {10}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("assignments = %v, want %v", got, want)
+	}
+}
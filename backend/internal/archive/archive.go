@@ -0,0 +1,242 @@
+// Package archive reads and writes family data archives: a JSON document with
+// the rows of a family database, used as a backup and to move a family between
+// servers. Rows keep the IDs they had when exported; restoring them gives them
+// new IDs, so references between rows go through an IDMap.
+package archive
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"time"
+
+	"expenses-backend/internal/database/sql/familydb"
+)
+
+const (
+	// Format names the document so other JSON files are not mistaken for one
+	Format = "expenses-family-archive"
+	// Version is the layout of the document, bumped on incompatible changes.
+	// Columns added by family migrations do not change it; the schema version
+	// covers those.
+	Version = 1
+)
+
+var (
+	ErrNotArchive         = errors.New("not a family data archive")
+	ErrUnsupportedVersion = errors.New("unsupported archive version")
+	ErrMissingReference   = errors.New("archive refers to a row it does not hold")
+)
+
+// Header describes an archive
+type Header struct {
+	Format        string    `json:"format"`
+	Version       int       `json:"version"`
+	SchemaVersion int64     `json:"schema_version"` // Family migration the database was at
+	ExportedAt    time.Time `json:"exported_at"`
+}
+
+// Archive holds a family's data. Sync state, sync errors and the family's data
+// keys are left out, as are secret settings: they are tied to the server the
+// family was exported from.
+type Archive struct {
+	Header
+	Members             []*familydb.FamilyMember       `json:"members"`
+	Categories          []*familydb.Category           `json:"categories"`
+	CategoryBudgets     []*familydb.CategoryBudget     `json:"category_budgets"`
+	Expenses            []*familydb.Expense            `json:"expenses"`
+	IncomeSources       []*familydb.IncomeSource       `json:"income_sources"`
+	Accounts            []*familydb.Account            `json:"accounts"`
+	Transactions        []*familydb.Transaction        `json:"transactions"`
+	ExpensePayments     []*familydb.ExpensePayment     `json:"expense_payments"`
+	PaycheckAllocations []*familydb.PaycheckAllocation `json:"paycheck_allocations"`
+	BalanceSnapshots    []*familydb.BalanceSnapshot    `json:"balance_snapshots"`
+	TransactionMatches  []*familydb.TransactionMatch   `json:"transaction_matches"`
+	TransactionRules    []*familydb.TransactionRule    `json:"transaction_rules"`
+	TransferPairs       []*familydb.TransferPair       `json:"transfer_pairs"`
+	TransactionSplits   []*familydb.TransactionSplit   `json:"transaction_splits"`
+	SharedCosts         []*familydb.SharedCost         `json:"shared_costs"`
+	SharedCostShares    []*familydb.SharedCostShare    `json:"shared_cost_shares"`
+	Settlements         []*familydb.Settlement         `json:"settlements"`
+	StatementMappings   []*familydb.StatementMapping   `json:"statement_mappings"`
+	Settings            []*familydb.FamilySetting      `json:"settings"`
+}
+
+// New starts an archive of a family database at the schema version
+func New(schemaVersion int64, exportedAt time.Time) *Archive {
+	return &Archive{Header: Header{
+		Format:        Format,
+		Version:       Version,
+		SchemaVersion: schemaVersion,
+		ExportedAt:    exportedAt.UTC(),
+	}}
+}
+
+// tables holds the JSON names of the archive's tables
+var tables = tableNames()
+
+func tableNames() map[string]bool {
+	names := make(map[string]bool)
+	t := reflect.TypeOf(Archive{})
+	for i := range t.NumField() {
+		if f := t.Field(i); !f.Anonymous {
+			names[strings.Split(f.Tag.Get("json"), ",")[0]] = true
+		}
+	}
+	return names
+}
+
+// Encoder writes an archive a table at a time, so that a family's rows need
+// not all be in memory together. Write the header with NewEncoder, each table
+// with EncodeTable, then call Close.
+type Encoder struct {
+	w       io.Writer
+	err     error
+	written map[string]bool
+}
+
+// NewEncoder starts an archive with the header
+func NewEncoder(w io.Writer, h Header) (*Encoder, error) {
+	header, err := json.Marshal(h)
+	if err != nil {
+		return nil, err
+	}
+	e := &Encoder{w: w, written: make(map[string]bool)}
+	// Leave the header object open for the tables
+	e.write(header[:len(header)-1])
+	return e, e.err
+}
+
+// EncodeTable writes the rows of the named table, one row at a time
+func EncodeTable[T any](e *Encoder, name string, rows []*T) error {
+	if e.err != nil {
+		return e.err
+	}
+	if !tables[name] || e.written[name] {
+		e.err = fmt.Errorf("archive table %q is unknown or already written", name)
+		return e.err
+	}
+	e.written[name] = true
+
+	key, _ := json.Marshal(name)
+	e.write([]byte(","), key, []byte(":["))
+	for i, row := range rows {
+		data, err := json.Marshal(row)
+		if err != nil {
+			e.err = err
+			return err
+		}
+		if i > 0 {
+			e.write([]byte(","))
+		}
+		e.write(data)
+	}
+	e.write([]byte("]"))
+	return e.err
+}
+
+// Close ends the archive
+func (e *Encoder) Close() error {
+	e.write([]byte("}\n"))
+	return e.err
+}
+
+func (e *Encoder) write(parts ...[]byte) {
+	for _, p := range parts {
+		if e.err != nil {
+			return
+		}
+		_, e.err = e.w.Write(p)
+	}
+}
+
+// Read decodes an archive, rejecting documents of another format or a version
+// this package cannot read
+func Read(r io.Reader) (*Archive, error) {
+	var a Archive
+	if err := json.NewDecoder(r).Decode(&a); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrNotArchive, err)
+	}
+	if a.Format != Format {
+		return nil, ErrNotArchive
+	}
+	if a.Version < 1 || a.Version > Version {
+		return nil, fmt.Errorf("%w %d; this server reads version %d", ErrUnsupportedVersion, a.Version, Version)
+	}
+	return &a, nil
+}
+
+// IDMap maps the IDs rows of a table had in the archive to the IDs they were
+// restored with
+type IDMap struct {
+	table string
+	ids   map[int64]int64
+}
+
+func NewIDMap(table string) *IDMap {
+	return &IDMap{table: table, ids: make(map[int64]int64)}
+}
+
+// Set records that the row archived as old was restored as restored
+func (m *IDMap) Set(old, restored int64) {
+	m.ids[old] = restored
+}
+
+// Lookup returns the restored ID of a row
+func (m *IDMap) Lookup(old int64) (int64, bool) {
+	id, ok := m.ids[old]
+	return id, ok
+}
+
+// Get returns the restored ID of a row a reference points at, failing with
+// ErrMissingReference when the row was not restored
+func (m *IDMap) Get(old int64) (int64, error) {
+	id, ok := m.ids[old]
+	if !ok {
+		return 0, fmt.Errorf("%w: %s %d", ErrMissingReference, m.table, old)
+	}
+	return id, nil
+}
+
+// Optional is Get for nullable references
+func (m *IDMap) Optional(old *int64) (*int64, error) {
+	if old == nil {
+		return nil, nil
+	}
+	id, err := m.Get(*old)
+	if err != nil {
+		return nil, err
+	}
+	return &id, nil
+}
+
+// Len returns the number of restored rows
+func (m *IDMap) Len() int {
+	return len(m.ids)
+}
+
+// MatchMembers maps archived family members to members of the family being
+// restored into by email, ignoring case. Member IDs are user IDs of the server
+// a family lives on, so they do not carry over; archived members without an
+// account in the family are left out of the map.
+func MatchMembers(archived, current []*familydb.FamilyMember) *IDMap {
+	byEmail := make(map[string]int64, len(current))
+	for _, m := range current {
+		byEmail[normalizeEmail(m.Email)] = m.ID
+	}
+
+	ids := NewIDMap("member")
+	for _, m := range archived {
+		if id, ok := byEmail[normalizeEmail(m.Email)]; ok {
+			ids.Set(m.ID, id)
+		}
+	}
+	return ids
+}
+
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
@@ -0,0 +1,254 @@
+package archive
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"expenses-backend/internal/database/sql/familydb"
+)
+
+func TestEncodeRead(t *testing.T) {
+	exported := time.Date(2025, 3, 1, 12, 30, 0, 0, time.UTC)
+	posted := time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC)
+	category := int64(4)
+	note := "groceries"
+
+	want := New(24, exported)
+	want.Accounts = []*familydb.Account{{ID: 2, AccountID: "ACT-1", Name: "Checking", AccountType: "checking", IncludeInBudget: true, Source: "simplefin"}}
+	want.Transactions = []*familydb.Transaction{
+		{ID: 9, AccountID: 2, PostedDate: posted, Description: "STORE", Payee: "Store", AmountCents: -1234, CategoryID: &category, Note: &note},
+		{ID: 10, AccountID: 2, PostedDate: posted, Description: "PAYROLL", Payee: "Payroll", AmountCents: 250000},
+	}
+	want.Settings = []*familydb.FamilySetting{}
+
+	var buf bytes.Buffer
+	enc, err := NewEncoder(&buf, want.Header)
+	if err != nil {
+		t.Fatalf("NewEncoder: %v", err)
+	}
+	if err := EncodeTable(enc, "accounts", want.Accounts); err != nil {
+		t.Fatalf("EncodeTable(accounts): %v", err)
+	}
+	if err := EncodeTable(enc, "transactions", want.Transactions); err != nil {
+		t.Fatalf("EncodeTable(transactions): %v", err)
+	}
+	if err := EncodeTable(enc, "settings", want.Settings); err != nil {
+		t.Fatalf("EncodeTable(settings): %v", err)
+	}
+	if err := enc.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	got, err := Read(&buf)
+	if err != nil {
+		t.Fatalf("Read: %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Read = %+v, want %+v", got, want)
+	}
+}
+
+func TestEncodeTableNames(t *testing.T) {
+	for _, name := range []string{"transaction", "format"} {
+		enc, err := NewEncoder(io.Discard, New(24, time.Now()).Header)
+		if err != nil {
+			t.Fatalf("NewEncoder: %v", err)
+		}
+		if err := EncodeTable(enc, name, []*familydb.Transaction{}); err == nil {
+			t.Errorf("EncodeTable(%q) succeeded for a name that is not a table", name)
+		}
+	}
+
+	enc, err := NewEncoder(io.Discard, New(24, time.Now()).Header)
+	if err != nil {
+		t.Fatalf("NewEncoder: %v", err)
+	}
+	if err := EncodeTable(enc, "accounts", []*familydb.Account{}); err != nil {
+		t.Fatalf("EncodeTable(accounts): %v", err)
+	}
+	if err := EncodeTable(enc, "accounts", []*familydb.Account{}); err == nil {
+		t.Error("EncodeTable wrote accounts twice")
+	}
+}
+
+func TestRead(t *testing.T) {
+	tests := []struct {
+		name    string
+		doc     string
+		wantErr error
+	}{
+		{
+			name: "current version",
+			doc:  `{"format":"expenses-family-archive","version":1,"schema_version":24,"categories":[{"id":1,"name":"Food"}]}`,
+		},
+		{
+			name:    "other format",
+			doc:     `{"format":"something-else","version":1}`,
+			wantErr: ErrNotArchive,
+		},
+		{
+			name:    "not JSON",
+			doc:     `Date,Description,Amount`,
+			wantErr: ErrNotArchive,
+		},
+		{
+			name:    "newer version",
+			doc:     `{"format":"expenses-family-archive","version":2}`,
+			wantErr: ErrUnsupportedVersion,
+		},
+		{
+			name:    "missing version",
+			doc:     `{"format":"expenses-family-archive"}`,
+			wantErr: ErrUnsupportedVersion,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Read(strings.NewReader(tt.doc))
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Read error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestIDMap(t *testing.T) {
+	ids := NewIDMap("account")
+	ids.Set(3, 10)
+
+	if got, err := ids.Get(3); err != nil || got != 10 {
+		t.Errorf("Get(3) = %d, %v, want 10", got, err)
+	}
+	if _, err := ids.Get(4); !errors.Is(err, ErrMissingReference) {
+		t.Errorf("Get(4) error = %v, want ErrMissingReference", err)
+	}
+
+	if got, err := ids.Optional(nil); got != nil || err != nil {
+		t.Errorf("Optional(nil) = %v, %v, want nil", got, err)
+	}
+	old := int64(3)
+	if got, err := ids.Optional(&old); err != nil || got == nil || *got != 10 {
+		t.Errorf("Optional(3) = %v, %v, want 10", got, err)
+	}
+	old = 5
+	if _, err := ids.Optional(&old); !errors.Is(err, ErrMissingReference) {
+		t.Errorf("Optional(5) error = %v, want ErrMissingReference", err)
+	}
+}
+
+func TestEncodeRestoreReferences(t *testing.T) {
+	posted := time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC)
+	category := int64(4)
+
+	a := New(24, posted)
+	a.Categories = []*familydb.Category{{ID: 4, Name: "Groceries"}}
+	a.Expenses = []*familydb.Expense{{ID: 3, Name: "Rent", Amount: 1500}}
+	a.Accounts = []*familydb.Account{{ID: 2, AccountID: "ACT-1", Name: "Checking"}}
+	a.Transactions = []*familydb.Transaction{
+		{ID: 9, AccountID: 2, PostedDate: posted, AmountCents: -10000},
+		{ID: 10, AccountID: 2, PostedDate: posted, AmountCents: -150000},
+	}
+	a.TransactionMatches = []*familydb.TransactionMatch{{ID: 1, TransactionID: 10, ExpenseID: 3, ScheduledDate: posted}}
+	a.TransactionSplits = []*familydb.TransactionSplit{
+		{ID: 5, TransactionID: 9, Position: 0, AmountCents: -6000, CategoryID: &category},
+		{ID: 6, TransactionID: 9, Position: 1, AmountCents: -4000},
+		// Added by a sync after its transaction was exported
+		{ID: 7, TransactionID: 11, Position: 0, AmountCents: -500},
+	}
+
+	var buf bytes.Buffer
+	enc, err := NewEncoder(&buf, a.Header)
+	if err != nil {
+		t.Fatalf("NewEncoder: %v", err)
+	}
+	for _, err := range []error{
+		EncodeTable(enc, "categories", a.Categories),
+		EncodeTable(enc, "expenses", a.Expenses),
+		EncodeTable(enc, "accounts", a.Accounts),
+		EncodeTable(enc, "transactions", a.Transactions),
+		EncodeTable(enc, "transaction_matches", a.TransactionMatches),
+		EncodeTable(enc, "transaction_splits", a.TransactionSplits),
+		enc.Close(),
+	} {
+		if err != nil {
+			t.Fatalf("encode: %v", err)
+		}
+	}
+	got, err := Read(&buf)
+	if err != nil {
+		t.Fatalf("Read: %v", err)
+	}
+
+	// Restored rows get new IDs, which references must follow
+	categories, expenses, accounts, transactions := NewIDMap("category"), NewIDMap("expense"), NewIDMap("account"), NewIDMap("transaction")
+	for _, c := range got.Categories {
+		categories.Set(c.ID, c.ID+100)
+	}
+	for _, e := range got.Expenses {
+		expenses.Set(e.ID, e.ID+200)
+	}
+	for _, acct := range got.Accounts {
+		accounts.Set(acct.ID, acct.ID+300)
+	}
+	for _, txn := range got.Transactions {
+		if _, err := accounts.Get(txn.AccountID); err != nil {
+			t.Fatalf("transaction %d: %v", txn.ID, err)
+		}
+		transactions.Set(txn.ID, txn.ID+400)
+	}
+
+	m := got.TransactionMatches[0]
+	if txn, err := transactions.Get(m.TransactionID); err != nil || txn != 410 {
+		t.Errorf("match transaction = %d, %v, want 410", txn, err)
+	}
+	if exp, err := expenses.Get(m.ExpenseID); err != nil || exp != 203 {
+		t.Errorf("match expense = %d, %v, want 203", exp, err)
+	}
+
+	for _, sp := range got.TransactionSplits[:2] {
+		if txn, err := transactions.Get(sp.TransactionID); err != nil || txn != 409 {
+			t.Errorf("split %d transaction = %d, %v, want 409", sp.ID, txn, err)
+		}
+	}
+	if cat, err := categories.Optional(got.TransactionSplits[0].CategoryID); err != nil || cat == nil || *cat != 104 {
+		t.Errorf("split 5 category = %v, %v, want 104", cat, err)
+	}
+	if cat, err := categories.Optional(got.TransactionSplits[1].CategoryID); err != nil || cat != nil {
+		t.Errorf("split 6 category = %v, %v, want nil", cat, err)
+	}
+	if _, err := transactions.Get(got.TransactionSplits[2].TransactionID); !errors.Is(err, ErrMissingReference) {
+		t.Errorf("split 7 transaction error = %v, want ErrMissingReference", err)
+	}
+}
+
+func TestMatchMembers(t *testing.T) {
+	archived := []*familydb.FamilyMember{
+		{ID: 1, Email: "ana@example.com"},
+		{ID: 2, Email: " Ben@Example.com"},
+		{ID: 3, Email: "cleo@example.com"},
+	}
+	current := []*familydb.FamilyMember{
+		{ID: 40, Email: "ANA@example.com"},
+		{ID: 41, Email: "ben@example.com"},
+		{ID: 42, Email: "dev@example.com"},
+	}
+
+	ids := MatchMembers(archived, current)
+	for old, want := range map[int64]int64{1: 40, 2: 41} {
+		if got, ok := ids.Lookup(old); !ok || got != want {
+			t.Errorf("Lookup(%d) = %d, %v, want %d", old, got, ok, want)
+		}
+	}
+	if _, ok := ids.Lookup(3); ok {
+		t.Error("Lookup(3) matched a member who is not in the family")
+	}
+	if ids.Len() != 2 {
+		t.Errorf("Len = %d, want 2", ids.Len())
+	}
+}
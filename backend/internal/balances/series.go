@@ -0,0 +1,95 @@
+// Package balances turns daily account balance snapshots into time series.
+package balances
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"expenses-backend/internal/recurrence"
+)
+
+// Granularity is the period each point of a series covers
+type Granularity string
+
+const (
+	Day   Granularity = "day"
+	Week  Granularity = "week" // Monday through Sunday
+	Month Granularity = "month"
+)
+
+// MaxPeriods bounds the length of a series
+const MaxPeriods = 2000
+
+var (
+	ErrInvalidGranularity = errors.New("invalid granularity")
+	ErrEndBeforeStart     = errors.New("end date must not be before start date")
+	ErrTooManyPeriods     = fmt.Errorf("a series can have at most %d points", MaxPeriods)
+)
+
+// Periods returns the dates of the points of a series from start to end,
+// inclusive: the last day of each period, with the last period cut off at end
+// so it shows the most recent balance.
+func Periods(start, end time.Time, g Granularity) ([]time.Time, error) {
+	start, end = recurrence.Date(start), recurrence.Date(end)
+	if end.Before(start) {
+		return nil, ErrEndBeforeStart
+	}
+
+	var periodEnd func(time.Time) time.Time
+	switch g {
+	case Day:
+		periodEnd = func(d time.Time) time.Time { return d }
+	case Week:
+		periodEnd = func(d time.Time) time.Time {
+			return d.AddDate(0, 0, (7-int(d.Weekday()))%7)
+		}
+	case Month:
+		periodEnd = func(d time.Time) time.Time {
+			return time.Date(d.Year(), d.Month()+1, 0, 0, 0, 0, 0, time.UTC)
+		}
+	default:
+		return nil, ErrInvalidGranularity
+	}
+
+	var dates []time.Time
+	for d := start; !d.After(end); d = periodEnd(d).AddDate(0, 0, 1) {
+		if len(dates) == MaxPeriods {
+			return nil, ErrTooManyPeriods
+		}
+		dates = append(dates, minDate(periodEnd(d), end))
+	}
+	return dates, nil
+}
+
+func minDate(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
+
+// Snapshot is an account balance recorded for a day
+type Snapshot struct {
+	Date  time.Time
+	Cents int64
+}
+
+// AsOf returns, for each date, the latest snapshot on or before it, or nil
+// when there is none yet. Balances carry forward over days without a
+// snapshot. snapshots and dates must be sorted by date.
+func AsOf(snapshots []Snapshot, dates []time.Time) []*int64 {
+	out := make([]*int64, len(dates))
+	i := 0
+	for j, d := range dates {
+		i += sort.Search(len(snapshots)-i, func(k int) bool {
+			return snapshots[i+k].Date.After(d)
+		})
+		if i > 0 {
+			cents := snapshots[i-1].Cents
+			out[j] = &cents
+		}
+	}
+	return out
+}
@@ -0,0 +1,85 @@
+package balances
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"expenses-backend/internal/recurrence"
+)
+
+func date(s string) time.Time {
+	d, err := recurrence.ParseDate(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+func formatDates(dates []time.Time) []string {
+	out := make([]string, len(dates))
+	for i, d := range dates {
+		out[i] = d.Format(recurrence.DateLayout)
+	}
+	return out
+}
+
+func TestPeriods(t *testing.T) {
+	tests := []struct {
+		name       string
+		start, end string
+		g          Granularity
+		want       []string
+	}{
+		{"days", "2025-02-27", "2025-03-02", Day, []string{"2025-02-27", "2025-02-28", "2025-03-01", "2025-03-02"}},
+		// 2025-03-05 is a Wednesday; weeks end on Sunday
+		{"weeks", "2025-03-05", "2025-03-20", Week, []string{"2025-03-09", "2025-03-16", "2025-03-20"}},
+		{"week ending on start", "2025-03-09", "2025-03-10", Week, []string{"2025-03-09", "2025-03-10"}},
+		{"months", "2025-01-15", "2025-04-02", Month, []string{"2025-01-31", "2025-02-28", "2025-03-31", "2025-04-02"}},
+		{"single day", "2025-01-31", "2025-01-31", Month, []string{"2025-01-31"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Periods(date(tt.start), date(tt.end), tt.g)
+			if err != nil {
+				t.Fatalf("Periods: %v", err)
+			}
+			if !reflect.DeepEqual(formatDates(got), tt.want) {
+				t.Errorf("Periods = %v, want %v", formatDates(got), tt.want)
+			}
+		})
+	}
+}
+
+func TestPeriodsErrors(t *testing.T) {
+	if _, err := Periods(date("2025-03-02"), date("2025-03-01"), Day); err != ErrEndBeforeStart {
+		t.Errorf("end before start error = %v", err)
+	}
+	if _, err := Periods(date("2025-03-01"), date("2025-03-02"), "hourly"); err != ErrInvalidGranularity {
+		t.Errorf("bad granularity error = %v", err)
+	}
+	if _, err := Periods(date("2000-01-01"), date("2025-01-01"), Day); err != ErrTooManyPeriods {
+		t.Errorf("long daily series error = %v", err)
+	}
+}
+
+func TestAsOf(t *testing.T) {
+	snapshots := []Snapshot{
+		{Date: date("2025-03-02"), Cents: 100},
+		{Date: date("2025-03-04"), Cents: 250},
+		{Date: date("2025-03-05"), Cents: -50},
+	}
+	dates := []time.Time{date("2025-03-01"), date("2025-03-02"), date("2025-03-03"), date("2025-03-05"), date("2025-03-09")}
+
+	got := AsOf(snapshots, dates)
+	want := []any{nil, int64(100), int64(100), int64(-50), int64(-50)}
+	for i := range dates {
+		switch {
+		case want[i] == nil && got[i] != nil:
+			t.Errorf("%s: got %d, want none", dates[i].Format(recurrence.DateLayout), *got[i])
+		case want[i] != nil && (got[i] == nil || *got[i] != want[i]):
+			t.Errorf("%s: got %v, want %d", dates[i].Format(recurrence.DateLayout), got[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,149 @@
+// Package budget compares what a family planned to spend per category with
+// what it actually spent.
+package budget
+
+import (
+	"cmp"
+	"slices"
+	"time"
+)
+
+// MaxRolloverMonths bounds how many past months rollover looks back over
+const MaxRolloverMonths = 12
+
+// Budget is a category's monthly budget
+type Budget struct {
+	CategoryID int64
+	Cents      int64
+	Rollover   bool      // Whether unspent money carries into the next month
+	StartMonth time.Time // First day of the first budgeted month
+}
+
+// Spend is money moved in a category: positive when spent, negative for
+// refunds. A nil category is uncategorized.
+type Spend struct {
+	CategoryID *int64
+	Date       time.Time
+	Cents      int64
+}
+
+// Line is one category's budget for a month. A nil category is uncategorized.
+type Line struct {
+	CategoryID *int64
+	Budgeted   int64
+	RolledOver int64 // Unspent money carried in from earlier months
+	Available  int64 // Budgeted + RolledOver
+	Planned    int64 // Bills due in the month
+	Spent      int64 // Net of refunds
+	Remaining  int64 // Available - Spent
+}
+
+// PercentUsed is how much of the available money was spent, or false when
+// nothing is available
+func (l Line) PercentUsed() (float64, bool) {
+	if l.Available <= 0 {
+		return 0, false
+	}
+	return float64(l.Spent) * 100 / float64(l.Available), true
+}
+
+// RolloverStart is the first month whose spending a report for month needs
+func RolloverStart(month time.Time) time.Time {
+	return monthStart(month).AddDate(0, -MaxRolloverMonths, 0)
+}
+
+// Report builds the month's line for every category with a budget, planned
+// bills or spending, ordered by category with uncategorized last. spends
+// must cover the month and, for rollover, the months from RolloverStart.
+// Each month with a rollover budget carries what is left into the next;
+// overspending is not carried, so a bad month doesn't eat into the next.
+func Report(month time.Time, budgets []Budget, planned map[int64]int64, plannedUncategorized int64, spends []Spend) []Line {
+	month = monthStart(month)
+
+	// Spending per category and month
+	type key struct {
+		category int64
+		month    time.Time
+	}
+	spent := make(map[key]int64)
+	var uncategorized int64
+	for _, s := range spends {
+		m := monthStart(s.Date)
+		if s.CategoryID == nil {
+			if m.Equal(month) {
+				uncategorized += s.Cents
+			}
+			continue
+		}
+		spent[key{*s.CategoryID, m}] += s.Cents
+	}
+
+	lines := make(map[int64]*Line)
+	line := func(id int64) *Line {
+		l, ok := lines[id]
+		if !ok {
+			l = &Line{CategoryID: &id}
+			lines[id] = l
+		}
+		return l
+	}
+
+	for _, b := range budgets {
+		start := monthStart(b.StartMonth)
+		if month.Before(start) {
+			continue
+		}
+		l := line(b.CategoryID)
+		l.Budgeted = b.Cents
+		if !b.Rollover {
+			continue
+		}
+		if from := RolloverStart(month); start.Before(from) {
+			start = from
+		}
+		for m := start; m.Before(month); m = m.AddDate(0, 1, 0) {
+			l.RolledOver = max(0, l.RolledOver+b.Cents-spent[key{b.CategoryID, m}])
+		}
+	}
+	for id, cents := range planned {
+		line(id).Planned = cents
+	}
+	for k, cents := range spent {
+		if k.month.Equal(month) {
+			line(k.category).Spent = cents
+		}
+	}
+
+	out := make([]Line, 0, len(lines)+1)
+	for _, l := range lines {
+		out = append(out, *l)
+	}
+	slices.SortFunc(out, func(a, b Line) int { return cmp.Compare(*a.CategoryID, *b.CategoryID) })
+	if uncategorized != 0 || plannedUncategorized != 0 {
+		out = append(out, Line{Planned: plannedUncategorized, Spent: uncategorized})
+	}
+
+	for i := range out {
+		out[i].Available = out[i].Budgeted + out[i].RolledOver
+		out[i].Remaining = out[i].Available - out[i].Spent
+	}
+	return out
+}
+
+// Total sums the lines of a report
+func Total(lines []Line) Line {
+	var t Line
+	for _, l := range lines {
+		t.Budgeted += l.Budgeted
+		t.RolledOver += l.RolledOver
+		t.Available += l.Available
+		t.Planned += l.Planned
+		t.Spent += l.Spent
+		t.Remaining += l.Remaining
+	}
+	return t
+}
+
+func monthStart(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
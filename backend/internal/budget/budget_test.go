@@ -0,0 +1,79 @@
+package budget
+
+import (
+	"testing"
+	"time"
+
+	"expenses-backend/internal/recurrence"
+)
+
+func date(s string) time.Time {
+	d, err := recurrence.ParseDate(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+func id(n int64) *int64 { return &n }
+
+func TestReport(t *testing.T) {
+	budgets := []Budget{
+		{CategoryID: 1, Cents: 50000, Rollover: true, StartMonth: date("2025-01-01")},
+		{CategoryID: 2, Cents: 20000, StartMonth: date("2025-01-01")},
+		{CategoryID: 3, Cents: 10000, StartMonth: date("2025-04-01")}, // Not started yet
+	}
+	spends := []Spend{
+		// Groceries: 100.00 left in January, overspent by 50.00 in February
+		{CategoryID: id(1), Date: date("2025-01-10"), Cents: 40000},
+		{CategoryID: id(1), Date: date("2025-02-10"), Cents: 65000},
+		{CategoryID: id(1), Date: date("2025-03-02"), Cents: 30000},
+		{CategoryID: id(1), Date: date("2025-03-09"), Cents: -5000}, // Refund
+		{CategoryID: id(2), Date: date("2025-02-10"), Cents: 1000},
+		{CategoryID: id(2), Date: date("2025-03-15"), Cents: 25000},
+		{CategoryID: nil, Date: date("2025-03-20"), Cents: 1500},
+		{CategoryID: nil, Date: date("2025-02-20"), Cents: 9900},
+	}
+	planned := map[int64]int64{2: 18000, 4: 7500}
+
+	lines := Report(date("2025-03-01"), budgets, planned, 0, spends)
+
+	want := []Line{
+		{CategoryID: id(1), Budgeted: 50000, RolledOver: 0, Available: 50000, Spent: 25000, Remaining: 25000},
+		{CategoryID: id(2), Budgeted: 20000, Available: 20000, Planned: 18000, Spent: 25000, Remaining: -5000},
+		{CategoryID: id(4), Planned: 7500},
+		{Spent: 1500, Remaining: -1500},
+	}
+	if len(lines) != len(want) {
+		t.Fatalf("got %d lines, want %d: %+v", len(lines), len(want), lines)
+	}
+	for i, w := range want {
+		got := lines[i]
+		if (got.CategoryID == nil) != (w.CategoryID == nil) || (got.CategoryID != nil && *got.CategoryID != *w.CategoryID) {
+			t.Fatalf("line %d category = %v, want %v", i, got.CategoryID, w.CategoryID)
+		}
+		got.CategoryID, w.CategoryID = nil, nil
+		if got != w {
+			t.Errorf("line %d = %+v, want %+v", i, got, w)
+		}
+	}
+
+	if pct, ok := lines[0].PercentUsed(); !ok || pct != 50 {
+		t.Errorf("percent used = %v, %v; want 50", pct, ok)
+	}
+	if _, ok := lines[2].PercentUsed(); ok {
+		t.Error("percent used of a line without a budget is set")
+	}
+}
+
+func TestReportRollover(t *testing.T) {
+	budgets := []Budget{{CategoryID: 1, Cents: 10000, Rollover: true, StartMonth: date("2024-01-01")}}
+	spends := []Spend{{CategoryID: id(1), Date: date("2025-02-03"), Cents: 4000}}
+
+	// Nothing spent for a year before February, but rollover only looks back
+	// MaxRolloverMonths: 11 untouched months plus 60.00 from February
+	lines := Report(date("2025-03-01"), budgets, nil, 0, spends)
+	if got, want := lines[0].RolledOver, int64(11*10000+6000); got != want {
+		t.Errorf("rolled over %d, want %d", got, want)
+	}
+}
@@ -0,0 +1,84 @@
+// Package cashflow projects a balance forward from scheduled income and
+// expenses.
+package cashflow
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"expenses-backend/internal/recurrence"
+)
+
+// MaxDays bounds how far ahead a projection can go
+const MaxDays = 366
+
+var ErrInvalidDays = fmt.Errorf("days must be between 1 and %d", MaxDays)
+
+// Event is money scheduled to come in or go out on a day
+type Event struct {
+	Date  time.Time
+	Name  string
+	Cents int64 // Positive for income, negative for expenses
+}
+
+// Day is the projected state at the end of a day
+type Day struct {
+	Date     time.Time
+	Income   int64
+	Expenses int64 // Total going out, as a positive amount
+	Balance  int64
+	Events   []Event
+}
+
+// Warning flags a day that ends below the threshold
+type Warning struct {
+	Date      time.Time
+	Balance   int64
+	Shortfall int64 // How far below the threshold the balance is
+}
+
+// Project applies events to the starting balance day by day for days days
+// from from, and warns about every day that ends below threshold. Events
+// outside the range are ignored.
+func Project(start int64, from time.Time, days int, events []Event, threshold int64) ([]Day, []Warning, error) {
+	if days < 1 || days > MaxDays {
+		return nil, nil, ErrInvalidDays
+	}
+	from = recurrence.Date(from)
+
+	series := make([]Day, days)
+	for i := range series {
+		series[i].Date = from.AddDate(0, 0, i)
+	}
+
+	events = slices.Clone(events)
+	slices.SortStableFunc(events, func(a, b Event) int { return a.Date.Compare(b.Date) })
+	for _, e := range events {
+		i := int(recurrence.Date(e.Date).Sub(from).Hours() / 24)
+		if i < 0 || i >= days {
+			continue
+		}
+		if e.Cents >= 0 {
+			series[i].Income += e.Cents
+		} else {
+			series[i].Expenses -= e.Cents
+		}
+		series[i].Events = append(series[i].Events, e)
+	}
+
+	var warnings []Warning
+	balance := start
+	for i := range series {
+		balance += series[i].Income - series[i].Expenses
+		series[i].Balance = balance
+		if balance < threshold {
+			warnings = append(warnings, Warning{
+				Date:      series[i].Date,
+				Balance:   balance,
+				Shortfall: threshold - balance,
+			})
+		}
+	}
+	return series, warnings, nil
+}
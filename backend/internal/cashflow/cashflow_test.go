@@ -0,0 +1,59 @@
+package cashflow
+
+import (
+	"testing"
+	"time"
+
+	"expenses-backend/internal/recurrence"
+)
+
+func date(s string) time.Time {
+	d, err := recurrence.ParseDate(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+func TestProject(t *testing.T) {
+	events := []Event{
+		{Date: date("2025-03-03"), Name: "Rent", Cents: -120000},
+		{Date: date("2025-03-01"), Name: "Paycheck", Cents: 50000},
+		{Date: date("2025-03-03"), Name: "Power", Cents: -8000},
+		{Date: date("2025-03-04"), Name: "Paycheck", Cents: 100000},
+		{Date: date("2025-02-28"), Name: "Before range", Cents: -1},
+		{Date: date("2025-03-05"), Name: "After range", Cents: -1},
+	}
+
+	days, warnings, err := Project(100000, date("2025-03-01"), 4, events, 10000)
+	if err != nil {
+		t.Fatalf("Project: %v", err)
+	}
+
+	wantBalances := []int64{150000, 150000, 22000, 122000}
+	for i, want := range wantBalances {
+		if days[i].Balance != want {
+			t.Errorf("%s: balance %d, want %d", days[i].Date.Format(recurrence.DateLayout), days[i].Balance, want)
+		}
+	}
+	if days[2].Expenses != 128000 || days[2].Income != 0 || len(days[2].Events) != 2 {
+		t.Errorf("2025-03-03: income %d, expenses %d, %d events", days[2].Income, days[2].Expenses, len(days[2].Events))
+	}
+
+	if len(warnings) != 0 {
+		t.Fatalf("warnings = %v, want none", warnings)
+	}
+
+	_, warnings, _ = Project(100000, date("2025-03-01"), 4, events, 30000)
+	if len(warnings) != 1 || !warnings[0].Date.Equal(date("2025-03-03")) || warnings[0].Shortfall != 8000 {
+		t.Errorf("warnings = %+v, want a shortfall of 8000 on 2025-03-03", warnings)
+	}
+}
+
+func TestProjectDays(t *testing.T) {
+	for _, days := range []int{0, MaxDays + 1} {
+		if _, _, err := Project(0, date("2025-03-01"), days, nil, 0); err != ErrInvalidDays {
+			t.Errorf("Project(%d days) error = %v", days, err)
+		}
+	}
+}
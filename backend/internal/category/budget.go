@@ -0,0 +1,118 @@
+package category
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"expenses-backend/internal/budget"
+	"expenses-backend/internal/database/sql/familydb"
+	"expenses-backend/internal/money"
+	categoryv1 "expenses-backend/pkg/category/v1"
+)
+
+// monthLayout is the YYYY-MM format of budget months
+const monthLayout = "2006-01"
+
+// uncategorizedName names the report line of uncategorized spending
+const uncategorizedName = "Uncategorized"
+
+// budgetReport builds the report for the month starting at first
+func (s *Service) budgetReport(ctx context.Context, familyID int64, q *familydb.Queries, first time.Time) ([]budget.Line, error) {
+	last := first.AddDate(0, 1, -1)
+
+	rows, err := q.ListCategoryBudgets(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list category budgets: %w", err)
+	}
+	budgets := make([]budget.Budget, len(rows))
+	for i, b := range rows {
+		budgets[i] = budget.Budget{
+			CategoryID: b.CategoryID,
+			Cents:      b.AmountCents,
+			Rollover:   b.Rollover,
+			StartMonth: b.StartMonth,
+		}
+	}
+
+	occurrences, err := s.expenseService.Occurrences(ctx, familyID, first, last)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get expense occurrences: %w", err)
+	}
+	planned := make(map[int64]int64)
+	var plannedUncategorized int64
+	for _, o := range occurrences {
+		cents := money.FromFloat(o.Expense.Amount)
+		if o.Expense.CategoryID == nil {
+			plannedUncategorized += cents
+			continue
+		}
+		planned[*o.Expense.CategoryID] += cents
+	}
+
+	transactions, err := q.ListBudgetTransactions(ctx, familydb.ListBudgetTransactionsParams{
+		StartDate: budget.RolloverStart(first),
+		EndDate:   last.AddDate(0, 0, 1),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list transactions: %w", err)
+	}
+	spends := make([]budget.Spend, len(transactions))
+	for i, t := range transactions {
+		// Outflows are negative, so spending is the amount negated
+		spends[i] = budget.Spend{CategoryID: t.CategoryID, Date: t.PostedDate, Cents: -t.AmountCents}
+	}
+
+	return budget.Report(first, budgets, planned, plannedUncategorized, spends), nil
+}
+
+func convertToProtoBudget(b *familydb.CategoryBudget) *categoryv1.CategoryBudget {
+	return &categoryv1.CategoryBudget{
+		CategoryId: b.CategoryID,
+		Amount:     money.FormatCents(b.AmountCents),
+		Rollover:   b.Rollover,
+		StartMonth: b.StartMonth.Format(monthLayout),
+		UpdatedAt:  b.UpdatedAt.Unix(),
+	}
+}
+
+func convertToProtoBudgetLine(l budget.Line, name string) *categoryv1.BudgetLine {
+	pb := &categoryv1.BudgetLine{
+		CategoryId: l.CategoryID,
+		Name:       name,
+		Budgeted:   money.FormatCents(l.Budgeted),
+		RolledOver: money.FormatCents(l.RolledOver),
+		Available:  money.FormatCents(l.Available),
+		Planned:    money.FormatCents(l.Planned),
+		Spent:      money.FormatCents(l.Spent),
+		Remaining:  money.FormatCents(l.Remaining),
+	}
+	if pct, ok := l.PercentUsed(); ok {
+		pb.PercentUsed = &pct
+	}
+	return pb
+}
+
+// budgetLineName names a report line, falling back for categories that no
+// longer exist
+func budgetLineName(l budget.Line, names map[int64]string) string {
+	if l.CategoryID == nil {
+		return uncategorizedName
+	}
+	if name, ok := names[*l.CategoryID]; ok {
+		return name
+	}
+	return fmt.Sprintf("Category %d", *l.CategoryID)
+}
+
+// compareBudgetLines orders lines by name, keeping uncategorized last
+func compareBudgetLines(a, b *categoryv1.BudgetLine) int {
+	if (a.CategoryId == nil) != (b.CategoryId == nil) {
+		if a.CategoryId == nil {
+			return 1
+		}
+		return -1
+	}
+	return strings.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name))
+}
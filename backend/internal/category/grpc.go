@@ -0,0 +1,374 @@
+package category
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"expenses-backend/internal/budget"
+	appcontext "expenses-backend/internal/context"
+	"expenses-backend/internal/database/sql/familydb"
+	"expenses-backend/internal/logger"
+	"expenses-backend/internal/money"
+	"expenses-backend/internal/recurrence"
+	categoryv1 "expenses-backend/pkg/category/v1"
+
+	"connectrpc.com/connect"
+)
+
+func (s *Service) CreateCategory(ctx context.Context, req *connect.Request[categoryv1.CreateCategoryRequest]) (*connect.Response[categoryv1.CreateCategoryResponse], error) {
+	authCtx, err := appcontext.RequireFamily(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	name := strings.TrimSpace(req.Msg.Name)
+	if name == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name is required"))
+	}
+
+	queries, err := s.dbManager.GetFamilyQueries(int(authCtx.FamilyID))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to access family database"))
+	}
+
+	now := time.Now()
+	category, err := queries.CreateCategory(ctx, familydb.CreateCategoryParams{
+		Name:        name,
+		Description: req.Msg.Description,
+		Color:       req.Msg.Color,
+		Icon:        req.Msg.Icon,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	})
+	if err != nil {
+		s.logger.Error("Failed to create category", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create category"))
+	}
+
+	s.logger.Info("Category created successfully",
+		logger.Int64("category_id", category.ID),
+		logger.Int64("family_id", authCtx.FamilyID))
+
+	return connect.NewResponse(&categoryv1.CreateCategoryResponse{
+		Category: convertToProtoCategory(category),
+	}), nil
+}
+
+func (s *Service) GetCategory(ctx context.Context, req *connect.Request[categoryv1.GetCategoryRequest]) (*connect.Response[categoryv1.GetCategoryResponse], error) {
+	authCtx, err := appcontext.RequireFamily(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Msg.Id == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("id is required"))
+	}
+
+	queries, err := s.dbManager.GetFamilyQueries(int(authCtx.FamilyID))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to access family database"))
+	}
+
+	category, err := s.getCategory(ctx, queries, req.Msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&categoryv1.GetCategoryResponse{
+		Category: convertToProtoCategory(category),
+	}), nil
+}
+
+func (s *Service) UpdateCategory(ctx context.Context, req *connect.Request[categoryv1.UpdateCategoryRequest]) (*connect.Response[categoryv1.UpdateCategoryResponse], error) {
+	authCtx, err := appcontext.RequireFamily(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Msg.Id == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("id is required"))
+	}
+
+	queries, err := s.dbManager.GetFamilyQueries(int(authCtx.FamilyID))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to access family database"))
+	}
+
+	current, err := s.getCategory(ctx, queries, req.Msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	updateParams := familydb.UpdateCategoryParams{
+		ID:          current.ID,
+		Name:        current.Name,
+		Description: current.Description,
+		Color:       current.Color,
+		Icon:        current.Icon,
+		UpdatedAt:   time.Now(),
+	}
+
+	if name := strings.TrimSpace(req.Msg.Name); name != "" {
+		updateParams.Name = name
+	}
+	if req.Msg.Description != nil {
+		updateParams.Description = req.Msg.Description
+	}
+	if req.Msg.Color != nil {
+		updateParams.Color = req.Msg.Color
+	}
+	if req.Msg.Icon != nil {
+		updateParams.Icon = req.Msg.Icon
+	}
+
+	category, err := queries.UpdateCategory(ctx, updateParams)
+	if err != nil {
+		s.logger.Error("Failed to update category", err, logger.Int64("category_id", req.Msg.Id))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update category"))
+	}
+
+	return connect.NewResponse(&categoryv1.UpdateCategoryResponse{
+		Category: convertToProtoCategory(category),
+	}), nil
+}
+
+func (s *Service) DeleteCategory(ctx context.Context, req *connect.Request[categoryv1.DeleteCategoryRequest]) (*connect.Response[categoryv1.DeleteCategoryResponse], error) {
+	authCtx, err := appcontext.RequireFamily(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Msg.Id == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("id is required"))
+	}
+
+	queries, err := s.dbManager.GetFamilyQueries(int(authCtx.FamilyID))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to access family database"))
+	}
+
+	if _, err := s.getCategory(ctx, queries, req.Msg.Id); err != nil {
+		return nil, err
+	}
+
+	// Anything using the category must be explicitly moved or uncategorized
+	var (
+		newCategoryID *int64
+		chosen        bool
+	)
+	switch action := req.Msg.ExpenseAction.(type) {
+	case *categoryv1.DeleteCategoryRequest_ReassignToCategoryId:
+		if action.ReassignToCategoryId == req.Msg.Id {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("cannot reassign to the category being deleted"))
+		}
+		if _, err := s.getCategory(ctx, queries, action.ReassignToCategoryId); err != nil {
+			return nil, err
+		}
+		newCategoryID = &action.ReassignToCategoryId
+		chosen = true
+	case *categoryv1.DeleteCategoryRequest_Uncategorize:
+		chosen = action.Uncategorize
+	}
+
+	usage, err := s.deleteCategory(ctx, authCtx.FamilyID, req.Msg.Id, newCategoryID, chosen)
+	if err != nil {
+		var connectErr *connect.Error
+		if errors.As(err, &connectErr) {
+			return nil, connectErr
+		}
+		s.logger.Error("Failed to delete category", err, logger.Int64("category_id", req.Msg.Id))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to delete category"))
+	}
+
+	s.logger.Info("Category deleted successfully",
+		logger.Int64("category_id", req.Msg.Id),
+		logger.Int64("affected_expenses", usage.Expenses),
+		logger.Int64("affected_transactions", usage.Transactions),
+		logger.Bool("budget_deleted", usage.HasBudget),
+		logger.Int64("user_id", authCtx.UserID))
+
+	return connect.NewResponse(&categoryv1.DeleteCategoryResponse{
+		Success:              true,
+		AffectedExpenses:     usage.Expenses,
+		AffectedTransactions: usage.Transactions,
+		AffectedSplits:       usage.Splits,
+		AffectedRules:        usage.Rules,
+		ClearedSuggestions:   usage.Suggestions,
+		BudgetDeleted:        usage.HasBudget,
+	}), nil
+}
+
+func (s *Service) ListCategories(ctx context.Context, req *connect.Request[categoryv1.ListCategoriesRequest]) (*connect.Response[categoryv1.ListCategoriesResponse], error) {
+	authCtx, err := appcontext.RequireFamily(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	queries, err := s.dbManager.GetFamilyQueries(int(authCtx.FamilyID))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to access family database"))
+	}
+
+	categories, err := queries.ListCategories(ctx)
+	if err != nil {
+		s.logger.Error("Failed to list categories", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list categories"))
+	}
+
+	resp := make([]*categoryv1.Category, 0, len(categories))
+	for _, c := range categories {
+		resp = append(resp, convertToProtoCategory(c))
+	}
+
+	return connect.NewResponse(&categoryv1.ListCategoriesResponse{
+		Categories: resp,
+	}), nil
+}
+
+func (s *Service) SetCategoryBudget(ctx context.Context, req *connect.Request[categoryv1.SetCategoryBudgetRequest]) (*connect.Response[categoryv1.SetCategoryBudgetResponse], error) {
+	authCtx, err := appcontext.RequireFamily(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Msg.CategoryId == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("category_id is required"))
+	}
+	cents, err := money.ParseCents(req.Msg.Amount)
+	if err != nil || cents < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("amount must be a non-negative decimal amount"))
+	}
+
+	now := time.Now()
+	startMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	if req.Msg.StartMonth != nil {
+		if startMonth, _, err = recurrence.ParseMonth(*req.Msg.StartMonth); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("start_month must be YYYY-MM"))
+		}
+	}
+
+	queries, err := s.dbManager.GetFamilyQueries(int(authCtx.FamilyID))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to access family database"))
+	}
+
+	if _, err := s.getCategory(ctx, queries, req.Msg.CategoryId); err != nil {
+		return nil, err
+	}
+
+	b, err := queries.UpsertCategoryBudget(ctx, familydb.UpsertCategoryBudgetParams{
+		CategoryID:  req.Msg.CategoryId,
+		AmountCents: cents,
+		Rollover:    req.Msg.Rollover,
+		StartMonth:  startMonth,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	})
+	if err != nil {
+		s.logger.Error("Failed to set category budget", err, logger.Int64("category_id", req.Msg.CategoryId))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to set category budget"))
+	}
+
+	return connect.NewResponse(&categoryv1.SetCategoryBudgetResponse{
+		Budget: convertToProtoBudget(b),
+	}), nil
+}
+
+func (s *Service) DeleteCategoryBudget(ctx context.Context, req *connect.Request[categoryv1.DeleteCategoryBudgetRequest]) (*connect.Response[categoryv1.DeleteCategoryBudgetResponse], error) {
+	authCtx, err := appcontext.RequireFamily(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Msg.CategoryId == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("category_id is required"))
+	}
+
+	queries, err := s.dbManager.GetFamilyQueries(int(authCtx.FamilyID))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to access family database"))
+	}
+
+	if err := queries.DeleteCategoryBudget(ctx, req.Msg.CategoryId); err != nil {
+		s.logger.Error("Failed to delete category budget", err, logger.Int64("category_id", req.Msg.CategoryId))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to delete category budget"))
+	}
+
+	return connect.NewResponse(&categoryv1.DeleteCategoryBudgetResponse{Success: true}), nil
+}
+
+func (s *Service) ListCategoryBudgets(ctx context.Context, req *connect.Request[categoryv1.ListCategoryBudgetsRequest]) (*connect.Response[categoryv1.ListCategoryBudgetsResponse], error) {
+	authCtx, err := appcontext.RequireFamily(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	queries, err := s.dbManager.GetFamilyQueries(int(authCtx.FamilyID))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to access family database"))
+	}
+
+	budgets, err := queries.ListCategoryBudgets(ctx)
+	if err != nil {
+		s.logger.Error("Failed to list category budgets", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list category budgets"))
+	}
+
+	resp := make([]*categoryv1.CategoryBudget, 0, len(budgets))
+	for _, b := range budgets {
+		resp = append(resp, convertToProtoBudget(b))
+	}
+
+	return connect.NewResponse(&categoryv1.ListCategoryBudgetsResponse{
+		Budgets: resp,
+	}), nil
+}
+
+func (s *Service) GetBudgetReport(ctx context.Context, req *connect.Request[categoryv1.GetBudgetReportRequest]) (*connect.Response[categoryv1.GetBudgetReportResponse], error) {
+	authCtx, err := appcontext.RequireFamily(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	first, _, err := recurrence.ParseMonth(req.Msg.Month)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("month must be YYYY-MM"))
+	}
+
+	queries, err := s.dbManager.GetFamilyQueries(int(authCtx.FamilyID))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to access family database"))
+	}
+
+	categories, err := queries.ListCategories(ctx)
+	if err != nil {
+		s.logger.Error("Failed to list categories", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get budget report"))
+	}
+	names := make(map[int64]string, len(categories))
+	for _, c := range categories {
+		names[c.ID] = c.Name
+	}
+
+	lines, err := s.budgetReport(ctx, authCtx.FamilyID, queries, first)
+	if err != nil {
+		s.logger.Error("Failed to build budget report", err, logger.Int64("family_id", authCtx.FamilyID))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get budget report"))
+	}
+
+	resp := &categoryv1.GetBudgetReportResponse{
+		Month: first.Format(monthLayout),
+		Lines: make([]*categoryv1.BudgetLine, len(lines)),
+		Total: convertToProtoBudgetLine(budget.Total(lines), "Total"),
+	}
+	for i, l := range lines {
+		resp.Lines[i] = convertToProtoBudgetLine(l, budgetLineName(l, names))
+	}
+	slices.SortStableFunc(resp.Lines, compareBudgetLines)
+
+	return connect.NewResponse(resp), nil
+}
@@ -0,0 +1,197 @@
+package category
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"expenses-backend/internal/database"
+	"expenses-backend/internal/database/sql/familydb"
+	"expenses-backend/internal/expense"
+	"expenses-backend/internal/logger"
+	categoryv1 "expenses-backend/pkg/category/v1"
+
+	"connectrpc.com/connect"
+)
+
+// Service handles category management for a family
+type Service struct {
+	dbManager      *database.DatabaseManager
+	expenseService *expense.Service
+	logger         logger.Logger
+}
+
+// NewService creates a new category service
+func NewService(dbManager *database.DatabaseManager, expenseService *expense.Service, log logger.Logger) *Service {
+	return &Service{
+		dbManager:      dbManager,
+		expenseService: expenseService,
+		logger:         log.With(logger.Str("component", "category-service")),
+	}
+}
+
+// categoryUsage counts the rows that still reference a category
+type categoryUsage struct {
+	Expenses     int64
+	Transactions int64
+	Splits       int64
+	Rules        int64
+	Suggestions  int64
+	HasBudget    bool
+}
+
+// references is the number of rows a delete would move or clear. The budget
+// is not counted: it belongs to the category and is deleted with it.
+func (u *categoryUsage) references() int64 {
+	return u.Expenses + u.Transactions + u.Splits + u.Rules + u.Suggestions
+}
+
+// String describes the non-zero counts, e.g. "2 expenses, 1 rule"
+func (u *categoryUsage) String() string {
+	var parts []string
+	for _, c := range []struct {
+		n    int64
+		noun string
+	}{
+		{u.Expenses, "expense"},
+		{u.Transactions, "transaction"},
+		{u.Splits, "split allocation"},
+		{u.Rules, "rule"},
+		{u.Suggestions, "suggestion"},
+	} {
+		switch {
+		case c.n == 1:
+			parts = append(parts, fmt.Sprintf("1 %s", c.noun))
+		case c.n > 1:
+			parts = append(parts, fmt.Sprintf("%d %ss", c.n, c.noun))
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// getCategoryUsage counts everything that references a category
+func getCategoryUsage(ctx context.Context, q *familydb.Queries, categoryID int64) (*categoryUsage, error) {
+	var (
+		usage categoryUsage
+		err   error
+	)
+	if usage.Expenses, err = q.CountExpensesByCategory(ctx, &categoryID); err != nil {
+		return nil, fmt.Errorf("failed to count expenses: %w", err)
+	}
+	if usage.Transactions, err = q.CountTransactionsByCategory(ctx, &categoryID); err != nil {
+		return nil, fmt.Errorf("failed to count transactions: %w", err)
+	}
+	if usage.Splits, err = q.CountSplitsByCategory(ctx, &categoryID); err != nil {
+		return nil, fmt.Errorf("failed to count transaction splits: %w", err)
+	}
+	if usage.Rules, err = q.CountRulesByCategory(ctx, &categoryID); err != nil {
+		return nil, fmt.Errorf("failed to count rules: %w", err)
+	}
+	if usage.Suggestions, err = q.CountCategorySuggestions(ctx, &categoryID); err != nil {
+		return nil, fmt.Errorf("failed to count category suggestions: %w", err)
+	}
+	if _, err := q.GetCategoryBudget(ctx, categoryID); err == nil {
+		usage.HasBudget = true
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("failed to get category budget: %w", err)
+	}
+	return &usage, nil
+}
+
+// deleteCategory removes a category and its budget. Anything else that
+// references it is moved to newCategoryID (nil leaves it uncategorized), which
+// the caller must have chosen explicitly: without a choice the delete fails
+// with FailedPrecondition while references remain. Returns what was moved.
+func (s *Service) deleteCategory(ctx context.Context, familyID int64, categoryID int64, newCategoryID *int64, chosen bool) (*categoryUsage, error) {
+	var usage *categoryUsage
+
+	err := s.dbManager.WithFamilyTx(ctx, int(familyID), func(q *familydb.Queries) error {
+		var err error
+		usage, err = getCategoryUsage(ctx, q, categoryID)
+		if err != nil {
+			return err
+		}
+		if !chosen && usage.references() > 0 {
+			return connect.NewError(connect.CodeFailedPrecondition,
+				fmt.Errorf("category is used by %s: set reassign_to_category_id or uncategorize", usage))
+		}
+
+		if _, err := q.ReassignExpensesCategory(ctx, familydb.ReassignExpensesCategoryParams{
+			NewCategoryID: newCategoryID,
+			OldCategoryID: &categoryID,
+		}); err != nil {
+			return fmt.Errorf("failed to reassign expenses: %w", err)
+		}
+
+		// Transactions and their allocations follow the expenses to the new category
+		if _, err := q.ReassignTransactionsCategory(ctx, familydb.ReassignTransactionsCategoryParams{
+			NewCategoryID: newCategoryID,
+			OldCategoryID: &categoryID,
+		}); err != nil {
+			return fmt.Errorf("failed to reassign transactions: %w", err)
+		}
+		if err := q.ReassignSplitsCategory(ctx, familydb.ReassignSplitsCategoryParams{
+			NewCategoryID: newCategoryID,
+			OldCategoryID: &categoryID,
+		}); err != nil {
+			return fmt.Errorf("failed to reassign transaction splits: %w", err)
+		}
+
+		if err := q.ClearCategorySuggestions(ctx, &categoryID); err != nil {
+			return fmt.Errorf("failed to clear category suggestions: %w", err)
+		}
+
+		// Rules follow too
+		if err := q.ReassignRulesCategory(ctx, familydb.ReassignRulesCategoryParams{
+			NewCategoryID: newCategoryID,
+			OldCategoryID: &categoryID,
+		}); err != nil {
+			return fmt.Errorf("failed to reassign rules: %w", err)
+		}
+
+		if usage.HasBudget {
+			if err := q.DeleteCategoryBudget(ctx, categoryID); err != nil {
+				return fmt.Errorf("failed to delete category budget: %w", err)
+			}
+		}
+
+		if err := q.DeleteCategory(ctx, categoryID); err != nil {
+			return fmt.Errorf("failed to delete category: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return usage, nil
+}
+
+// getCategory loads a category, mapping a missing row to a NotFound error
+func (s *Service) getCategory(ctx context.Context, queries *familydb.Queries, id int64) (*familydb.Category, error) {
+	category, err := queries.GetCategoryByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("category %d not found", id))
+		}
+		s.logger.Error("Failed to get category", err, logger.Int64("category_id", id))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get category"))
+	}
+	return category, nil
+}
+
+// convertToProtoCategory converts SQLC category to protobuf category
+func convertToProtoCategory(c *familydb.Category) *categoryv1.Category {
+	return &categoryv1.Category{
+		Id:          c.ID,
+		Name:        c.Name,
+		Description: c.Description,
+		Color:       c.Color,
+		Icon:        c.Icon,
+		CreatedAt:   c.CreatedAt.Unix(),
+		UpdatedAt:   c.UpdatedAt.Unix(),
+	}
+}
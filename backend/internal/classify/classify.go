@@ -0,0 +1,149 @@
+// Package classify suggests transaction categories with a naive Bayes model
+// trained on a family's own categorized transactions.
+package classify
+
+import (
+	"math"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+const (
+	// MinExamples is how many categorized transactions a model needs before
+	// it makes suggestions
+	MinExamples = 10
+
+	// DefaultThreshold is the confidence at or above which a suggestion is
+	// applied automatically
+	DefaultThreshold = 0.9
+)
+
+// noise are words banks add to descriptions that say nothing about the
+// category
+var noise = map[string]bool{
+	"ach": true, "debit": true, "credit": true, "pos": true, "purchase": true, "card": true,
+	"payment": true, "pmt": true, "recurring": true, "online": true, "web": true, "id": true,
+	"ref": true, "the": true, "and": true, "inc": true, "llc": true, "co": true, "com": true,
+	"www": true, "withdrawal": true, "deposit": true, "transfer": true, "xfer": true,
+}
+
+// Tokens normalizes transaction text into the distinct words the model uses.
+// Words are lowercased; single letters, words containing digits (card, store
+// and reference numbers) and common bank noise are dropped.
+func Tokens(text string) []string {
+	fields := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	seen := make(map[string]bool, len(fields))
+	var tokens []string
+	for _, f := range fields {
+		if len([]rune(f)) < 2 || noise[f] || seen[f] || strings.ContainsFunc(f, unicode.IsDigit) {
+			continue
+		}
+		seen[f] = true
+		tokens = append(tokens, f)
+	}
+	return tokens
+}
+
+// Example is a categorized transaction to learn from
+type Example struct {
+	Text       string // Payee and description
+	CategoryID int64
+}
+
+// class is what the model knows about one category
+type class struct {
+	examples int
+	tokens   map[string]int
+	total    int // Sum of tokens
+}
+
+// Model is a multinomial naive Bayes model over binary token counts with
+// Laplace smoothing
+type Model struct {
+	classes  map[int64]*class
+	vocab    map[string]bool
+	examples int
+}
+
+// Train builds a model from the examples. Examples without tokens are skipped.
+func Train(examples []Example) *Model {
+	m := &Model{classes: make(map[int64]*class), vocab: make(map[string]bool)}
+	for _, e := range examples {
+		tokens := Tokens(e.Text)
+		if len(tokens) == 0 {
+			continue
+		}
+		c, ok := m.classes[e.CategoryID]
+		if !ok {
+			c = &class{tokens: make(map[string]int)}
+			m.classes[e.CategoryID] = c
+		}
+		c.examples++
+		for _, t := range tokens {
+			c.tokens[t]++
+			c.total++
+			m.vocab[t] = true
+		}
+		m.examples++
+	}
+	return m
+}
+
+// Examples is how many examples the model learned from
+func (m *Model) Examples() int {
+	return m.examples
+}
+
+// Prediction is a suggested category
+type Prediction struct {
+	CategoryID int64
+	Confidence float64 // Posterior probability, 0 to 1
+}
+
+// Predict suggests a category for the text. It returns false when the model
+// has fewer than MinExamples examples or two categories to choose from, or
+// when none of the text's words were seen in training.
+func (m *Model) Predict(text string) (Prediction, bool) {
+	if m.examples < MinExamples || len(m.classes) < 2 {
+		return Prediction{}, false
+	}
+
+	var known []string
+	for _, t := range Tokens(text) {
+		if m.vocab[t] {
+			known = append(known, t)
+		}
+	}
+	if len(known) == 0 {
+		return Prediction{}, false
+	}
+
+	ids := make([]int64, 0, len(m.classes))
+	for id := range m.classes {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+
+	vocab := float64(len(m.vocab))
+	scores := make([]float64, len(ids))
+	for i, id := range ids {
+		c := m.classes[id]
+		score := math.Log(float64(c.examples) / float64(m.examples))
+		for _, t := range known {
+			score += math.Log((float64(c.tokens[t]) + 1) / (float64(c.total) + vocab))
+		}
+		scores[i] = score
+	}
+
+	// Normalize the log scores into probabilities without overflowing
+	best := slices.Index(scores, slices.Max(scores))
+	var sum float64
+	for _, s := range scores {
+		sum += math.Exp(s - scores[best])
+	}
+	return Prediction{CategoryID: ids[best], Confidence: 1 / sum}, true
+}
@@ -0,0 +1,73 @@
+package classify
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTokens(t *testing.T) {
+	tests := []struct {
+		text string
+		want []string
+	}{
+		{"ACH DEBIT COMCAST 8774", []string{"comcast"}},
+		{"SQ *BLUE BOTTLE COFFEE #0123 Oakland CA", []string{"sq", "blue", "bottle", "coffee", "oakland", "ca"}},
+		{"Trader Joe's TRADER JOE'S", []string{"trader", "joe"}},
+		{"POS PURCHASE 12/03 X", nil},
+	}
+	for _, tt := range tests {
+		if got := Tokens(tt.text); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Tokens(%q) = %q, want %q", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestPredict(t *testing.T) {
+	const groceries, dining, utilities = 1, 2, 3
+	var examples []Example
+	for range 4 {
+		examples = append(examples,
+			Example{"SAFEWAY #1234 OAKLAND", groceries},
+			Example{"TRADER JOE'S #552", groceries},
+			Example{"BLUE BOTTLE COFFEE", dining},
+			Example{"CHIPOTLE ONLINE", dining},
+			Example{"PG&E WEB ONLINE PAYMENT", utilities},
+		)
+	}
+	m := Train(examples)
+
+	p, ok := m.Predict("SAFEWAY FUEL #99")
+	if !ok || p.CategoryID != groceries {
+		t.Fatalf("Predict = %+v, %v; want groceries", p, ok)
+	}
+
+	// More known words make for a more confident guess
+	strong, ok := m.Predict("SAFEWAY #1234 OAKLAND")
+	if !ok || strong.CategoryID != groceries || strong.Confidence <= p.Confidence || strong.Confidence > 1 {
+		t.Errorf("Predict = %+v, %v; want groceries more confident than %v", strong, ok, p.Confidence)
+	}
+
+	// Words from two categories make for a less confident one
+	if mixed, ok := m.Predict("SAFEWAY COFFEE"); !ok || mixed.Confidence >= p.Confidence {
+		t.Errorf("mixed Predict = %+v, %v; want less confident than %v", mixed, ok, p.Confidence)
+	}
+
+	if _, ok := m.Predict("UNKNOWN MERCHANT"); ok {
+		t.Error("Predict of unseen words suggested a category")
+	}
+}
+
+func TestPredictNeedsData(t *testing.T) {
+	few := Train([]Example{{"SAFEWAY", 1}, {"CHIPOTLE", 2}})
+	if _, ok := few.Predict("SAFEWAY"); ok {
+		t.Error("model with too few examples suggested a category")
+	}
+
+	var single []Example
+	for range MinExamples {
+		single = append(single, Example{"SAFEWAY", 1})
+	}
+	if _, ok := Train(single).Predict("SAFEWAY"); ok {
+		t.Error("model with one category suggested it")
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"expenses-backend/internal/database/turso"
 	"expenses-backend/internal/logger"
 	"fmt"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -68,6 +69,19 @@ func (dm *DatabaseManager) GetFamilyDB(familyID int) (*sql.DB, error) {
 	return db, nil
 }
 
+// FamilyIDs returns the IDs of the family databases currently loaded
+func (dm *DatabaseManager) FamilyIDs() []int64 {
+	dm.mu.RLock()
+	defer dm.mu.RUnlock()
+
+	ids := make([]int64, 0, len(dm.familyQueries))
+	for id := range dm.familyQueries {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	return ids
+}
+
 func (dm *DatabaseManager) GetFamilyQueries(familyID int) (*familydb.Queries, error) {
 	dm.mu.RLock()
 	defer dm.mu.RUnlock()
@@ -0,0 +1,8 @@
+-- Description: Add recurrence rules to expenses so non-monthly bills can be scheduled
+
+ALTER TABLE expenses ADD COLUMN frequency TEXT NOT NULL DEFAULT 'monthly'
+    CHECK (frequency IN ('weekly', 'biweekly', 'monthly', 'quarterly', 'semiannual', 'annual'));
+ALTER TABLE expenses ADD COLUMN interval_count INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE expenses ADD COLUMN anchor_date TIMESTAMP; -- First due date of the series, NULL means day_of_month_due every month
+ALTER TABLE expenses ADD COLUMN end_date TIMESTAMP; -- Optional last possible due date
+ALTER TABLE expenses ADD COLUMN max_occurrences INTEGER; -- Optional number of payments
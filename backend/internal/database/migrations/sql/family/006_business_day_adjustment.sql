@@ -0,0 +1,4 @@
+-- Description: Let expenses move due dates that land on weekends or holidays to a business day
+
+ALTER TABLE expenses ADD COLUMN business_day_adjustment TEXT NOT NULL DEFAULT 'none'
+    CHECK (business_day_adjustment IN ('none', 'previous', 'next'));
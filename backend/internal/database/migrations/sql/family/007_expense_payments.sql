@@ -0,0 +1,18 @@
+-- Description: Track payments of individual expense occurrences
+
+CREATE TABLE IF NOT EXISTS expense_payments (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    expense_id INTEGER NOT NULL REFERENCES expenses(id) ON DELETE CASCADE,
+    scheduled_date TIMESTAMP NOT NULL, -- Occurrence the payment is for, before business day adjustment
+    status TEXT NOT NULL CHECK (status IN ('paid', 'unpaid')), -- 'unpaid' overrides autopay marking
+    paid_date TIMESTAMP,
+    amount DECIMAL(10, 2),
+    paid_by INTEGER REFERENCES family_members(id),
+    note TEXT,
+    is_automatic BOOLEAN NOT NULL DEFAULT FALSE, -- Marked paid by autopay rather than a person
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (expense_id, scheduled_date)
+);
+
+CREATE INDEX IF NOT EXISTS idx_expense_payments_scheduled_date ON expense_payments(scheduled_date);
@@ -0,0 +1,23 @@
+-- Description: Store transaction amounts and match bank transactions to expense occurrences
+
+ALTER TABLE transactions ADD COLUMN amount_cents INTEGER NOT NULL DEFAULT 0; -- Negative for money leaving the account
+ALTER TABLE transactions ADD COLUMN matched_expense_id INTEGER REFERENCES expenses(id) ON DELETE SET NULL;
+ALTER TABLE transactions ADD COLUMN matched_scheduled_date TIMESTAMP;
+
+ALTER TABLE expense_payments ADD COLUMN transaction_id INTEGER REFERENCES transactions(id) ON DELETE SET NULL;
+
+-- Matches found by the matcher; pending rows form the review queue
+CREATE TABLE IF NOT EXISTS transaction_matches (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    transaction_id INTEGER NOT NULL REFERENCES transactions(id) ON DELETE CASCADE,
+    expense_id INTEGER NOT NULL REFERENCES expenses(id) ON DELETE CASCADE,
+    scheduled_date TIMESTAMP NOT NULL,
+    score REAL NOT NULL,
+    status TEXT NOT NULL CHECK (status IN ('pending', 'accepted', 'rejected')),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (transaction_id, expense_id, scheduled_date)
+);
+
+CREATE INDEX IF NOT EXISTS idx_transactions_posted_date ON transactions(posted_date);
+CREATE INDEX IF NOT EXISTS idx_transaction_matches_status ON transaction_matches(status, created_at);
@@ -0,0 +1,26 @@
+-- Description: Sync SimpleFIN transactions in the background with per-account cursors
+
+ALTER TABLE transactions ADD COLUMN external_id TEXT; -- SimpleFIN transaction ID
+ALTER TABLE transactions ADD COLUMN pending BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE INDEX IF NOT EXISTS idx_transactions_account_external_id ON transactions(account_id, external_id);
+
+-- One row per linked account describing its last sync
+CREATE TABLE IF NOT EXISTS account_sync_state (
+    account_id INTEGER PRIMARY KEY REFERENCES accounts(id) ON DELETE CASCADE,
+    sync_cursor TIMESTAMP, -- Transactions up to this time have been fetched
+    last_attempt_at TIMESTAMP,
+    last_success_at TIMESTAMP,
+    last_error TEXT,
+    transactions_added INTEGER NOT NULL DEFAULT 0 -- New transactions in the last successful sync
+);
+
+-- Errors reported by SimpleFIN or raised while syncing
+CREATE TABLE IF NOT EXISTS sync_errors (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    account_id INTEGER REFERENCES accounts(id) ON DELETE CASCADE, -- NULL for connection-wide errors
+    message TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_sync_errors_created_at ON sync_errors(created_at);
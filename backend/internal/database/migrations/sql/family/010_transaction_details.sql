@@ -0,0 +1,9 @@
+-- Description: Let transactions be categorized and annotated, and index them for listing
+
+ALTER TABLE transactions ADD COLUMN category_id INTEGER REFERENCES categories(id);
+ALTER TABLE transactions ADD COLUMN note TEXT;
+
+-- Replaces the posted_date index from 008, which cannot break ties by id
+DROP INDEX IF EXISTS idx_transactions_posted_date;
+CREATE INDEX IF NOT EXISTS idx_transactions_posted_date_id ON transactions(posted_date, id);
+CREATE INDEX IF NOT EXISTS idx_transactions_category_id ON transactions(category_id);
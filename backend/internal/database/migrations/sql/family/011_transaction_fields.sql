@@ -0,0 +1,49 @@
+-- Description: Store the remaining SimpleFIN transaction fields and make the SimpleFIN ID unique per account
+
+-- amount_cents (exact integer cents), external_id and pending were added by
+-- earlier migrations
+ALTER TABLE transactions ADD COLUMN transacted_at TIMESTAMP;
+
+-- Earlier syncs could store a SimpleFIN transaction more than once. Fold the
+-- copies into the oldest one, keeping any category, note, expense link,
+-- payment or match made on a copy, before the ID becomes unique.
+UPDATE transactions SET
+    category_id = COALESCE(category_id, (SELECT d.category_id FROM transactions d WHERE d.account_id = transactions.account_id AND d.external_id = transactions.external_id AND d.category_id IS NOT NULL ORDER BY d.id LIMIT 1)),
+    note = COALESCE(note, (SELECT d.note FROM transactions d WHERE d.account_id = transactions.account_id AND d.external_id = transactions.external_id AND d.note IS NOT NULL ORDER BY d.id LIMIT 1)),
+    matched_expense_id = COALESCE(matched_expense_id, (SELECT d.matched_expense_id FROM transactions d WHERE d.account_id = transactions.account_id AND d.external_id = transactions.external_id AND d.matched_expense_id IS NOT NULL ORDER BY d.id LIMIT 1)),
+    matched_scheduled_date = CASE WHEN matched_expense_id IS NULL THEN (SELECT d.matched_scheduled_date FROM transactions d WHERE d.account_id = transactions.account_id AND d.external_id = transactions.external_id AND d.matched_expense_id IS NOT NULL ORDER BY d.id LIMIT 1) ELSE matched_scheduled_date END
+WHERE id IN (
+    SELECT MIN(id) FROM transactions
+    WHERE external_id IS NOT NULL
+    GROUP BY account_id, external_id
+    HAVING COUNT(*) > 1
+);
+
+UPDATE expense_payments SET transaction_id = (SELECT MIN(k.id) FROM transactions k JOIN transactions t ON k.account_id = t.account_id AND k.external_id = t.external_id WHERE t.id = expense_payments.transaction_id)
+WHERE transaction_id IN (
+    SELECT t.id FROM transactions t
+    WHERE t.external_id IS NOT NULL
+      AND t.id > (SELECT MIN(k.id) FROM transactions k WHERE k.account_id = t.account_id AND k.external_id = t.external_id)
+);
+
+UPDATE OR IGNORE transaction_matches SET transaction_id = (SELECT MIN(k.id) FROM transactions k JOIN transactions t ON k.account_id = t.account_id AND k.external_id = t.external_id WHERE t.id = transaction_matches.transaction_id)
+WHERE transaction_id IN (
+    SELECT t.id FROM transactions t
+    WHERE t.external_id IS NOT NULL
+      AND t.id > (SELECT MIN(k.id) FROM transactions k WHERE k.account_id = t.account_id AND k.external_id = t.external_id)
+);
+
+DELETE FROM transaction_matches WHERE transaction_id IN (
+    SELECT t.id FROM transactions t
+    WHERE t.external_id IS NOT NULL
+      AND t.id > (SELECT MIN(k.id) FROM transactions k WHERE k.account_id = t.account_id AND k.external_id = t.external_id)
+);
+
+DELETE FROM transactions WHERE id IN (
+    SELECT t.id FROM transactions t
+    WHERE t.external_id IS NOT NULL
+      AND t.id > (SELECT MIN(k.id) FROM transactions k WHERE k.account_id = t.account_id AND k.external_id = t.external_id)
+);
+
+DROP INDEX IF EXISTS idx_transactions_account_external_id;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_transactions_account_external_id ON transactions(account_id, external_id);
@@ -0,0 +1,11 @@
+-- Description: Store per-family data keys for encrypting secret settings
+
+-- Each key is encrypted ("wrapped") with a server master key that never
+-- reaches the database. Secret settings name the data key that sealed them.
+CREATE TABLE IF NOT EXISTS family_data_keys (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    wrapped_key TEXT NOT NULL, -- base64 nonce and ciphertext
+    master_key_id TEXT NOT NULL,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP NOT NULL
+);
@@ -0,0 +1,9 @@
+-- Description: Account display settings, unlinking and relinking
+
+ALTER TABLE accounts ADD COLUMN account_type TEXT NOT NULL DEFAULT 'other'; -- checking, savings, credit_card, loan, investment, cash or other
+ALTER TABLE accounts ADD COLUMN hidden BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE accounts ADD COLUMN include_in_budget BOOLEAN NOT NULL DEFAULT TRUE;
+ALTER TABLE accounts ADD COLUMN unlinked_at TIMESTAMP; -- Removed from SimpleFIN sync, transactions kept
+ALTER TABLE accounts ADD COLUMN relinked_at TIMESTAMP; -- When the SimpleFIN account ID was last replaced
+
+CREATE INDEX IF NOT EXISTS idx_accounts_account_id ON accounts(account_id);
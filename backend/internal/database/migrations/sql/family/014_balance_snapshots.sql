@@ -0,0 +1,14 @@
+-- Description: Daily account balance snapshots recorded by the SimpleFIN sync
+
+CREATE TABLE IF NOT EXISTS balance_snapshots (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    account_id INTEGER NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+    snapshot_date TIMESTAMP NOT NULL, -- UTC day the balance applies to; the latest balance of the day wins
+    balance_cents INTEGER NOT NULL, -- As reported by the bank; negative for money owed on most liabilities
+    available_balance_cents INTEGER,
+    balance_date TIMESTAMP NOT NULL, -- When the bank computed the balance
+    created_at TIMESTAMP NOT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_balance_snapshots_account_date ON balance_snapshots(account_id, snapshot_date);
+CREATE INDEX IF NOT EXISTS idx_balance_snapshots_date ON balance_snapshots(snapshot_date);
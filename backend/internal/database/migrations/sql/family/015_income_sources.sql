@@ -0,0 +1,36 @@
+-- Description: Move income sources out of the monthly_income setting into their own table with pay schedules
+
+CREATE TABLE IF NOT EXISTS income_sources (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL,
+    description TEXT,
+    member_id INTEGER REFERENCES family_members(id) ON DELETE SET NULL, -- Who earns it, NULL when shared or unknown
+    pay_frequency TEXT NOT NULL CHECK (pay_frequency IN ('weekly', 'biweekly', 'semimonthly', 'monthly')),
+    anchor_date TIMESTAMP NOT NULL, -- First pay date of the series
+    gross_cents INTEGER NOT NULL, -- Per paycheck, before deductions
+    net_cents INTEGER NOT NULL, -- Per paycheck, as deposited
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_income_sources_member ON income_sources(member_id);
+
+-- Sources stored only a monthly amount, so each becomes a monthly paycheck
+-- of that amount paid on the 1st
+INSERT INTO income_sources (name, description, pay_frequency, anchor_date, gross_cents, net_cents, is_active, created_at, updated_at)
+SELECT
+    json_extract(s.value, '$.name'),
+    NULLIF(json_extract(s.value, '$.description'), ''),
+    'monthly',
+    date('now', 'start of month') || ' 00:00:00+00:00',
+    CAST(ROUND(json_extract(s.value, '$.amount') * 100) AS INTEGER),
+    CAST(ROUND(json_extract(s.value, '$.amount') * 100) AS INTEGER),
+    COALESCE(json_extract(s.value, '$.is_active'), 0),
+    CURRENT_TIMESTAMP,
+    CURRENT_TIMESTAMP
+FROM family_settings, json_each(family_settings.setting_value, '$.sources') AS s
+WHERE family_settings.setting_key = 'monthly_income' AND json_valid(family_settings.setting_value)
+ORDER BY s.key;
+
+DELETE FROM family_settings WHERE setting_key = 'monthly_income';
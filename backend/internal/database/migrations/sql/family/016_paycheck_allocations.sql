@@ -0,0 +1,15 @@
+-- Description: Remember which paycheck pays each bill, including bills pinned to a paycheck by hand
+
+CREATE TABLE IF NOT EXISTS paycheck_allocations (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    expense_id INTEGER NOT NULL REFERENCES expenses(id) ON DELETE CASCADE,
+    scheduled_date TIMESTAMP NOT NULL, -- The bill's occurrence, before business day adjustment
+    income_source_id INTEGER NOT NULL REFERENCES income_sources(id) ON DELETE CASCADE,
+    pay_date TIMESTAMP NOT NULL, -- The paycheck's scheduled pay date, before business day adjustment
+    pinned BOOLEAN NOT NULL DEFAULT FALSE, -- Set by hand; kept when allocations are recomputed
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_paycheck_allocations_bill ON paycheck_allocations(expense_id, scheduled_date);
+CREATE INDEX IF NOT EXISTS idx_paycheck_allocations_source ON paycheck_allocations(income_source_id);
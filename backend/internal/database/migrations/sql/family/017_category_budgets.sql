@@ -0,0 +1,10 @@
+-- Description: Monthly budget amounts per category, with optional rollover of unspent money
+
+CREATE TABLE IF NOT EXISTS category_budgets (
+    category_id INTEGER PRIMARY KEY REFERENCES categories(id) ON DELETE CASCADE,
+    amount_cents INTEGER NOT NULL, -- Budgeted each month
+    rollover BOOLEAN NOT NULL DEFAULT FALSE, -- Carry what is left at the end of a month into the next
+    start_month TIMESTAMP NOT NULL, -- First day of the first budgeted month; rollover starts here
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL
+);
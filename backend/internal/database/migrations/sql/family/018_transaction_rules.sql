@@ -0,0 +1,34 @@
+-- Description: User-defined rules that categorize, rename and link transactions
+
+CREATE TABLE IF NOT EXISTS transaction_rules (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL,
+    position INTEGER NOT NULL DEFAULT 0, -- Rules run in ascending position
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+
+    -- Conditions; unset ones match every transaction
+    match_field TEXT NOT NULL DEFAULT 'any' CHECK (match_field IN ('payee', 'description', 'any')),
+    match_type TEXT NOT NULL DEFAULT 'contains' CHECK (match_type IN ('contains', 'regex')),
+    pattern TEXT NOT NULL DEFAULT '',
+    min_amount_cents INTEGER, -- Inclusive, signed like transaction amounts
+    max_amount_cents INTEGER,
+    account_id INTEGER REFERENCES accounts(id) ON DELETE CASCADE,
+
+    -- Actions
+    set_category_id INTEGER REFERENCES categories(id) ON DELETE SET NULL,
+    set_payee TEXT,
+    link_expense_id INTEGER REFERENCES expenses(id) ON DELETE SET NULL,
+    mark_transfer BOOLEAN NOT NULL DEFAULT FALSE,
+
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_transaction_rules_position ON transaction_rules(position, id);
+
+-- Payee shown instead of the bank's, set by a rule or by hand. The bank's
+-- payee stays in payee so syncs can still compare against it.
+ALTER TABLE transactions ADD COLUMN display_payee TEXT;
+
+-- Money moved between the family's own accounts rather than spent or earned
+ALTER TABLE transactions ADD COLUMN is_transfer BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,6 @@
+-- Description: Store the category the family's classifier suggests for each transaction
+
+-- When the confidence reached the family's threshold the suggestion was also
+-- applied, and category_id equals suggested_category_id
+ALTER TABLE transactions ADD COLUMN suggested_category_id INTEGER REFERENCES categories(id) ON DELETE SET NULL;
+ALTER TABLE transactions ADD COLUMN suggestion_confidence REAL;
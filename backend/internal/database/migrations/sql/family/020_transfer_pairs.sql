@@ -0,0 +1,16 @@
+-- Description: Pair the two sides of transfers between a family's own accounts
+
+-- Both sides of a detected or confirmed pair are marked is_transfer. Split
+-- pairs are kept so the detector doesn't pair the same transactions again.
+CREATE TABLE IF NOT EXISTS transfer_pairs (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    outflow_transaction_id INTEGER NOT NULL REFERENCES transactions(id) ON DELETE CASCADE,
+    inflow_transaction_id INTEGER NOT NULL REFERENCES transactions(id) ON DELETE CASCADE,
+    status TEXT NOT NULL CHECK (status IN ('detected', 'confirmed', 'split')),
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    UNIQUE (outflow_transaction_id, inflow_transaction_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_transfer_pairs_inflow ON transfer_pairs(inflow_transaction_id);
+CREATE INDEX IF NOT EXISTS idx_transfer_pairs_status ON transfer_pairs(status, id);
@@ -0,0 +1,17 @@
+-- Description: Split a transaction into allocations with their own category, note and member
+
+-- The allocations of a transaction add up to its amount, and reports use
+-- them instead of the transaction
+CREATE TABLE IF NOT EXISTS transaction_splits (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    transaction_id INTEGER NOT NULL REFERENCES transactions(id) ON DELETE CASCADE,
+    position INTEGER NOT NULL, -- Order within the transaction
+    amount_cents INTEGER NOT NULL, -- Signed like the transaction
+    category_id INTEGER REFERENCES categories(id) ON DELETE SET NULL,
+    note TEXT,
+    member_id INTEGER REFERENCES family_members(id) ON DELETE SET NULL, -- Who the allocation is for
+    created_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_transaction_splits_transaction ON transaction_splits(transaction_id, position);
+CREATE INDEX IF NOT EXISTS idx_transaction_splits_category ON transaction_splits(category_id);
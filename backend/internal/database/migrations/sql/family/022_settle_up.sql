@@ -0,0 +1,33 @@
+-- Description: Record who paid shared costs and how they are split, and reimbursements between members
+
+-- A cost paid by one member on behalf of others, from either a paid expense
+-- occurrence or a bank transaction. The amount is read from the source.
+CREATE TABLE IF NOT EXISTS shared_costs (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    expense_payment_id INTEGER UNIQUE REFERENCES expense_payments(id) ON DELETE CASCADE,
+    transaction_id INTEGER UNIQUE REFERENCES transactions(id) ON DELETE CASCADE,
+    paid_by INTEGER NOT NULL REFERENCES family_members(id),
+    split_method TEXT NOT NULL CHECK (split_method IN ('equal', 'percentage', 'fixed')),
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    CHECK ((expense_payment_id IS NULL) != (transaction_id IS NULL))
+);
+
+CREATE TABLE IF NOT EXISTS shared_cost_shares (
+    shared_cost_id INTEGER NOT NULL REFERENCES shared_costs(id) ON DELETE CASCADE,
+    member_id INTEGER NOT NULL REFERENCES family_members(id),
+    position INTEGER NOT NULL, -- Order of the shares, which breaks ties when cents don't divide evenly
+    value INTEGER NOT NULL DEFAULT 0, -- Basis points for percentage splits, cents for fixed ones
+    PRIMARY KEY (shared_cost_id, member_id)
+);
+
+-- Money one member paid another to settle up
+CREATE TABLE IF NOT EXISTS settlements (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    from_member_id INTEGER NOT NULL REFERENCES family_members(id),
+    to_member_id INTEGER NOT NULL REFERENCES family_members(id),
+    amount_cents INTEGER NOT NULL,
+    note TEXT,
+    recorded_by INTEGER REFERENCES family_members(id),
+    settled_at TIMESTAMP NOT NULL
+);
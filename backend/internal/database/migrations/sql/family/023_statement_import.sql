@@ -0,0 +1,26 @@
+-- Description: Accounts fed by statement files instead of SimpleFIN, and the CSV column mappings of each bank
+
+-- simplefin accounts are synced; import accounts get transactions from
+-- uploaded CSV, OFX and QFX statements and have no SimpleFIN account ID
+ALTER TABLE accounts ADD COLUMN source TEXT NOT NULL DEFAULT 'simplefin' CHECK (source IN ('simplefin', 'import'));
+
+-- Where a bank's CSV export keeps each field. Columns are header names or
+-- column numbers counting from 1.
+CREATE TABLE IF NOT EXISTS statement_mappings (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL UNIQUE, -- Usually the bank's name
+    delimiter TEXT NOT NULL DEFAULT ',',
+    has_header BOOLEAN NOT NULL DEFAULT TRUE,
+    skip_rows INTEGER NOT NULL DEFAULT 0, -- Lines before the header, such as an account summary
+    date_column TEXT NOT NULL,
+    date_format TEXT NOT NULL DEFAULT '', -- Such as MM/DD/YYYY; empty tries ISO dates, then US ones
+    description_column TEXT NOT NULL,
+    payee_column TEXT,
+    amount_column TEXT, -- Signed amount; NULL when money out and in have their own columns
+    debit_column TEXT,
+    credit_column TEXT,
+    id_column TEXT,
+    negate_amounts BOOLEAN NOT NULL DEFAULT FALSE, -- For exports that show purchases as positive
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL
+);
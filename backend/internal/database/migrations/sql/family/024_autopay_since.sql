@@ -0,0 +1,16 @@
+-- Description: Remember when autopay was turned on so only occurrences due after that are marked paid
+
+-- NULL when autopay is off
+ALTER TABLE expenses ADD COLUMN autopay_since TIMESTAMP;
+
+-- Expenses already on autopay are taken to have been switched on at their
+-- last edit, which is never earlier than the real switch. The timestamp
+-- trigger is dropped meanwhile so the backfill doesn't move updated_at.
+DROP TRIGGER IF EXISTS update_expenses_timestamp;
+UPDATE expenses SET autopay_since = updated_at WHERE is_autopay = TRUE;
+CREATE TRIGGER IF NOT EXISTS update_expenses_timestamp
+    AFTER UPDATE ON expenses
+    FOR EACH ROW
+BEGIN
+    UPDATE expenses SET updated_at = CURRENT_TIMESTAMP WHERE id = new.id;
+END;
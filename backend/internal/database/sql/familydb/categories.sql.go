@@ -11,13 +11,12 @@ import (
 )
 
 const createCategory = `-- name: CreateCategory :one
-INSERT INTO categories (id, name, description, color, icon, created_at, updated_at)
-VALUES (?, ?, ?, ?, ?, ?, ?)
+INSERT INTO categories (name, description, color, icon, created_at, updated_at)
+VALUES (?, ?, ?, ?, ?, ?)
 RETURNING id, name, description, color, icon, created_at, updated_at
 `
 
 type CreateCategoryParams struct {
-	ID          int64     `json:"id"`
 	Name        string    `json:"name"`
 	Description *string   `json:"description"`
 	Color       *string   `json:"color"`
@@ -28,7 +27,6 @@ type CreateCategoryParams struct {
 
 func (q *Queries) CreateCategory(ctx context.Context, arg CreateCategoryParams) (*Category, error) {
 	row := q.db.QueryRowContext(ctx, createCategory,
-		arg.ID,
 		arg.Name,
 		arg.Description,
 		arg.Color,
@@ -12,29 +12,63 @@ import (
 
 const countExpenses = `-- name: CountExpenses :one
 SELECT COUNT(*) FROM expenses
+WHERE (?1 IS NULL OR category_id = ?1)
+  AND (?2 IS NULL OR is_autopay = ?2)
+  AND (?3 IS NULL OR day_of_month_due >= ?3)
+  AND (?4 IS NULL OR day_of_month_due <= ?4)
 `
 
-func (q *Queries) CountExpenses(ctx context.Context) (int64, error) {
-	row := q.db.QueryRowContext(ctx, countExpenses)
+type CountExpensesParams struct {
+	CategoryID *int64 `json:"category_id"`
+	IsAutopay  *bool  `json:"is_autopay"`
+	MinDay     *int64 `json:"min_day"`
+	MaxDay     *int64 `json:"max_day"`
+}
+
+func (q *Queries) CountExpenses(ctx context.Context, arg CountExpensesParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countExpenses,
+		arg.CategoryID,
+		arg.IsAutopay,
+		arg.MinDay,
+		arg.MaxDay,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countExpensesByCategory = `-- name: CountExpensesByCategory :one
+SELECT COUNT(*) FROM expenses WHERE category_id = ?
+`
+
+func (q *Queries) CountExpensesByCategory(ctx context.Context, categoryID *int64) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countExpensesByCategory, categoryID)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
 }
 
 const createExpense = `-- name: CreateExpense :one
-INSERT INTO expenses (category_id, amount, name, day_of_month_due, is_autopay, created_at, updated_at)
-VALUES (?, ?, ?, ?, ?, ?, ?)
-RETURNING id, category_id, amount, name, day_of_month_due, is_autopay, created_at, updated_at
+INSERT INTO expenses (category_id, amount, name, day_of_month_due, is_autopay, created_at, updated_at, frequency, interval_count, anchor_date, end_date, max_occurrences, business_day_adjustment, autopay_since)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+RETURNING id, category_id, amount, name, day_of_month_due, is_autopay, created_at, updated_at, frequency, interval_count, anchor_date, end_date, max_occurrences, business_day_adjustment, autopay_since
 `
 
 type CreateExpenseParams struct {
-	CategoryID    *int64    `json:"category_id"`
-	Amount        float64   `json:"amount"`
-	Name          string    `json:"name"`
-	DayOfMonthDue int64     `json:"day_of_month_due"`
-	IsAutopay     bool      `json:"is_autopay"`
-	CreatedAt     time.Time `json:"created_at"`
-	UpdatedAt     time.Time `json:"updated_at"`
+	CategoryID            *int64     `json:"category_id"`
+	Amount                float64    `json:"amount"`
+	Name                  string     `json:"name"`
+	DayOfMonthDue         int64      `json:"day_of_month_due"`
+	IsAutopay             bool       `json:"is_autopay"`
+	CreatedAt             time.Time  `json:"created_at"`
+	UpdatedAt             time.Time  `json:"updated_at"`
+	Frequency             string     `json:"frequency"`
+	IntervalCount         int64      `json:"interval_count"`
+	AnchorDate            *time.Time `json:"anchor_date"`
+	EndDate               *time.Time `json:"end_date"`
+	MaxOccurrences        *int64     `json:"max_occurrences"`
+	BusinessDayAdjustment string     `json:"business_day_adjustment"`
+	AutopaySince          *time.Time `json:"autopay_since"`
 }
 
 func (q *Queries) CreateExpense(ctx context.Context, arg CreateExpenseParams) (*Expense, error) {
@@ -46,6 +80,13 @@ func (q *Queries) CreateExpense(ctx context.Context, arg CreateExpenseParams) (*
 		arg.IsAutopay,
 		arg.CreatedAt,
 		arg.UpdatedAt,
+		arg.Frequency,
+		arg.IntervalCount,
+		arg.AnchorDate,
+		arg.EndDate,
+		arg.MaxOccurrences,
+		arg.BusinessDayAdjustment,
+		arg.AutopaySince,
 	)
 	var i Expense
 	err := row.Scan(
@@ -57,6 +98,13 @@ func (q *Queries) CreateExpense(ctx context.Context, arg CreateExpenseParams) (*
 		&i.IsAutopay,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Frequency,
+		&i.IntervalCount,
+		&i.AnchorDate,
+		&i.EndDate,
+		&i.MaxOccurrences,
+		&i.BusinessDayAdjustment,
+		&i.AutopaySince,
 	)
 	return &i, err
 }
@@ -71,7 +119,7 @@ func (q *Queries) DeleteExpense(ctx context.Context, id int64) error {
 }
 
 const getExpenseByID = `-- name: GetExpenseByID :one
-SELECT id, category_id, amount, name, day_of_month_due, is_autopay, created_at, updated_at FROM expenses WHERE id = ?
+SELECT id, category_id, amount, name, day_of_month_due, is_autopay, created_at, updated_at, frequency, interval_count, anchor_date, end_date, max_occurrences, business_day_adjustment, autopay_since FROM expenses WHERE id = ?
 `
 
 func (q *Queries) GetExpenseByID(ctx context.Context, id int64) (*Expense, error) {
@@ -86,12 +134,19 @@ func (q *Queries) GetExpenseByID(ctx context.Context, id int64) (*Expense, error
 		&i.IsAutopay,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Frequency,
+		&i.IntervalCount,
+		&i.AnchorDate,
+		&i.EndDate,
+		&i.MaxOccurrences,
+		&i.BusinessDayAdjustment,
+		&i.AutopaySince,
 	)
 	return &i, err
 }
 
 const getExpensesByDateRange = `-- name: GetExpensesByDateRange :many
-SELECT id, category_id, amount, name, day_of_month_due, is_autopay, created_at, updated_at FROM expenses
+SELECT id, category_id, amount, name, day_of_month_due, is_autopay, created_at, updated_at, frequency, interval_count, anchor_date, end_date, max_occurrences, business_day_adjustment, autopay_since FROM expenses
 WHERE day_of_month_due BETWEEN ? AND ?
 ORDER BY day_of_month_due ASC
 `
@@ -119,6 +174,56 @@ func (q *Queries) GetExpensesByDateRange(ctx context.Context, arg GetExpensesByD
 			&i.IsAutopay,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Frequency,
+			&i.IntervalCount,
+			&i.AnchorDate,
+			&i.EndDate,
+			&i.MaxOccurrences,
+			&i.BusinessDayAdjustment,
+			&i.AutopaySince,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAllExpenses = `-- name: ListAllExpenses :many
+SELECT id, category_id, amount, name, day_of_month_due, is_autopay, created_at, updated_at, frequency, interval_count, anchor_date, end_date, max_occurrences, business_day_adjustment, autopay_since FROM expenses ORDER BY id ASC
+`
+
+func (q *Queries) ListAllExpenses(ctx context.Context) ([]*Expense, error) {
+	rows, err := q.db.QueryContext(ctx, listAllExpenses)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*Expense{}
+	for rows.Next() {
+		var i Expense
+		if err := rows.Scan(
+			&i.ID,
+			&i.CategoryID,
+			&i.Amount,
+			&i.Name,
+			&i.DayOfMonthDue,
+			&i.IsAutopay,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Frequency,
+			&i.IntervalCount,
+			&i.AnchorDate,
+			&i.EndDate,
+			&i.MaxOccurrences,
+			&i.BusinessDayAdjustment,
+			&i.AutopaySince,
 		); err != nil {
 			return nil, err
 		}
@@ -134,18 +239,40 @@ func (q *Queries) GetExpensesByDateRange(ctx context.Context, arg GetExpensesByD
 }
 
 const listExpenses = `-- name: ListExpenses :many
-SELECT id, category_id, amount, name, day_of_month_due, is_autopay, created_at, updated_at FROM expenses 
-ORDER BY created_at DESC
-LIMIT ? OFFSET ?
+SELECT id, category_id, amount, name, day_of_month_due, is_autopay, created_at, updated_at, frequency, interval_count, anchor_date, end_date, max_occurrences, business_day_adjustment, autopay_since FROM expenses
+WHERE (?1 IS NULL OR category_id = ?1)
+  AND (?2 IS NULL OR is_autopay = ?2)
+  AND (?3 IS NULL OR day_of_month_due >= ?3)
+  AND (?4 IS NULL OR day_of_month_due <= ?4)
+  AND (
+    ?5 IS NULL
+    OR day_of_month_due > ?5
+    OR (day_of_month_due = ?5 AND id > ?6)
+  )
+ORDER BY day_of_month_due ASC, id ASC
+LIMIT ?7
 `
 
 type ListExpensesParams struct {
-	Limit  int64 `json:"limit"`
-	Offset int64 `json:"offset"`
+	CategoryID *int64 `json:"category_id"`
+	IsAutopay  *bool  `json:"is_autopay"`
+	MinDay     *int64 `json:"min_day"`
+	MaxDay     *int64 `json:"max_day"`
+	AfterDay   *int64 `json:"after_day"`
+	AfterID    int64  `json:"after_id"`
+	Limit      int64  `json:"limit"`
 }
 
 func (q *Queries) ListExpenses(ctx context.Context, arg ListExpensesParams) ([]*Expense, error) {
-	rows, err := q.db.QueryContext(ctx, listExpenses, arg.Limit, arg.Offset)
+	rows, err := q.db.QueryContext(ctx, listExpenses,
+		arg.CategoryID,
+		arg.IsAutopay,
+		arg.MinDay,
+		arg.MaxDay,
+		arg.AfterDay,
+		arg.AfterID,
+		arg.Limit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -162,6 +289,13 @@ func (q *Queries) ListExpenses(ctx context.Context, arg ListExpensesParams) ([]*
 			&i.IsAutopay,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Frequency,
+			&i.IntervalCount,
+			&i.AnchorDate,
+			&i.EndDate,
+			&i.MaxOccurrences,
+			&i.BusinessDayAdjustment,
+			&i.AutopaySince,
 		); err != nil {
 			return nil, err
 		}
@@ -177,7 +311,7 @@ func (q *Queries) ListExpenses(ctx context.Context, arg ListExpensesParams) ([]*
 }
 
 const listExpensesByCategory = `-- name: ListExpensesByCategory :many
-SELECT id, category_id, amount, name, day_of_month_due, is_autopay, created_at, updated_at FROM expenses 
+SELECT id, category_id, amount, name, day_of_month_due, is_autopay, created_at, updated_at, frequency, interval_count, anchor_date, end_date, max_occurrences, business_day_adjustment, autopay_since FROM expenses 
 WHERE category_id = ?
 ORDER BY created_at DESC
 `
@@ -200,6 +334,13 @@ func (q *Queries) ListExpensesByCategory(ctx context.Context, categoryID *int64)
 			&i.IsAutopay,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Frequency,
+			&i.IntervalCount,
+			&i.AnchorDate,
+			&i.EndDate,
+			&i.MaxOccurrences,
+			&i.BusinessDayAdjustment,
+			&i.AutopaySince,
 		); err != nil {
 			return nil, err
 		}
@@ -214,21 +355,49 @@ func (q *Queries) ListExpensesByCategory(ctx context.Context, categoryID *int64)
 	return items, nil
 }
 
+const reassignExpensesCategory = `-- name: ReassignExpensesCategory :execrows
+UPDATE expenses
+SET category_id = ?1
+WHERE category_id = ?2
+`
+
+type ReassignExpensesCategoryParams struct {
+	NewCategoryID *int64 `json:"new_category_id"`
+	OldCategoryID *int64 `json:"old_category_id"`
+}
+
+func (q *Queries) ReassignExpensesCategory(ctx context.Context, arg ReassignExpensesCategoryParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, reassignExpensesCategory, arg.NewCategoryID, arg.OldCategoryID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const updateExpense = `-- name: UpdateExpense :one
 UPDATE expenses 
-SET category_id = ?, amount = ?, name = ?, day_of_month_due = ?, is_autopay = ?, updated_at = ?
+SET category_id = ?, amount = ?, name = ?, day_of_month_due = ?, is_autopay = ?, updated_at = ?,
+    frequency = ?, interval_count = ?, anchor_date = ?, end_date = ?, max_occurrences = ?,
+    business_day_adjustment = ?, autopay_since = ?
 WHERE id = ?
-RETURNING id, category_id, amount, name, day_of_month_due, is_autopay, created_at, updated_at
+RETURNING id, category_id, amount, name, day_of_month_due, is_autopay, created_at, updated_at, frequency, interval_count, anchor_date, end_date, max_occurrences, business_day_adjustment, autopay_since
 `
 
 type UpdateExpenseParams struct {
-	CategoryID    *int64    `json:"category_id"`
-	Amount        float64   `json:"amount"`
-	Name          string    `json:"name"`
-	DayOfMonthDue int64     `json:"day_of_month_due"`
-	IsAutopay     bool      `json:"is_autopay"`
-	UpdatedAt     time.Time `json:"updated_at"`
-	ID            int64     `json:"id"`
+	CategoryID            *int64     `json:"category_id"`
+	Amount                float64    `json:"amount"`
+	Name                  string     `json:"name"`
+	DayOfMonthDue         int64      `json:"day_of_month_due"`
+	IsAutopay             bool       `json:"is_autopay"`
+	UpdatedAt             time.Time  `json:"updated_at"`
+	Frequency             string     `json:"frequency"`
+	IntervalCount         int64      `json:"interval_count"`
+	AnchorDate            *time.Time `json:"anchor_date"`
+	EndDate               *time.Time `json:"end_date"`
+	MaxOccurrences        *int64     `json:"max_occurrences"`
+	BusinessDayAdjustment string     `json:"business_day_adjustment"`
+	AutopaySince          *time.Time `json:"autopay_since"`
+	ID                    int64      `json:"id"`
 }
 
 func (q *Queries) UpdateExpense(ctx context.Context, arg UpdateExpenseParams) (*Expense, error) {
@@ -239,6 +408,13 @@ func (q *Queries) UpdateExpense(ctx context.Context, arg UpdateExpenseParams) (*
 		arg.DayOfMonthDue,
 		arg.IsAutopay,
 		arg.UpdatedAt,
+		arg.Frequency,
+		arg.IntervalCount,
+		arg.AnchorDate,
+		arg.EndDate,
+		arg.MaxOccurrences,
+		arg.BusinessDayAdjustment,
+		arg.AutopaySince,
 		arg.ID,
 	)
 	var i Expense
@@ -251,6 +427,13 @@ func (q *Queries) UpdateExpense(ctx context.Context, arg UpdateExpenseParams) (*
 		&i.IsAutopay,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Frequency,
+		&i.IntervalCount,
+		&i.AnchorDate,
+		&i.EndDate,
+		&i.MaxOccurrences,
+		&i.BusinessDayAdjustment,
+		&i.AutopaySince,
 	)
 	return &i, err
 }
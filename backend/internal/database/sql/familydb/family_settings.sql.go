@@ -42,6 +42,23 @@ func (q *Queries) DeleteFamilySetting(ctx context.Context, id int64) error {
 	return err
 }
 
+const getFamilySettingByID = `-- name: GetFamilySettingByID :one
+SELECT id, setting_key, setting_value, data_type FROM family_settings
+WHERE id = ?
+`
+
+func (q *Queries) GetFamilySettingByID(ctx context.Context, id int64) (*FamilySetting, error) {
+	row := q.db.QueryRowContext(ctx, getFamilySettingByID, id)
+	var i FamilySetting
+	err := row.Scan(
+		&i.ID,
+		&i.SettingKey,
+		&i.SettingValue,
+		&i.DataType,
+	)
+	return &i, err
+}
+
 const getFamilySettingByKey = `-- name: GetFamilySettingByKey :one
 SELECT id, setting_key, setting_value, data_type FROM family_settings
 WHERE setting_key = ?
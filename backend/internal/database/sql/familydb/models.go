@@ -9,9 +9,34 @@ import (
 )
 
 type Account struct {
-	ID        int64  `json:"id"`
-	AccountID string `json:"account_id"`
-	Name      string `json:"name"`
+	ID              int64      `json:"id"`
+	AccountID       string     `json:"account_id"`
+	Name            string     `json:"name"`
+	AccountType     string     `json:"account_type"`
+	Hidden          bool       `json:"hidden"`
+	IncludeInBudget bool       `json:"include_in_budget"`
+	UnlinkedAt      *time.Time `json:"unlinked_at"`
+	RelinkedAt      *time.Time `json:"relinked_at"`
+	Source          string     `json:"source"`
+}
+
+type AccountSyncState struct {
+	AccountID         int64      `json:"account_id"`
+	SyncCursor        *time.Time `json:"sync_cursor"`
+	LastAttemptAt     *time.Time `json:"last_attempt_at"`
+	LastSuccessAt     *time.Time `json:"last_success_at"`
+	LastError         *string    `json:"last_error"`
+	TransactionsAdded int64      `json:"transactions_added"`
+}
+
+type BalanceSnapshot struct {
+	ID                    int64     `json:"id"`
+	AccountID             int64     `json:"account_id"`
+	SnapshotDate          time.Time `json:"snapshot_date"`
+	BalanceCents          int64     `json:"balance_cents"`
+	AvailableBalanceCents *int64    `json:"available_balance_cents"`
+	BalanceDate           time.Time `json:"balance_date"`
+	CreatedAt             time.Time `json:"created_at"`
 }
 
 type Category struct {
@@ -24,15 +49,54 @@ type Category struct {
 	UpdatedAt   time.Time `json:"updated_at"`
 }
 
+type CategoryBudget struct {
+	CategoryID  int64     `json:"category_id"`
+	AmountCents int64     `json:"amount_cents"`
+	Rollover    bool      `json:"rollover"`
+	StartMonth  time.Time `json:"start_month"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
 type Expense struct {
-	ID            int64     `json:"id"`
-	CategoryID    *int64    `json:"category_id"`
-	Amount        float64   `json:"amount"`
-	Name          string    `json:"name"`
-	DayOfMonthDue int64     `json:"day_of_month_due"`
-	IsAutopay     bool      `json:"is_autopay"`
-	CreatedAt     time.Time `json:"created_at"`
-	UpdatedAt     time.Time `json:"updated_at"`
+	ID                    int64      `json:"id"`
+	CategoryID            *int64     `json:"category_id"`
+	Amount                float64    `json:"amount"`
+	Name                  string     `json:"name"`
+	DayOfMonthDue         int64      `json:"day_of_month_due"`
+	IsAutopay             bool       `json:"is_autopay"`
+	CreatedAt             time.Time  `json:"created_at"`
+	UpdatedAt             time.Time  `json:"updated_at"`
+	Frequency             string     `json:"frequency"`
+	IntervalCount         int64      `json:"interval_count"`
+	AnchorDate            *time.Time `json:"anchor_date"`
+	EndDate               *time.Time `json:"end_date"`
+	MaxOccurrences        *int64     `json:"max_occurrences"`
+	BusinessDayAdjustment string     `json:"business_day_adjustment"`
+	AutopaySince          *time.Time `json:"autopay_since"`
+}
+
+type ExpensePayment struct {
+	ID            int64      `json:"id"`
+	ExpenseID     int64      `json:"expense_id"`
+	ScheduledDate time.Time  `json:"scheduled_date"`
+	Status        string     `json:"status"`
+	PaidDate      *time.Time `json:"paid_date"`
+	Amount        *float64   `json:"amount"`
+	PaidBy        *int64     `json:"paid_by"`
+	Note          *string    `json:"note"`
+	IsAutomatic   bool       `json:"is_automatic"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+	TransactionID *int64     `json:"transaction_id"`
+}
+
+type FamilyDataKey struct {
+	ID          int64     `json:"id"`
+	WrappedKey  string    `json:"wrapped_key"`
+	MasterKeyID string    `json:"master_key_id"`
+	Active      bool      `json:"active"`
+	CreatedAt   time.Time `json:"created_at"`
 }
 
 type FamilyMember struct {
@@ -51,6 +115,31 @@ type FamilySetting struct {
 	DataType     string  `json:"data_type"`
 }
 
+type IncomeSource struct {
+	ID           int64     `json:"id"`
+	Name         string    `json:"name"`
+	Description  *string   `json:"description"`
+	MemberID     *int64    `json:"member_id"`
+	PayFrequency string    `json:"pay_frequency"`
+	AnchorDate   time.Time `json:"anchor_date"`
+	GrossCents   int64     `json:"gross_cents"`
+	NetCents     int64     `json:"net_cents"`
+	IsActive     bool      `json:"is_active"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+type PaycheckAllocation struct {
+	ID             int64     `json:"id"`
+	ExpenseID      int64     `json:"expense_id"`
+	ScheduledDate  time.Time `json:"scheduled_date"`
+	IncomeSourceID int64     `json:"income_source_id"`
+	PayDate        time.Time `json:"pay_date"`
+	Pinned         bool      `json:"pinned"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
 type SchemaMigration struct {
 	Version         int64      `json:"version"`
 	Name            string     `json:"name"`
@@ -63,10 +152,125 @@ type SchemaMigration struct {
 	AppliedBy       *string    `json:"applied_by"`
 }
 
+type Settlement struct {
+	ID           int64     `json:"id"`
+	FromMemberID int64     `json:"from_member_id"`
+	ToMemberID   int64     `json:"to_member_id"`
+	AmountCents  int64     `json:"amount_cents"`
+	Note         *string   `json:"note"`
+	RecordedBy   *int64    `json:"recorded_by"`
+	SettledAt    time.Time `json:"settled_at"`
+}
+
+type SharedCost struct {
+	ID               int64     `json:"id"`
+	ExpensePaymentID *int64    `json:"expense_payment_id"`
+	TransactionID    *int64    `json:"transaction_id"`
+	PaidBy           int64     `json:"paid_by"`
+	SplitMethod      string    `json:"split_method"`
+	CreatedAt        time.Time `json:"created_at"`
+	UpdatedAt        time.Time `json:"updated_at"`
+}
+
+type SharedCostShare struct {
+	SharedCostID int64 `json:"shared_cost_id"`
+	MemberID     int64 `json:"member_id"`
+	Position     int64 `json:"position"`
+	Value        int64 `json:"value"`
+}
+
+type StatementMapping struct {
+	ID                int64     `json:"id"`
+	Name              string    `json:"name"`
+	Delimiter         string    `json:"delimiter"`
+	HasHeader         bool      `json:"has_header"`
+	SkipRows          int64     `json:"skip_rows"`
+	DateColumn        string    `json:"date_column"`
+	DateFormat        string    `json:"date_format"`
+	DescriptionColumn string    `json:"description_column"`
+	PayeeColumn       *string   `json:"payee_column"`
+	AmountColumn      *string   `json:"amount_column"`
+	DebitColumn       *string   `json:"debit_column"`
+	CreditColumn      *string   `json:"credit_column"`
+	IDColumn          *string   `json:"id_column"`
+	NegateAmounts     bool      `json:"negate_amounts"`
+	CreatedAt         time.Time `json:"created_at"`
+	UpdatedAt         time.Time `json:"updated_at"`
+}
+
+type SyncError struct {
+	ID        int64     `json:"id"`
+	AccountID *int64    `json:"account_id"`
+	Message   string    `json:"message"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
 type Transaction struct {
-	ID          int64     `json:"id"`
-	AccountID   int64     `json:"account_id"`
-	PostedDate  time.Time `json:"posted_date"`
-	Description string    `json:"description"`
-	Payee       string    `json:"payee"`
+	ID                   int64      `json:"id"`
+	AccountID            int64      `json:"account_id"`
+	PostedDate           time.Time  `json:"posted_date"`
+	Description          string     `json:"description"`
+	Payee                string     `json:"payee"`
+	AmountCents          int64      `json:"amount_cents"`
+	MatchedExpenseID     *int64     `json:"matched_expense_id"`
+	MatchedScheduledDate *time.Time `json:"matched_scheduled_date"`
+	ExternalID           *string    `json:"external_id"`
+	Pending              bool       `json:"pending"`
+	CategoryID           *int64     `json:"category_id"`
+	Note                 *string    `json:"note"`
+	TransactedAt         *time.Time `json:"transacted_at"`
+	DisplayPayee         *string    `json:"display_payee"`
+	IsTransfer           bool       `json:"is_transfer"`
+	SuggestedCategoryID  *int64     `json:"suggested_category_id"`
+	SuggestionConfidence *float64   `json:"suggestion_confidence"`
+}
+
+type TransactionMatch struct {
+	ID            int64     `json:"id"`
+	TransactionID int64     `json:"transaction_id"`
+	ExpenseID     int64     `json:"expense_id"`
+	ScheduledDate time.Time `json:"scheduled_date"`
+	Score         float64   `json:"score"`
+	Status        string    `json:"status"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+type TransactionRule struct {
+	ID             int64     `json:"id"`
+	Name           string    `json:"name"`
+	Position       int64     `json:"position"`
+	IsActive       bool      `json:"is_active"`
+	MatchField     string    `json:"match_field"`
+	MatchType      string    `json:"match_type"`
+	Pattern        string    `json:"pattern"`
+	MinAmountCents *int64    `json:"min_amount_cents"`
+	MaxAmountCents *int64    `json:"max_amount_cents"`
+	AccountID      *int64    `json:"account_id"`
+	SetCategoryID  *int64    `json:"set_category_id"`
+	SetPayee       *string   `json:"set_payee"`
+	LinkExpenseID  *int64    `json:"link_expense_id"`
+	MarkTransfer   bool      `json:"mark_transfer"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+type TransactionSplit struct {
+	ID            int64     `json:"id"`
+	TransactionID int64     `json:"transaction_id"`
+	Position      int64     `json:"position"`
+	AmountCents   int64     `json:"amount_cents"`
+	CategoryID    *int64    `json:"category_id"`
+	Note          *string   `json:"note"`
+	MemberID      *int64    `json:"member_id"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+type TransferPair struct {
+	ID                   int64     `json:"id"`
+	OutflowTransactionID int64     `json:"outflow_transaction_id"`
+	InflowTransactionID  int64     `json:"inflow_transaction_id"`
+	Status               string    `json:"status"`
+	CreatedAt            time.Time `json:"created_at"`
+	UpdatedAt            time.Time `json:"updated_at"`
 }
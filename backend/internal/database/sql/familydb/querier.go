@@ -6,6 +6,7 @@ package familydb
 
 import (
 	"context"
+	"time"
 )
 
 type Querier interface {
@@ -13,42 +14,182 @@ type Querier interface {
 	// This query will return 1 if table exists, 0 if not
 	// We use a simple approach that works with sqlc
 	CheckMigrationsTableExists(ctx context.Context) (int64, error)
-	CountExpenses(ctx context.Context) (int64, error)
+	ClearCategorySuggestions(ctx context.Context, suggestedCategoryID *int64) error
+	ClearRulesExpense(ctx context.Context, linkExpenseID *int64) error
+	ClearTransactionSuggestion(ctx context.Context, id int64) error
+	CountCategorySuggestions(ctx context.Context, suggestedCategoryID *int64) (int64, error)
+	CountExpenses(ctx context.Context, arg CountExpensesParams) (int64, error)
+	CountExpensesByCategory(ctx context.Context, categoryID *int64) (int64, error)
+	CountPendingTransactionMatches(ctx context.Context) (int64, error)
+	CountRulesByCategory(ctx context.Context, setCategoryID *int64) (int64, error)
+	CountSplitsByCategory(ctx context.Context, categoryID *int64) (int64, error)
+	CountTransactions(ctx context.Context, arg CountTransactionsParams) (int64, error)
+	CountTransactionsByCategory(ctx context.Context, categoryID *int64) (int64, error)
+	CountTransferPairs(ctx context.Context, status *string) (int64, error)
 	CreateAccount(ctx context.Context, arg CreateAccountParams) (*Account, error)
+	CreateAutomaticPayment(ctx context.Context, arg CreateAutomaticPaymentParams) (int64, error)
 	CreateCategory(ctx context.Context, arg CreateCategoryParams) (*Category, error)
 	CreateExpense(ctx context.Context, arg CreateExpenseParams) (*Expense, error)
+	CreateFamilyDataKey(ctx context.Context, arg CreateFamilyDataKeyParams) (*FamilyDataKey, error)
 	CreateFamilyMember(ctx context.Context, arg CreateFamilyMemberParams) (*FamilyMember, error)
 	CreateFamilySetting(ctx context.Context, arg CreateFamilySettingParams) (*FamilySetting, error)
+	CreateIncomeSource(ctx context.Context, arg CreateIncomeSourceParams) (*IncomeSource, error)
 	CreateMigrationsTable(ctx context.Context) error
+	CreateSettlement(ctx context.Context, arg CreateSettlementParams) (*Settlement, error)
+	CreateSharedCost(ctx context.Context, arg CreateSharedCostParams) (*SharedCost, error)
+	CreateSharedCostShare(ctx context.Context, arg CreateSharedCostShareParams) error
+	CreateStatementMapping(ctx context.Context, arg CreateStatementMappingParams) (*StatementMapping, error)
+	CreateSyncError(ctx context.Context, arg CreateSyncErrorParams) error
 	CreateTransaction(ctx context.Context, arg CreateTransactionParams) (*Transaction, error)
+	CreateTransactionMatch(ctx context.Context, arg CreateTransactionMatchParams) (int64, error)
+	CreateTransactionRule(ctx context.Context, arg CreateTransactionRuleParams) (*TransactionRule, error)
+	CreateTransactionSplit(ctx context.Context, arg CreateTransactionSplitParams) (*TransactionSplit, error)
+	CreateTransferPair(ctx context.Context, arg CreateTransferPairParams) (*TransferPair, error)
+	DeactivateFamilyDataKeys(ctx context.Context) error
 	DeactivateFamilyMember(ctx context.Context, id int64) error
 	DeleteAccount(ctx context.Context, id int64) error
+	DeleteAccountSyncState(ctx context.Context, accountID int64) error
+	DeleteAllIncomeSources(ctx context.Context) error
+	DeleteBalanceSnapshotsByAccount(ctx context.Context, accountID int64) error
 	DeleteCategory(ctx context.Context, id int64) error
+	DeleteCategoryBudget(ctx context.Context, categoryID int64) error
 	DeleteExpense(ctx context.Context, id int64) error
+	DeleteExpensePayment(ctx context.Context, arg DeleteExpensePaymentParams) (int64, error)
 	DeleteFamilyMember(ctx context.Context, id int64) error
 	DeleteFamilySetting(ctx context.Context, id int64) error
+	DeleteInactiveFamilyDataKeys(ctx context.Context) error
+	DeleteIncomeSource(ctx context.Context, id int64) error
+	DeleteOrphanedSharedCostShares(ctx context.Context) error
+	DeletePaycheckAllocation(ctx context.Context, arg DeletePaycheckAllocationParams) error
+	DeletePaycheckAllocationsByExpense(ctx context.Context, expenseID int64) error
+	DeletePaycheckAllocationsByIncomeSource(ctx context.Context, incomeSourceID int64) error
+	DeletePaymentByTransaction(ctx context.Context, transactionID *int64) error
+	DeletePaymentsByExpense(ctx context.Context, expenseID int64) error
+	DeleteSharedCost(ctx context.Context, id int64) error
+	DeleteSharedCostShares(ctx context.Context, sharedCostID int64) error
+	DeleteSharedCostsByAccount(ctx context.Context, accountID int64) error
+	DeleteSharedCostsByExpense(ctx context.Context, expenseID int64) error
+	DeleteStatementMapping(ctx context.Context, id int64) error
+	DeleteSyncErrorsBefore(ctx context.Context, createdAt time.Time) error
+	DeleteSyncErrorsByAccount(ctx context.Context, accountID *int64) error
+	DeleteTransactionMatchesByAccount(ctx context.Context, accountID int64) error
+	DeleteTransactionRule(ctx context.Context, id int64) error
+	DeleteTransactionRulesByAccount(ctx context.Context, accountID *int64) error
+	DeleteTransactionSplits(ctx context.Context, transactionID int64) error
+	DeleteTransactionSplitsByAccount(ctx context.Context, accountID int64) error
+	DeleteTransactionsByAccount(ctx context.Context, accountID int64) error
+	DeleteTransferPairsByAccount(ctx context.Context, accountID int64) error
+	DetachPaymentsFromAccount(ctx context.Context, accountID int64) error
+	GetAccountByID(ctx context.Context, id int64) (*Account, error)
+	GetAccountBySimplefinID(ctx context.Context, accountID string) (*Account, error)
+	GetAccountSyncState(ctx context.Context, accountID int64) (*AccountSyncState, error)
 	GetAccounts(ctx context.Context) ([]*Account, error)
+	GetActiveFamilyDataKey(ctx context.Context) (*FamilyDataKey, error)
 	GetAppliedMigrations(ctx context.Context) ([]*GetAppliedMigrationsRow, error)
+	GetCategoryBudget(ctx context.Context, categoryID int64) (*CategoryBudget, error)
 	GetCategoryByID(ctx context.Context, id int64) (*Category, error)
 	// Migration-related queries for family database
 	GetCurrentMigrationVersion(ctx context.Context) (int64, error)
 	GetExpenseByID(ctx context.Context, id int64) (*Expense, error)
+	GetExpensePayment(ctx context.Context, arg GetExpensePaymentParams) (*ExpensePayment, error)
+	GetExpensePaymentByID(ctx context.Context, id int64) (*ExpensePayment, error)
 	GetExpensesByDateRange(ctx context.Context, arg GetExpensesByDateRangeParams) ([]*Expense, error)
+	GetFamilyDataKey(ctx context.Context, id int64) (*FamilyDataKey, error)
 	GetFamilyMemberByEmail(ctx context.Context, email string) (*FamilyMember, error)
 	GetFamilyMemberByID(ctx context.Context, id int64) (*FamilyMember, error)
+	GetFamilySettingByID(ctx context.Context, id int64) (*FamilySetting, error)
 	GetFamilySettingByKey(ctx context.Context, settingKey string) (*FamilySetting, error)
+	GetIncomeSourceByID(ctx context.Context, id int64) (*IncomeSource, error)
+	GetIncomeSourceByName(ctx context.Context, name string) (*IncomeSource, error)
+	GetSharedCostByPayment(ctx context.Context, expensePaymentID *int64) (*SharedCost, error)
+	GetSharedCostByTransaction(ctx context.Context, transactionID *int64) (*SharedCost, error)
+	GetStatementMapping(ctx context.Context, id int64) (*StatementMapping, error)
+	GetTransactionByExternalID(ctx context.Context, arg GetTransactionByExternalIDParams) (*Transaction, error)
+	GetTransactionByID(ctx context.Context, id int64) (*Transaction, error)
+	GetTransactionMatch(ctx context.Context, id int64) (*TransactionMatch, error)
+	GetTransactionMatchByPair(ctx context.Context, arg GetTransactionMatchByPairParams) (*TransactionMatch, error)
+	GetTransactionRule(ctx context.Context, id int64) (*TransactionRule, error)
 	GetTransactionsByAccount(ctx context.Context, accountID int64) ([]*Transaction, error)
+	GetTransferPair(ctx context.Context, id int64) (*TransferPair, error)
+	LinkTransactionToExpense(ctx context.Context, arg LinkTransactionToExpenseParams) error
+	ListAccountSyncStates(ctx context.Context) ([]*AccountSyncState, error)
+	ListAccountTransactionsBetween(ctx context.Context, arg ListAccountTransactionsBetweenParams) ([]*Transaction, error)
+	ListAllBalanceSnapshots(ctx context.Context) ([]*BalanceSnapshot, error)
+	ListAllExpensePayments(ctx context.Context) ([]*ExpensePayment, error)
+	ListAllExpenses(ctx context.Context) ([]*Expense, error)
 	ListAllFamilyMembers(ctx context.Context) ([]*FamilyMember, error)
+	ListAllPaycheckAllocations(ctx context.Context) ([]*PaycheckAllocation, error)
+	ListAllSharedCostShares(ctx context.Context) ([]*SharedCostShare, error)
+	ListAllSharedCosts(ctx context.Context) ([]*SharedCost, error)
+	ListAllTransactionMatches(ctx context.Context) ([]*TransactionMatch, error)
+	ListAllTransactionSplits(ctx context.Context) ([]*TransactionSplit, error)
+	ListAllTransactions(ctx context.Context) ([]*Transaction, error)
+	ListAllTransferPairs(ctx context.Context) ([]*TransferPair, error)
+	ListBalanceSnapshots(ctx context.Context, endDate time.Time) ([]*BalanceSnapshot, error)
+	ListBudgetTransactions(ctx context.Context, arg ListBudgetTransactionsParams) ([]*ListBudgetTransactionsRow, error)
 	ListCategories(ctx context.Context) ([]*Category, error)
+	ListCategoryBudgets(ctx context.Context) ([]*CategoryBudget, error)
+	ListCategoryTrainingTransactions(ctx context.Context, limit int64) ([]*ListCategoryTrainingTransactionsRow, error)
+	ListExpensePayments(ctx context.Context, arg ListExpensePaymentsParams) ([]*ExpensePayment, error)
 	ListExpenses(ctx context.Context, arg ListExpensesParams) ([]*Expense, error)
 	ListExpensesByCategory(ctx context.Context, categoryID *int64) ([]*Expense, error)
 	ListFamilyMembers(ctx context.Context) ([]*FamilyMember, error)
 	ListFamilySettings(ctx context.Context) ([]*FamilySetting, error)
+	ListIncomeSources(ctx context.Context) ([]*IncomeSource, error)
+	ListPaycheckAllocations(ctx context.Context, arg ListPaycheckAllocationsParams) ([]*PaycheckAllocation, error)
+	ListPaymentsByScheduledDate(ctx context.Context, arg ListPaymentsByScheduledDateParams) ([]*ExpensePayment, error)
+	ListPendingTransactionMatches(ctx context.Context, arg ListPendingTransactionMatchesParams) ([]*TransactionMatch, error)
+	ListRecentSyncErrors(ctx context.Context, limit int64) ([]*SyncError, error)
+	ListSettlements(ctx context.Context) ([]*Settlement, error)
+	ListSharedCostAmounts(ctx context.Context) ([]*ListSharedCostAmountsRow, error)
+	ListSharedCostShares(ctx context.Context, sharedCostID int64) ([]*SharedCostShare, error)
+	ListStatementMappings(ctx context.Context) ([]*StatementMapping, error)
+	ListTransactionRules(ctx context.Context) ([]*TransactionRule, error)
+	ListTransactionSplits(ctx context.Context, transactionID int64) ([]*TransactionSplit, error)
+	ListTransactionSplitsByPostedDate(ctx context.Context, arg ListTransactionSplitsByPostedDateParams) ([]*TransactionSplit, error)
+	ListTransactions(ctx context.Context, arg ListTransactionsParams) ([]*Transaction, error)
+	ListTransactionsBetween(ctx context.Context, arg ListTransactionsBetweenParams) ([]*Transaction, error)
+	ListTransactionsForAdoption(ctx context.Context, arg ListTransactionsForAdoptionParams) ([]*Transaction, error)
+	ListTransferCandidates(ctx context.Context, startDate time.Time) ([]*Transaction, error)
+	ListTransferPairs(ctx context.Context, arg ListTransferPairsParams) ([]*TransferPair, error)
+	ListTransferPairsSince(ctx context.Context, startDate time.Time) ([]*TransferPair, error)
+	ListUncategorizedTransactions(ctx context.Context, arg ListUncategorizedTransactionsParams) ([]*Transaction, error)
+	ListUnmatchedTransactions(ctx context.Context, since time.Time) ([]*Transaction, error)
+	ReassignExpensesCategory(ctx context.Context, arg ReassignExpensesCategoryParams) (int64, error)
+	ReassignRulesCategory(ctx context.Context, arg ReassignRulesCategoryParams) error
+	ReassignSplitsCategory(ctx context.Context, arg ReassignSplitsCategoryParams) error
+	ReassignTransactionsCategory(ctx context.Context, arg ReassignTransactionsCategoryParams) (int64, error)
 	RecordMigration(ctx context.Context, arg RecordMigrationParams) error
+	RecordTransactionPayment(ctx context.Context, arg RecordTransactionPaymentParams) (*ExpensePayment, error)
+	RejectPendingTransactionMatches(ctx context.Context, arg RejectPendingTransactionMatchesParams) error
+	RelinkAccount(ctx context.Context, arg RelinkAccountParams) (*Account, error)
+	RestoreAccount(ctx context.Context, arg RestoreAccountParams) (*Account, error)
+	RestoreExpensePayment(ctx context.Context, arg RestoreExpensePaymentParams) (*ExpensePayment, error)
+	RestoreTransaction(ctx context.Context, arg RestoreTransactionParams) (*Transaction, error)
+	SetTransactionExternalID(ctx context.Context, arg SetTransactionExternalIDParams) error
+	SetTransactionSuggestion(ctx context.Context, arg SetTransactionSuggestionParams) (*Transaction, error)
+	SetTransactionTransfer(ctx context.Context, arg SetTransactionTransferParams) error
+	UnlinkAccount(ctx context.Context, arg UnlinkAccountParams) error
+	UnmarkTransferPartnersByAccount(ctx context.Context, accountID int64) error
+	UpdateAccount(ctx context.Context, arg UpdateAccountParams) (*Account, error)
 	UpdateCategory(ctx context.Context, arg UpdateCategoryParams) (*Category, error)
 	UpdateExpense(ctx context.Context, arg UpdateExpenseParams) (*Expense, error)
 	UpdateFamilyMember(ctx context.Context, arg UpdateFamilyMemberParams) (*FamilyMember, error)
 	UpdateFamilySetting(ctx context.Context, arg UpdateFamilySettingParams) (*FamilySetting, error)
+	UpdateIncomeSource(ctx context.Context, arg UpdateIncomeSourceParams) (*IncomeSource, error)
+	UpdateSharedCost(ctx context.Context, arg UpdateSharedCostParams) (*SharedCost, error)
+	UpdateStatementMapping(ctx context.Context, arg UpdateStatementMappingParams) (*StatementMapping, error)
+	UpdateSyncedTransaction(ctx context.Context, arg UpdateSyncedTransactionParams) error
+	UpdateTransactionDetails(ctx context.Context, arg UpdateTransactionDetailsParams) (*Transaction, error)
+	UpdateTransactionMatchStatus(ctx context.Context, arg UpdateTransactionMatchStatusParams) error
+	UpdateTransactionRule(ctx context.Context, arg UpdateTransactionRuleParams) (*TransactionRule, error)
+	UpdateTransferPairStatus(ctx context.Context, arg UpdateTransferPairStatusParams) error
+	UpsertAccountSyncState(ctx context.Context, arg UpsertAccountSyncStateParams) error
+	UpsertBalanceSnapshot(ctx context.Context, arg UpsertBalanceSnapshotParams) error
+	UpsertCategoryBudget(ctx context.Context, arg UpsertCategoryBudgetParams) (*CategoryBudget, error)
+	UpsertExpensePayment(ctx context.Context, arg UpsertExpensePaymentParams) (*ExpensePayment, error)
+	UpsertPaycheckAllocation(ctx context.Context, arg UpsertPaycheckAllocationParams) (*PaycheckAllocation, error)
+	UpsertTransactionMatch(ctx context.Context, arg UpsertTransactionMatchParams) (*TransactionMatch, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -10,35 +10,149 @@ import (
 	"time"
 )
 
+const clearCategorySuggestions = `-- name: ClearCategorySuggestions :exec
+UPDATE transactions
+SET suggested_category_id = NULL, suggestion_confidence = NULL
+WHERE suggested_category_id = ?
+`
+
+func (q *Queries) ClearCategorySuggestions(ctx context.Context, suggestedCategoryID *int64) error {
+	_, err := q.db.ExecContext(ctx, clearCategorySuggestions, suggestedCategoryID)
+	return err
+}
+
+const clearTransactionSuggestion = `-- name: ClearTransactionSuggestion :exec
+UPDATE transactions
+SET suggested_category_id = NULL, suggestion_confidence = NULL
+WHERE id = ?
+`
+
+func (q *Queries) ClearTransactionSuggestion(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, clearTransactionSuggestion, id)
+	return err
+}
+
+const countCategorySuggestions = `-- name: CountCategorySuggestions :one
+SELECT COUNT(*) FROM transactions WHERE suggested_category_id = ?
+`
+
+func (q *Queries) CountCategorySuggestions(ctx context.Context, suggestedCategoryID *int64) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countCategorySuggestions, suggestedCategoryID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countTransactions = `-- name: CountTransactions :one
+SELECT COUNT(*) FROM transactions
+WHERE (?1 IS NULL OR account_id = ?1)
+  AND (?2 IS NULL OR posted_date >= ?2)
+  AND (?3 IS NULL OR posted_date < ?3)
+  AND (?4 IS NULL OR amount_cents >= ?4)
+  AND (?5 IS NULL OR amount_cents <= ?5)
+  AND (?6 IS NULL OR pending = ?6)
+  AND (
+    ?7 IS NULL
+    OR (category_id = ?7 AND id NOT IN (SELECT transaction_id FROM transaction_splits))
+    OR id IN (SELECT transaction_id FROM transaction_splits WHERE category_id = ?7)
+  )
+  AND (
+    ?8 IS NULL
+    OR instr(lower(description), lower(?8)) > 0
+    OR instr(lower(payee), lower(?8)) > 0
+    OR instr(lower(coalesce(display_payee, '')), lower(?8)) > 0
+    OR instr(lower(coalesce(note, '')), lower(?8)) > 0
+  )
+`
+
+type CountTransactionsParams struct {
+	AccountID      *int64     `json:"account_id"`
+	StartDate      *time.Time `json:"start_date"`
+	EndDate        *time.Time `json:"end_date"`
+	MinAmountCents *int64     `json:"min_amount_cents"`
+	MaxAmountCents *int64     `json:"max_amount_cents"`
+	Pending        *bool      `json:"pending"`
+	CategoryID     *int64     `json:"category_id"`
+	Search         *string    `json:"search"`
+}
+
+func (q *Queries) CountTransactions(ctx context.Context, arg CountTransactionsParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countTransactions,
+		arg.AccountID,
+		arg.StartDate,
+		arg.EndDate,
+		arg.MinAmountCents,
+		arg.MaxAmountCents,
+		arg.Pending,
+		arg.CategoryID,
+		arg.Search,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countTransactionsByCategory = `-- name: CountTransactionsByCategory :one
+SELECT COUNT(*) FROM transactions WHERE category_id = ?
+`
+
+func (q *Queries) CountTransactionsByCategory(ctx context.Context, categoryID *int64) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countTransactionsByCategory, categoryID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createAccount = `-- name: CreateAccount :one
-INSERT INTO accounts (account_id,name)
-VALUES (?,?)
-RETURNING id, account_id, name
+INSERT INTO accounts (account_id,name,account_type,source)
+VALUES (?,?,?,?)
+RETURNING id, account_id, name, account_type, hidden, include_in_budget, unlinked_at, relinked_at, source
 `
 
 type CreateAccountParams struct {
-	AccountID string `json:"account_id"`
-	Name      string `json:"name"`
+	AccountID   string `json:"account_id"`
+	Name        string `json:"name"`
+	AccountType string `json:"account_type"`
+	Source      string `json:"source"`
 }
 
 func (q *Queries) CreateAccount(ctx context.Context, arg CreateAccountParams) (*Account, error) {
-	row := q.db.QueryRowContext(ctx, createAccount, arg.AccountID, arg.Name)
+	row := q.db.QueryRowContext(ctx, createAccount,
+		arg.AccountID,
+		arg.Name,
+		arg.AccountType,
+		arg.Source,
+	)
 	var i Account
-	err := row.Scan(&i.ID, &i.AccountID, &i.Name)
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Name,
+		&i.AccountType,
+		&i.Hidden,
+		&i.IncludeInBudget,
+		&i.UnlinkedAt,
+		&i.RelinkedAt,
+		&i.Source,
+	)
 	return &i, err
 }
 
 const createTransaction = `-- name: CreateTransaction :one
-INSERT INTO transactions (account_id,posted_date,description,payee)
-VALUES (?,?,?,?)
-RETURNING id, account_id, posted_date, description, payee
+INSERT INTO transactions (account_id,posted_date,description,payee,amount_cents,external_id,pending,transacted_at)
+VALUES (?,?,?,?,?,?,?,?)
+RETURNING id, account_id, posted_date, description, payee, amount_cents, matched_expense_id, matched_scheduled_date, external_id, pending, category_id, note, transacted_at, display_payee, is_transfer, suggested_category_id, suggestion_confidence
 `
 
 type CreateTransactionParams struct {
-	AccountID   int64     `json:"account_id"`
-	PostedDate  time.Time `json:"posted_date"`
-	Description string    `json:"description"`
-	Payee       string    `json:"payee"`
+	AccountID    int64      `json:"account_id"`
+	PostedDate   time.Time  `json:"posted_date"`
+	Description  string     `json:"description"`
+	Payee        string     `json:"payee"`
+	AmountCents  int64      `json:"amount_cents"`
+	ExternalID   *string    `json:"external_id"`
+	Pending      bool       `json:"pending"`
+	TransactedAt *time.Time `json:"transacted_at"`
 }
 
 func (q *Queries) CreateTransaction(ctx context.Context, arg CreateTransactionParams) (*Transaction, error) {
@@ -47,6 +161,10 @@ func (q *Queries) CreateTransaction(ctx context.Context, arg CreateTransactionPa
 		arg.PostedDate,
 		arg.Description,
 		arg.Payee,
+		arg.AmountCents,
+		arg.ExternalID,
+		arg.Pending,
+		arg.TransactedAt,
 	)
 	var i Transaction
 	err := row.Scan(
@@ -55,6 +173,18 @@ func (q *Queries) CreateTransaction(ctx context.Context, arg CreateTransactionPa
 		&i.PostedDate,
 		&i.Description,
 		&i.Payee,
+		&i.AmountCents,
+		&i.MatchedExpenseID,
+		&i.MatchedScheduledDate,
+		&i.ExternalID,
+		&i.Pending,
+		&i.CategoryID,
+		&i.Note,
+		&i.TransactedAt,
+		&i.DisplayPayee,
+		&i.IsTransfer,
+		&i.SuggestedCategoryID,
+		&i.SuggestionConfidence,
 	)
 	return &i, err
 }
@@ -68,8 +198,61 @@ func (q *Queries) DeleteAccount(ctx context.Context, id int64) error {
 	return err
 }
 
+const deleteTransactionsByAccount = `-- name: DeleteTransactionsByAccount :exec
+DELETE FROM transactions WHERE account_id = ?
+`
+
+func (q *Queries) DeleteTransactionsByAccount(ctx context.Context, accountID int64) error {
+	_, err := q.db.ExecContext(ctx, deleteTransactionsByAccount, accountID)
+	return err
+}
+
+const getAccountByID = `-- name: GetAccountByID :one
+SELECT id, account_id, name, account_type, hidden, include_in_budget, unlinked_at, relinked_at, source FROM accounts WHERE id = ?
+`
+
+func (q *Queries) GetAccountByID(ctx context.Context, id int64) (*Account, error) {
+	row := q.db.QueryRowContext(ctx, getAccountByID, id)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Name,
+		&i.AccountType,
+		&i.Hidden,
+		&i.IncludeInBudget,
+		&i.UnlinkedAt,
+		&i.RelinkedAt,
+		&i.Source,
+	)
+	return &i, err
+}
+
+const getAccountBySimplefinID = `-- name: GetAccountBySimplefinID :one
+SELECT id, account_id, name, account_type, hidden, include_in_budget, unlinked_at, relinked_at, source FROM accounts WHERE account_id = ?
+ORDER BY id ASC
+LIMIT 1
+`
+
+func (q *Queries) GetAccountBySimplefinID(ctx context.Context, accountID string) (*Account, error) {
+	row := q.db.QueryRowContext(ctx, getAccountBySimplefinID, accountID)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Name,
+		&i.AccountType,
+		&i.Hidden,
+		&i.IncludeInBudget,
+		&i.UnlinkedAt,
+		&i.RelinkedAt,
+		&i.Source,
+	)
+	return &i, err
+}
+
 const getAccounts = `-- name: GetAccounts :many
-SELECT id, account_id, name FROM accounts
+SELECT id, account_id, name, account_type, hidden, include_in_budget, unlinked_at, relinked_at, source FROM accounts
 `
 
 func (q *Queries) GetAccounts(ctx context.Context) ([]*Account, error) {
@@ -81,7 +264,17 @@ func (q *Queries) GetAccounts(ctx context.Context) ([]*Account, error) {
 	items := []*Account{}
 	for rows.Next() {
 		var i Account
-		if err := rows.Scan(&i.ID, &i.AccountID, &i.Name); err != nil {
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.Name,
+			&i.AccountType,
+			&i.Hidden,
+			&i.IncludeInBudget,
+			&i.UnlinkedAt,
+			&i.RelinkedAt,
+			&i.Source,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -95,8 +288,72 @@ func (q *Queries) GetAccounts(ctx context.Context) ([]*Account, error) {
 	return items, nil
 }
 
+const getTransactionByExternalID = `-- name: GetTransactionByExternalID :one
+SELECT id, account_id, posted_date, description, payee, amount_cents, matched_expense_id, matched_scheduled_date, external_id, pending, category_id, note, transacted_at, display_payee, is_transfer, suggested_category_id, suggestion_confidence FROM transactions
+WHERE account_id = ? AND external_id = ?
+`
+
+type GetTransactionByExternalIDParams struct {
+	AccountID  int64   `json:"account_id"`
+	ExternalID *string `json:"external_id"`
+}
+
+func (q *Queries) GetTransactionByExternalID(ctx context.Context, arg GetTransactionByExternalIDParams) (*Transaction, error) {
+	row := q.db.QueryRowContext(ctx, getTransactionByExternalID, arg.AccountID, arg.ExternalID)
+	var i Transaction
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.PostedDate,
+		&i.Description,
+		&i.Payee,
+		&i.AmountCents,
+		&i.MatchedExpenseID,
+		&i.MatchedScheduledDate,
+		&i.ExternalID,
+		&i.Pending,
+		&i.CategoryID,
+		&i.Note,
+		&i.TransactedAt,
+		&i.DisplayPayee,
+		&i.IsTransfer,
+		&i.SuggestedCategoryID,
+		&i.SuggestionConfidence,
+	)
+	return &i, err
+}
+
+const getTransactionByID = `-- name: GetTransactionByID :one
+SELECT id, account_id, posted_date, description, payee, amount_cents, matched_expense_id, matched_scheduled_date, external_id, pending, category_id, note, transacted_at, display_payee, is_transfer, suggested_category_id, suggestion_confidence FROM transactions WHERE id = ?
+`
+
+func (q *Queries) GetTransactionByID(ctx context.Context, id int64) (*Transaction, error) {
+	row := q.db.QueryRowContext(ctx, getTransactionByID, id)
+	var i Transaction
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.PostedDate,
+		&i.Description,
+		&i.Payee,
+		&i.AmountCents,
+		&i.MatchedExpenseID,
+		&i.MatchedScheduledDate,
+		&i.ExternalID,
+		&i.Pending,
+		&i.CategoryID,
+		&i.Note,
+		&i.TransactedAt,
+		&i.DisplayPayee,
+		&i.IsTransfer,
+		&i.SuggestedCategoryID,
+		&i.SuggestionConfidence,
+	)
+	return &i, err
+}
+
 const getTransactionsByAccount = `-- name: GetTransactionsByAccount :many
-SELECT id, account_id, posted_date, description, payee FROM transactions WHERE account_id = ?
+SELECT id, account_id, posted_date, description, payee, amount_cents, matched_expense_id, matched_scheduled_date, external_id, pending, category_id, note, transacted_at, display_payee, is_transfer, suggested_category_id, suggestion_confidence FROM transactions WHERE account_id = ?
 `
 
 func (q *Queries) GetTransactionsByAccount(ctx context.Context, accountID int64) ([]*Transaction, error) {
@@ -114,6 +371,266 @@ func (q *Queries) GetTransactionsByAccount(ctx context.Context, accountID int64)
 			&i.PostedDate,
 			&i.Description,
 			&i.Payee,
+			&i.AmountCents,
+			&i.MatchedExpenseID,
+			&i.MatchedScheduledDate,
+			&i.ExternalID,
+			&i.Pending,
+			&i.CategoryID,
+			&i.Note,
+			&i.TransactedAt,
+			&i.DisplayPayee,
+			&i.IsTransfer,
+			&i.SuggestedCategoryID,
+			&i.SuggestionConfidence,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const linkTransactionToExpense = `-- name: LinkTransactionToExpense :exec
+UPDATE transactions
+SET matched_expense_id = ?, matched_scheduled_date = ?
+WHERE id = ?
+`
+
+type LinkTransactionToExpenseParams struct {
+	MatchedExpenseID     *int64     `json:"matched_expense_id"`
+	MatchedScheduledDate *time.Time `json:"matched_scheduled_date"`
+	ID                   int64      `json:"id"`
+}
+
+func (q *Queries) LinkTransactionToExpense(ctx context.Context, arg LinkTransactionToExpenseParams) error {
+	_, err := q.db.ExecContext(ctx, linkTransactionToExpense, arg.MatchedExpenseID, arg.MatchedScheduledDate, arg.ID)
+	return err
+}
+
+const listAccountTransactionsBetween = `-- name: ListAccountTransactionsBetween :many
+SELECT id, account_id, posted_date, description, payee, amount_cents, matched_expense_id, matched_scheduled_date, external_id, pending, category_id, note, transacted_at, display_payee, is_transfer, suggested_category_id, suggestion_confidence FROM transactions
+WHERE account_id = ?1 AND posted_date >= ?2 AND posted_date < ?3
+ORDER BY id ASC
+`
+
+type ListAccountTransactionsBetweenParams struct {
+	AccountID int64     `json:"account_id"`
+	StartDate time.Time `json:"start_date"`
+	EndDate   time.Time `json:"end_date"`
+}
+
+func (q *Queries) ListAccountTransactionsBetween(ctx context.Context, arg ListAccountTransactionsBetweenParams) ([]*Transaction, error) {
+	rows, err := q.db.QueryContext(ctx, listAccountTransactionsBetween, arg.AccountID, arg.StartDate, arg.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*Transaction{}
+	for rows.Next() {
+		var i Transaction
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.PostedDate,
+			&i.Description,
+			&i.Payee,
+			&i.AmountCents,
+			&i.MatchedExpenseID,
+			&i.MatchedScheduledDate,
+			&i.ExternalID,
+			&i.Pending,
+			&i.CategoryID,
+			&i.Note,
+			&i.TransactedAt,
+			&i.DisplayPayee,
+			&i.IsTransfer,
+			&i.SuggestedCategoryID,
+			&i.SuggestionConfidence,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAllTransactions = `-- name: ListAllTransactions :many
+SELECT id, account_id, posted_date, description, payee, amount_cents, matched_expense_id, matched_scheduled_date, external_id, pending, category_id, note, transacted_at, display_payee, is_transfer, suggested_category_id, suggestion_confidence FROM transactions ORDER BY id ASC
+`
+
+func (q *Queries) ListAllTransactions(ctx context.Context) ([]*Transaction, error) {
+	rows, err := q.db.QueryContext(ctx, listAllTransactions)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*Transaction{}
+	for rows.Next() {
+		var i Transaction
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.PostedDate,
+			&i.Description,
+			&i.Payee,
+			&i.AmountCents,
+			&i.MatchedExpenseID,
+			&i.MatchedScheduledDate,
+			&i.ExternalID,
+			&i.Pending,
+			&i.CategoryID,
+			&i.Note,
+			&i.TransactedAt,
+			&i.DisplayPayee,
+			&i.IsTransfer,
+			&i.SuggestedCategoryID,
+			&i.SuggestionConfidence,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCategoryTrainingTransactions = `-- name: ListCategoryTrainingTransactions :many
+SELECT description, payee, category_id FROM transactions
+WHERE category_id IS NOT NULL AND is_transfer = FALSE
+  AND (suggested_category_id IS NULL OR suggested_category_id != category_id)
+ORDER BY posted_date DESC, id DESC
+LIMIT ?1
+`
+
+type ListCategoryTrainingTransactionsRow struct {
+	Description string `json:"description"`
+	Payee       string `json:"payee"`
+	CategoryID  *int64 `json:"category_id"`
+}
+
+func (q *Queries) ListCategoryTrainingTransactions(ctx context.Context, limit int64) ([]*ListCategoryTrainingTransactionsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listCategoryTrainingTransactions, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ListCategoryTrainingTransactionsRow{}
+	for rows.Next() {
+		var i ListCategoryTrainingTransactionsRow
+		if err := rows.Scan(&i.Description, &i.Payee, &i.CategoryID); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTransactions = `-- name: ListTransactions :many
+SELECT id, account_id, posted_date, description, payee, amount_cents, matched_expense_id, matched_scheduled_date, external_id, pending, category_id, note, transacted_at, display_payee, is_transfer, suggested_category_id, suggestion_confidence FROM transactions
+WHERE (?1 IS NULL OR account_id = ?1)
+  AND (?2 IS NULL OR posted_date >= ?2)
+  AND (?3 IS NULL OR posted_date < ?3)
+  AND (?4 IS NULL OR amount_cents >= ?4)
+  AND (?5 IS NULL OR amount_cents <= ?5)
+  AND (?6 IS NULL OR pending = ?6)
+  AND (
+    ?7 IS NULL
+    OR (category_id = ?7 AND id NOT IN (SELECT transaction_id FROM transaction_splits))
+    OR id IN (SELECT transaction_id FROM transaction_splits WHERE category_id = ?7)
+  )
+  AND (
+    ?8 IS NULL
+    OR instr(lower(description), lower(?8)) > 0
+    OR instr(lower(payee), lower(?8)) > 0
+    OR instr(lower(coalesce(display_payee, '')), lower(?8)) > 0
+    OR instr(lower(coalesce(note, '')), lower(?8)) > 0
+  )
+  AND (
+    ?9 IS NULL
+    OR posted_date < ?9
+    OR (posted_date = ?9 AND id < ?10)
+  )
+ORDER BY posted_date DESC, id DESC
+LIMIT ?11
+`
+
+type ListTransactionsParams struct {
+	AccountID      *int64     `json:"account_id"`
+	StartDate      *time.Time `json:"start_date"`
+	EndDate        *time.Time `json:"end_date"`
+	MinAmountCents *int64     `json:"min_amount_cents"`
+	MaxAmountCents *int64     `json:"max_amount_cents"`
+	Pending        *bool      `json:"pending"`
+	CategoryID     *int64     `json:"category_id"`
+	Search         *string    `json:"search"`
+	BeforeDate     *time.Time `json:"before_date"`
+	BeforeID       int64      `json:"before_id"`
+	Limit          int64      `json:"limit"`
+}
+
+func (q *Queries) ListTransactions(ctx context.Context, arg ListTransactionsParams) ([]*Transaction, error) {
+	rows, err := q.db.QueryContext(ctx, listTransactions,
+		arg.AccountID,
+		arg.StartDate,
+		arg.EndDate,
+		arg.MinAmountCents,
+		arg.MaxAmountCents,
+		arg.Pending,
+		arg.CategoryID,
+		arg.Search,
+		arg.BeforeDate,
+		arg.BeforeID,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*Transaction{}
+	for rows.Next() {
+		var i Transaction
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.PostedDate,
+			&i.Description,
+			&i.Payee,
+			&i.AmountCents,
+			&i.MatchedExpenseID,
+			&i.MatchedScheduledDate,
+			&i.ExternalID,
+			&i.Pending,
+			&i.CategoryID,
+			&i.Note,
+			&i.TransactedAt,
+			&i.DisplayPayee,
+			&i.IsTransfer,
+			&i.SuggestedCategoryID,
+			&i.SuggestionConfidence,
 		); err != nil {
 			return nil, err
 		}
@@ -127,3 +644,573 @@ func (q *Queries) GetTransactionsByAccount(ctx context.Context, accountID int64)
 	}
 	return items, nil
 }
+
+const listTransactionsBetween = `-- name: ListTransactionsBetween :many
+SELECT id, account_id, posted_date, description, payee, amount_cents, matched_expense_id, matched_scheduled_date, external_id, pending, category_id, note, transacted_at, display_payee, is_transfer, suggested_category_id, suggestion_confidence FROM transactions
+WHERE (?1 IS NULL OR posted_date >= ?1)
+  AND (?2 IS NULL OR posted_date < ?2)
+ORDER BY posted_date ASC, id ASC
+`
+
+type ListTransactionsBetweenParams struct {
+	StartDate *time.Time `json:"start_date"`
+	EndDate   *time.Time `json:"end_date"`
+}
+
+func (q *Queries) ListTransactionsBetween(ctx context.Context, arg ListTransactionsBetweenParams) ([]*Transaction, error) {
+	rows, err := q.db.QueryContext(ctx, listTransactionsBetween, arg.StartDate, arg.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*Transaction{}
+	for rows.Next() {
+		var i Transaction
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.PostedDate,
+			&i.Description,
+			&i.Payee,
+			&i.AmountCents,
+			&i.MatchedExpenseID,
+			&i.MatchedScheduledDate,
+			&i.ExternalID,
+			&i.Pending,
+			&i.CategoryID,
+			&i.Note,
+			&i.TransactedAt,
+			&i.DisplayPayee,
+			&i.IsTransfer,
+			&i.SuggestedCategoryID,
+			&i.SuggestionConfidence,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTransactionsForAdoption = `-- name: ListTransactionsForAdoption :many
+SELECT id, account_id, posted_date, description, payee, amount_cents, matched_expense_id, matched_scheduled_date, external_id, pending, category_id, note, transacted_at, display_payee, is_transfer, suggested_category_id, suggestion_confidence FROM transactions
+WHERE account_id = ? AND posted_date = ? AND amount_cents = ?
+ORDER BY id ASC
+`
+
+type ListTransactionsForAdoptionParams struct {
+	AccountID   int64     `json:"account_id"`
+	PostedDate  time.Time `json:"posted_date"`
+	AmountCents int64     `json:"amount_cents"`
+}
+
+func (q *Queries) ListTransactionsForAdoption(ctx context.Context, arg ListTransactionsForAdoptionParams) ([]*Transaction, error) {
+	rows, err := q.db.QueryContext(ctx, listTransactionsForAdoption, arg.AccountID, arg.PostedDate, arg.AmountCents)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*Transaction{}
+	for rows.Next() {
+		var i Transaction
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.PostedDate,
+			&i.Description,
+			&i.Payee,
+			&i.AmountCents,
+			&i.MatchedExpenseID,
+			&i.MatchedScheduledDate,
+			&i.ExternalID,
+			&i.Pending,
+			&i.CategoryID,
+			&i.Note,
+			&i.TransactedAt,
+			&i.DisplayPayee,
+			&i.IsTransfer,
+			&i.SuggestedCategoryID,
+			&i.SuggestionConfidence,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUncategorizedTransactions = `-- name: ListUncategorizedTransactions :many
+SELECT id, account_id, posted_date, description, payee, amount_cents, matched_expense_id, matched_scheduled_date, external_id, pending, category_id, note, transacted_at, display_payee, is_transfer, suggested_category_id, suggestion_confidence FROM transactions
+WHERE category_id IS NULL AND is_transfer = FALSE
+  AND id NOT IN (SELECT transaction_id FROM transaction_splits)
+  AND (?1 IS NULL OR posted_date >= ?1)
+  AND (?2 IS NULL OR posted_date < ?2)
+ORDER BY posted_date ASC, id ASC
+`
+
+type ListUncategorizedTransactionsParams struct {
+	StartDate *time.Time `json:"start_date"`
+	EndDate   *time.Time `json:"end_date"`
+}
+
+func (q *Queries) ListUncategorizedTransactions(ctx context.Context, arg ListUncategorizedTransactionsParams) ([]*Transaction, error) {
+	rows, err := q.db.QueryContext(ctx, listUncategorizedTransactions, arg.StartDate, arg.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*Transaction{}
+	for rows.Next() {
+		var i Transaction
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.PostedDate,
+			&i.Description,
+			&i.Payee,
+			&i.AmountCents,
+			&i.MatchedExpenseID,
+			&i.MatchedScheduledDate,
+			&i.ExternalID,
+			&i.Pending,
+			&i.CategoryID,
+			&i.Note,
+			&i.TransactedAt,
+			&i.DisplayPayee,
+			&i.IsTransfer,
+			&i.SuggestedCategoryID,
+			&i.SuggestionConfidence,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUnmatchedTransactions = `-- name: ListUnmatchedTransactions :many
+SELECT id, account_id, posted_date, description, payee, amount_cents, matched_expense_id, matched_scheduled_date, external_id, pending, category_id, note, transacted_at, display_payee, is_transfer, suggested_category_id, suggestion_confidence FROM transactions
+WHERE matched_expense_id IS NULL AND amount_cents < 0 AND pending = FALSE AND posted_date >= ?1
+ORDER BY posted_date ASC, id ASC
+`
+
+func (q *Queries) ListUnmatchedTransactions(ctx context.Context, since time.Time) ([]*Transaction, error) {
+	rows, err := q.db.QueryContext(ctx, listUnmatchedTransactions, since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*Transaction{}
+	for rows.Next() {
+		var i Transaction
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.PostedDate,
+			&i.Description,
+			&i.Payee,
+			&i.AmountCents,
+			&i.MatchedExpenseID,
+			&i.MatchedScheduledDate,
+			&i.ExternalID,
+			&i.Pending,
+			&i.CategoryID,
+			&i.Note,
+			&i.TransactedAt,
+			&i.DisplayPayee,
+			&i.IsTransfer,
+			&i.SuggestedCategoryID,
+			&i.SuggestionConfidence,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const reassignTransactionsCategory = `-- name: ReassignTransactionsCategory :execrows
+UPDATE transactions
+SET category_id = ?1
+WHERE category_id = ?2
+`
+
+type ReassignTransactionsCategoryParams struct {
+	NewCategoryID *int64 `json:"new_category_id"`
+	OldCategoryID *int64 `json:"old_category_id"`
+}
+
+func (q *Queries) ReassignTransactionsCategory(ctx context.Context, arg ReassignTransactionsCategoryParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, reassignTransactionsCategory, arg.NewCategoryID, arg.OldCategoryID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const relinkAccount = `-- name: RelinkAccount :one
+UPDATE accounts
+SET account_id = ?, source = 'simplefin', unlinked_at = NULL, relinked_at = ?
+WHERE id = ?
+RETURNING id, account_id, name, account_type, hidden, include_in_budget, unlinked_at, relinked_at, source
+`
+
+type RelinkAccountParams struct {
+	AccountID  string     `json:"account_id"`
+	RelinkedAt *time.Time `json:"relinked_at"`
+	ID         int64      `json:"id"`
+}
+
+func (q *Queries) RelinkAccount(ctx context.Context, arg RelinkAccountParams) (*Account, error) {
+	row := q.db.QueryRowContext(ctx, relinkAccount, arg.AccountID, arg.RelinkedAt, arg.ID)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Name,
+		&i.AccountType,
+		&i.Hidden,
+		&i.IncludeInBudget,
+		&i.UnlinkedAt,
+		&i.RelinkedAt,
+		&i.Source,
+	)
+	return &i, err
+}
+
+const restoreAccount = `-- name: RestoreAccount :one
+INSERT INTO accounts (account_id, name, account_type, hidden, include_in_budget, unlinked_at, relinked_at, source)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+RETURNING id, account_id, name, account_type, hidden, include_in_budget, unlinked_at, relinked_at, source
+`
+
+type RestoreAccountParams struct {
+	AccountID       string     `json:"account_id"`
+	Name            string     `json:"name"`
+	AccountType     string     `json:"account_type"`
+	Hidden          bool       `json:"hidden"`
+	IncludeInBudget bool       `json:"include_in_budget"`
+	UnlinkedAt      *time.Time `json:"unlinked_at"`
+	RelinkedAt      *time.Time `json:"relinked_at"`
+	Source          string     `json:"source"`
+}
+
+func (q *Queries) RestoreAccount(ctx context.Context, arg RestoreAccountParams) (*Account, error) {
+	row := q.db.QueryRowContext(ctx, restoreAccount,
+		arg.AccountID,
+		arg.Name,
+		arg.AccountType,
+		arg.Hidden,
+		arg.IncludeInBudget,
+		arg.UnlinkedAt,
+		arg.RelinkedAt,
+		arg.Source,
+	)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Name,
+		&i.AccountType,
+		&i.Hidden,
+		&i.IncludeInBudget,
+		&i.UnlinkedAt,
+		&i.RelinkedAt,
+		&i.Source,
+	)
+	return &i, err
+}
+
+const restoreTransaction = `-- name: RestoreTransaction :one
+INSERT INTO transactions (
+    account_id, posted_date, description, payee, amount_cents, matched_expense_id, matched_scheduled_date,
+    external_id, pending, category_id, note, transacted_at, display_payee, is_transfer,
+    suggested_category_id, suggestion_confidence
+)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+RETURNING id, account_id, posted_date, description, payee, amount_cents, matched_expense_id, matched_scheduled_date, external_id, pending, category_id, note, transacted_at, display_payee, is_transfer, suggested_category_id, suggestion_confidence
+`
+
+type RestoreTransactionParams struct {
+	AccountID            int64      `json:"account_id"`
+	PostedDate           time.Time  `json:"posted_date"`
+	Description          string     `json:"description"`
+	Payee                string     `json:"payee"`
+	AmountCents          int64      `json:"amount_cents"`
+	MatchedExpenseID     *int64     `json:"matched_expense_id"`
+	MatchedScheduledDate *time.Time `json:"matched_scheduled_date"`
+	ExternalID           *string    `json:"external_id"`
+	Pending              bool       `json:"pending"`
+	CategoryID           *int64     `json:"category_id"`
+	Note                 *string    `json:"note"`
+	TransactedAt         *time.Time `json:"transacted_at"`
+	DisplayPayee         *string    `json:"display_payee"`
+	IsTransfer           bool       `json:"is_transfer"`
+	SuggestedCategoryID  *int64     `json:"suggested_category_id"`
+	SuggestionConfidence *float64   `json:"suggestion_confidence"`
+}
+
+func (q *Queries) RestoreTransaction(ctx context.Context, arg RestoreTransactionParams) (*Transaction, error) {
+	row := q.db.QueryRowContext(ctx, restoreTransaction,
+		arg.AccountID,
+		arg.PostedDate,
+		arg.Description,
+		arg.Payee,
+		arg.AmountCents,
+		arg.MatchedExpenseID,
+		arg.MatchedScheduledDate,
+		arg.ExternalID,
+		arg.Pending,
+		arg.CategoryID,
+		arg.Note,
+		arg.TransactedAt,
+		arg.DisplayPayee,
+		arg.IsTransfer,
+		arg.SuggestedCategoryID,
+		arg.SuggestionConfidence,
+	)
+	var i Transaction
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.PostedDate,
+		&i.Description,
+		&i.Payee,
+		&i.AmountCents,
+		&i.MatchedExpenseID,
+		&i.MatchedScheduledDate,
+		&i.ExternalID,
+		&i.Pending,
+		&i.CategoryID,
+		&i.Note,
+		&i.TransactedAt,
+		&i.DisplayPayee,
+		&i.IsTransfer,
+		&i.SuggestedCategoryID,
+		&i.SuggestionConfidence,
+	)
+	return &i, err
+}
+
+const setTransactionExternalID = `-- name: SetTransactionExternalID :exec
+UPDATE transactions SET external_id = ? WHERE id = ?
+`
+
+type SetTransactionExternalIDParams struct {
+	ExternalID *string `json:"external_id"`
+	ID         int64   `json:"id"`
+}
+
+func (q *Queries) SetTransactionExternalID(ctx context.Context, arg SetTransactionExternalIDParams) error {
+	_, err := q.db.ExecContext(ctx, setTransactionExternalID, arg.ExternalID, arg.ID)
+	return err
+}
+
+const setTransactionSuggestion = `-- name: SetTransactionSuggestion :one
+UPDATE transactions
+SET category_id = ?, suggested_category_id = ?, suggestion_confidence = ?
+WHERE id = ?
+RETURNING id, account_id, posted_date, description, payee, amount_cents, matched_expense_id, matched_scheduled_date, external_id, pending, category_id, note, transacted_at, display_payee, is_transfer, suggested_category_id, suggestion_confidence
+`
+
+type SetTransactionSuggestionParams struct {
+	CategoryID           *int64   `json:"category_id"`
+	SuggestedCategoryID  *int64   `json:"suggested_category_id"`
+	SuggestionConfidence *float64 `json:"suggestion_confidence"`
+	ID                   int64    `json:"id"`
+}
+
+func (q *Queries) SetTransactionSuggestion(ctx context.Context, arg SetTransactionSuggestionParams) (*Transaction, error) {
+	row := q.db.QueryRowContext(ctx, setTransactionSuggestion,
+		arg.CategoryID,
+		arg.SuggestedCategoryID,
+		arg.SuggestionConfidence,
+		arg.ID,
+	)
+	var i Transaction
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.PostedDate,
+		&i.Description,
+		&i.Payee,
+		&i.AmountCents,
+		&i.MatchedExpenseID,
+		&i.MatchedScheduledDate,
+		&i.ExternalID,
+		&i.Pending,
+		&i.CategoryID,
+		&i.Note,
+		&i.TransactedAt,
+		&i.DisplayPayee,
+		&i.IsTransfer,
+		&i.SuggestedCategoryID,
+		&i.SuggestionConfidence,
+	)
+	return &i, err
+}
+
+const setTransactionTransfer = `-- name: SetTransactionTransfer :exec
+UPDATE transactions SET is_transfer = ? WHERE id = ?
+`
+
+type SetTransactionTransferParams struct {
+	IsTransfer bool  `json:"is_transfer"`
+	ID         int64 `json:"id"`
+}
+
+func (q *Queries) SetTransactionTransfer(ctx context.Context, arg SetTransactionTransferParams) error {
+	_, err := q.db.ExecContext(ctx, setTransactionTransfer, arg.IsTransfer, arg.ID)
+	return err
+}
+
+const unlinkAccount = `-- name: UnlinkAccount :exec
+UPDATE accounts SET unlinked_at = ? WHERE id = ?
+`
+
+type UnlinkAccountParams struct {
+	UnlinkedAt *time.Time `json:"unlinked_at"`
+	ID         int64      `json:"id"`
+}
+
+func (q *Queries) UnlinkAccount(ctx context.Context, arg UnlinkAccountParams) error {
+	_, err := q.db.ExecContext(ctx, unlinkAccount, arg.UnlinkedAt, arg.ID)
+	return err
+}
+
+const updateAccount = `-- name: UpdateAccount :one
+UPDATE accounts
+SET name = ?, account_type = ?, hidden = ?, include_in_budget = ?
+WHERE id = ?
+RETURNING id, account_id, name, account_type, hidden, include_in_budget, unlinked_at, relinked_at, source
+`
+
+type UpdateAccountParams struct {
+	Name            string `json:"name"`
+	AccountType     string `json:"account_type"`
+	Hidden          bool   `json:"hidden"`
+	IncludeInBudget bool   `json:"include_in_budget"`
+	ID              int64  `json:"id"`
+}
+
+func (q *Queries) UpdateAccount(ctx context.Context, arg UpdateAccountParams) (*Account, error) {
+	row := q.db.QueryRowContext(ctx, updateAccount,
+		arg.Name,
+		arg.AccountType,
+		arg.Hidden,
+		arg.IncludeInBudget,
+		arg.ID,
+	)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Name,
+		&i.AccountType,
+		&i.Hidden,
+		&i.IncludeInBudget,
+		&i.UnlinkedAt,
+		&i.RelinkedAt,
+		&i.Source,
+	)
+	return &i, err
+}
+
+const updateSyncedTransaction = `-- name: UpdateSyncedTransaction :exec
+UPDATE transactions
+SET posted_date = ?, description = ?, payee = ?, amount_cents = ?, pending = ?, transacted_at = ?
+WHERE id = ?
+`
+
+type UpdateSyncedTransactionParams struct {
+	PostedDate   time.Time  `json:"posted_date"`
+	Description  string     `json:"description"`
+	Payee        string     `json:"payee"`
+	AmountCents  int64      `json:"amount_cents"`
+	Pending      bool       `json:"pending"`
+	TransactedAt *time.Time `json:"transacted_at"`
+	ID           int64      `json:"id"`
+}
+
+func (q *Queries) UpdateSyncedTransaction(ctx context.Context, arg UpdateSyncedTransactionParams) error {
+	_, err := q.db.ExecContext(ctx, updateSyncedTransaction,
+		arg.PostedDate,
+		arg.Description,
+		arg.Payee,
+		arg.AmountCents,
+		arg.Pending,
+		arg.TransactedAt,
+		arg.ID,
+	)
+	return err
+}
+
+const updateTransactionDetails = `-- name: UpdateTransactionDetails :one
+UPDATE transactions
+SET category_id = ?, note = ?, display_payee = ?, is_transfer = ?
+WHERE id = ?
+RETURNING id, account_id, posted_date, description, payee, amount_cents, matched_expense_id, matched_scheduled_date, external_id, pending, category_id, note, transacted_at, display_payee, is_transfer, suggested_category_id, suggestion_confidence
+`
+
+type UpdateTransactionDetailsParams struct {
+	CategoryID   *int64  `json:"category_id"`
+	Note         *string `json:"note"`
+	DisplayPayee *string `json:"display_payee"`
+	IsTransfer   bool    `json:"is_transfer"`
+	ID           int64   `json:"id"`
+}
+
+func (q *Queries) UpdateTransactionDetails(ctx context.Context, arg UpdateTransactionDetailsParams) (*Transaction, error) {
+	row := q.db.QueryRowContext(ctx, updateTransactionDetails,
+		arg.CategoryID,
+		arg.Note,
+		arg.DisplayPayee,
+		arg.IsTransfer,
+		arg.ID,
+	)
+	var i Transaction
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.PostedDate,
+		&i.Description,
+		&i.Payee,
+		&i.AmountCents,
+		&i.MatchedExpenseID,
+		&i.MatchedScheduledDate,
+		&i.ExternalID,
+		&i.Pending,
+		&i.CategoryID,
+		&i.Note,
+		&i.TransactedAt,
+		&i.DisplayPayee,
+		&i.IsTransfer,
+		&i.SuggestedCategoryID,
+		&i.SuggestionConfidence,
+	)
+	return &i, err
+}
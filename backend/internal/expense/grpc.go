@@ -3,12 +3,16 @@ package expense
 import (
 	"context"
 	"database/sql"
+	"errors"
 	appcontext "expenses-backend/internal/context"
 	"expenses-backend/internal/database"
 	"expenses-backend/internal/database/sql/familydb"
 	"expenses-backend/internal/family"
 	"expenses-backend/internal/logger"
+	"expenses-backend/internal/pagination"
+	"expenses-backend/internal/recurrence"
 	expensev1 "expenses-backend/pkg/expense/v1"
+	"fmt"
 	"slices"
 	"strconv"
 	"time"
@@ -18,22 +22,61 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+const (
+	defaultPageSize = 50
+	maxPageSize     = 500
+
+	defaultOccurrenceCount = 12
+	maxOccurrenceCount     = 100
+)
+
 // Service handles expense operations using database queries
 type Service struct {
 	dbManager     *database.DatabaseManager
 	familyService *family.Service
+	pageTokens    *pagination.Codec
 	logger        logger.Logger
 }
 
 // NewService creates a new expense service
-func NewService(dbManager *database.DatabaseManager, familyService *family.Service, log logger.Logger) *Service {
+func NewService(dbManager *database.DatabaseManager, familyService *family.Service, pageTokens *pagination.Codec, log logger.Logger) *Service {
 	return &Service{
 		dbManager:     dbManager,
 		familyService: familyService,
+		pageTokens:    pageTokens,
 		logger:        log.With(logger.Str("component", "expense-service")),
 	}
 }
 
+// expenseCursor is the keyset position encoded in ListExpenses page tokens
+type expenseCursor struct {
+	Day int64 `json:"d"`
+	ID  int64 `json:"i"`
+}
+
+// expenseFilters are the server-side filters supported by ListExpenses
+type expenseFilters struct {
+	CategoryID *int64
+	IsAutopay  *bool
+	MinDay     *int64
+	MaxDay     *int64
+}
+
+// scope identifies the filter set a page token was issued for
+func (f expenseFilters) scope() string {
+	return fmt.Sprintf("expenses:%s:%s:%s:%s",
+		pagination.ScopeValue(f.CategoryID), pagination.ScopeValue(f.IsAutopay),
+		pagination.ScopeValue(f.MinDay), pagination.ScopeValue(f.MaxDay))
+}
+
+func int32PtrToInt64(v *int32) *int64 {
+	if v == nil {
+		return nil
+	}
+	i := int64(*v)
+	return &i
+}
+
 func (s *Service) CreateExpense(ctx context.Context, req *connect.Request[expensev1.CreateExpenseRequest]) (*connect.Response[expensev1.CreateExpenseResponse], error) {
 	// Get authentication context
 	authCtx, err := appcontext.RequireFamily(ctx)
@@ -48,31 +91,55 @@ func (s *Service) CreateExpense(ctx context.Context, req *connect.Request[expens
 	if req.Msg.Amount <= 0 {
 		return nil, status.Error(codes.InvalidArgument, "amount must be positive")
 	}
-	if req.Msg.DayOfMonthDue < 1 || req.Msg.DayOfMonthDue > 31 {
+
+	// Expenses without a recurrence are due on the same day every month
+	sched := monthlySchedule(req.Msg.DayOfMonthDue)
+	if req.Msg.Recurrence != nil {
+		sched, err = scheduleFromProto(req.Msg.Recurrence)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	} else if req.Msg.DayOfMonthDue < 1 || req.Msg.DayOfMonthDue > 31 {
 		return nil, status.Error(codes.InvalidArgument, "day_of_month_due must be between 1 and 31")
 	}
 
-	now := time.Now()
-
-	// Validate day of month
-	day := max(min(req.Msg.DayOfMonthDue, 31), 1)
-
-	createParams := familydb.CreateExpenseParams{
-		CategoryID:    nil, // Set to nil for now, could be mapped from request
-		Amount:        req.Msg.Amount,
-		Name:          req.Msg.Name,
-		DayOfMonthDue: int64(day),
-		IsAutopay:     req.Msg.IsAutopay,
-		CreatedAt:     now,
-		UpdatedAt:     now,
+	adjustment, err := adjustmentFromProto(req.Msg.BusinessDayAdjustment)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	now := time.Now()
+
 	// Get family database queries
 	familyQueries, err := s.dbManager.GetFamilyQueries(int(authCtx.FamilyID))
 	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to access family database")
 	}
 
+	categoryID, err := s.resolveCategoryID(ctx, familyQueries, req.Msg.CategoryId)
+	if err != nil {
+		return nil, err
+	}
+
+	createParams := familydb.CreateExpenseParams{
+		CategoryID:            categoryID,
+		Amount:                req.Msg.Amount,
+		Name:                  req.Msg.Name,
+		DayOfMonthDue:         sched.DayOfMonthDue,
+		IsAutopay:             req.Msg.IsAutopay,
+		CreatedAt:             now,
+		UpdatedAt:             now,
+		Frequency:             sched.Frequency,
+		IntervalCount:         sched.IntervalCount,
+		AnchorDate:            sched.AnchorDate,
+		EndDate:               sched.EndDate,
+		MaxOccurrences:        sched.MaxOccurrences,
+		BusinessDayAdjustment: adjustment,
+	}
+	if req.Msg.IsAutopay {
+		createParams.AutopaySince = &now
+	}
+
 	// Create expense using SQLC
 	expenseResult, err := familyQueries.CreateExpense(ctx, createParams)
 	if err != nil {
@@ -175,13 +242,20 @@ func (s *Service) UpdateExpense(ctx context.Context, req *connect.Request[expens
 
 	// Build update parameters
 	updateParams := familydb.UpdateExpenseParams{
-		ID:            req.Msg.Id,
-		CategoryID:    current.CategoryID,
-		Amount:        current.Amount,
-		Name:          current.Name,
-		DayOfMonthDue: current.DayOfMonthDue,
-		IsAutopay:     current.IsAutopay,
-		UpdatedAt:     time.Now(),
+		ID:                    req.Msg.Id,
+		CategoryID:            current.CategoryID,
+		Amount:                current.Amount,
+		Name:                  current.Name,
+		DayOfMonthDue:         current.DayOfMonthDue,
+		IsAutopay:             current.IsAutopay,
+		UpdatedAt:             time.Now(),
+		Frequency:             current.Frequency,
+		IntervalCount:         current.IntervalCount,
+		AnchorDate:            current.AnchorDate,
+		EndDate:               current.EndDate,
+		MaxOccurrences:        current.MaxOccurrences,
+		BusinessDayAdjustment: current.BusinessDayAdjustment,
+		AutopaySince:          current.AutopaySince,
 	}
 
 	// Apply updates
@@ -191,11 +265,43 @@ func (s *Service) UpdateExpense(ctx context.Context, req *connect.Request[expens
 	if req.Msg.Amount > 0 {
 		updateParams.Amount = req.Msg.Amount
 	}
-	if req.Msg.DayOfMonthDue >= 1 && req.Msg.DayOfMonthDue <= 31 {
+	if req.Msg.Recurrence != nil {
+		sched, err := scheduleFromProto(req.Msg.Recurrence)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		updateParams.Frequency = sched.Frequency
+		updateParams.IntervalCount = sched.IntervalCount
+		updateParams.AnchorDate = sched.AnchorDate
+		updateParams.EndDate = sched.EndDate
+		updateParams.MaxOccurrences = sched.MaxOccurrences
+		updateParams.DayOfMonthDue = sched.DayOfMonthDue
+	} else if req.Msg.DayOfMonthDue >= 1 && req.Msg.DayOfMonthDue <= 31 && int64(req.Msg.DayOfMonthDue) != current.DayOfMonthDue {
+		// The due day of an anchored schedule comes from its anchor date
+		if current.AnchorDate != nil {
+			return nil, status.Error(codes.InvalidArgument, "day_of_month_due cannot be changed on an expense with a recurrence; update recurrence.anchor_date instead")
+		}
 		updateParams.DayOfMonthDue = int64(req.Msg.DayOfMonthDue)
 	}
 	if req.Msg.IsAutopay != current.IsAutopay {
+		// Autopay only pays occurrences due from the moment it was turned on
 		updateParams.IsAutopay = req.Msg.IsAutopay
+		updateParams.AutopaySince = nil
+		if req.Msg.IsAutopay {
+			updateParams.AutopaySince = &updateParams.UpdatedAt
+		}
+	}
+	if req.Msg.BusinessDayAdjustment != nil {
+		updateParams.BusinessDayAdjustment, err = adjustmentFromProto(req.Msg.GetBusinessDayAdjustment())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if req.Msg.CategoryId != nil {
+		updateParams.CategoryID, err = s.resolveCategoryID(ctx, familyQueries, req.Msg.CategoryId)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	// Update expense using SQLC
@@ -246,8 +352,27 @@ func (s *Service) DeleteExpense(ctx context.Context, req *connect.Request[expens
 		return nil, status.Error(codes.PermissionDenied, "access denied to expense")
 	}
 
-	// Delete expense using SQLC
-	err = familyQueries.DeleteExpense(ctx, req.Msg.Id)
+	// Delete expense with its payment history, the shared costs of its
+	// payments and its paycheck allocations, and stop rules from linking
+	// transactions to it
+	err = s.dbManager.WithFamilyTx(ctx, int(authCtx.FamilyID), func(q *familydb.Queries) error {
+		if err := q.DeleteSharedCostsByExpense(ctx, req.Msg.Id); err != nil {
+			return err
+		}
+		if err := q.DeleteOrphanedSharedCostShares(ctx); err != nil {
+			return err
+		}
+		if err := q.DeletePaymentsByExpense(ctx, req.Msg.Id); err != nil {
+			return err
+		}
+		if err := q.DeletePaycheckAllocationsByExpense(ctx, req.Msg.Id); err != nil {
+			return err
+		}
+		if err := q.ClearRulesExpense(ctx, &req.Msg.Id); err != nil {
+			return err
+		}
+		return q.DeleteExpense(ctx, req.Msg.Id)
+	})
 	if err != nil {
 		s.logger.Error("Failed to delete expense", err,
 			logger.Int64("expense_id", req.Msg.Id))
@@ -271,14 +396,54 @@ func (s *Service) ListExpenses(ctx context.Context, req *connect.Request[expense
 	}
 
 	// Set pagination parameters
-	limit := int64(50) // default limit
+	limit := int64(defaultPageSize)
 	if req.Msg.PageSize > 0 {
-		limit = int64(req.Msg.PageSize)
+		limit = min(int64(req.Msg.PageSize), maxPageSize)
+	}
+
+	if req.Msg.MinDayDue != nil && req.Msg.MaxDayDue != nil && req.Msg.GetMinDayDue() > req.Msg.GetMaxDayDue() {
+		return nil, status.Error(codes.InvalidArgument, "min_day_due must not be after max_day_due")
+	}
+
+	// A month restricts grouping to expenses that actually fall due in it. Pages
+	// are cut by day_of_month_due, which does not order occurrences, so a month
+	// is always listed whole.
+	var monthStart, monthEnd time.Time
+	if req.Msg.Month != nil {
+		monthStart, monthEnd, err = recurrence.ParseMonth(req.Msg.GetMonth())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "month must be YYYY-MM")
+		}
+		if req.Msg.PageSize > 0 || req.Msg.PageToken != "" {
+			return nil, status.Error(codes.InvalidArgument, "month cannot be combined with page_size or page_token")
+		}
+	}
+
+	filters := expenseFilters{
+		CategoryID: req.Msg.CategoryId,
+		IsAutopay:  req.Msg.IsAutopay,
+		MinDay:     int32PtrToInt64(req.Msg.MinDayDue),
+		MaxDay:     int32PtrToInt64(req.Msg.MaxDayDue),
 	}
 
 	listParams := familydb.ListExpensesParams{
-		Limit:  limit,
-		Offset: 0, // TODO: Implement proper pagination with page tokens
+		CategoryID: filters.CategoryID,
+		IsAutopay:  filters.IsAutopay,
+		MinDay:     filters.MinDay,
+		MaxDay:     filters.MaxDay,
+		Limit:      limit + 1, // fetch one extra row to detect another page
+	}
+	if req.Msg.Month != nil {
+		listParams.Limit = -1 // SQLite reads a negative LIMIT as no limit
+	}
+
+	if req.Msg.PageToken != "" {
+		var cursor expenseCursor
+		if err := s.pageTokens.Decode(req.Msg.PageToken, filters.scope(), &cursor); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+		}
+		listParams.AfterDay = &cursor.Day
+		listParams.AfterID = cursor.ID
 	}
 
 	// Get family database queries
@@ -295,11 +460,55 @@ func (s *Service) ListExpenses(ctx context.Context, req *connect.Request[expense
 		return nil, status.Error(codes.Internal, "failed to list expenses")
 	}
 
+	totalCount, err := familyQueries.CountExpenses(ctx, familydb.CountExpensesParams{
+		CategoryID: filters.CategoryID,
+		IsAutopay:  filters.IsAutopay,
+		MinDay:     filters.MinDay,
+		MaxDay:     filters.MaxDay,
+	})
+	if err != nil {
+		s.logger.Error("Failed to count expenses", err)
+		return nil, status.Error(codes.Internal, "failed to list expenses")
+	}
+
+	nextPageToken := ""
+	if req.Msg.Month == nil && int64(len(expenses)) > limit {
+		expenses = expenses[:limit]
+		last := expenses[len(expenses)-1]
+		nextPageToken, err = s.pageTokens.Encode(filters.scope(), expenseCursor{Day: last.DayOfMonthDue, ID: last.ID})
+		if err != nil {
+			s.logger.Error("Failed to encode page token", err)
+			return nil, status.Error(codes.Internal, "failed to list expenses")
+		}
+	}
+
 	// Convert to protobuf format and group by day of month
 	expensesByDayMap := make(map[int32][]*expensev1.Expense)
-	for _, exp := range expenses {
-		pbExpense := s.convertToProtoExpense(exp)
-		expensesByDayMap[pbExpense.DayOfMonthDue] = append(expensesByDayMap[pbExpense.DayOfMonthDue], pbExpense)
+	var occurrences []*expensev1.ExpenseOccurrence
+	if req.Msg.Month != nil {
+		res, err := s.resolver(ctx, authCtx.FamilyID)
+		if err != nil {
+			s.logger.Error("Failed to load holiday calendar", err)
+			return nil, status.Error(codes.Internal, "failed to list expenses")
+		}
+		monthOccurrences := occurrencesBetween(res, expenses, monthStart, monthEnd)
+		if err := s.attachPayments(ctx, familyQueries, monthOccurrences); err != nil {
+			s.logger.Error("Failed to load expense payments", err)
+			return nil, status.Error(codes.Internal, "failed to list expenses")
+		}
+
+		today := recurrence.Date(time.Now())
+		for _, o := range monthOccurrences {
+			day := int32(o.DueDate.Day())
+			expensesByDayMap[day] = append(expensesByDayMap[day], s.convertToProtoExpense(o.Expense))
+			occurrences = append(occurrences, convertToProtoOccurrence(o, today))
+		}
+		totalCount = int64(len(occurrences))
+	} else {
+		for _, exp := range expenses {
+			pbExpense := s.convertToProtoExpense(exp)
+			expensesByDayMap[pbExpense.DayOfMonthDue] = append(expensesByDayMap[pbExpense.DayOfMonthDue], pbExpense)
+		}
 	}
 
 	// Extract and sort unique days
@@ -336,7 +545,9 @@ func (s *Service) ListExpenses(ctx context.Context, req *connect.Request[expense
 
 	return connect.NewResponse(&expensev1.ListExpensesResponse{
 		Expenses:      sortedExpenses,
-		NextPageToken: "", // TODO: Implement proper pagination
+		NextPageToken: nextPageToken,
+		TotalCount:    totalCount,
+		Occurrences:   occurrences,
 	}), nil
 }
 
@@ -345,16 +556,38 @@ func (s *Service) ListExpenses(ctx context.Context, req *connect.Request[expense
 // convertToProtoExpense converts SQLC expense to protobuf expense
 func (s *Service) convertToProtoExpense(exp *familydb.Expense) *expensev1.Expense {
 	return &expensev1.Expense{
-		Id:            exp.ID,
-		Name:          exp.Name,
-		Amount:        exp.Amount,
-		DayOfMonthDue: int32(exp.DayOfMonthDue),
-		IsAutopay:     exp.IsAutopay,
-		CreatedAt:     exp.CreatedAt.Unix(),
-		UpdatedAt:     exp.UpdatedAt.Unix(),
+		Id:                    exp.ID,
+		Name:                  exp.Name,
+		Amount:                exp.Amount,
+		DayOfMonthDue:         int32(exp.DayOfMonthDue),
+		IsAutopay:             exp.IsAutopay,
+		CreatedAt:             exp.CreatedAt.Unix(),
+		UpdatedAt:             exp.UpdatedAt.Unix(),
+		CategoryId:            exp.CategoryID,
+		Recurrence:            convertToProtoRecurrence(exp),
+		BusinessDayAdjustment: adjustmentToProto[adjustmentForExpense(exp)],
 	}
 }
 
+// resolveCategoryID validates a requested category. A missing or zero ID means
+// the expense is uncategorized.
+func (s *Service) resolveCategoryID(ctx context.Context, queries *familydb.Queries, categoryID *int64) (*int64, error) {
+	if categoryID == nil || *categoryID == 0 {
+		return nil, nil
+	}
+
+	if _, err := queries.GetCategoryByID(ctx, *categoryID); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.InvalidArgument, "category not found")
+		}
+		s.logger.Error("Failed to get category", err, logger.Int64("category_id", *categoryID))
+		return nil, status.Error(codes.Internal, "failed to verify category")
+	}
+
+	id := *categoryID
+	return &id, nil
+}
+
 // userCanAccessExpense checks if a user can access an expense (simplified for family-based access)
 func (s *Service) userCanAccessExpense(ctx context.Context, queries *familydb.Queries, expenseID, userID string) (bool, error) {
 	// For family databases, all family members can access all expenses
@@ -373,3 +606,295 @@ func (s *Service) userCanAccessExpense(ctx context.Context, queries *familydb.Qu
 	}
 	return true, nil
 }
+
+func (s *Service) GetNextOccurrences(ctx context.Context, req *connect.Request[expensev1.GetNextOccurrencesRequest]) (*connect.Response[expensev1.GetNextOccurrencesResponse], error) {
+	// Get authentication context
+	authCtx, err := appcontext.RequireFamily(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Msg.ExpenseId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "expense_id is required")
+	}
+
+	count := defaultOccurrenceCount
+	if req.Msg.Count > 0 {
+		count = min(int(req.Msg.Count), maxOccurrenceCount)
+	}
+
+	from := recurrence.Date(time.Now())
+	if req.Msg.FromDate != nil {
+		from, err = recurrence.ParseDate(req.Msg.GetFromDate())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "from_date must be YYYY-MM-DD")
+		}
+	}
+
+	occurrences, err := s.NextOccurrences(ctx, authCtx.FamilyID, req.Msg.ExpenseId, from, count)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "expense not found")
+		}
+		s.logger.Error("Failed to compute expense occurrences", err,
+			logger.Int64("expense_id", req.Msg.ExpenseId))
+		return nil, status.Error(codes.Internal, "failed to compute occurrences")
+	}
+
+	today := recurrence.Date(time.Now())
+	resp := make([]*expensev1.ExpenseOccurrence, 0, len(occurrences))
+	for _, o := range occurrences {
+		resp = append(resp, convertToProtoOccurrence(o, today))
+	}
+
+	return connect.NewResponse(&expensev1.GetNextOccurrencesResponse{
+		Occurrences: resp,
+	}), nil
+}
+
+func (s *Service) MarkPaid(ctx context.Context, req *connect.Request[expensev1.MarkPaidRequest]) (*connect.Response[expensev1.MarkPaidResponse], error) {
+	// Get authentication context
+	authCtx, err := appcontext.RequireFamily(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Msg.ExpenseId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "expense_id is required")
+	}
+	scheduledDate, err := recurrence.ParseDate(req.Msg.ScheduledDate)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "scheduled_date must be YYYY-MM-DD")
+	}
+	paidDate := recurrence.Date(time.Now())
+	if req.Msg.PaidDate != nil {
+		paidDate, err = recurrence.ParseDate(req.Msg.GetPaidDate())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "paid_date must be YYYY-MM-DD")
+		}
+	}
+	if req.Msg.Amount != nil && req.Msg.GetAmount() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "amount must not be negative")
+	}
+
+	// Get family database queries
+	familyQueries, err := s.dbManager.GetFamilyQueries(int(authCtx.FamilyID))
+	if err != nil {
+		s.logger.Error("Failed to get family database", err)
+		return nil, status.Error(codes.Internal, "failed to access family database")
+	}
+
+	exp, err := familyQueries.GetExpenseByID(ctx, req.Msg.ExpenseId)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "expense not found")
+		}
+		s.logger.Error("Failed to get expense", err, logger.Int64("expense_id", req.Msg.ExpenseId))
+		return nil, status.Error(codes.Internal, "failed to get expense")
+	}
+	if !IsOccurrence(exp, scheduledDate) {
+		return nil, status.Error(codes.InvalidArgument, "scheduled_date is not an occurrence of the expense")
+	}
+
+	// Members often mark a partner's bill paid, so the payer may be anyone in
+	// the family
+	paidBy := authCtx.UserID
+	if req.Msg.PaidBy != nil {
+		paidBy = req.Msg.GetPaidBy()
+		if err := family.RequireMember(ctx, familyQueries, paidBy); err != nil {
+			var connectErr *connect.Error
+			if errors.As(err, &connectErr) {
+				return nil, connectErr
+			}
+			s.logger.Error("Failed to check payer", err, logger.Int64("member_id", paidBy))
+			return nil, status.Error(codes.Internal, "failed to mark expense paid")
+		}
+	}
+
+	amount := exp.Amount
+	if req.Msg.Amount != nil {
+		amount = req.Msg.GetAmount()
+	}
+
+	now := time.Now()
+	payment, err := familyQueries.UpsertExpensePayment(ctx, familydb.UpsertExpensePaymentParams{
+		ExpenseID:     exp.ID,
+		ScheduledDate: scheduledDate,
+		Status:        PaymentStatusPaid,
+		PaidDate:      &paidDate,
+		Amount:        &amount,
+		PaidBy:        &paidBy,
+		Note:          req.Msg.Note,
+		IsAutomatic:   false,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	})
+	if err != nil {
+		s.logger.Error("Failed to mark expense paid", err,
+			logger.Int64("expense_id", exp.ID))
+		return nil, status.Error(codes.Internal, "failed to mark expense paid")
+	}
+
+	s.logger.Info("Expense marked paid successfully",
+		logger.Int64("expense_id", exp.ID),
+		logger.Str("scheduled_date", req.Msg.ScheduledDate),
+		logger.Int64("user_id", authCtx.UserID))
+
+	return connect.NewResponse(&expensev1.MarkPaidResponse{
+		Payment: convertToProtoPayment(payment),
+	}), nil
+}
+
+func (s *Service) UnmarkPaid(ctx context.Context, req *connect.Request[expensev1.UnmarkPaidRequest]) (*connect.Response[expensev1.UnmarkPaidResponse], error) {
+	// Get authentication context
+	authCtx, err := appcontext.RequireFamily(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Msg.ExpenseId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "expense_id is required")
+	}
+	scheduledDate, err := recurrence.ParseDate(req.Msg.ScheduledDate)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "scheduled_date must be YYYY-MM-DD")
+	}
+
+	// Get family database queries
+	familyQueries, err := s.dbManager.GetFamilyQueries(int(authCtx.FamilyID))
+	if err != nil {
+		s.logger.Error("Failed to get family database", err)
+		return nil, status.Error(codes.Internal, "failed to access family database")
+	}
+
+	exp, err := familyQueries.GetExpenseByID(ctx, req.Msg.ExpenseId)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "expense not found")
+		}
+		s.logger.Error("Failed to get expense", err, logger.Int64("expense_id", req.Msg.ExpenseId))
+		return nil, status.Error(codes.Internal, "failed to get expense")
+	}
+	if !IsOccurrence(exp, scheduledDate) {
+		return nil, status.Error(codes.InvalidArgument, "scheduled_date is not an occurrence of the expense")
+	}
+
+	if exp.IsAutopay {
+		// Keep an override row so autopay does not mark it paid again
+		now := time.Now()
+		_, err = familyQueries.UpsertExpensePayment(ctx, familydb.UpsertExpensePaymentParams{
+			ExpenseID:     exp.ID,
+			ScheduledDate: scheduledDate,
+			Status:        PaymentStatusUnpaid,
+			PaidBy:        &authCtx.UserID,
+			CreatedAt:     now,
+			UpdatedAt:     now,
+		})
+	} else {
+		var deleted int64
+		deleted, err = familyQueries.DeleteExpensePayment(ctx, familydb.DeleteExpensePaymentParams{
+			ExpenseID:     exp.ID,
+			ScheduledDate: scheduledDate,
+		})
+		if err == nil && deleted == 0 {
+			return nil, status.Error(codes.NotFound, "payment not found")
+		}
+	}
+	if err != nil {
+		s.logger.Error("Failed to unmark expense paid", err,
+			logger.Int64("expense_id", exp.ID))
+		return nil, status.Error(codes.Internal, "failed to unmark expense paid")
+	}
+
+	s.logger.Info("Expense unmarked paid successfully",
+		logger.Int64("expense_id", exp.ID),
+		logger.Str("scheduled_date", req.Msg.ScheduledDate),
+		logger.Int64("user_id", authCtx.UserID))
+
+	return connect.NewResponse(&expensev1.UnmarkPaidResponse{
+		Success: true,
+	}), nil
+}
+
+func (s *Service) ListPayments(ctx context.Context, req *connect.Request[expensev1.ListPaymentsRequest]) (*connect.Response[expensev1.ListPaymentsResponse], error) {
+	// Get authentication context
+	authCtx, err := appcontext.RequireFamily(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set pagination parameters
+	limit := int64(defaultPageSize)
+	if req.Msg.PageSize > 0 {
+		limit = min(int64(req.Msg.PageSize), maxPageSize)
+	}
+
+	filters := paymentFilters{ExpenseID: req.Msg.ExpenseId}
+	if req.Msg.FromDate != nil {
+		from, err := recurrence.ParseDate(req.Msg.GetFromDate())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "from_date must be YYYY-MM-DD")
+		}
+		filters.FromDate = &from
+	}
+	if req.Msg.ToDate != nil {
+		to, err := recurrence.ParseDate(req.Msg.GetToDate())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "to_date must be YYYY-MM-DD")
+		}
+		filters.ToDate = &to
+	}
+	if filters.FromDate != nil && filters.ToDate != nil && filters.FromDate.After(*filters.ToDate) {
+		return nil, status.Error(codes.InvalidArgument, "from_date must not be after to_date")
+	}
+
+	listParams := familydb.ListExpensePaymentsParams{
+		ExpenseID: filters.ExpenseID,
+		FromDate:  filters.FromDate,
+		ToDate:    filters.ToDate,
+		Limit:     limit + 1, // fetch one extra row to detect another page
+	}
+
+	if req.Msg.PageToken != "" {
+		var cursor paymentCursor
+		if err := s.pageTokens.Decode(req.Msg.PageToken, filters.scope(), &cursor); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+		}
+		listParams.BeforeDate = &cursor.Date
+		listParams.BeforeID = cursor.ID
+	}
+
+	// Get family database queries
+	familyQueries, err := s.dbManager.GetFamilyQueries(int(authCtx.FamilyID))
+	if err != nil {
+		s.logger.Error("Failed to get family database", err)
+		return nil, status.Error(codes.Internal, "failed to access family database")
+	}
+
+	payments, err := familyQueries.ListExpensePayments(ctx, listParams)
+	if err != nil {
+		s.logger.Error("Failed to list payments", err)
+		return nil, status.Error(codes.Internal, "failed to list payments")
+	}
+
+	nextPageToken := ""
+	if int64(len(payments)) > limit {
+		payments = payments[:limit]
+		last := payments[len(payments)-1]
+		nextPageToken, err = s.pageTokens.Encode(filters.scope(), paymentCursor{Date: last.ScheduledDate, ID: last.ID})
+		if err != nil {
+			s.logger.Error("Failed to encode page token", err)
+			return nil, status.Error(codes.Internal, "failed to list payments")
+		}
+	}
+
+	resp := make([]*expensev1.Payment, 0, len(payments))
+	for _, p := range payments {
+		resp = append(resp, convertToProtoPayment(p))
+	}
+
+	return connect.NewResponse(&expensev1.ListPaymentsResponse{
+		Payments:      resp,
+		NextPageToken: nextPageToken,
+	}), nil
+}
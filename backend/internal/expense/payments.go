@@ -0,0 +1,232 @@
+package expense
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"expenses-backend/internal/database/sql/familydb"
+	"expenses-backend/internal/logger"
+	"expenses-backend/internal/pagination"
+	"expenses-backend/internal/recurrence"
+	expensev1 "expenses-backend/pkg/expense/v1"
+)
+
+const (
+	PaymentStatusPaid   = "paid"
+	PaymentStatusUnpaid = "unpaid" // Override that stops autopay from marking an occurrence paid
+
+	// dueSoonDays is how far ahead an unpaid occurrence counts as due rather than upcoming
+	dueSoonDays = 7
+
+	// DefaultAutopayInterval is how often the scheduler marks due autopay
+	// occurrences paid
+	DefaultAutopayInterval = time.Hour
+)
+
+type paymentKey struct {
+	ExpenseID     int64
+	ScheduledDate time.Time
+}
+
+// paymentCursor is the position after the last payment of a ListPayments page
+type paymentCursor struct {
+	Date time.Time `json:"d"`
+	ID   int64     `json:"i"`
+}
+
+// paymentFilters are the server-side filters supported by ListPayments
+type paymentFilters struct {
+	ExpenseID *int64
+	FromDate  *time.Time
+	ToDate    *time.Time
+}
+
+// scope identifies the filter set a page token was issued for
+func (f paymentFilters) scope() string {
+	return fmt.Sprintf("payments:%s:%s:%s", pagination.ScopeValue(f.ExpenseID), pagination.ScopeValue(f.FromDate), pagination.ScopeValue(f.ToDate))
+}
+
+// attachPayments loads the payment recorded for each occurrence
+func (s *Service) attachPayments(ctx context.Context, queries *familydb.Queries, occurrences []Occurrence) error {
+	if len(occurrences) == 0 {
+		return nil
+	}
+
+	from, to := occurrences[0].ScheduledDate, occurrences[0].ScheduledDate
+	for _, o := range occurrences {
+		from = minTime(from, o.ScheduledDate)
+		to = maxTime(to, o.ScheduledDate)
+	}
+
+	payments, err := queries.ListPaymentsByScheduledDate(ctx, familydb.ListPaymentsByScheduledDateParams{
+		FromDate: from,
+		ToDate:   to,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list payments: %w", err)
+	}
+
+	byKey := make(map[paymentKey]*familydb.ExpensePayment, len(payments))
+	for _, p := range payments {
+		byKey[paymentKey{p.ExpenseID, recurrence.Date(p.ScheduledDate)}] = p
+	}
+	for i := range occurrences {
+		occurrences[i].Payment = byKey[paymentKey{occurrences[i].Expense.ID, occurrences[i].ScheduledDate}]
+	}
+	return nil
+}
+
+// StartAutopayScheduler marks due autopay occurrences of every loaded family
+// paid, at once and then each interval until ctx is done
+func (s *Service) StartAutopayScheduler(ctx context.Context, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			s.payAllFamilies(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	s.logger.Info("Autopay scheduler started", logger.Duration("interval", interval))
+}
+
+// payAllFamilies runs PayAutopay for each loaded family in turn
+func (s *Service) payAllFamilies(ctx context.Context) {
+	for _, familyID := range s.dbManager.FamilyIDs() {
+		if ctx.Err() != nil {
+			return
+		}
+		paid, err := s.PayAutopay(ctx, familyID, time.Now())
+		if err != nil {
+			s.logger.Error("Failed to mark autopay expenses paid", err, logger.Int64("family_id", familyID))
+			continue
+		}
+		if paid > 0 {
+			s.logger.Info("Marked autopay expenses paid",
+				logger.Int64("family_id", familyID),
+				logger.Int("payments", paid))
+		}
+	}
+}
+
+// PayAutopay records a payment for each occurrence of an autopay expense due
+// by now, from the day autopay was turned on, and returns how many it
+// recorded. Autopay pays on the due date; an existing row, including an
+// "unpaid" override, is left alone.
+func (s *Service) PayAutopay(ctx context.Context, familyID int64, now time.Time) (int, error) {
+	queries, err := s.dbManager.GetFamilyQueries(int(familyID))
+	if err != nil {
+		return 0, err
+	}
+
+	expenses, err := queries.ListAllExpenses(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list expenses: %w", err)
+	}
+	today := recurrence.Date(now)
+	from := today
+	var autopay []*familydb.Expense
+	for _, exp := range expenses {
+		if exp.IsAutopay && exp.AutopaySince != nil {
+			autopay = append(autopay, exp)
+			from = minTime(from, recurrence.Date(*exp.AutopaySince))
+		}
+	}
+	if len(autopay) == 0 {
+		return 0, nil
+	}
+
+	res, err := s.resolver(ctx, familyID)
+	if err != nil {
+		return 0, err
+	}
+	occurrences := occurrencesBetween(res, autopay, from, today)
+	if err := s.attachPayments(ctx, queries, occurrences); err != nil {
+		return 0, err
+	}
+
+	paid := 0
+	for _, o := range occurrences {
+		if o.Payment != nil || o.DueDate.Before(recurrence.Date(*o.Expense.AutopaySince)) {
+			continue
+		}
+		paidDate := o.DueDate
+		amount := o.Expense.Amount
+		n, err := queries.CreateAutomaticPayment(ctx, familydb.CreateAutomaticPaymentParams{
+			ExpenseID:     o.Expense.ID,
+			ScheduledDate: o.ScheduledDate,
+			PaidDate:      &paidDate,
+			Amount:        &amount,
+			CreatedAt:     now,
+			UpdatedAt:     now,
+		})
+		if err != nil {
+			return paid, fmt.Errorf("failed to mark autopay expense paid: %w", err)
+		}
+		paid += int(n)
+	}
+	return paid, nil
+}
+
+// IsOccurrence reports whether date is a scheduled date of the expense
+func IsOccurrence(exp *familydb.Expense, date time.Time) bool {
+	return slices.ContainsFunc(ruleForExpense(exp).Between(date, date), date.Equal)
+}
+
+// occurrenceStatus derives the status of an occurrence relative to today
+func occurrenceStatus(o Occurrence, today time.Time) expensev1.OccurrenceStatus {
+	switch {
+	case o.Payment != nil && o.Payment.Status == PaymentStatusPaid:
+		return expensev1.OccurrenceStatus_OCCURRENCE_STATUS_PAID
+	case o.DueDate.Before(today):
+		return expensev1.OccurrenceStatus_OCCURRENCE_STATUS_OVERDUE
+	case o.DueDate.Before(today.AddDate(0, 0, dueSoonDays+1)):
+		return expensev1.OccurrenceStatus_OCCURRENCE_STATUS_DUE
+	default:
+		return expensev1.OccurrenceStatus_OCCURRENCE_STATUS_UPCOMING
+	}
+}
+
+// convertToProtoPayment converts SQLC payment to protobuf payment
+func convertToProtoPayment(p *familydb.ExpensePayment) *expensev1.Payment {
+	pb := &expensev1.Payment{
+		Id:            p.ID,
+		ExpenseId:     p.ExpenseID,
+		ScheduledDate: p.ScheduledDate.Format(recurrence.DateLayout),
+		PaidBy:        p.PaidBy,
+		Note:          p.Note,
+		IsAutomatic:   p.IsAutomatic,
+		CreatedAt:     p.CreatedAt.Unix(),
+		UpdatedAt:     p.UpdatedAt.Unix(),
+		TransactionId: p.TransactionID,
+	}
+	if p.PaidDate != nil {
+		pb.PaidDate = p.PaidDate.Format(recurrence.DateLayout)
+	}
+	if p.Amount != nil {
+		pb.Amount = *p.Amount
+	}
+	return pb
+}
+
+func minTime(a, b time.Time) time.Time {
+	if b.Before(a) {
+		return b
+	}
+	return a
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if b.After(a) {
+		return b
+	}
+	return a
+}
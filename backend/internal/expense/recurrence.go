@@ -0,0 +1,275 @@
+package expense
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+	"time"
+
+	"expenses-backend/internal/database/sql/familydb"
+	"expenses-backend/internal/recurrence"
+	expensev1 "expenses-backend/pkg/expense/v1"
+)
+
+var frequencyToProto = map[recurrence.Frequency]expensev1.Frequency{
+	recurrence.Weekly:     expensev1.Frequency_FREQUENCY_WEEKLY,
+	recurrence.Biweekly:   expensev1.Frequency_FREQUENCY_BIWEEKLY,
+	recurrence.Monthly:    expensev1.Frequency_FREQUENCY_MONTHLY,
+	recurrence.Quarterly:  expensev1.Frequency_FREQUENCY_QUARTERLY,
+	recurrence.Semiannual: expensev1.Frequency_FREQUENCY_SEMIANNUAL,
+	recurrence.Annual:     expensev1.Frequency_FREQUENCY_ANNUAL,
+}
+
+var adjustmentToProto = map[recurrence.Adjustment]expensev1.BusinessDayAdjustment{
+	recurrence.AdjustNone:     expensev1.BusinessDayAdjustment_BUSINESS_DAY_ADJUSTMENT_NONE,
+	recurrence.AdjustPrevious: expensev1.BusinessDayAdjustment_BUSINESS_DAY_ADJUSTMENT_PREVIOUS,
+	recurrence.AdjustNext:     expensev1.BusinessDayAdjustment_BUSINESS_DAY_ADJUSTMENT_NEXT,
+}
+
+// schedule holds the recurrence columns stored on an expense
+type schedule struct {
+	Frequency      string
+	IntervalCount  int64
+	AnchorDate     *time.Time
+	EndDate        *time.Time
+	MaxOccurrences *int64
+	DayOfMonthDue  int64
+}
+
+// Occurrence is a single concrete due date of an expense
+type Occurrence struct {
+	Expense       *familydb.Expense
+	ScheduledDate time.Time // Date produced by the recurrence rule
+	DueDate       time.Time // ScheduledDate moved to a business day if requested
+	Payment       *familydb.ExpensePayment
+}
+
+// monthlySchedule is the schedule of an expense created with only a day of month
+func monthlySchedule(day int32) schedule {
+	return schedule{
+		Frequency:     string(recurrence.Monthly),
+		IntervalCount: 1,
+		DayOfMonthDue: int64(day),
+	}
+}
+
+// scheduleFromProto validates a recurrence from the API and converts it to
+// the stored schedule
+func scheduleFromProto(pb *expensev1.Recurrence) (schedule, error) {
+	var freq recurrence.Frequency
+	for f, p := range frequencyToProto {
+		if p == pb.Frequency {
+			freq = f
+		}
+	}
+	if freq == "" {
+		return schedule{}, fmt.Errorf("recurrence.frequency is required")
+	}
+
+	anchor, err := recurrence.ParseDate(pb.AnchorDate)
+	if err != nil {
+		return schedule{}, fmt.Errorf("recurrence.anchor_date must be YYYY-MM-DD")
+	}
+
+	rule := recurrence.Rule{
+		Frequency: freq,
+		Interval:  max(int(pb.Interval), 1),
+		Anchor:    anchor,
+		Count:     int(pb.GetOccurrenceCount()),
+	}
+
+	sched := schedule{
+		Frequency:     string(freq),
+		IntervalCount: int64(rule.Interval),
+		AnchorDate:    &anchor,
+		DayOfMonthDue: int64(anchor.Day()),
+	}
+
+	if pb.EndDate != nil {
+		end, err := recurrence.ParseDate(*pb.EndDate)
+		if err != nil {
+			return schedule{}, fmt.Errorf("recurrence.end_date must be YYYY-MM-DD")
+		}
+		rule.Until = &end
+		sched.EndDate = &end
+	}
+	if pb.OccurrenceCount != nil {
+		count := int64(*pb.OccurrenceCount)
+		sched.MaxOccurrences = &count
+	}
+
+	if err := rule.Validate(); err != nil {
+		return schedule{}, fmt.Errorf("invalid recurrence: %w", err)
+	}
+
+	return sched, nil
+}
+
+// adjustmentFromProto converts a business day adjustment from the API,
+// treating unspecified as no adjustment
+func adjustmentFromProto(pb expensev1.BusinessDayAdjustment) (string, error) {
+	if pb == expensev1.BusinessDayAdjustment_BUSINESS_DAY_ADJUSTMENT_UNSPECIFIED {
+		return string(recurrence.AdjustNone), nil
+	}
+	for a, p := range adjustmentToProto {
+		if p == pb {
+			return string(a), nil
+		}
+	}
+	return "", fmt.Errorf("invalid business_day_adjustment")
+}
+
+// ruleForExpense builds the recurrence rule of a stored expense. Expenses
+// without an anchor date are due on day_of_month_due every month.
+func ruleForExpense(exp *familydb.Expense) recurrence.Rule {
+	if exp.AnchorDate == nil {
+		return recurrence.Rule{
+			Frequency: recurrence.Monthly,
+			Interval:  1,
+			Anchor:    time.Date(exp.CreatedAt.Year(), time.January, int(exp.DayOfMonthDue), 0, 0, 0, 0, time.UTC),
+		}
+	}
+
+	rule := recurrence.Rule{
+		Frequency: recurrence.Frequency(exp.Frequency),
+		Interval:  int(exp.IntervalCount),
+		Anchor:    *exp.AnchorDate,
+		Until:     exp.EndDate,
+	}
+	if exp.MaxOccurrences != nil {
+		rule.Count = int(*exp.MaxOccurrences)
+	}
+	return rule
+}
+
+// convertToProtoRecurrence converts the stored schedule of an expense
+func convertToProtoRecurrence(exp *familydb.Expense) *expensev1.Recurrence {
+	pb := &expensev1.Recurrence{
+		Frequency: frequencyToProto[recurrence.Frequency(exp.Frequency)],
+		Interval:  int32(exp.IntervalCount),
+	}
+	if exp.AnchorDate != nil {
+		pb.AnchorDate = exp.AnchorDate.Format(recurrence.DateLayout)
+	}
+	if exp.EndDate != nil {
+		end := exp.EndDate.Format(recurrence.DateLayout)
+		pb.EndDate = &end
+	}
+	if exp.MaxOccurrences != nil {
+		count := int32(*exp.MaxOccurrences)
+		pb.OccurrenceCount = &count
+	}
+	return pb
+}
+
+// adjustmentForExpense returns the stored business day adjustment of an expense
+func adjustmentForExpense(exp *familydb.Expense) recurrence.Adjustment {
+	adj, err := recurrence.ParseAdjustment(exp.BusinessDayAdjustment)
+	if err != nil {
+		return recurrence.AdjustNone
+	}
+	return adj
+}
+
+// resolver builds the due date resolver for the family's holiday calendar
+func (s *Service) resolver(ctx context.Context, familyID int64) (*recurrence.Resolver, error) {
+	calendar, err := s.familyService.HolidayCalendar(ctx, int(familyID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get holiday calendar: %w", err)
+	}
+	return recurrence.NewResolver(calendar), nil
+}
+
+// occurrencesBetween returns the due dates of the given expenses within
+// [from, to] after business day adjustment, ordered by date
+func occurrencesBetween(res *recurrence.Resolver, expenses []*familydb.Expense, from, to time.Time) []Occurrence {
+	var occurrences []Occurrence
+	for _, exp := range expenses {
+		for _, d := range res.Between(ruleForExpense(exp), adjustmentForExpense(exp), from, to) {
+			occurrences = append(occurrences, Occurrence{Expense: exp, ScheduledDate: d.Scheduled, DueDate: d.Due})
+		}
+	}
+
+	slices.SortStableFunc(occurrences, func(a, b Occurrence) int {
+		if c := a.DueDate.Compare(b.DueDate); c != 0 {
+			return c
+		}
+		return int(a.Expense.ID - b.Expense.ID)
+	})
+	return occurrences
+}
+
+// NextOccurrences computes the next n due dates of an expense on or after from
+func (s *Service) NextOccurrences(ctx context.Context, familyID int64, expenseID int64, from time.Time, n int) ([]Occurrence, error) {
+	queries, err := s.dbManager.GetFamilyQueries(int(familyID))
+	if err != nil {
+		return nil, err
+	}
+
+	exp, err := queries.GetExpenseByID(ctx, expenseID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to get expense: %w", err)
+	}
+
+	res, err := s.resolver(ctx, familyID)
+	if err != nil {
+		return nil, err
+	}
+
+	var occurrences []Occurrence
+	for _, d := range res.Next(ruleForExpense(exp), adjustmentForExpense(exp), from, n) {
+		occurrences = append(occurrences, Occurrence{Expense: exp, ScheduledDate: d.Scheduled, DueDate: d.Due})
+	}
+
+	if err := s.attachPayments(ctx, queries, occurrences); err != nil {
+		return nil, err
+	}
+	return occurrences, nil
+}
+
+// Occurrences computes every expense due date within [from, to] with its
+// recorded payment
+func (s *Service) Occurrences(ctx context.Context, familyID int64, from, to time.Time) ([]Occurrence, error) {
+	queries, err := s.dbManager.GetFamilyQueries(int(familyID))
+	if err != nil {
+		return nil, err
+	}
+
+	expenses, err := queries.ListAllExpenses(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list expenses: %w", err)
+	}
+
+	res, err := s.resolver(ctx, familyID)
+	if err != nil {
+		return nil, err
+	}
+
+	occurrences := occurrencesBetween(res, expenses, from, to)
+	if err := s.attachPayments(ctx, queries, occurrences); err != nil {
+		return nil, err
+	}
+	return occurrences, nil
+}
+
+// convertToProtoOccurrence converts an occurrence to protobuf format
+func convertToProtoOccurrence(o Occurrence, today time.Time) *expensev1.ExpenseOccurrence {
+	var payment *expensev1.Payment
+	if o.Payment != nil && o.Payment.Status == PaymentStatusPaid {
+		payment = convertToProtoPayment(o.Payment)
+	}
+
+	return &expensev1.ExpenseOccurrence{
+		ExpenseId:     o.Expense.ID,
+		DueDate:       o.DueDate.Format(recurrence.DateLayout),
+		Amount:        o.Expense.Amount,
+		Name:          o.Expense.Name,
+		ScheduledDate: o.ScheduledDate.Format(recurrence.DateLayout),
+		Status:        occurrenceStatus(o, today),
+		Payment:       payment,
+	}
+}
@@ -0,0 +1,855 @@
+package family
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"expenses-backend/internal/archive"
+	appcontext "expenses-backend/internal/context"
+	"expenses-backend/internal/database/sql/familydb"
+	"expenses-backend/internal/logger"
+	v1 "expenses-backend/pkg/family/v1"
+
+	"connectrpc.com/connect"
+)
+
+// exportChunkBytes bounds the archive bytes sent in one stream message
+const exportChunkBytes = 64 << 10
+
+// chunkWriter sends what is written to it as export stream messages
+type chunkWriter struct {
+	stream *connect.ServerStream[v1.ExportFamilyDataResponse]
+}
+
+func (w chunkWriter) Write(p []byte) (int, error) {
+	for sent := 0; sent < len(p); {
+		n := min(len(p)-sent, exportChunkBytes)
+		if err := w.stream.Send(&v1.ExportFamilyDataResponse{Chunk: p[sent : sent+n]}); err != nil {
+			return sent, err
+		}
+		sent += n
+	}
+	return len(p), nil
+}
+
+// tableExport lists a table and writes it to an archive, returning the number
+// of rows written
+type tableExport func(context.Context, *archive.Encoder) (int, error)
+
+func exportTable[T any](name string, list func(context.Context) ([]*T, error)) tableExport {
+	return func(ctx context.Context, enc *archive.Encoder) (int, error) {
+		rows, err := list(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("failed to list %s: %w", strings.ReplaceAll(name, "_", " "), err)
+		}
+		return len(rows), archive.EncodeTable(enc, name, rows)
+	}
+}
+
+// writeArchive writes the family's data as an archive, a table at a time, and
+// returns the number of rows written
+func writeArchive(ctx context.Context, q *familydb.Queries, w io.Writer, now time.Time) (int, error) {
+	schemaVersion, err := q.GetCurrentMigrationVersion(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get schema version: %w", err)
+	}
+	enc, err := archive.NewEncoder(w, archive.New(schemaVersion, now).Header)
+	if err != nil {
+		return 0, err
+	}
+
+	// Secret settings would not decrypt on another server
+	settings := func(ctx context.Context) ([]*familydb.FamilySetting, error) {
+		all, err := q.ListFamilySettings(ctx)
+		var kept []*familydb.FamilySetting
+		for _, setting := range all {
+			if !isSecretSetting(setting.SettingKey, setting.DataType) {
+				kept = append(kept, setting)
+			}
+		}
+		return kept, err
+	}
+
+	total := 0
+	for _, export := range []tableExport{
+		exportTable("members", q.ListAllFamilyMembers),
+		exportTable("categories", q.ListCategories),
+		exportTable("category_budgets", q.ListCategoryBudgets),
+		exportTable("expenses", q.ListAllExpenses),
+		exportTable("income_sources", q.ListIncomeSources),
+		exportTable("accounts", q.GetAccounts),
+		exportTable("transactions", q.ListAllTransactions),
+		exportTable("expense_payments", q.ListAllExpensePayments),
+		exportTable("paycheck_allocations", q.ListAllPaycheckAllocations),
+		exportTable("balance_snapshots", q.ListAllBalanceSnapshots),
+		exportTable("transaction_matches", q.ListAllTransactionMatches),
+		exportTable("transaction_rules", q.ListTransactionRules),
+		exportTable("transfer_pairs", q.ListAllTransferPairs),
+		exportTable("transaction_splits", q.ListAllTransactionSplits),
+		exportTable("shared_costs", q.ListAllSharedCosts),
+		exportTable("shared_cost_shares", q.ListAllSharedCostShares),
+		exportTable("settlements", q.ListSettlements),
+		exportTable("statement_mappings", q.ListStatementMappings),
+		exportTable("settings", settings),
+	} {
+		n, err := export(ctx, enc)
+		if err != nil {
+			return total, err
+		}
+		total += n
+	}
+	return total, enc.Close()
+}
+
+func (s *Service) ExportFamilyData(ctx context.Context, req *connect.Request[v1.ExportFamilyDataRequest], stream *connect.ServerStream[v1.ExportFamilyDataResponse]) error {
+	authCtx, err := appcontext.RequireFamilyManager(ctx)
+	if err != nil {
+		return err
+	}
+
+	// One transaction gives every table the same snapshot, so a sync running
+	// meanwhile can't leave matches or splits without their transactions
+	var rows int
+	w := bufio.NewWriterSize(chunkWriter{stream: stream}, exportChunkBytes)
+	err = s.dbManager.WithFamilyTx(ctx, int(authCtx.FamilyID), func(q *familydb.Queries) error {
+		var err error
+		rows, err = writeArchive(ctx, q, w, time.Now())
+		return err
+	})
+	if err == nil {
+		err = w.Flush()
+	}
+	if err != nil {
+		s.logger.Error("Failed to export family data", err, logger.Int64("family_id", authCtx.FamilyID))
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to export family data"))
+	}
+
+	s.logger.Info("Family data exported",
+		logger.Int64("family_id", authCtx.FamilyID),
+		logger.Int("rows", rows),
+		logger.Int64("user_id", authCtx.UserID))
+
+	return nil
+}
+
+func (s *Service) ImportFamilyData(ctx context.Context, req *connect.Request[v1.ImportFamilyDataRequest]) (*connect.Response[v1.ImportFamilyDataResponse], error) {
+	authCtx, err := appcontext.RequireFamilyManager(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.Msg.Archive) == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("archive is required"))
+	}
+	a, err := archive.Read(bytes.NewReader(req.Msg.Archive))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	var resp *v1.ImportFamilyDataResponse
+	err = s.dbManager.WithFamilyTx(ctx, int(authCtx.FamilyID), func(q *familydb.Queries) error {
+		schemaVersion, err := q.GetCurrentMigrationVersion(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get schema version: %w", err)
+		}
+		if a.SchemaVersion > schemaVersion {
+			return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("the archive comes from a newer server (schema version %d, this server has %d); upgrade this server first", a.SchemaVersion, schemaVersion))
+		}
+		if err := requireEmptyFamily(ctx, q); err != nil {
+			return err
+		}
+
+		r, err := newRestorer(ctx, q, a)
+		if err != nil {
+			return err
+		}
+		if err := r.restore(ctx); err != nil {
+			return err
+		}
+		resp = r.resp
+		return nil
+	})
+	if err != nil {
+		var connectErr *connect.Error
+		if errors.As(err, &connectErr) {
+			return nil, err
+		}
+		if errors.Is(err, archive.ErrMissingReference) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		s.logger.Error("Failed to import family data", err, logger.Int64("family_id", authCtx.FamilyID))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to import family data"))
+	}
+
+	for _, setting := range a.Settings {
+		if !isSecretSetting(setting.SettingKey, setting.DataType) {
+			s.notifySettingChange(int(authCtx.FamilyID), setting.SettingKey)
+		}
+	}
+
+	s.logger.Info("Family data imported",
+		logger.Int64("family_id", authCtx.FamilyID),
+		logger.Int("transactions", len(a.Transactions)),
+		logger.Int("unmatched_members", len(resp.UnmatchedMembers)),
+		logger.Int64("user_id", authCtx.UserID))
+
+	return connect.NewResponse(resp), nil
+}
+
+// requireEmptyFamily checks that the family has nothing an import could clash
+// with. Categories and settings may exist, as new families start with default
+// categories.
+func requireEmptyFamily(ctx context.Context, q *familydb.Queries) error {
+	expenses, err := q.CountExpenses(ctx, familydb.CountExpensesParams{})
+	if err != nil {
+		return fmt.Errorf("failed to count expenses: %w", err)
+	}
+	accounts, err := q.GetAccounts(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list accounts: %w", err)
+	}
+	sources, err := q.ListIncomeSources(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list income sources: %w", err)
+	}
+	rules, err := q.ListTransactionRules(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list rules: %w", err)
+	}
+	mappings, err := q.ListStatementMappings(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list statement mappings: %w", err)
+	}
+	settlements, err := q.ListSettlements(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list settlements: %w", err)
+	}
+
+	if expenses > 0 || len(accounts) > 0 || len(sources) > 0 || len(rules) > 0 || len(mappings) > 0 || len(settlements) > 0 {
+		return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("the family already has data; import into a new or empty family"))
+	}
+	return nil
+}
+
+// restorer writes an archive's rows into a family database, keeping track of
+// the IDs they were given
+type restorer struct {
+	q    *familydb.Queries
+	a    *archive.Archive
+	resp *v1.ImportFamilyDataResponse
+
+	members      *archive.IDMap
+	categories   *archive.IDMap
+	expenses     *archive.IDMap
+	incomes      *archive.IDMap
+	accounts     *archive.IDMap
+	transactions *archive.IDMap
+	payments     *archive.IDMap
+}
+
+func newRestorer(ctx context.Context, q *familydb.Queries, a *archive.Archive) (*restorer, error) {
+	current, err := q.ListAllFamilyMembers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list family members: %w", err)
+	}
+
+	r := &restorer{
+		q:            q,
+		a:            a,
+		resp:         &v1.ImportFamilyDataResponse{},
+		members:      archive.MatchMembers(a.Members, current),
+		categories:   archive.NewIDMap("category"),
+		expenses:     archive.NewIDMap("expense"),
+		incomes:      archive.NewIDMap("income source"),
+		accounts:     archive.NewIDMap("account"),
+		transactions: archive.NewIDMap("transaction"),
+		payments:     archive.NewIDMap("expense payment"),
+	}
+	for _, m := range a.Members {
+		if _, ok := r.members.Lookup(m.ID); !ok {
+			r.resp.UnmatchedMembers = append(r.resp.UnmatchedMembers, m.Email)
+		}
+	}
+	return r, nil
+}
+
+// restore writes the tables in an order where every row comes after the rows
+// it refers to
+func (r *restorer) restore(ctx context.Context) error {
+	for _, step := range []struct {
+		name string
+		fn   func(context.Context, *v1.ImportedTable) error
+	}{
+		{"categories", r.restoreCategories},
+		{"category_budgets", r.restoreCategoryBudgets},
+		{"expenses", r.restoreExpenses},
+		{"income_sources", r.restoreIncomeSources},
+		{"accounts", r.restoreAccounts},
+		{"transactions", r.restoreTransactions},
+		{"expense_payments", r.restoreExpensePayments},
+		{"paycheck_allocations", r.restorePaycheckAllocations},
+		{"balance_snapshots", r.restoreBalanceSnapshots},
+		{"transaction_matches", r.restoreTransactionMatches},
+		{"transaction_rules", r.restoreTransactionRules},
+		{"transfer_pairs", r.restoreTransferPairs},
+		{"transaction_splits", r.restoreTransactionSplits},
+		{"shared_costs", r.restoreSharedCosts},
+		{"settlements", r.restoreSettlements},
+		{"statement_mappings", r.restoreStatementMappings},
+		{"settings", r.restoreSettings},
+	} {
+		table := &v1.ImportedTable{Name: step.name}
+		if err := step.fn(ctx, table); err != nil {
+			return fmt.Errorf("failed to restore %s: %w", strings.ReplaceAll(step.name, "_", " "), err)
+		}
+		r.resp.Tables = append(r.resp.Tables, table)
+	}
+	return nil
+}
+
+// restoreCategories reuses categories the family already has under the same
+// name, such as the defaults new families start with
+func (r *restorer) restoreCategories(ctx context.Context, table *v1.ImportedTable) error {
+	existing, err := r.q.ListCategories(ctx)
+	if err != nil {
+		return err
+	}
+	byName := make(map[string]*familydb.Category, len(existing))
+	for _, c := range existing {
+		byName[strings.ToLower(strings.TrimSpace(c.Name))] = c
+	}
+
+	for _, c := range r.a.Categories {
+		key := strings.ToLower(strings.TrimSpace(c.Name))
+		if match, ok := byName[key]; ok {
+			delete(byName, key)
+			if _, err := r.q.UpdateCategory(ctx, familydb.UpdateCategoryParams{
+				Name:        match.Name,
+				Description: c.Description,
+				Color:       c.Color,
+				Icon:        c.Icon,
+				UpdatedAt:   c.UpdatedAt,
+				ID:          match.ID,
+			}); err != nil {
+				return err
+			}
+			r.categories.Set(c.ID, match.ID)
+		} else {
+			created, err := r.q.CreateCategory(ctx, familydb.CreateCategoryParams{
+				Name:        c.Name,
+				Description: c.Description,
+				Color:       c.Color,
+				Icon:        c.Icon,
+				CreatedAt:   c.CreatedAt,
+				UpdatedAt:   c.UpdatedAt,
+			})
+			if err != nil {
+				return err
+			}
+			r.categories.Set(c.ID, created.ID)
+		}
+		table.Imported++
+	}
+	return nil
+}
+
+func (r *restorer) restoreCategoryBudgets(ctx context.Context, table *v1.ImportedTable) error {
+	for _, b := range r.a.CategoryBudgets {
+		categoryID, err := r.categories.Get(b.CategoryID)
+		if err != nil {
+			return err
+		}
+		if _, err := r.q.UpsertCategoryBudget(ctx, familydb.UpsertCategoryBudgetParams{
+			CategoryID:  categoryID,
+			AmountCents: b.AmountCents,
+			Rollover:    b.Rollover,
+			StartMonth:  b.StartMonth,
+			CreatedAt:   b.CreatedAt,
+			UpdatedAt:   b.UpdatedAt,
+		}); err != nil {
+			return err
+		}
+		table.Imported++
+	}
+	return nil
+}
+
+func (r *restorer) restoreExpenses(ctx context.Context, table *v1.ImportedTable) error {
+	for _, e := range r.a.Expenses {
+		categoryID, err := r.categories.Optional(e.CategoryID)
+		if err != nil {
+			return err
+		}
+		// Archives from before autopay_since existed get the migration's guess
+		autopaySince := e.AutopaySince
+		if e.IsAutopay && autopaySince == nil {
+			autopaySince = &e.UpdatedAt
+		}
+		created, err := r.q.CreateExpense(ctx, familydb.CreateExpenseParams{
+			CategoryID:            categoryID,
+			Amount:                e.Amount,
+			Name:                  e.Name,
+			DayOfMonthDue:         e.DayOfMonthDue,
+			IsAutopay:             e.IsAutopay,
+			CreatedAt:             e.CreatedAt,
+			UpdatedAt:             e.UpdatedAt,
+			Frequency:             e.Frequency,
+			IntervalCount:         e.IntervalCount,
+			AnchorDate:            e.AnchorDate,
+			EndDate:               e.EndDate,
+			MaxOccurrences:        e.MaxOccurrences,
+			BusinessDayAdjustment: e.BusinessDayAdjustment,
+			AutopaySince:          autopaySince,
+		})
+		if err != nil {
+			return err
+		}
+		r.expenses.Set(e.ID, created.ID)
+		table.Imported++
+	}
+	return nil
+}
+
+func (r *restorer) restoreIncomeSources(ctx context.Context, table *v1.ImportedTable) error {
+	for _, src := range r.a.IncomeSources {
+		created, err := r.q.CreateIncomeSource(ctx, familydb.CreateIncomeSourceParams{
+			Name:         src.Name,
+			Description:  src.Description,
+			MemberID:     r.member(src.MemberID),
+			PayFrequency: src.PayFrequency,
+			AnchorDate:   src.AnchorDate,
+			GrossCents:   src.GrossCents,
+			NetCents:     src.NetCents,
+			IsActive:     src.IsActive,
+			CreatedAt:    src.CreatedAt,
+			UpdatedAt:    src.UpdatedAt,
+		})
+		if err != nil {
+			return err
+		}
+		r.incomes.Set(src.ID, created.ID)
+		table.Imported++
+	}
+	return nil
+}
+
+func (r *restorer) restoreAccounts(ctx context.Context, table *v1.ImportedTable) error {
+	for _, a := range r.a.Accounts {
+		created, err := r.q.RestoreAccount(ctx, familydb.RestoreAccountParams{
+			AccountID:       a.AccountID,
+			Name:            a.Name,
+			AccountType:     a.AccountType,
+			Hidden:          a.Hidden,
+			IncludeInBudget: a.IncludeInBudget,
+			UnlinkedAt:      a.UnlinkedAt,
+			RelinkedAt:      a.RelinkedAt,
+			Source:          a.Source,
+		})
+		if err != nil {
+			return err
+		}
+		r.accounts.Set(a.ID, created.ID)
+		table.Imported++
+	}
+	return nil
+}
+
+func (r *restorer) restoreTransactions(ctx context.Context, table *v1.ImportedTable) error {
+	for _, t := range r.a.Transactions {
+		accountID, err := r.accounts.Get(t.AccountID)
+		if err != nil {
+			return err
+		}
+		expenseID, err := r.expenses.Optional(t.MatchedExpenseID)
+		if err != nil {
+			return err
+		}
+		categoryID, err := r.categories.Optional(t.CategoryID)
+		if err != nil {
+			return err
+		}
+		suggestedID, err := r.categories.Optional(t.SuggestedCategoryID)
+		if err != nil {
+			return err
+		}
+		created, err := r.q.RestoreTransaction(ctx, familydb.RestoreTransactionParams{
+			AccountID:            accountID,
+			PostedDate:           t.PostedDate,
+			Description:          t.Description,
+			Payee:                t.Payee,
+			AmountCents:          t.AmountCents,
+			MatchedExpenseID:     expenseID,
+			MatchedScheduledDate: t.MatchedScheduledDate,
+			ExternalID:           t.ExternalID,
+			Pending:              t.Pending,
+			CategoryID:           categoryID,
+			Note:                 t.Note,
+			TransactedAt:         t.TransactedAt,
+			DisplayPayee:         t.DisplayPayee,
+			IsTransfer:           t.IsTransfer,
+			SuggestedCategoryID:  suggestedID,
+			SuggestionConfidence: t.SuggestionConfidence,
+		})
+		if err != nil {
+			return err
+		}
+		r.transactions.Set(t.ID, created.ID)
+		table.Imported++
+	}
+	return nil
+}
+
+func (r *restorer) restoreExpensePayments(ctx context.Context, table *v1.ImportedTable) error {
+	for _, p := range r.a.ExpensePayments {
+		expenseID, err := r.expenses.Get(p.ExpenseID)
+		if err != nil {
+			return err
+		}
+		transactionID, err := r.transactions.Optional(p.TransactionID)
+		if err != nil {
+			return err
+		}
+		created, err := r.q.RestoreExpensePayment(ctx, familydb.RestoreExpensePaymentParams{
+			ExpenseID:     expenseID,
+			ScheduledDate: p.ScheduledDate,
+			Status:        p.Status,
+			PaidDate:      p.PaidDate,
+			Amount:        p.Amount,
+			PaidBy:        r.member(p.PaidBy),
+			Note:          p.Note,
+			IsAutomatic:   p.IsAutomatic,
+			CreatedAt:     p.CreatedAt,
+			UpdatedAt:     p.UpdatedAt,
+			TransactionID: transactionID,
+		})
+		if err != nil {
+			return err
+		}
+		r.payments.Set(p.ID, created.ID)
+		table.Imported++
+	}
+	return nil
+}
+
+func (r *restorer) restorePaycheckAllocations(ctx context.Context, table *v1.ImportedTable) error {
+	for _, a := range r.a.PaycheckAllocations {
+		expenseID, err := r.expenses.Get(a.ExpenseID)
+		if err != nil {
+			return err
+		}
+		incomeID, err := r.incomes.Get(a.IncomeSourceID)
+		if err != nil {
+			return err
+		}
+		if _, err := r.q.UpsertPaycheckAllocation(ctx, familydb.UpsertPaycheckAllocationParams{
+			ExpenseID:      expenseID,
+			ScheduledDate:  a.ScheduledDate,
+			IncomeSourceID: incomeID,
+			PayDate:        a.PayDate,
+			Pinned:         a.Pinned,
+			CreatedAt:      a.CreatedAt,
+			UpdatedAt:      a.UpdatedAt,
+		}); err != nil {
+			return err
+		}
+		table.Imported++
+	}
+	return nil
+}
+
+func (r *restorer) restoreBalanceSnapshots(ctx context.Context, table *v1.ImportedTable) error {
+	for _, b := range r.a.BalanceSnapshots {
+		accountID, err := r.accounts.Get(b.AccountID)
+		if err != nil {
+			return err
+		}
+		if err := r.q.UpsertBalanceSnapshot(ctx, familydb.UpsertBalanceSnapshotParams{
+			AccountID:             accountID,
+			SnapshotDate:          b.SnapshotDate,
+			BalanceCents:          b.BalanceCents,
+			AvailableBalanceCents: b.AvailableBalanceCents,
+			BalanceDate:           b.BalanceDate,
+			CreatedAt:             b.CreatedAt,
+		}); err != nil {
+			return err
+		}
+		table.Imported++
+	}
+	return nil
+}
+
+func (r *restorer) restoreTransactionMatches(ctx context.Context, table *v1.ImportedTable) error {
+	for _, m := range r.a.TransactionMatches {
+		transactionID, err := r.transactions.Get(m.TransactionID)
+		if err != nil {
+			return err
+		}
+		expenseID, err := r.expenses.Get(m.ExpenseID)
+		if err != nil {
+			return err
+		}
+		if _, err := r.q.CreateTransactionMatch(ctx, familydb.CreateTransactionMatchParams{
+			TransactionID: transactionID,
+			ExpenseID:     expenseID,
+			ScheduledDate: m.ScheduledDate,
+			Score:         m.Score,
+			Status:        m.Status,
+			CreatedAt:     m.CreatedAt,
+			UpdatedAt:     m.UpdatedAt,
+		}); err != nil {
+			return err
+		}
+		table.Imported++
+	}
+	return nil
+}
+
+func (r *restorer) restoreTransactionRules(ctx context.Context, table *v1.ImportedTable) error {
+	for _, rule := range r.a.TransactionRules {
+		accountID, err := r.accounts.Optional(rule.AccountID)
+		if err != nil {
+			return err
+		}
+		categoryID, err := r.categories.Optional(rule.SetCategoryID)
+		if err != nil {
+			return err
+		}
+		expenseID, err := r.expenses.Optional(rule.LinkExpenseID)
+		if err != nil {
+			return err
+		}
+		if _, err := r.q.CreateTransactionRule(ctx, familydb.CreateTransactionRuleParams{
+			Name:           rule.Name,
+			Position:       rule.Position,
+			IsActive:       rule.IsActive,
+			MatchField:     rule.MatchField,
+			MatchType:      rule.MatchType,
+			Pattern:        rule.Pattern,
+			MinAmountCents: rule.MinAmountCents,
+			MaxAmountCents: rule.MaxAmountCents,
+			AccountID:      accountID,
+			SetCategoryID:  categoryID,
+			SetPayee:       rule.SetPayee,
+			LinkExpenseID:  expenseID,
+			MarkTransfer:   rule.MarkTransfer,
+			CreatedAt:      rule.CreatedAt,
+			UpdatedAt:      rule.UpdatedAt,
+		}); err != nil {
+			return err
+		}
+		table.Imported++
+	}
+	return nil
+}
+
+func (r *restorer) restoreTransferPairs(ctx context.Context, table *v1.ImportedTable) error {
+	for _, p := range r.a.TransferPairs {
+		outflowID, err := r.transactions.Get(p.OutflowTransactionID)
+		if err != nil {
+			return err
+		}
+		inflowID, err := r.transactions.Get(p.InflowTransactionID)
+		if err != nil {
+			return err
+		}
+		if _, err := r.q.CreateTransferPair(ctx, familydb.CreateTransferPairParams{
+			OutflowTransactionID: outflowID,
+			InflowTransactionID:  inflowID,
+			Status:               p.Status,
+			CreatedAt:            p.CreatedAt,
+			UpdatedAt:            p.UpdatedAt,
+		}); err != nil {
+			return err
+		}
+		table.Imported++
+	}
+	return nil
+}
+
+func (r *restorer) restoreTransactionSplits(ctx context.Context, table *v1.ImportedTable) error {
+	for _, sp := range r.a.TransactionSplits {
+		transactionID, err := r.transactions.Get(sp.TransactionID)
+		if err != nil {
+			return err
+		}
+		categoryID, err := r.categories.Optional(sp.CategoryID)
+		if err != nil {
+			return err
+		}
+		if _, err := r.q.CreateTransactionSplit(ctx, familydb.CreateTransactionSplitParams{
+			TransactionID: transactionID,
+			Position:      sp.Position,
+			AmountCents:   sp.AmountCents,
+			CategoryID:    categoryID,
+			Note:          sp.Note,
+			MemberID:      r.member(sp.MemberID),
+			CreatedAt:     sp.CreatedAt,
+		}); err != nil {
+			return err
+		}
+		table.Imported++
+	}
+	return nil
+}
+
+// restoreSharedCosts skips costs paid by or shared with a member who is not in
+// the family, as their balances could not add up without them
+func (r *restorer) restoreSharedCosts(ctx context.Context, table *v1.ImportedTable) error {
+	shares := make(map[int64][]*familydb.SharedCostShare)
+	for _, sh := range r.a.SharedCostShares {
+		shares[sh.SharedCostID] = append(shares[sh.SharedCostID], sh)
+	}
+
+costs:
+	for _, c := range r.a.SharedCosts {
+		paidBy, ok := r.members.Lookup(c.PaidBy)
+		if !ok {
+			table.Skipped++
+			continue
+		}
+		for _, sh := range shares[c.ID] {
+			if _, ok := r.members.Lookup(sh.MemberID); !ok {
+				table.Skipped++
+				continue costs
+			}
+		}
+
+		paymentID, err := r.payments.Optional(c.ExpensePaymentID)
+		if err != nil {
+			return err
+		}
+		transactionID, err := r.transactions.Optional(c.TransactionID)
+		if err != nil {
+			return err
+		}
+		created, err := r.q.CreateSharedCost(ctx, familydb.CreateSharedCostParams{
+			ExpensePaymentID: paymentID,
+			TransactionID:    transactionID,
+			PaidBy:           paidBy,
+			SplitMethod:      c.SplitMethod,
+			CreatedAt:        c.CreatedAt,
+			UpdatedAt:        c.UpdatedAt,
+		})
+		if err != nil {
+			return err
+		}
+		for _, sh := range shares[c.ID] {
+			memberID, _ := r.members.Lookup(sh.MemberID)
+			if err := r.q.CreateSharedCostShare(ctx, familydb.CreateSharedCostShareParams{
+				SharedCostID: created.ID,
+				MemberID:     memberID,
+				Position:     sh.Position,
+				Value:        sh.Value,
+			}); err != nil {
+				return err
+			}
+		}
+		table.Imported++
+	}
+	return nil
+}
+
+func (r *restorer) restoreSettlements(ctx context.Context, table *v1.ImportedTable) error {
+	for _, st := range r.a.Settlements {
+		fromID, fromOK := r.members.Lookup(st.FromMemberID)
+		toID, toOK := r.members.Lookup(st.ToMemberID)
+		if !fromOK || !toOK {
+			table.Skipped++
+			continue
+		}
+		if _, err := r.q.CreateSettlement(ctx, familydb.CreateSettlementParams{
+			FromMemberID: fromID,
+			ToMemberID:   toID,
+			AmountCents:  st.AmountCents,
+			Note:         st.Note,
+			RecordedBy:   r.member(st.RecordedBy),
+			SettledAt:    st.SettledAt,
+		}); err != nil {
+			return err
+		}
+		table.Imported++
+	}
+	return nil
+}
+
+func (r *restorer) restoreStatementMappings(ctx context.Context, table *v1.ImportedTable) error {
+	for _, m := range r.a.StatementMappings {
+		if _, err := r.q.CreateStatementMapping(ctx, familydb.CreateStatementMappingParams{
+			Name:              m.Name,
+			Delimiter:         m.Delimiter,
+			HasHeader:         m.HasHeader,
+			SkipRows:          m.SkipRows,
+			DateColumn:        m.DateColumn,
+			DateFormat:        m.DateFormat,
+			DescriptionColumn: m.DescriptionColumn,
+			PayeeColumn:       m.PayeeColumn,
+			AmountColumn:      m.AmountColumn,
+			DebitColumn:       m.DebitColumn,
+			CreditColumn:      m.CreditColumn,
+			IDColumn:          m.IDColumn,
+			NegateAmounts:     m.NegateAmounts,
+			CreatedAt:         m.CreatedAt,
+			UpdatedAt:         m.UpdatedAt,
+		}); err != nil {
+			return err
+		}
+		table.Imported++
+	}
+	return nil
+}
+
+// restoreSettings replaces settings the family already has. Secret settings
+// are never in an export, and would not decrypt on this server if they were.
+func (r *restorer) restoreSettings(ctx context.Context, table *v1.ImportedTable) error {
+	existing, err := r.q.ListFamilySettings(ctx)
+	if err != nil {
+		return err
+	}
+	byKey := make(map[string]*familydb.FamilySetting, len(existing))
+	for _, setting := range existing {
+		byKey[setting.SettingKey] = setting
+	}
+
+	for _, setting := range r.a.Settings {
+		if isSecretSetting(setting.SettingKey, setting.DataType) {
+			table.Skipped++
+			continue
+		}
+		if match, ok := byKey[setting.SettingKey]; ok {
+			_, err = r.q.UpdateFamilySetting(ctx, familydb.UpdateFamilySettingParams{
+				SettingValue: setting.SettingValue,
+				DataType:     setting.DataType,
+				ID:           match.ID,
+			})
+		} else {
+			_, err = r.q.CreateFamilySetting(ctx, familydb.CreateFamilySettingParams{
+				SettingKey:   setting.SettingKey,
+				SettingValue: setting.SettingValue,
+				DataType:     setting.DataType,
+			})
+		}
+		if err != nil {
+			return err
+		}
+		table.Imported++
+	}
+	return nil
+}
+
+// member maps a nullable member reference, dropping members who are not in
+// the family
+func (r *restorer) member(old *int64) *int64 {
+	if old == nil {
+		return nil
+	}
+	if id, ok := r.members.Lookup(*old); ok {
+		return &id
+	}
+	return nil
+}
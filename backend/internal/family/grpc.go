@@ -4,10 +4,18 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	appcontext "expenses-backend/internal/context"
 	"expenses-backend/internal/database/sql/familydb"
+	"expenses-backend/internal/ledger"
+	"expenses-backend/internal/logger"
+	"expenses-backend/internal/money"
+	"expenses-backend/internal/recurrence"
+	"expenses-backend/internal/secrets"
 	v1 "expenses-backend/pkg/family/v1"
 
 	"connectrpc.com/connect"
@@ -19,24 +27,33 @@ func (s *Service) CreateFamilySetting(ctx context.Context, req *connect.Request[
 		return nil, err
 	}
 
-	queries, err := s.dbManager.GetFamilyQueries(int(authCtx.FamilyID))
+	var setting *familydb.FamilySetting
+	err = s.dbManager.WithFamilyTx(ctx, int(authCtx.FamilyID), func(q *familydb.Queries) error {
+		value, dataType := req.Msg.SettingValue, req.Msg.DataType
+		if isSecretSetting(req.Msg.SettingKey, dataType) {
+			dataType = secrets.DataType
+			if value, err = s.sealSetting(ctx, q, req.Msg.SettingKey, value); err != nil {
+				return err
+			}
+		}
+
+		setting, err = q.CreateFamilySetting(ctx, familydb.CreateFamilySettingParams{
+			SettingKey:   req.Msg.SettingKey,
+			SettingValue: value,
+			DataType:     dataType,
+		})
+		return err
+	})
 	if err != nil {
+		if errors.Is(err, secrets.ErrNoMasterKey) {
+			return nil, SecretsDisabledError()
+		}
 		return nil, err
 	}
-
-	setting, err := queries.CreateFamilySetting(ctx, familydb.CreateFamilySettingParams{
-		SettingKey:   req.Msg.SettingKey,
-		SettingValue: req.Msg.SettingValue,
-		DataType:     req.Msg.DataType,
-	})
+	s.notifySettingChange(int(authCtx.FamilyID), setting.SettingKey)
 
 	return connect.NewResponse(&v1.CreateFamilySettingResponse{
-		FamilySetting: &v1.FamilySetting{
-			Id:           setting.ID,
-			SettingKey:   setting.SettingKey,
-			SettingValue: setting.SettingValue,
-			DataType:     setting.DataType,
-		},
+		FamilySetting: convertToProtoSetting(setting),
 	}), nil
 }
 
@@ -59,12 +76,7 @@ func (s *Service) ListFamilySettings(ctx context.Context, req *connect.Request[v
 	resp := []*v1.FamilySetting{}
 
 	for _, s := range settings {
-		resp = append(resp, &v1.FamilySetting{
-			Id:           s.ID,
-			SettingKey:   s.SettingKey,
-			SettingValue: s.SettingValue,
-			DataType:     s.DataType,
-		})
+		resp = append(resp, convertToProtoSetting(s))
 	}
 
 	return connect.NewResponse(&v1.ListFamilySettingsResponse{
@@ -93,12 +105,7 @@ func (s *Service) GetFamilySettingByKey(ctx context.Context, req *connect.Reques
 	}
 
 	return connect.NewResponse(&v1.GetFamilySettingByKeyResponse{
-		FamilySetting: &v1.FamilySetting{
-			Id:           setting.ID,
-			SettingKey:   setting.SettingKey,
-			SettingValue: setting.SettingValue,
-			DataType:     setting.DataType,
-		},
+		FamilySetting: convertToProtoSetting(setting),
 	}), nil
 }
 
@@ -108,34 +115,73 @@ func (s *Service) UpdateFamilySetting(ctx context.Context, req *connect.Request[
 		return nil, err
 	}
 
-	queries, err := s.dbManager.GetFamilyQueries(int(authCtx.FamilyID))
-	if err != nil {
-		return nil, err
-	}
+	var setting *familydb.FamilySetting
+	err = s.dbManager.WithFamilyTx(ctx, int(authCtx.FamilyID), func(q *familydb.Queries) error {
+		existing, err := q.GetFamilySettingByID(ctx, req.Msg.Id)
+		if err != nil {
+			return err
+		}
+
+		// A secret setting stays secret, so its value can't be read back in plaintext
+		value, dataType := req.Msg.SettingValue, req.Msg.DataType
+		if isSecretSetting(existing.SettingKey, dataType) || existing.DataType == secrets.DataType {
+			dataType = secrets.DataType
+			if value, err = s.sealSetting(ctx, q, existing.SettingKey, value); err != nil {
+				return err
+			}
+		}
 
-	setting, err := queries.UpdateFamilySetting(ctx, familydb.UpdateFamilySettingParams{
-		SettingValue: req.Msg.SettingValue,
-		DataType:     req.Msg.DataType,
-		ID:           req.Msg.Id,
+		setting, err = q.UpdateFamilySetting(ctx, familydb.UpdateFamilySettingParams{
+			SettingValue: value,
+			DataType:     dataType,
+			ID:           existing.ID,
+		})
+		return err
 	})
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("setting not found"))
+		}
+		if errors.Is(err, secrets.ErrNoMasterKey) {
+			return nil, SecretsDisabledError()
+		}
 		return nil, err
 	}
+	s.notifySettingChange(int(authCtx.FamilyID), setting.SettingKey)
 
 	return &connect.Response[v1.UpdateFamilySettingResponse]{
 		Msg: &v1.UpdateFamilySettingResponse{
-			FamilySetting: &v1.FamilySetting{
-				Id:           setting.ID,
-				SettingKey:   setting.SettingKey,
-				SettingValue: setting.SettingValue,
-				DataType:     setting.DataType,
-			},
+			FamilySetting: convertToProtoSetting(setting),
 		},
 	}, nil
 }
 
 func (s *Service) DeleteFamilySetting(ctx context.Context, req *connect.Request[v1.DeleteFamilySettingRequest]) (*connect.Response[v1.DeleteFamilySettingResponse], error) {
-	return nil, nil
+	authCtx, err := appcontext.RequireFamily(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var key string
+	err = s.dbManager.WithFamilyTx(ctx, int(authCtx.FamilyID), func(q *familydb.Queries) error {
+		existing, err := q.GetFamilySettingByID(ctx, req.Msg.Id)
+		if err != nil {
+			return err
+		}
+		key = existing.SettingKey
+		return q.DeleteFamilySetting(ctx, existing.ID)
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("setting not found"))
+		}
+		return nil, err
+	}
+	s.notifySettingChange(int(authCtx.FamilyID), key)
+
+	return connect.NewResponse(&v1.DeleteFamilySettingResponse{
+		Success: true,
+	}), nil
 }
 
 // Income management gRPC endpoints
@@ -146,28 +192,13 @@ func (s *Service) GetMonthlyIncome(ctx context.Context, req *connect.Request[v1.
 		return nil, err
 	}
 
-	income, err := s.getMonthlyIncomeInternal(ctx, int(authCtx.FamilyID))
+	sources, err := s.IncomeSources(ctx, int(authCtx.FamilyID))
 	if err != nil {
 		return nil, err
 	}
 
-	// Convert to proto format
-	protoSources := make([]*v1.IncomeSource, len(income.Sources))
-	for i, source := range income.Sources {
-		protoSources[i] = &v1.IncomeSource{
-			Name:        source.Name,
-			Amount:      source.Amount,
-			Description: source.Description,
-			IsActive:    source.IsActive,
-		}
-	}
-
 	return connect.NewResponse(&v1.GetMonthlyIncomeResponse{
-		MonthlyIncome: &v1.MonthlyIncome{
-			TotalAmount: income.TotalAmount,
-			Sources:     protoSources,
-			UpdatedAt:   income.UpdatedAt.Unix(),
-		},
+		MonthlyIncome: monthlyIncomeToProto(sources),
 	}), nil
 }
 
@@ -176,29 +207,20 @@ func (s *Service) SetMonthlyIncome(ctx context.Context, req *connect.Request[v1.
 	if err != nil {
 		return nil, err
 	}
-
-	// Convert from proto format
-	sources := make([]IncomeSource, len(req.Msg.MonthlyIncome.Sources))
-	for i, protoSource := range req.Msg.MonthlyIncome.Sources {
-		sources[i] = IncomeSource{
-			Name:        protoSource.Name,
-			Amount:      protoSource.Amount,
-			Description: protoSource.Description,
-			IsActive:    protoSource.IsActive,
-		}
+	if req.Msg.MonthlyIncome == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("monthly_income is required"))
 	}
 
-	income := &MonthlyIncome{
-		TotalAmount: req.Msg.MonthlyIncome.TotalAmount,
-		Sources:     sources,
-		UpdatedAt:   time.Unix(req.Msg.MonthlyIncome.UpdatedAt, 0),
-	}
-
-	err = s.setMonthlyIncomeInternal(ctx, int(authCtx.FamilyID), income)
+	now := time.Now().UTC()
+	err = s.dbManager.WithFamilyTx(ctx, int(authCtx.FamilyID), func(q *familydb.Queries) error {
+		return replaceIncomeSources(ctx, q, req.Msg.MonthlyIncome.Sources, now)
+	})
 	if err != nil {
 		return nil, err
 	}
 
+	s.logger.Info("Monthly income updated successfully", logger.Int64("family_id", authCtx.FamilyID), logger.Int("sources", len(req.Msg.MonthlyIncome.Sources)))
+
 	return connect.NewResponse(&v1.SetMonthlyIncomeResponse{
 		Success: true,
 	}), nil
@@ -210,17 +232,18 @@ func (s *Service) AddIncomeSource(ctx context.Context, req *connect.Request[v1.A
 		return nil, err
 	}
 
-	source := IncomeSource{
-		Name:        req.Msg.IncomeSource.Name,
-		Amount:      req.Msg.IncomeSource.Amount,
-		Description: req.Msg.IncomeSource.Description,
-		IsActive:    req.Msg.IncomeSource.IsActive,
+	queries, err := s.dbManager.GetFamilyQueries(int(authCtx.FamilyID))
+	if err != nil {
+		return nil, err
 	}
 
-	err = s.addIncomeSourceInternal(ctx, int(authCtx.FamilyID), source)
+	source, err := incomeSourceFromProto(ctx, queries, req.Msg.IncomeSource, nil)
 	if err != nil {
 		return nil, err
 	}
+	if err := createIncomeSource(ctx, queries, source, time.Now().UTC()); err != nil {
+		return nil, err
+	}
 
 	return connect.NewResponse(&v1.AddIncomeSourceResponse{
 		Success: true,
@@ -233,7 +256,17 @@ func (s *Service) RemoveIncomeSource(ctx context.Context, req *connect.Request[v
 		return nil, err
 	}
 
-	err = s.removeIncomeSourceInternal(ctx, int(authCtx.FamilyID), req.Msg.SourceName)
+	err = s.dbManager.WithFamilyTx(ctx, int(authCtx.FamilyID), func(q *familydb.Queries) error {
+		// Removing a source that doesn't exist is a no-op
+		source, err := q.GetIncomeSourceByName(ctx, req.Msg.SourceName)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get income source: %w", err)
+		}
+		return deleteIncomeSource(ctx, q, source.ID)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -249,14 +282,22 @@ func (s *Service) UpdateIncomeSource(ctx context.Context, req *connect.Request[v
 		return nil, err
 	}
 
-	updatedSource := IncomeSource{
-		Name:        req.Msg.UpdatedSource.Name,
-		Amount:      req.Msg.UpdatedSource.Amount,
-		Description: req.Msg.UpdatedSource.Description,
-		IsActive:    req.Msg.UpdatedSource.IsActive,
-	}
+	err = s.dbManager.WithFamilyTx(ctx, int(authCtx.FamilyID), func(q *familydb.Queries) error {
+		// Updating a source that doesn't exist is a no-op
+		existing, err := q.GetIncomeSourceByName(ctx, req.Msg.SourceName)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get income source: %w", err)
+		}
 
-	err = s.updateIncomeSourceInternal(ctx, int(authCtx.FamilyID), req.Msg.SourceName, updatedSource)
+		source, err := incomeSourceFromProto(ctx, q, req.Msg.UpdatedSource, existing)
+		if err != nil {
+			return err
+		}
+		return updateIncomeSource(ctx, q, existing.ID, source, time.Now().UTC())
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -265,3 +306,330 @@ func (s *Service) UpdateIncomeSource(ctx context.Context, req *connect.Request[v
 		Success: true,
 	}), nil
 }
+
+func (s *Service) ListPaydays(ctx context.Context, req *connect.Request[v1.ListPaydaysRequest]) (*connect.Response[v1.ListPaydaysResponse], error) {
+	authCtx, err := appcontext.RequireFamily(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	from, err := recurrence.ParseDate(req.Msg.StartDate)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("start_date must be YYYY-MM-DD"))
+	}
+	to, err := recurrence.ParseDate(req.Msg.EndDate)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("end_date must be YYYY-MM-DD"))
+	}
+	if to.Before(from) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("start_date must not be after end_date"))
+	}
+	if to.After(from.AddDate(0, 0, maxPaydayRangeDays)) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("the range can span at most %d days", maxPaydayRangeDays))
+	}
+
+	sources, err := s.IncomeSources(ctx, int(authCtx.FamilyID))
+	if err != nil {
+		return nil, err
+	}
+	if !req.Msg.IncludeInactive {
+		sources = slices.DeleteFunc(sources, func(src *familydb.IncomeSource) bool { return !src.IsActive })
+	}
+
+	calendar, err := s.HolidayCalendar(ctx, int(authCtx.FamilyID))
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.ListPaydaysResponse{
+		Paydays: paydays(sources, recurrence.NewResolver(calendar), from, to),
+	}), nil
+}
+
+// Holiday calendar gRPC endpoints
+
+var holidayPresetToProto = map[recurrence.Preset]v1.HolidayPreset{
+	recurrence.PresetUSBank: v1.HolidayPreset_HOLIDAY_PRESET_US_BANK,
+}
+
+func (s *Service) GetHolidayCalendar(ctx context.Context, req *connect.Request[v1.GetHolidayCalendarRequest]) (*connect.Response[v1.GetHolidayCalendarResponse], error) {
+	authCtx, err := appcontext.RequireFamily(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	calendar, err := s.HolidayCalendar(ctx, int(authCtx.FamilyID))
+	if err != nil {
+		return nil, err
+	}
+
+	year := time.Now().Year()
+	if req.Msg.Year != nil {
+		year = int(req.Msg.GetYear())
+	}
+
+	// Convert to proto format
+	protoCalendar := &v1.HolidayCalendar{
+		Holidays: convertToProtoHolidays(calendar.Holidays),
+	}
+	for _, preset := range calendar.Presets {
+		protoCalendar.Presets = append(protoCalendar.Presets, holidayPresetToProto[preset])
+	}
+
+	return connect.NewResponse(&v1.GetHolidayCalendarResponse{
+		Calendar:         protoCalendar,
+		ObservedHolidays: convertToProtoHolidays(calendar.HolidaysIn(year)),
+	}), nil
+}
+
+func (s *Service) SetHolidayCalendar(ctx context.Context, req *connect.Request[v1.SetHolidayCalendarRequest]) (*connect.Response[v1.SetHolidayCalendarResponse], error) {
+	authCtx, err := appcontext.RequireFamily(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert from proto format
+	calendar := &recurrence.Calendar{}
+	for _, protoPreset := range req.Msg.GetCalendar().GetPresets() {
+		var preset recurrence.Preset
+		for p, pb := range holidayPresetToProto {
+			if pb == protoPreset {
+				preset = p
+			}
+		}
+		if preset == "" {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown holiday preset %v", protoPreset))
+		}
+		if !slices.Contains(calendar.Presets, preset) {
+			calendar.Presets = append(calendar.Presets, preset)
+		}
+	}
+	for _, protoHoliday := range req.Msg.GetCalendar().GetHolidays() {
+		calendar.Holidays = append(calendar.Holidays, recurrence.Holiday{
+			Date: protoHoliday.Date,
+			Name: protoHoliday.Name,
+		})
+	}
+
+	if err := calendar.Validate(); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	err = s.setHolidayCalendarInternal(ctx, int(authCtx.FamilyID), calendar)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.SetHolidayCalendarResponse{
+		Success: true,
+	}), nil
+}
+
+func convertToProtoHolidays(holidays []recurrence.Holiday) []*v1.Holiday {
+	protoHolidays := make([]*v1.Holiday, len(holidays))
+	for i, holiday := range holidays {
+		protoHolidays[i] = &v1.Holiday{
+			Date: holiday.Date,
+			Name: holiday.Name,
+		}
+	}
+	return protoHolidays
+}
+
+// Settle-up gRPC endpoints
+
+func (s *Service) SetSharedCost(ctx context.Context, req *connect.Request[v1.SetSharedCostRequest]) (*connect.Response[v1.SetSharedCostResponse], error) {
+	authCtx, err := appcontext.RequireFamily(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var cost *v1.SharedCost
+	err = s.dbManager.WithFamilyTx(ctx, int(authCtx.FamilyID), func(q *familydb.Queries) error {
+		src, err := resolveCostSource(ctx, q, req.Msg.Source)
+		if err != nil {
+			return err
+		}
+		lc, err := ledgerCostFromProto(ctx, q, req.Msg, src)
+		if err != nil {
+			return err
+		}
+		if err := saveSharedCost(ctx, q, src, lc, time.Now().UTC()); err != nil {
+			return err
+		}
+		cost, err = sharedCostToProto(ctx, q, src, req.Msg.Source)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.SetSharedCostResponse{
+		SharedCost: cost,
+	}), nil
+}
+
+func (s *Service) GetSharedCost(ctx context.Context, req *connect.Request[v1.GetSharedCostRequest]) (*connect.Response[v1.GetSharedCostResponse], error) {
+	authCtx, err := appcontext.RequireFamily(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	queries, err := s.dbManager.GetFamilyQueries(int(authCtx.FamilyID))
+	if err != nil {
+		return nil, err
+	}
+
+	src, err := resolveCostSource(ctx, queries, req.Msg.Source)
+	if err != nil {
+		return nil, err
+	}
+	cost, err := sharedCostToProto(ctx, queries, src, req.Msg.Source)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.GetSharedCostResponse{
+		SharedCost: cost,
+	}), nil
+}
+
+func (s *Service) DeleteSharedCost(ctx context.Context, req *connect.Request[v1.DeleteSharedCostRequest]) (*connect.Response[v1.DeleteSharedCostResponse], error) {
+	authCtx, err := appcontext.RequireFamily(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.dbManager.WithFamilyTx(ctx, int(authCtx.FamilyID), func(q *familydb.Queries) error {
+		src, err := resolveCostSource(ctx, q, req.Msg.Source)
+		if err != nil {
+			return err
+		}
+		// Deleting a cost that isn't shared is a no-op
+		cost, err := getSharedCost(ctx, q, src)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get shared cost: %w", err)
+		}
+		if err := q.DeleteSharedCostShares(ctx, cost.ID); err != nil {
+			return fmt.Errorf("failed to delete shares: %w", err)
+		}
+		return q.DeleteSharedCost(ctx, cost.ID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.DeleteSharedCostResponse{
+		Success: true,
+	}), nil
+}
+
+func (s *Service) GetBalances(ctx context.Context, req *connect.Request[v1.GetBalancesRequest]) (*connect.Response[v1.GetBalancesResponse], error) {
+	authCtx, err := appcontext.RequireFamily(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	queries, err := s.dbManager.GetFamilyQueries(int(authCtx.FamilyID))
+	if err != nil {
+		return nil, err
+	}
+
+	balances, err := familyBalances(ctx, queries)
+	if err != nil {
+		return nil, err
+	}
+	members, err := balancesToProto(ctx, queries, balances)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &v1.GetBalancesResponse{Balances: members}
+	for _, p := range ledger.Settle(balances) {
+		resp.SettleUp = append(resp.SettleUp, reimbursementToProto(p))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *Service) SettleUp(ctx context.Context, req *connect.Request[v1.SettleUpRequest]) (*connect.Response[v1.SettleUpResponse], error) {
+	authCtx, err := appcontext.RequireFamily(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var note *string
+	if n := strings.TrimSpace(req.Msg.GetNote()); n != "" {
+		note = &n
+	}
+
+	resp := &v1.SettleUpResponse{}
+	err = s.dbManager.WithFamilyTx(ctx, int(authCtx.FamilyID), func(q *familydb.Queries) error {
+		var payments []ledger.Payment
+		for _, r := range req.Msg.Reimbursements {
+			cents, err := money.ParseCents(r.Amount)
+			if err != nil {
+				return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid amount: %w", err))
+			}
+			if cents <= 0 {
+				return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("amount must be positive"))
+			}
+			if r.FromMemberId == r.ToMemberId {
+				return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("a member cannot reimburse themselves"))
+			}
+			for _, id := range []int64{r.FromMemberId, r.ToMemberId} {
+				if err := RequireMember(ctx, q, id); err != nil {
+					return err
+				}
+			}
+			payments = append(payments, ledger.Payment{From: r.FromMemberId, To: r.ToMemberId, Cents: cents})
+		}
+
+		if len(payments) == 0 {
+			balances, err := familyBalances(ctx, q)
+			if err != nil {
+				return err
+			}
+			payments = ledger.Settle(balances)
+		}
+
+		// Whoever records it need not be a member of the family database yet
+		var recordedBy *int64
+		if err := RequireMember(ctx, q, authCtx.UserID); err == nil {
+			recordedBy = &authCtx.UserID
+		}
+		now := time.Now().UTC()
+		for _, p := range payments {
+			if _, err := q.CreateSettlement(ctx, familydb.CreateSettlementParams{
+				FromMemberID: p.From,
+				ToMemberID:   p.To,
+				AmountCents:  p.Cents,
+				Note:         note,
+				RecordedBy:   recordedBy,
+				SettledAt:    now,
+			}); err != nil {
+				return fmt.Errorf("failed to record reimbursement: %w", err)
+			}
+			resp.Recorded = append(resp.Recorded, reimbursementToProto(p))
+		}
+
+		balances, err := familyBalances(ctx, q)
+		if err != nil {
+			return err
+		}
+		resp.Balances, err = balancesToProto(ctx, q, balances)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Settled up",
+		logger.Int64("family_id", authCtx.FamilyID),
+		logger.Int("reimbursements", len(resp.Recorded)),
+		logger.Int64("user_id", authCtx.UserID))
+
+	return connect.NewResponse(resp), nil
+}
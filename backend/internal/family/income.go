@@ -0,0 +1,327 @@
+package family
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"expenses-backend/internal/database/sql/familydb"
+	"expenses-backend/internal/income"
+	"expenses-backend/internal/money"
+	"expenses-backend/internal/recurrence"
+	v1 "expenses-backend/pkg/family/v1"
+
+	"connectrpc.com/connect"
+)
+
+// maxPaydayRangeDays bounds the range of a ListPaydays request
+const maxPaydayRangeDays = 366
+
+var payFrequencyToProto = map[income.Frequency]v1.PayFrequency{
+	income.Weekly:      v1.PayFrequency_PAY_FREQUENCY_WEEKLY,
+	income.Biweekly:    v1.PayFrequency_PAY_FREQUENCY_BIWEEKLY,
+	income.Semimonthly: v1.PayFrequency_PAY_FREQUENCY_SEMIMONTHLY,
+	income.Monthly:     v1.PayFrequency_PAY_FREQUENCY_MONTHLY,
+}
+
+// IncomeSources lists the family's income sources
+func (s *Service) IncomeSources(ctx context.Context, familyID int) ([]*familydb.IncomeSource, error) {
+	queries, err := s.dbManager.GetFamilyQueries(familyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get family database: %w", err)
+	}
+
+	sources, err := queries.ListIncomeSources(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list income sources: %w", err)
+	}
+	return sources, nil
+}
+
+// IncomeSchedule returns when an income source pays
+func IncomeSchedule(src *familydb.IncomeSource) income.Schedule {
+	return income.Schedule{Frequency: income.Frequency(src.PayFrequency), Anchor: src.AnchorDate}
+}
+
+// monthlyNetCents is the source's net pay per month on average
+func monthlyNetCents(src *familydb.IncomeSource) int64 {
+	cents, err := income.MonthlyCents(src.NetCents, income.Frequency(src.PayFrequency))
+	if err != nil {
+		return 0
+	}
+	return cents
+}
+
+func incomeSourceToProto(src *familydb.IncomeSource) *v1.IncomeSource {
+	anchor := src.AnchorDate.Format(recurrence.DateLayout)
+	gross := money.FormatCents(src.GrossCents)
+	net := money.FormatCents(src.NetCents)
+	pb := &v1.IncomeSource{
+		Id:            src.ID,
+		Name:          src.Name,
+		Amount:        money.ToFloat(monthlyNetCents(src)),
+		IsActive:      src.IsActive,
+		PayFrequency:  payFrequencyToProto[income.Frequency(src.PayFrequency)],
+		PayAnchorDate: &anchor,
+		GrossAmount:   &gross,
+		NetAmount:     &net,
+		MemberId:      src.MemberID,
+	}
+	if src.Description != nil {
+		pb.Description = *src.Description
+	}
+	return pb
+}
+
+// monthlyIncomeToProto totals the active sources' net pay per month
+func monthlyIncomeToProto(sources []*familydb.IncomeSource) *v1.MonthlyIncome {
+	var total int64
+	updatedAt := time.Now()
+	if len(sources) > 0 {
+		updatedAt = time.Time{}
+	}
+
+	pb := &v1.MonthlyIncome{Sources: make([]*v1.IncomeSource, len(sources))}
+	for i, src := range sources {
+		pb.Sources[i] = incomeSourceToProto(src)
+		if src.IsActive {
+			total += monthlyNetCents(src)
+		}
+		if src.UpdatedAt.After(updatedAt) {
+			updatedAt = src.UpdatedAt
+		}
+	}
+	pb.TotalAmount = money.ToFloat(total)
+	pb.UpdatedAt = updatedAt.Unix()
+	return pb
+}
+
+// incomeSourceFields are the stored fields of an income source
+type incomeSourceFields struct {
+	name        string
+	description *string
+	memberID    *int64
+	frequency   income.Frequency
+	anchor      time.Time
+	grossCents  int64
+	netCents    int64
+	isActive    bool
+}
+
+// incomeSourceFromProto validates an income source from the API. Fields the
+// request leaves out keep their value from existing, which is nil for new
+// sources. Clients that only know the monthly amount get monthly pay on the
+// 1st, with the gross amount following the net one.
+func incomeSourceFromProto(ctx context.Context, q *familydb.Queries, pb *v1.IncomeSource, existing *familydb.IncomeSource) (incomeSourceFields, error) {
+	if pb == nil {
+		return incomeSourceFields{}, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("income source is required"))
+	}
+
+	today := time.Now().UTC()
+	f := incomeSourceFields{
+		name:      strings.TrimSpace(pb.Name),
+		frequency: income.Monthly,
+		anchor:    time.Date(today.Year(), today.Month(), 1, 0, 0, 0, 0, time.UTC),
+		isActive:  pb.IsActive,
+	}
+	if existing != nil {
+		f.memberID = existing.MemberID
+		f.frequency = income.Frequency(existing.PayFrequency)
+		f.anchor = existing.AnchorDate
+	}
+	if f.name == "" {
+		return incomeSourceFields{}, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name is required"))
+	}
+	if d := strings.TrimSpace(pb.Description); d != "" {
+		f.description = &d
+	}
+
+	if pb.PayFrequency != v1.PayFrequency_PAY_FREQUENCY_UNSPECIFIED {
+		f.frequency = ""
+		for freq, protoFreq := range payFrequencyToProto {
+			if protoFreq == pb.PayFrequency {
+				f.frequency = freq
+			}
+		}
+		if f.frequency == "" {
+			return incomeSourceFields{}, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown pay frequency %v", pb.PayFrequency))
+		}
+	}
+	if pb.PayAnchorDate != nil {
+		anchor, err := recurrence.ParseDate(*pb.PayAnchorDate)
+		if err != nil {
+			return incomeSourceFields{}, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("pay_anchor_date must be YYYY-MM-DD"))
+		}
+		f.anchor = anchor
+	}
+
+	var err error
+	if pb.NetAmount != nil {
+		if f.netCents, err = money.ParseCents(*pb.NetAmount); err != nil {
+			return incomeSourceFields{}, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("net_amount must be a decimal amount"))
+		}
+	} else if f.netCents, err = income.PerPayCents(money.FromFloat(pb.Amount), f.frequency); err != nil {
+		return incomeSourceFields{}, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	switch {
+	case pb.GrossAmount != nil:
+		if f.grossCents, err = money.ParseCents(*pb.GrossAmount); err != nil {
+			return incomeSourceFields{}, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("gross_amount must be a decimal amount"))
+		}
+	case existing != nil && existing.NetCents == f.netCents:
+		f.grossCents = existing.GrossCents
+	default:
+		f.grossCents = f.netCents
+	}
+	if f.netCents < 0 {
+		return incomeSourceFields{}, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("net amount must not be negative"))
+	}
+	if f.grossCents < f.netCents {
+		return incomeSourceFields{}, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("gross amount must not be less than net amount"))
+	}
+
+	if pb.MemberId != nil {
+		f.memberID = nil
+		if *pb.MemberId != 0 {
+			if _, err := q.GetFamilyMemberByID(ctx, *pb.MemberId); err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return incomeSourceFields{}, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("member %d is not in the family", *pb.MemberId))
+				}
+				return incomeSourceFields{}, fmt.Errorf("failed to get family member: %w", err)
+			}
+			f.memberID = pb.MemberId
+		}
+	}
+	return f, nil
+}
+
+func createIncomeSource(ctx context.Context, q *familydb.Queries, f incomeSourceFields, now time.Time) error {
+	_, err := q.CreateIncomeSource(ctx, familydb.CreateIncomeSourceParams{
+		Name:         f.name,
+		Description:  f.description,
+		MemberID:     f.memberID,
+		PayFrequency: string(f.frequency),
+		AnchorDate:   f.anchor,
+		GrossCents:   f.grossCents,
+		NetCents:     f.netCents,
+		IsActive:     f.isActive,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create income source: %w", err)
+	}
+	return nil
+}
+
+func updateIncomeSource(ctx context.Context, q *familydb.Queries, id int64, f incomeSourceFields, now time.Time) error {
+	_, err := q.UpdateIncomeSource(ctx, familydb.UpdateIncomeSourceParams{
+		Name:         f.name,
+		Description:  f.description,
+		MemberID:     f.memberID,
+		PayFrequency: string(f.frequency),
+		AnchorDate:   f.anchor,
+		GrossCents:   f.grossCents,
+		NetCents:     f.netCents,
+		IsActive:     f.isActive,
+		UpdatedAt:    now,
+		ID:           id,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update income source: %w", err)
+	}
+	return nil
+}
+
+// replaceIncomeSources makes the family's sources match the given list.
+// Sources are matched to stored ones by ID, or by name when they have none;
+// stored sources left unmatched are deleted.
+func replaceIncomeSources(ctx context.Context, q *familydb.Queries, sources []*v1.IncomeSource, now time.Time) error {
+	stored, err := q.ListIncomeSources(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list income sources: %w", err)
+	}
+
+	kept := make(map[int64]bool)
+	for _, pb := range sources {
+		i := slices.IndexFunc(stored, func(src *familydb.IncomeSource) bool {
+			if kept[src.ID] {
+				return false
+			}
+			if pb.Id != 0 {
+				return src.ID == pb.Id
+			}
+			return src.Name == strings.TrimSpace(pb.Name)
+		})
+		if i < 0 && pb.Id != 0 {
+			return connect.NewError(connect.CodeNotFound, fmt.Errorf("income source %d not found", pb.Id))
+		}
+
+		var existing *familydb.IncomeSource
+		if i >= 0 {
+			existing = stored[i]
+		}
+		f, err := incomeSourceFromProto(ctx, q, pb, existing)
+		if err != nil {
+			return err
+		}
+		if existing == nil {
+			err = createIncomeSource(ctx, q, f, now)
+		} else {
+			kept[existing.ID] = true
+			err = updateIncomeSource(ctx, q, existing.ID, f, now)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, src := range stored {
+		if kept[src.ID] {
+			continue
+		}
+		if err := deleteIncomeSource(ctx, q, src.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteIncomeSource deletes a source with the bill allocations to its paychecks
+func deleteIncomeSource(ctx context.Context, q *familydb.Queries, id int64) error {
+	if err := q.DeletePaycheckAllocationsByIncomeSource(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete paycheck allocations: %w", err)
+	}
+	if err := q.DeleteIncomeSource(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete income source: %w", err)
+	}
+	return nil
+}
+
+// paydays lists every pay date of the sources in [from, to], ordered by date
+func paydays(sources []*familydb.IncomeSource, res *recurrence.Resolver, from, to time.Time) []*v1.Payday {
+	var out []*v1.Payday
+	for _, src := range sources {
+		for _, d := range IncomeSchedule(src).Paydays(res, from, to) {
+			out = append(out, &v1.Payday{
+				Date:           d.Due.Format(recurrence.DateLayout),
+				ScheduledDate:  d.Scheduled.Format(recurrence.DateLayout),
+				IncomeSourceId: src.ID,
+				Name:           src.Name,
+				GrossAmount:    money.FormatCents(src.GrossCents),
+				NetAmount:      money.FormatCents(src.NetCents),
+				MemberId:       src.MemberID,
+			})
+		}
+	}
+
+	// Dates are YYYY-MM-DD, so they sort as strings
+	slices.SortStableFunc(out, func(a, b *v1.Payday) int {
+		return strings.Compare(a.Date, b.Date)
+	})
+	return out
+}
@@ -0,0 +1,306 @@
+package family
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"expenses-backend/internal/database/sql/familydb"
+	"expenses-backend/internal/ledger"
+	"expenses-backend/internal/money"
+	v1 "expenses-backend/pkg/family/v1"
+
+	"connectrpc.com/connect"
+)
+
+var splitMethodToProto = map[ledger.Method]v1.SplitMethod{
+	ledger.MethodEqual:      v1.SplitMethod_SPLIT_METHOD_EQUAL,
+	ledger.MethodPercentage: v1.SplitMethod_SPLIT_METHOD_PERCENTAGE,
+	ledger.MethodFixed:      v1.SplitMethod_SPLIT_METHOD_FIXED,
+}
+
+// costSource is a resolved SharedCostSource
+type costSource struct {
+	paymentID     *int64
+	transactionID *int64
+	cents         int64  // What the cost is now
+	paidBy        *int64 // Who marked the expense paid, if anyone
+}
+
+// resolveCostSource looks up the expense payment or transaction a shared cost
+// comes from
+func resolveCostSource(ctx context.Context, q *familydb.Queries, pb *v1.SharedCostSource) (costSource, error) {
+	switch src := pb.GetSource().(type) {
+	case *v1.SharedCostSource_PaymentId:
+		payment, err := q.GetExpensePaymentByID(ctx, src.PaymentId)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return costSource{}, connect.NewError(connect.CodeNotFound, fmt.Errorf("payment not found"))
+			}
+			return costSource{}, fmt.Errorf("failed to get payment: %w", err)
+		}
+		if payment.Status != "paid" {
+			return costSource{}, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("the expense occurrence is not paid"))
+		}
+
+		amount := payment.Amount
+		if amount == nil {
+			exp, err := q.GetExpenseByID(ctx, payment.ExpenseID)
+			if err != nil {
+				return costSource{}, fmt.Errorf("failed to get expense: %w", err)
+			}
+			amount = &exp.Amount
+		}
+		return costSource{paymentID: &payment.ID, cents: money.FromFloat(*amount), paidBy: payment.PaidBy}, nil
+
+	case *v1.SharedCostSource_TransactionId:
+		txn, err := q.GetTransactionByID(ctx, src.TransactionId)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return costSource{}, connect.NewError(connect.CodeNotFound, fmt.Errorf("transaction not found"))
+			}
+			return costSource{}, fmt.Errorf("failed to get transaction: %w", err)
+		}
+		return costSource{transactionID: &txn.ID, cents: -txn.AmountCents}, nil
+
+	default:
+		return costSource{}, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("payment_id or transaction_id is required"))
+	}
+}
+
+// getSharedCost returns the shared cost recorded for the source, or
+// sql.ErrNoRows when there is none
+func getSharedCost(ctx context.Context, q *familydb.Queries, src costSource) (*familydb.SharedCost, error) {
+	if src.paymentID != nil {
+		return q.GetSharedCostByPayment(ctx, src.paymentID)
+	}
+	return q.GetSharedCostByTransaction(ctx, src.transactionID)
+}
+
+// RequireMember checks that the member belongs to the family, failing with
+// InvalidArgument when it does not
+func RequireMember(ctx context.Context, q *familydb.Queries, memberID int64) error {
+	if _, err := q.GetFamilyMemberByID(ctx, memberID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("member %d is not in the family", memberID))
+		}
+		return fmt.Errorf("failed to get family member: %w", err)
+	}
+	return nil
+}
+
+// ledgerCostFromProto validates a requested shared cost against its source
+func ledgerCostFromProto(ctx context.Context, q *familydb.Queries, req *v1.SetSharedCostRequest, src costSource) (ledger.Cost, error) {
+	cost := ledger.Cost{Cents: src.cents}
+
+	switch {
+	case req.PaidBy != nil:
+		cost.PaidBy = req.GetPaidBy()
+	case src.paidBy != nil:
+		cost.PaidBy = *src.paidBy
+	default:
+		return ledger.Cost{}, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("paid_by is required"))
+	}
+	if err := RequireMember(ctx, q, cost.PaidBy); err != nil {
+		return ledger.Cost{}, err
+	}
+
+	for m, pb := range splitMethodToProto {
+		if pb == req.Method {
+			cost.Method = m
+		}
+	}
+	if cost.Method == "" {
+		return ledger.Cost{}, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("method is required"))
+	}
+
+	for _, share := range req.Shares {
+		if err := RequireMember(ctx, q, share.MemberId); err != nil {
+			return ledger.Cost{}, err
+		}
+		var value int64
+		if cost.Method != ledger.MethodEqual {
+			// Percentages have two decimals too, so they parse to basis points
+			v, err := money.ParseCents(strings.TrimSpace(share.Value))
+			if err != nil {
+				return ledger.Cost{}, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("member %d: invalid value: %w", share.MemberId, err))
+			}
+			value = v
+		}
+		cost.Shares = append(cost.Shares, ledger.Share{MemberID: share.MemberId, Value: value})
+	}
+
+	if err := cost.Validate(); err != nil {
+		return ledger.Cost{}, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	return cost, nil
+}
+
+// saveSharedCost records the cost for the source, replacing an earlier one
+func saveSharedCost(ctx context.Context, q *familydb.Queries, src costSource, cost ledger.Cost, now time.Time) error {
+	existing, err := getSharedCost(ctx, q, src)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("failed to get shared cost: %w", err)
+	}
+
+	var saved *familydb.SharedCost
+	if err == nil {
+		saved, err = q.UpdateSharedCost(ctx, familydb.UpdateSharedCostParams{
+			PaidBy:      cost.PaidBy,
+			SplitMethod: string(cost.Method),
+			UpdatedAt:   now,
+			ID:          existing.ID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update shared cost: %w", err)
+		}
+		if err := q.DeleteSharedCostShares(ctx, saved.ID); err != nil {
+			return fmt.Errorf("failed to delete shares: %w", err)
+		}
+	} else {
+		saved, err = q.CreateSharedCost(ctx, familydb.CreateSharedCostParams{
+			ExpensePaymentID: src.paymentID,
+			TransactionID:    src.transactionID,
+			PaidBy:           cost.PaidBy,
+			SplitMethod:      string(cost.Method),
+			CreatedAt:        now,
+			UpdatedAt:        now,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create shared cost: %w", err)
+		}
+	}
+
+	for i, share := range cost.Shares {
+		if err := q.CreateSharedCostShare(ctx, familydb.CreateSharedCostShareParams{
+			SharedCostID: saved.ID,
+			MemberID:     share.MemberID,
+			Position:     int64(i),
+			Value:        share.Value,
+		}); err != nil {
+			return fmt.Errorf("failed to create share: %w", err)
+		}
+	}
+	return nil
+}
+
+// sharedCostToProto converts the shared cost recorded for a source
+func sharedCostToProto(ctx context.Context, q *familydb.Queries, src costSource, sourcePB *v1.SharedCostSource) (*v1.SharedCost, error) {
+	cost, err := getSharedCost(ctx, q, src)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("no shared cost is recorded"))
+		}
+		return nil, fmt.Errorf("failed to get shared cost: %w", err)
+	}
+	shares, err := q.ListSharedCostShares(ctx, cost.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list shares: %w", err)
+	}
+
+	lc := ledgerCost(cost.PaidBy, cost.SplitMethod, src.cents, shares)
+	owed := lc.Owed()
+	pb := &v1.SharedCost{
+		Source: sourcePB,
+		PaidBy: cost.PaidBy,
+		Method: splitMethodToProto[lc.Method],
+		Amount: money.FormatCents(src.cents),
+		Shares: make([]*v1.MemberShare, 0, len(shares)),
+	}
+	for _, share := range lc.Shares {
+		value := ""
+		if lc.Method != ledger.MethodEqual {
+			value = money.FormatCents(share.Value)
+		}
+		pb.Shares = append(pb.Shares, &v1.MemberShare{
+			MemberId: share.MemberID,
+			Value:    value,
+			Owed:     money.FormatCents(owed[share.MemberID]),
+		})
+	}
+	return pb, nil
+}
+
+func ledgerCost(paidBy int64, method string, cents int64, shares []*familydb.SharedCostShare) ledger.Cost {
+	cost := ledger.Cost{PaidBy: paidBy, Cents: cents, Method: ledger.Method(method)}
+	for _, s := range shares {
+		cost.Shares = append(cost.Shares, ledger.Share{MemberID: s.MemberID, Value: s.Value})
+	}
+	return cost
+}
+
+// familyBalances nets every shared cost and reimbursement of the family.
+// Costs whose expense payment or transaction is gone are skipped.
+func familyBalances(ctx context.Context, q *familydb.Queries) (map[int64]int64, error) {
+	rows, err := q.ListSharedCostAmounts(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list shared costs: %w", err)
+	}
+	allShares, err := q.ListAllSharedCostShares(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list shares: %w", err)
+	}
+	sharesByCost := make(map[int64][]*familydb.SharedCostShare)
+	for _, s := range allShares {
+		sharesByCost[s.SharedCostID] = append(sharesByCost[s.SharedCostID], s)
+	}
+
+	costs := make([]ledger.Cost, 0, len(rows))
+	for _, r := range rows {
+		var cents int64
+		switch {
+		case r.TransactionAmountCents != nil:
+			cents = -*r.TransactionAmountCents
+		case r.PaymentAmount != nil:
+			cents = money.FromFloat(*r.PaymentAmount)
+		default:
+			continue
+		}
+		costs = append(costs, ledgerCost(r.PaidBy, r.SplitMethod, cents, sharesByCost[r.ID]))
+	}
+
+	settlements, err := q.ListSettlements(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list settlements: %w", err)
+	}
+	payments := make([]ledger.Payment, 0, len(settlements))
+	for _, s := range settlements {
+		payments = append(payments, ledger.Payment{From: s.FromMemberID, To: s.ToMemberID, Cents: s.AmountCents})
+	}
+
+	return ledger.Balances(costs, payments), nil
+}
+
+// balancesToProto lists the members with a balance and the active members,
+// by name
+func balancesToProto(ctx context.Context, q *familydb.Queries, balances map[int64]int64) ([]*v1.MemberBalance, error) {
+	members, err := q.ListAllFamilyMembers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list family members: %w", err)
+	}
+
+	var out []*v1.MemberBalance
+	for _, m := range members {
+		balance, ok := balances[m.ID]
+		if !ok && (m.IsActive == nil || !*m.IsActive) {
+			continue
+		}
+		out = append(out, &v1.MemberBalance{
+			MemberId: m.ID,
+			Name:     m.Name,
+			Balance:  money.FormatCents(balance),
+		})
+	}
+	return out, nil
+}
+
+func reimbursementToProto(p ledger.Payment) *v1.Reimbursement {
+	return &v1.Reimbursement{
+		FromMemberId: p.From,
+		ToMemberId:   p.To,
+		Amount:       money.FormatCents(p.Cents),
+	}
+}
@@ -0,0 +1,128 @@
+package family
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+
+	"connectrpc.com/connect"
+	"expenses-backend/internal/database/sql/familydb"
+	"expenses-backend/internal/secrets"
+	v1 "expenses-backend/pkg/family/v1"
+)
+
+// SecretsDisabledError is returned by RPCs that would store a secret setting
+// on a server without a master key
+func SecretsDisabledError() error {
+	return connect.NewError(connect.CodeFailedPrecondition,
+		fmt.Errorf("secret settings are disabled on this server: set SECRETS_MASTER_KEY to enable them"))
+}
+
+// SecretSettingKeys are settings that are always stored encrypted, whatever
+// data_type the client sends
+var SecretSettingKeys = []string{"simplefin_token"}
+
+// isSecretSetting reports whether a setting must be encrypted at rest
+func isSecretSetting(key, dataType string) bool {
+	return dataType == secrets.DataType || slices.Contains(SecretSettingKeys, key)
+}
+
+// SecretsEnabled reports whether the server has a master key to encrypt
+// secret settings with
+func (s *Service) SecretsEnabled() bool {
+	return s.keyring != nil
+}
+
+// sealSetting encrypts a secret setting value; nil stays nil
+func (s *Service) sealSetting(ctx context.Context, q *familydb.Queries, key string, value *string) (*string, error) {
+	if value == nil {
+		return nil, nil
+	}
+	sealed, err := s.keyring.Seal(ctx, q, key, *value)
+	if err != nil {
+		return nil, err
+	}
+	return &sealed, nil
+}
+
+// Secret returns the decrypted value of a secret setting, or nil if it is not
+// set. Values stored before encryption was enabled are returned as is.
+func (s *Service) Secret(ctx context.Context, familyID int, key string) (*string, error) {
+	queries, err := s.dbManager.GetFamilyQueries(familyID)
+	if err != nil {
+		return nil, err
+	}
+
+	setting, err := queries.GetFamilySettingByKey(ctx, key)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get setting %s: %w", key, err)
+	}
+	if setting.SettingValue == nil || setting.DataType != secrets.DataType {
+		return setting.SettingValue, nil
+	}
+
+	value, err := s.keyring.Open(ctx, queries, key, *setting.SettingValue)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt setting %s: %w", key, err)
+	}
+	return &value, nil
+}
+
+// SetSecret encrypts and stores a secret setting; a nil value deletes it
+func (s *Service) SetSecret(ctx context.Context, familyID int, key string, value *string) error {
+	defer s.notifySettingChange(familyID, key)
+
+	return s.dbManager.WithFamilyTx(ctx, familyID, func(q *familydb.Queries) error {
+		setting, err := q.GetFamilySettingByKey(ctx, key)
+		if err != nil && err != sql.ErrNoRows {
+			return fmt.Errorf("failed to get setting %s: %w", key, err)
+		}
+		exists := err == nil
+
+		if value == nil {
+			if !exists {
+				return nil
+			}
+			return q.DeleteFamilySetting(ctx, setting.ID)
+		}
+
+		sealed, err := s.sealSetting(ctx, q, key, value)
+		if err != nil {
+			return fmt.Errorf("failed to encrypt setting %s: %w", key, err)
+		}
+
+		if exists {
+			_, err = q.UpdateFamilySetting(ctx, familydb.UpdateFamilySettingParams{
+				SettingValue: sealed,
+				DataType:     secrets.DataType,
+				ID:           setting.ID,
+			})
+		} else {
+			_, err = q.CreateFamilySetting(ctx, familydb.CreateFamilySettingParams{
+				SettingKey:   key,
+				SettingValue: sealed,
+				DataType:     secrets.DataType,
+			})
+		}
+		return err
+	})
+}
+
+// convertToProtoSetting converts SQLC setting to protobuf, redacting secrets
+func convertToProtoSetting(setting *familydb.FamilySetting) *v1.FamilySetting {
+	pb := &v1.FamilySetting{
+		Id:           setting.ID,
+		SettingKey:   setting.SettingKey,
+		SettingValue: setting.SettingValue,
+		DataType:     setting.DataType,
+	}
+	if isSecretSetting(setting.SettingKey, setting.DataType) {
+		pb.SettingValue = nil
+		pb.Redacted = true
+	}
+	return pb
+}
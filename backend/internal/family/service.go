@@ -7,11 +7,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"sync"
 	"time"
 
 	"expenses-backend/internal/database"
 	"expenses-backend/internal/database/sql/familydb"
 	"expenses-backend/internal/database/sql/masterdb"
+	"expenses-backend/internal/recurrence"
+	"expenses-backend/internal/secrets"
 
 	"expenses-backend/internal/logger"
 )
@@ -19,9 +22,17 @@ import (
 // Service handles family management operations
 type Service struct {
 	dbManager *database.DatabaseManager
+	keyring   *secrets.Keyring // nil when no master key is configured
 	logger    logger.Logger
+
+	hooksMu      sync.RWMutex
+	settingHooks []SettingChangeFunc
 }
 
+// SettingChangeFunc is called after a family setting was created, updated or
+// deleted, so values cached elsewhere can be dropped
+type SettingChangeFunc func(familyID int, key string)
+
 type FamilyError struct {
 	Code    string `json:"code"`
 	Message string `json:"message"`
@@ -48,13 +59,33 @@ var memorableWords = []string{
 	"calm", "dream", "free", "green", "happy", "love", "magic", "pure",
 }
 
-func NewService(dbManager *database.DatabaseManager, log logger.Logger) *Service {
+func NewService(dbManager *database.DatabaseManager, keyring *secrets.Keyring, log logger.Logger) *Service {
 	return &Service{
 		dbManager: dbManager,
+		keyring:   keyring,
 		logger:    log.With(logger.Str("component", "family-service")),
 	}
 }
 
+// OnSettingChange registers fn to be called whenever a family setting changes
+func (s *Service) OnSettingChange(fn SettingChangeFunc) {
+	s.hooksMu.Lock()
+	defer s.hooksMu.Unlock()
+
+	s.settingHooks = append(s.settingHooks, fn)
+}
+
+// notifySettingChange runs the registered hooks for a changed setting
+func (s *Service) notifySettingChange(familyID int, key string) {
+	s.hooksMu.RLock()
+	hooks := s.settingHooks
+	s.hooksMu.RUnlock()
+
+	for _, fn := range hooks {
+		fn(familyID, key)
+	}
+}
+
 func (s *Service) CreateFamily(ctx context.Context, req CreateFamilyRequest) (*masterdb.Family, error) {
 	if err := req.Validate(); err != nil {
 		return nil, err
@@ -482,153 +513,75 @@ func (s *Service) removeMemberFromFamilyDatabase(ctx context.Context, familyID,
 	return err
 }
 
-// Income management methods
+// Holiday calendar methods
 
-// GetMonthlyIncomeInternal retrieves the family's monthly income
-func (s *Service) getMonthlyIncomeInternal(ctx context.Context, familyID int) (*MonthlyIncome, error) {
-	familyDB, err := s.dbManager.GetFamilyDB(familyID)
+// holidayCalendarKey is the family setting holding the JSON holiday calendar
+const holidayCalendarKey = "holiday_calendar"
+
+// HolidayCalendar retrieves the family's holiday calendar used to resolve
+// business days. An unset calendar has no holidays.
+func (s *Service) HolidayCalendar(ctx context.Context, familyID int) (*recurrence.Calendar, error) {
+	familyQueries, err := s.dbManager.GetFamilyQueries(familyID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get family database: %w", err)
 	}
 
-	familyQueries := familydb.New(familyDB)
-
-	setting, err := familyQueries.GetFamilySettingByKey(ctx, "monthly_income")
+	setting, err := familyQueries.GetFamilySettingByKey(ctx, holidayCalendarKey)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			// Return empty income if not set
-			return &MonthlyIncome{
-				TotalAmount: 0,
-				Sources:     []IncomeSource{},
-				UpdatedAt:   time.Now(),
-			}, nil
+			return &recurrence.Calendar{}, nil
 		}
-		return nil, fmt.Errorf("failed to get monthly income setting: %w", err)
+		return nil, fmt.Errorf("failed to get holiday calendar setting: %w", err)
 	}
 
-	var income MonthlyIncome
+	var calendar recurrence.Calendar
 	if setting.SettingValue != nil {
-		if err := json.Unmarshal([]byte(*setting.SettingValue), &income); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal income data: %w", err)
+		if err := json.Unmarshal([]byte(*setting.SettingValue), &calendar); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal holiday calendar: %w", err)
 		}
 	}
 
-	return &income, nil
+	return &calendar, nil
 }
 
-// setMonthlyIncomeInternal sets the family's monthly income
-func (s *Service) setMonthlyIncomeInternal(ctx context.Context, familyID int, income *MonthlyIncome) error {
-	if income == nil {
-		return fmt.Errorf("income cannot be nil")
-	}
-
-	familyDB, err := s.dbManager.GetFamilyDB(familyID)
+// setHolidayCalendarInternal stores the family's holiday calendar
+func (s *Service) setHolidayCalendarInternal(ctx context.Context, familyID int, calendar *recurrence.Calendar) error {
+	calendarJSON, err := json.Marshal(calendar)
 	if err != nil {
-		return fmt.Errorf("failed to get family database: %w", err)
-	}
-
-	familyQueries := familydb.New(familyDB)
-
-	// Calculate total amount from sources
-	var totalAmount float64
-	for _, source := range income.Sources {
-		if source.IsActive {
-			totalAmount += source.Amount
-		}
+		return fmt.Errorf("failed to marshal holiday calendar: %w", err)
 	}
-	income.TotalAmount = totalAmount
-	income.UpdatedAt = time.Now()
+	calendarValue := string(calendarJSON)
 
-	// Serialize income data
-	incomeJSON, err := json.Marshal(income)
-	if err != nil {
-		return fmt.Errorf("failed to marshal income data: %w", err)
-	}
-
-	// Check if setting exists
-	_, err = familyQueries.GetFamilySettingByKey(ctx, "monthly_income")
-	if err != nil {
+	err = s.dbManager.WithFamilyTx(ctx, familyID, func(q *familydb.Queries) error {
+		setting, err := q.GetFamilySettingByKey(ctx, holidayCalendarKey)
 		if err == sql.ErrNoRows {
-			// Create new setting
-			incomeValue := string(incomeJSON)
-			_, err = familyQueries.CreateFamilySetting(ctx, familydb.CreateFamilySettingParams{
-				SettingKey:   "monthly_income",
-				SettingValue: &incomeValue,
+			_, err = q.CreateFamilySetting(ctx, familydb.CreateFamilySettingParams{
+				SettingKey:   holidayCalendarKey,
+				SettingValue: &calendarValue,
 				DataType:     "json",
 			})
-			if err != nil {
-				return fmt.Errorf("failed to create monthly income setting: %w", err)
-			}
-		} else {
-			return fmt.Errorf("failed to check existing monthly income setting: %w", err)
+			return err
 		}
-	} else {
-		// Update existing setting
-		setting, err := familyQueries.GetFamilySettingByKey(ctx, "monthly_income")
 		if err != nil {
-			return fmt.Errorf("failed to get existing setting: %w", err)
+			return err
 		}
 
-		incomeValue := string(incomeJSON)
-		_, err = familyQueries.UpdateFamilySetting(ctx, familydb.UpdateFamilySettingParams{
+		_, err = q.UpdateFamilySetting(ctx, familydb.UpdateFamilySettingParams{
 			ID:           setting.ID,
-			SettingValue: &incomeValue,
+			SettingValue: &calendarValue,
 			DataType:     "json",
 		})
-		if err != nil {
-			return fmt.Errorf("failed to update monthly income setting: %w", err)
-		}
-	}
-
-	s.logger.Info("Monthly income updated successfully", logger.Int64("family_id", int64(familyID)), logger.Str("total_amount", fmt.Sprintf("%.2f", totalAmount)))
-
-	return nil
-}
-
-// addIncomeSourceInternal adds a new income source to the family
-func (s *Service) addIncomeSourceInternal(ctx context.Context, familyID int, source IncomeSource) error {
-	income, err := s.getMonthlyIncomeInternal(ctx, familyID)
-	if err != nil {
-		return fmt.Errorf("failed to get current income: %w", err)
-	}
-
-	income.Sources = append(income.Sources, source)
-
-	return s.setMonthlyIncomeInternal(ctx, familyID, income)
-}
-
-// removeIncomeSourceInternal removes an income source from the family
-func (s *Service) removeIncomeSourceInternal(ctx context.Context, familyID int, sourceName string) error {
-	income, err := s.getMonthlyIncomeInternal(ctx, familyID)
+		return err
+	})
 	if err != nil {
-		return fmt.Errorf("failed to get current income: %w", err)
+		return fmt.Errorf("failed to save holiday calendar: %w", err)
 	}
 
-	// Find and remove the source
-	for i, source := range income.Sources {
-		if source.Name == sourceName {
-			income.Sources = append(income.Sources[:i], income.Sources[i+1:]...)
-			break
-		}
-	}
+	s.notifySettingChange(familyID, holidayCalendarKey)
 
-	return s.setMonthlyIncomeInternal(ctx, familyID, income)
-}
-
-// updateIncomeSourceInternal updates an existing income source
-func (s *Service) updateIncomeSourceInternal(ctx context.Context, familyID int, sourceName string, updatedSource IncomeSource) error {
-	income, err := s.getMonthlyIncomeInternal(ctx, familyID)
-	if err != nil {
-		return fmt.Errorf("failed to get current income: %w", err)
-	}
-
-	// Find and update the source
-	for i, source := range income.Sources {
-		if source.Name == sourceName {
-			income.Sources[i] = updatedSource
-			break
-		}
-	}
+	s.logger.Info("Holiday calendar updated successfully",
+		logger.Int64("family_id", int64(familyID)),
+		logger.Int("holiday_count", len(calendar.Holidays)))
 
-	return s.setMonthlyIncomeInternal(ctx, familyID, income)
+	return nil
 }
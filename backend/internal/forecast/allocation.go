@@ -0,0 +1,147 @@
+package forecast
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"expenses-backend/internal/allocation"
+	"expenses-backend/internal/database/sql/familydb"
+	"expenses-backend/internal/expense"
+	"expenses-backend/internal/family"
+	"expenses-backend/internal/money"
+	"expenses-backend/internal/recurrence"
+	v1 "expenses-backend/pkg/forecast/v1"
+)
+
+// allocationLookbackDays is how far before a month to look for the paycheck
+// that pays its first bills
+const allocationLookbackDays = 31
+
+// monthPaychecks lists the paychecks of the active sources arriving in
+// [first, last], preceded by the last ones to arrive before first
+func monthPaychecks(sources []*familydb.IncomeSource, res *recurrence.Resolver, first, last time.Time) []allocation.Paycheck {
+	var in, before []allocation.Paycheck
+	var latest time.Time
+	for _, src := range sources {
+		if !src.IsActive {
+			continue
+		}
+		for _, d := range family.IncomeSchedule(src).Paydays(res, first.AddDate(0, 0, -allocationLookbackDays), last) {
+			p := allocation.Paycheck{SourceID: src.ID, Scheduled: d.Scheduled, Date: d.Due, Cents: src.NetCents}
+			if !d.Due.Before(first) {
+				in = append(in, p)
+				continue
+			}
+			if d.Due.After(latest) {
+				latest = d.Due
+			}
+			before = append(before, p)
+		}
+	}
+
+	for _, p := range before {
+		if p.Date.Equal(latest) {
+			in = append(in, p)
+		}
+	}
+	return in
+}
+
+// monthBills turns expense occurrences into bills, keyed for looking the
+// occurrences back up
+func monthBills(occurrences []expense.Occurrence) ([]allocation.Bill, map[allocation.BillKey]expense.Occurrence) {
+	bills := make([]allocation.Bill, len(occurrences))
+	byKey := make(map[allocation.BillKey]expense.Occurrence, len(occurrences))
+	for i, o := range occurrences {
+		bills[i] = allocation.Bill{
+			ExpenseID: o.Expense.ID,
+			Scheduled: o.ScheduledDate,
+			Due:       o.DueDate,
+			Cents:     money.FromFloat(o.Expense.Amount),
+		}
+		byKey[bills[i].Key()] = o
+	}
+	return bills, byKey
+}
+
+// saveAllocations stores where each bill of the month went. Bills pinned by
+// hand keep their stored pin, even when it no longer matches a paycheck.
+func saveAllocations(ctx context.Context, q *familydb.Queries, result allocation.Result, stored map[allocation.BillKey]*familydb.PaycheckAllocation, now time.Time) error {
+	for _, a := range result.Paychecks {
+		for _, b := range a.Bills {
+			if s, ok := stored[b.Key()]; ok && s.Pinned {
+				continue
+			}
+			if _, err := q.UpsertPaycheckAllocation(ctx, familydb.UpsertPaycheckAllocationParams{
+				ExpenseID:      b.ExpenseID,
+				ScheduledDate:  b.Scheduled,
+				IncomeSourceID: a.Paycheck.SourceID,
+				PayDate:        a.Paycheck.Scheduled,
+				Pinned:         false,
+				CreatedAt:      now,
+				UpdatedAt:      now,
+			}); err != nil {
+				return fmt.Errorf("failed to save paycheck allocation: %w", err)
+			}
+		}
+	}
+
+	for _, b := range result.Unassigned {
+		if s, ok := stored[b.Key()]; ok && !s.Pinned {
+			if err := q.DeletePaycheckAllocation(ctx, familydb.DeletePaycheckAllocationParams{
+				ExpenseID:     b.ExpenseID,
+				ScheduledDate: b.Scheduled,
+			}); err != nil {
+				return fmt.Errorf("failed to delete paycheck allocation: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+func allocatedBillToProto(b allocation.Bill, pinned bool, o expense.Occurrence) *v1.AllocatedBill {
+	return &v1.AllocatedBill{
+		ExpenseId:     b.ExpenseID,
+		Name:          o.Expense.Name,
+		ScheduledDate: b.Scheduled.Format(recurrence.DateLayout),
+		DueDate:       b.Due.Format(recurrence.DateLayout),
+		Amount:        money.FormatCents(b.Cents),
+		Pinned:        pinned,
+		Paid:          o.Payment != nil && o.Payment.Status == expense.PaymentStatusPaid,
+	}
+}
+
+func allocationToProto(result allocation.Result, sources map[int64]*familydb.IncomeSource, occurrences map[allocation.BillKey]expense.Occurrence) *v1.AllocatePaychecksResponse {
+	resp := &v1.AllocatePaychecksResponse{}
+	for _, a := range result.Paychecks {
+		pb := &v1.PaycheckAllocation{
+			IncomeSourceId: a.Paycheck.SourceID,
+			Name:           sources[a.Paycheck.SourceID].Name,
+			PayDate:        a.Paycheck.Scheduled.Format(recurrence.DateLayout),
+			Date:           a.Paycheck.Date.Format(recurrence.DateLayout),
+			Amount:         money.FormatCents(a.Paycheck.Cents),
+			Total:          money.FormatCents(a.Total),
+			Leftover:       money.FormatCents(a.Leftover),
+		}
+		for _, b := range a.Bills {
+			pb.Bills = append(pb.Bills, allocatedBillToProto(b.Bill, b.Pinned, occurrences[b.Key()]))
+		}
+		resp.Paychecks = append(resp.Paychecks, pb)
+	}
+	for _, b := range result.Unassigned {
+		resp.Unassigned = append(resp.Unassigned, allocatedBillToProto(b, false, occurrences[b.Key()]))
+	}
+	return resp
+}
+
+// isPayday reports whether date is a scheduled pay date of the source
+func isPayday(src *familydb.IncomeSource, res *recurrence.Resolver, date time.Time) bool {
+	// Weekends and holidays move a pay date back by a few days at most
+	for _, d := range family.IncomeSchedule(src).Paydays(res, date.AddDate(0, 0, -7), date) {
+		if d.Scheduled.Equal(date) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,326 @@
+package forecast
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"expenses-backend/internal/allocation"
+	"expenses-backend/internal/cashflow"
+	appcontext "expenses-backend/internal/context"
+	"expenses-backend/internal/database/sql/familydb"
+	"expenses-backend/internal/expense"
+	"expenses-backend/internal/logger"
+	"expenses-backend/internal/money"
+	"expenses-backend/internal/recurrence"
+	v1 "expenses-backend/pkg/forecast/v1"
+
+	"connectrpc.com/connect"
+)
+
+func (s *Service) GetCashFlowForecast(ctx context.Context, req *connect.Request[v1.GetCashFlowForecastRequest]) (*connect.Response[v1.GetCashFlowForecastResponse], error) {
+	authCtx, err := appcontext.RequireFamily(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	days := int(req.Msg.Days)
+	if days == 0 {
+		days = defaultDays
+	}
+	if days < 1 || days > cashflow.MaxDays {
+		return nil, connect.NewError(connect.CodeInvalidArgument, cashflow.ErrInvalidDays)
+	}
+
+	var threshold int64
+	if req.Msg.Threshold != nil {
+		if threshold, err = money.ParseCents(*req.Msg.Threshold); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("threshold must be a decimal amount"))
+		}
+	}
+
+	queries, err := s.dbManager.GetFamilyQueries(int(authCtx.FamilyID))
+	if err != nil {
+		return nil, err
+	}
+
+	accounts, err := queries.GetAccounts(ctx)
+	if err != nil {
+		s.logger.Error("Failed to get accounts", err, logger.Int64("family_id", authCtx.FamilyID))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get cash flow forecast"))
+	}
+	accounts, err = checkingAccounts(accounts, req.Msg.AccountIds)
+	if err != nil {
+		return nil, err
+	}
+
+	from := recurrence.Date(time.Now().UTC())
+	to := from.AddDate(0, 0, days-1)
+
+	start, err := startingBalance(ctx, queries, accounts, from)
+	if err != nil {
+		s.logger.Error("Failed to get starting balance", err, logger.Int64("family_id", authCtx.FamilyID))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get cash flow forecast"))
+	}
+
+	sources, err := s.familyService.IncomeSources(ctx, int(authCtx.FamilyID))
+	if err != nil {
+		s.logger.Error("Failed to get income sources", err, logger.Int64("family_id", authCtx.FamilyID))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get cash flow forecast"))
+	}
+	calendar, err := s.familyService.HolidayCalendar(ctx, int(authCtx.FamilyID))
+	if err != nil {
+		s.logger.Error("Failed to get holiday calendar", err, logger.Int64("family_id", authCtx.FamilyID))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get cash flow forecast"))
+	}
+	events := incomeEvents(sources, recurrence.NewResolver(calendar), from, to)
+
+	occurrences, err := s.expenseService.Occurrences(ctx, authCtx.FamilyID, from, to)
+	if err != nil {
+		s.logger.Error("Failed to get expense occurrences", err, logger.Int64("family_id", authCtx.FamilyID))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get cash flow forecast"))
+	}
+	events = append(events, expenseEvents(occurrences)...)
+
+	series, warnings, err := cashflow.Project(start, from, days, events, threshold)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	resp := &v1.GetCashFlowForecastResponse{
+		StartingBalance: money.FormatCents(start),
+		Threshold:       money.FormatCents(threshold),
+		Days:            make([]*v1.ForecastDay, len(series)),
+	}
+	for i, d := range series {
+		resp.Days[i] = forecastDayToProto(d)
+	}
+	for _, w := range warnings {
+		resp.Warnings = append(resp.Warnings, &v1.ForecastWarning{
+			Date:      w.Date.Format(recurrence.DateLayout),
+			Balance:   money.FormatCents(w.Balance),
+			Shortfall: money.FormatCents(w.Shortfall),
+		})
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// checkingAccounts picks the accounts a forecast starts from: the requested
+// ones, which must be checking accounts, or every linked checking account
+func checkingAccounts(accounts []*familydb.Account, ids []int64) ([]*familydb.Account, error) {
+	if len(ids) == 0 {
+		return slices.DeleteFunc(accounts, func(a *familydb.Account) bool {
+			return a.AccountType != checkingAccountType || a.UnlinkedAt != nil
+		}), nil
+	}
+
+	byID := make(map[int64]*familydb.Account, len(accounts))
+	for _, a := range accounts {
+		byID[a.ID] = a
+	}
+	picked := make([]*familydb.Account, 0, len(ids))
+	for _, id := range ids {
+		a, ok := byID[id]
+		if !ok {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("account %d not found", id))
+		}
+		if a.AccountType != checkingAccountType {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("account %d is not a checking account", id))
+		}
+		if !slices.Contains(picked, a) {
+			picked = append(picked, a)
+		}
+	}
+	return picked, nil
+}
+
+func forecastDayToProto(d cashflow.Day) *v1.ForecastDay {
+	pb := &v1.ForecastDay{
+		Date:     d.Date.Format(recurrence.DateLayout),
+		Income:   money.FormatCents(d.Income),
+		Expenses: money.FormatCents(d.Expenses),
+		Balance:  money.FormatCents(d.Balance),
+	}
+	for _, e := range d.Events {
+		event := &v1.ForecastEvent{
+			Kind:   v1.ForecastEventKind_FORECAST_EVENT_KIND_INCOME,
+			Name:   e.Name,
+			Amount: money.FormatCents(e.Cents),
+		}
+		if e.Cents < 0 {
+			event.Kind = v1.ForecastEventKind_FORECAST_EVENT_KIND_EXPENSE
+			event.Amount = money.FormatCents(-e.Cents)
+		}
+		pb.Events = append(pb.Events, event)
+	}
+	return pb
+}
+
+// Paycheck allocation gRPC endpoints
+
+func (s *Service) AllocatePaychecks(ctx context.Context, req *connect.Request[v1.AllocatePaychecksRequest]) (*connect.Response[v1.AllocatePaychecksResponse], error) {
+	authCtx, err := appcontext.RequireFamily(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	first, last, err := recurrence.ParseMonth(req.Msg.Month)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("month must be YYYY-MM"))
+	}
+
+	sources, err := s.familyService.IncomeSources(ctx, int(authCtx.FamilyID))
+	if err != nil {
+		s.logger.Error("Failed to get income sources", err, logger.Int64("family_id", authCtx.FamilyID))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to allocate paychecks"))
+	}
+	calendar, err := s.familyService.HolidayCalendar(ctx, int(authCtx.FamilyID))
+	if err != nil {
+		s.logger.Error("Failed to get holiday calendar", err, logger.Int64("family_id", authCtx.FamilyID))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to allocate paychecks"))
+	}
+	paychecks := monthPaychecks(sources, recurrence.NewResolver(calendar), first, last)
+
+	occurrences, err := s.expenseService.Occurrences(ctx, authCtx.FamilyID, first, last)
+	if err != nil {
+		s.logger.Error("Failed to get expense occurrences", err, logger.Int64("family_id", authCtx.FamilyID))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to allocate paychecks"))
+	}
+	bills, byKey := monthBills(occurrences)
+
+	var result allocation.Result
+	err = s.dbManager.WithFamilyTx(ctx, int(authCtx.FamilyID), func(q *familydb.Queries) error {
+		// Due dates can move a bill scheduled next to the month into it
+		rows, err := q.ListPaycheckAllocations(ctx, familydb.ListPaycheckAllocationsParams{
+			StartDate: first.AddDate(0, 0, -allocationLookbackDays),
+			EndDate:   last.AddDate(0, 0, allocationLookbackDays),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list paycheck allocations: %w", err)
+		}
+		stored := make(map[allocation.BillKey]*familydb.PaycheckAllocation, len(rows))
+		pins := make(map[allocation.BillKey]allocation.PaycheckKey)
+		for _, row := range rows {
+			key := allocation.BillKey{ExpenseID: row.ExpenseID, Scheduled: row.ScheduledDate}
+			stored[key] = row
+			if row.Pinned {
+				pins[key] = allocation.PaycheckKey{SourceID: row.IncomeSourceID, Scheduled: row.PayDate}
+			}
+		}
+
+		result = allocation.Allocate(paychecks, bills, pins)
+		return saveAllocations(ctx, q, result, stored, time.Now().UTC())
+	})
+	if err != nil {
+		s.logger.Error("Failed to save paycheck allocations", err, logger.Int64("family_id", authCtx.FamilyID))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to allocate paychecks"))
+	}
+
+	sourcesByID := make(map[int64]*familydb.IncomeSource, len(sources))
+	for _, src := range sources {
+		sourcesByID[src.ID] = src
+	}
+	return connect.NewResponse(allocationToProto(result, sourcesByID, byKey)), nil
+}
+
+func (s *Service) PinBill(ctx context.Context, req *connect.Request[v1.PinBillRequest]) (*connect.Response[v1.PinBillResponse], error) {
+	authCtx, err := appcontext.RequireFamily(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	scheduled, err := recurrence.ParseDate(req.Msg.ScheduledDate)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("scheduled_date must be YYYY-MM-DD"))
+	}
+	payDate, err := recurrence.ParseDate(req.Msg.PayDate)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("pay_date must be YYYY-MM-DD"))
+	}
+	calendar, err := s.familyService.HolidayCalendar(ctx, int(authCtx.FamilyID))
+	if err != nil {
+		s.logger.Error("Failed to get holiday calendar", err, logger.Int64("family_id", authCtx.FamilyID))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to pin bill"))
+	}
+	res := recurrence.NewResolver(calendar)
+
+	err = s.dbManager.WithFamilyTx(ctx, int(authCtx.FamilyID), func(q *familydb.Queries) error {
+		exp, err := q.GetExpenseByID(ctx, req.Msg.ExpenseId)
+		if errors.Is(err, sql.ErrNoRows) {
+			return connect.NewError(connect.CodeNotFound, fmt.Errorf("expense %d not found", req.Msg.ExpenseId))
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get expense: %w", err)
+		}
+		if !expense.IsOccurrence(exp, scheduled) {
+			return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%s is not a scheduled date of expense %d", req.Msg.ScheduledDate, exp.ID))
+		}
+
+		src, err := q.GetIncomeSourceByID(ctx, req.Msg.IncomeSourceId)
+		if errors.Is(err, sql.ErrNoRows) {
+			return connect.NewError(connect.CodeNotFound, fmt.Errorf("income source %d not found", req.Msg.IncomeSourceId))
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get income source: %w", err)
+		}
+		if !isPayday(src, res, payDate) {
+			return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%s is not a pay date of income source %d", req.Msg.PayDate, src.ID))
+		}
+
+		now := time.Now().UTC()
+		if _, err := q.UpsertPaycheckAllocation(ctx, familydb.UpsertPaycheckAllocationParams{
+			ExpenseID:      exp.ID,
+			ScheduledDate:  scheduled,
+			IncomeSourceID: src.ID,
+			PayDate:        payDate,
+			Pinned:         true,
+			CreatedAt:      now,
+			UpdatedAt:      now,
+		}); err != nil {
+			return fmt.Errorf("failed to pin bill: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		var connectErr *connect.Error
+		if errors.As(err, &connectErr) {
+			return nil, err
+		}
+		s.logger.Error("Failed to pin bill", err, logger.Int64("family_id", authCtx.FamilyID), logger.Int64("expense_id", req.Msg.ExpenseId))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to pin bill"))
+	}
+
+	return connect.NewResponse(&v1.PinBillResponse{}), nil
+}
+
+func (s *Service) UnpinBill(ctx context.Context, req *connect.Request[v1.UnpinBillRequest]) (*connect.Response[v1.UnpinBillResponse], error) {
+	authCtx, err := appcontext.RequireFamily(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	scheduled, err := recurrence.ParseDate(req.Msg.ScheduledDate)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("scheduled_date must be YYYY-MM-DD"))
+	}
+
+	queries, err := s.dbManager.GetFamilyQueries(int(authCtx.FamilyID))
+	if err != nil {
+		return nil, err
+	}
+
+	// The next allocation assigns the bill by its due date again
+	if err := queries.DeletePaycheckAllocation(ctx, familydb.DeletePaycheckAllocationParams{
+		ExpenseID:     req.Msg.ExpenseId,
+		ScheduledDate: scheduled,
+	}); err != nil {
+		s.logger.Error("Failed to unpin bill", err, logger.Int64("family_id", authCtx.FamilyID), logger.Int64("expense_id", req.Msg.ExpenseId))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to unpin bill"))
+	}
+
+	return connect.NewResponse(&v1.UnpinBillResponse{}), nil
+}
@@ -0,0 +1,92 @@
+package forecast
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"expenses-backend/internal/cashflow"
+	"expenses-backend/internal/database"
+	"expenses-backend/internal/database/sql/familydb"
+	"expenses-backend/internal/expense"
+	"expenses-backend/internal/family"
+	"expenses-backend/internal/logger"
+	"expenses-backend/internal/money"
+	"expenses-backend/internal/recurrence"
+)
+
+// defaultDays is how far ahead a forecast looks when the request doesn't say
+const defaultDays = 30
+
+// checkingAccountType is the accounts.account_type a forecast starts from
+const checkingAccountType = "checking"
+
+// Service plans a family's cash flow from its balances, income and expenses:
+// balance forecasts and which paycheck pays each bill
+type Service struct {
+	dbManager      *database.DatabaseManager
+	familyService  *family.Service
+	expenseService *expense.Service
+	logger         logger.Logger
+}
+
+// NewService creates a new forecast service
+func NewService(dbManager *database.DatabaseManager, familyService *family.Service, expenseService *expense.Service, log logger.Logger) *Service {
+	return &Service{
+		dbManager:      dbManager,
+		familyService:  familyService,
+		expenseService: expenseService,
+		logger:         log.With(logger.Str("component", "forecast-service")),
+	}
+}
+
+// startingBalance sums the latest balance snapshot of each account
+func startingBalance(ctx context.Context, q *familydb.Queries, accounts []*familydb.Account, asOf time.Time) (int64, error) {
+	snapshots, err := q.ListBalanceSnapshots(ctx, asOf)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list balance snapshots: %w", err)
+	}
+
+	// Snapshots come ordered by date, so the last one of an account wins
+	latest := make(map[int64]int64)
+	for _, snap := range snapshots {
+		latest[snap.AccountID] = snap.BalanceCents
+	}
+
+	var total int64
+	for _, a := range accounts {
+		total += latest[a.ID]
+	}
+	return total, nil
+}
+
+// incomeEvents schedules each active income source's net pay on its paydays
+// in [from, to]
+func incomeEvents(sources []*familydb.IncomeSource, res *recurrence.Resolver, from, to time.Time) []cashflow.Event {
+	var events []cashflow.Event
+	for _, src := range sources {
+		if !src.IsActive {
+			continue
+		}
+		for _, d := range family.IncomeSchedule(src).Paydays(res, from, to) {
+			events = append(events, cashflow.Event{Date: d.Due, Name: src.Name, Cents: src.NetCents})
+		}
+	}
+	return events
+}
+
+// expenseEvents schedules the occurrences that are not paid yet on their due dates
+func expenseEvents(occurrences []expense.Occurrence) []cashflow.Event {
+	var events []cashflow.Event
+	for _, o := range occurrences {
+		if o.Payment != nil && o.Payment.Status == expense.PaymentStatusPaid {
+			continue
+		}
+		events = append(events, cashflow.Event{
+			Date:  o.DueDate,
+			Name:  o.Expense.Name,
+			Cents: -money.FromFloat(o.Expense.Amount),
+		})
+	}
+	return events
+}
@@ -0,0 +1,142 @@
+// Package income computes when income sources pay out and how much they
+// bring in per month.
+package income
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	"expenses-backend/internal/recurrence"
+)
+
+// Frequency is how often an income source pays
+type Frequency string
+
+const (
+	Weekly      Frequency = "weekly"
+	Biweekly    Frequency = "biweekly"
+	Semimonthly Frequency = "semimonthly" // Twice a month, half a month apart
+	Monthly     Frequency = "monthly"
+)
+
+var (
+	ErrInvalidFrequency = errors.New("invalid pay frequency")
+	ErrMissingAnchor    = errors.New("anchor pay date is required")
+)
+
+// PaysPerYear returns how many paychecks the frequency brings in a year
+func (f Frequency) PaysPerYear() (int, error) {
+	switch f {
+	case Weekly:
+		return 52, nil
+	case Biweekly:
+		return 26, nil
+	case Semimonthly:
+		return 24, nil
+	case Monthly:
+		return 12, nil
+	default:
+		return 0, fmt.Errorf("%w: %q", ErrInvalidFrequency, f)
+	}
+}
+
+// Schedule is when an income source pays. Weekly and biweekly pay repeats
+// every 7 or 14 days from Anchor; monthly pay falls on Anchor's day of the
+// month. Semimonthly pay falls on Anchor's day and the day half a month from
+// it: the 1st and 16th, the 15th and 30th, and so on, with an anchor on the
+// last day of a month paying on the 15th and the last day.
+type Schedule struct {
+	Frequency Frequency
+	Anchor    time.Time // The first pay date
+}
+
+// Validate checks that the schedule is well formed
+func (s Schedule) Validate() error {
+	if _, err := s.Frequency.PaysPerYear(); err != nil {
+		return err
+	}
+	if s.Anchor.IsZero() {
+		return ErrMissingAnchor
+	}
+	return nil
+}
+
+// rules returns the recurrence rules whose dates together make up the schedule
+func (s Schedule) rules() []recurrence.Rule {
+	anchor := recurrence.Date(s.Anchor)
+	switch s.Frequency {
+	case Weekly:
+		return []recurrence.Rule{{Frequency: recurrence.Weekly, Interval: 1, Anchor: anchor}}
+	case Biweekly:
+		return []recurrence.Rule{{Frequency: recurrence.Biweekly, Interval: 1, Anchor: anchor}}
+	case Monthly:
+		return []recurrence.Rule{{Frequency: recurrence.Monthly, Interval: 1, Anchor: anchor}}
+	case Semimonthly:
+		// Both rules are anchored in the December before, which has every
+		// day, so clamping keeps day 31 on the last day of shorter months.
+		// Paydays drops the dates before the anchor.
+		first, second := semimonthlyDays(anchor)
+		dec := time.Date(anchor.Year()-1, time.December, 1, 0, 0, 0, 0, time.UTC)
+		return []recurrence.Rule{
+			{Frequency: recurrence.Monthly, Interval: 1, Anchor: dec.AddDate(0, 0, first-1)},
+			{Frequency: recurrence.Monthly, Interval: 1, Anchor: dec.AddDate(0, 0, second-1)},
+		}
+	default:
+		return nil
+	}
+}
+
+// semimonthlyDays returns the two days of the month a semimonthly schedule
+// pays on; 31 stands for the last day of the month
+func semimonthlyDays(anchor time.Time) (int, int) {
+	day := anchor.Day()
+	if anchor.AddDate(0, 0, 1).Month() != anchor.Month() {
+		return 15, 31
+	}
+	if day <= 15 {
+		return day, day + 15
+	}
+	return day - 15, day
+}
+
+// Paydays returns the pay dates in [from, to], ordered by date. Pay that is
+// scheduled on a weekend or holiday arrives on the business day before.
+func (s Schedule) Paydays(res *recurrence.Resolver, from, to time.Time) []recurrence.DueDate {
+	anchor := recurrence.Date(s.Anchor)
+
+	var dates []recurrence.DueDate
+	for _, rule := range s.rules() {
+		for _, d := range res.Between(rule, recurrence.AdjustPrevious, from, to) {
+			if !d.Scheduled.Before(anchor) {
+				dates = append(dates, d)
+			}
+		}
+	}
+	slices.SortFunc(dates, func(a, b recurrence.DueDate) int {
+		return a.Scheduled.Compare(b.Scheduled)
+	})
+	return dates
+}
+
+// MonthlyCents converts a per paycheck amount into its average per month,
+// rounded to the nearest cent
+func MonthlyCents(perPay int64, f Frequency) (int64, error) {
+	n, err := f.PaysPerYear()
+	if err != nil {
+		return 0, err
+	}
+	return int64(math.Round(float64(perPay) * float64(n) / 12)), nil
+}
+
+// PerPayCents splits a monthly amount into what each paycheck brings in,
+// rounded to the nearest cent
+func PerPayCents(monthly int64, f Frequency) (int64, error) {
+	n, err := f.PaysPerYear()
+	if err != nil {
+		return 0, err
+	}
+	return int64(math.Round(float64(monthly) * 12 / float64(n))), nil
+}
@@ -0,0 +1,73 @@
+package income
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"expenses-backend/internal/recurrence"
+)
+
+func date(s string) time.Time {
+	d, err := recurrence.ParseDate(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+func TestPaydays(t *testing.T) {
+	res := recurrence.NewResolver(&recurrence.Calendar{Presets: []recurrence.Preset{recurrence.PresetUSBank}})
+
+	tests := []struct {
+		name     string
+		schedule Schedule
+		from, to string
+		want     []string
+	}{
+		{"biweekly", Schedule{Biweekly, date("2025-01-03")}, "2025-01-01", "2025-02-10", []string{"2025-01-03", "2025-01-17", "2025-01-31"}},
+		{"nothing before anchor", Schedule{Weekly, date("2025-03-07")}, "2025-02-20", "2025-03-14", []string{"2025-03-07", "2025-03-14"}},
+		// 2025-03-01 is a Saturday, so that pay arrives on Friday
+		{"monthly on a weekend", Schedule{Monthly, date("2025-01-01")}, "2025-02-15", "2025-03-15", []string{"2025-02-28"}},
+		{"semimonthly early anchor", Schedule{Semimonthly, date("2025-04-01")}, "2025-04-01", "2025-04-30", []string{"2025-04-01", "2025-04-16"}},
+		{"semimonthly late anchor", Schedule{Semimonthly, date("2025-01-30")}, "2025-01-01", "2025-03-31", []string{"2025-01-30", "2025-02-14", "2025-02-28", "2025-03-14", "2025-03-28"}},
+		{"semimonthly month end", Schedule{Semimonthly, date("2025-04-30")}, "2025-04-01", "2025-06-30", []string{"2025-04-30", "2025-05-15", "2025-05-30", "2025-06-13", "2025-06-30"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, d := range tt.schedule.Paydays(res, date(tt.from), date(tt.to)) {
+				got = append(got, d.Due.Format(recurrence.DateLayout))
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Paydays = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMonthlyCents(t *testing.T) {
+	tests := []struct {
+		perPay int64
+		freq   Frequency
+		want   int64
+	}{
+		{100000, Weekly, 433333},
+		{200000, Biweekly, 433333},
+		{200000, Semimonthly, 400000},
+		{400000, Monthly, 400000},
+	}
+	for _, tt := range tests {
+		got, err := MonthlyCents(tt.perPay, tt.freq)
+		if err != nil || got != tt.want {
+			t.Errorf("MonthlyCents(%d, %s) = %d, %v; want %d", tt.perPay, tt.freq, got, err, tt.want)
+		}
+		if back, _ := PerPayCents(got, tt.freq); back != tt.perPay {
+			t.Errorf("PerPayCents(%d, %s) = %d, want %d", got, tt.freq, back, tt.perPay)
+		}
+	}
+	if _, err := MonthlyCents(100, "annual"); err == nil {
+		t.Error("MonthlyCents(annual) succeeded")
+	}
+}
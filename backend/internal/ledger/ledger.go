@@ -0,0 +1,269 @@
+// Package ledger works out what family members owe each other for shared
+// costs one of them paid, and the fewest payments that settle up.
+package ledger
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"maps"
+	"math/bits"
+	"slices"
+)
+
+// Method is how a shared cost is divided
+type Method string
+
+const (
+	MethodEqual      Method = "equal"
+	MethodPercentage Method = "percentage" // Share values are basis points summing to 10000
+	MethodFixed      Method = "fixed"      // Share values are cents summing to the cost
+)
+
+// maxExactMembers bounds the members with a balance that Settle minimizes
+// payments for exactly; larger groups fall back to a greedy plan
+const maxExactMembers = 16
+
+var ErrNoShares = errors.New("a shared cost needs at least one member share")
+
+// Share is one member's part of a cost
+type Share struct {
+	MemberID int64
+	Value    int64 // Basis points or cents depending on the method; unused for equal splits
+}
+
+// Cost is a cost one member paid on behalf of others
+type Cost struct {
+	PaidBy int64
+	Cents  int64
+	Method Method
+	Shares []Share
+}
+
+// Payment is money one member gives another
+type Payment struct {
+	From  int64
+	To    int64
+	Cents int64
+}
+
+// Validate checks that the shares fit the method and the cost
+func (c Cost) Validate() error {
+	if len(c.Shares) == 0 {
+		return ErrNoShares
+	}
+	seen := make(map[int64]bool, len(c.Shares))
+	var total int64
+	for _, s := range c.Shares {
+		if seen[s.MemberID] {
+			return fmt.Errorf("member %d has more than one share", s.MemberID)
+		}
+		seen[s.MemberID] = true
+		total += s.Value
+	}
+
+	switch c.Method {
+	case MethodEqual:
+	case MethodPercentage:
+		for _, s := range c.Shares {
+			if s.Value <= 0 {
+				return errors.New("percentages must be positive")
+			}
+		}
+		if total != 10000 {
+			return errors.New("percentages must add up to 100")
+		}
+	case MethodFixed:
+		if total != c.Cents {
+			return errors.New("fixed shares must add up to the cost")
+		}
+	default:
+		return fmt.Errorf("invalid split method %q", c.Method)
+	}
+	return nil
+}
+
+// Owed divides the cost between the members sharing it. Cents that don't
+// divide evenly go to the members with the largest remainders, then to the
+// earlier shares. Fixed shares that no longer add up to the cost, because the
+// cost changed after they were recorded, leave the difference to the payer.
+func (c Cost) Owed() map[int64]int64 {
+	owed := make(map[int64]int64, len(c.Shares)+1)
+	switch c.Method {
+	case MethodFixed:
+		var total int64
+		for _, s := range c.Shares {
+			owed[s.MemberID] += s.Value
+			total += s.Value
+		}
+		if total != c.Cents {
+			owed[c.PaidBy] += c.Cents - total
+		}
+	default:
+		weights := make([]int64, len(c.Shares))
+		for i, s := range c.Shares {
+			weights[i] = 1
+			if c.Method == MethodPercentage {
+				weights[i] = s.Value
+			}
+		}
+		for i, cents := range allocate(c.Cents, weights) {
+			owed[c.Shares[i].MemberID] += cents
+		}
+	}
+	return owed
+}
+
+// allocate divides cents in proportion to the weights using the largest
+// remainder method, so the parts always add up to cents
+func allocate(cents int64, weights []int64) []int64 {
+	var total int64
+	for _, w := range weights {
+		total += w
+	}
+	parts := make([]int64, len(weights))
+	if total == 0 {
+		return parts
+	}
+
+	sign := int64(1)
+	if cents < 0 {
+		sign, cents = -1, -cents
+	}
+	remainders := make([]int64, len(weights))
+	left := cents
+	for i, w := range weights {
+		parts[i] = cents * w / total
+		remainders[i] = cents * w % total
+		left -= parts[i]
+	}
+
+	order := make([]int, len(weights))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		return cmp.Compare(remainders[b], remainders[a])
+	})
+	for _, i := range order[:left] {
+		parts[i]++
+	}
+
+	for i := range parts {
+		parts[i] *= sign
+	}
+	return parts
+}
+
+// Balances nets the costs and the payments already made between members.
+// A positive balance is owed to the member; a negative one the member owes.
+// Members whose balance is zero are left out.
+func Balances(costs []Cost, payments []Payment) map[int64]int64 {
+	balances := make(map[int64]int64)
+	for _, c := range costs {
+		balances[c.PaidBy] += c.Cents
+		for member, cents := range c.Owed() {
+			balances[member] -= cents
+		}
+	}
+	for _, p := range payments {
+		balances[p.From] += p.Cents
+		balances[p.To] -= p.Cents
+	}
+	maps.DeleteFunc(balances, func(_ int64, cents int64) bool { return cents == 0 })
+	return balances
+}
+
+// Settle plans the fewest payments that bring every balance to zero. The
+// balances must add up to zero. Members are split into as many groups with
+// zero total as possible, since a group of n settles in n-1 payments; beyond
+// maxExactMembers members the whole set is settled as one group.
+func Settle(balances map[int64]int64) []Payment {
+	members := slices.Sorted(maps.Keys(balances))
+	members = slices.DeleteFunc(members, func(m int64) bool { return balances[m] == 0 })
+	if len(members) == 0 {
+		return nil
+	}
+	if len(members) > maxExactMembers {
+		return settleGroup(members, balances)
+	}
+
+	n := len(members)
+	full := 1<<n - 1
+	sums := make([]int64, full+1)
+	groups := make([]int, full+1) // Most zero-sum groups the members of the mask split into
+	for mask := 1; mask <= full; mask++ {
+		low := mask & -mask
+		sums[mask] = sums[mask^low] + balances[members[bits.TrailingZeros(uint(low))]]
+		for j := 0; j < n; j++ {
+			if bit := 1 << j; mask&bit != 0 {
+				groups[mask] = max(groups[mask], groups[mask^bit])
+			}
+		}
+		if sums[mask] == 0 {
+			groups[mask]++
+		}
+	}
+
+	// Walk down from the full set along masks keeping the best group count;
+	// consecutive zero-sum masks on the way differ by one group
+	var payments []Payment
+	mask, boundary := full, full
+	for mask != 0 {
+		zero := 0
+		if sums[mask] == 0 {
+			zero = 1
+		}
+		for j := 0; j < n; j++ {
+			bit := 1 << j
+			if mask&bit != 0 && groups[mask^bit] == groups[mask]-zero {
+				mask ^= bit
+				break
+			}
+		}
+		if sums[mask] == 0 {
+			payments = append(payments, settleGroup(membersOf(members, boundary^mask), balances)...)
+			boundary = mask
+		}
+	}
+	return payments
+}
+
+// settleGroup has the largest debtor pay the largest creditor until the
+// group, whose balances add up to zero, is settled
+func settleGroup(members []int64, balances map[int64]int64) []Payment {
+	left := make(map[int64]int64, len(members))
+	for _, m := range members {
+		left[m] = balances[m]
+	}
+
+	var payments []Payment
+	for {
+		var debtor, creditor int64
+		var owes, owed int64
+		for _, m := range members {
+			if b := left[m]; b < 0 && -b > owes {
+				debtor, owes = m, -b
+			} else if b > 0 && b > owed {
+				creditor, owed = m, b
+			}
+		}
+		if owes == 0 || owed == 0 {
+			return payments
+		}
+		cents := min(owes, owed)
+		payments = append(payments, Payment{From: debtor, To: creditor, Cents: cents})
+		left[debtor] += cents
+		left[creditor] -= cents
+	}
+}
+
+func membersOf(members []int64, mask int) []int64 {
+	var out []int64
+	for i, m := range members {
+		if mask&(1<<i) != 0 {
+			out = append(out, m)
+		}
+	}
+	return out
+}
@@ -0,0 +1,132 @@
+package ledger
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestOwed(t *testing.T) {
+	tests := []struct {
+		name string
+		cost Cost
+		want map[int64]int64
+	}{
+		{
+			name: "equal with leftover cents",
+			cost: Cost{PaidBy: 1, Cents: 1000, Method: MethodEqual, Shares: []Share{{MemberID: 1}, {MemberID: 2}, {MemberID: 3}}},
+			want: map[int64]int64{1: 334, 2: 333, 3: 333},
+		},
+		{
+			name: "percentage",
+			cost: Cost{PaidBy: 1, Cents: 999, Method: MethodPercentage, Shares: []Share{{MemberID: 1, Value: 2500}, {MemberID: 2, Value: 7500}}},
+			want: map[int64]int64{1: 250, 2: 749},
+		},
+		{
+			name: "negative cost",
+			cost: Cost{PaidBy: 1, Cents: -1000, Method: MethodEqual, Shares: []Share{{MemberID: 1}, {MemberID: 2}, {MemberID: 3}}},
+			want: map[int64]int64{1: -334, 2: -333, 3: -333},
+		},
+		{
+			name: "fixed",
+			cost: Cost{PaidBy: 1, Cents: 5000, Method: MethodFixed, Shares: []Share{{MemberID: 2, Value: 3000}, {MemberID: 3, Value: 2000}}},
+			want: map[int64]int64{2: 3000, 3: 2000},
+		},
+		{
+			name: "fixed after the cost changed",
+			cost: Cost{PaidBy: 1, Cents: 5600, Method: MethodFixed, Shares: []Share{{MemberID: 2, Value: 3000}, {MemberID: 3, Value: 2000}}},
+			want: map[int64]int64{1: 600, 2: 3000, 3: 2000},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.cost.Owed(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Owed = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name  string
+		cost  Cost
+		valid bool
+	}{
+		{"equal", Cost{Cents: 100, Method: MethodEqual, Shares: []Share{{MemberID: 1}, {MemberID: 2}}}, true},
+		{"no shares", Cost{Cents: 100, Method: MethodEqual}, false},
+		{"duplicate member", Cost{Cents: 100, Method: MethodEqual, Shares: []Share{{MemberID: 1}, {MemberID: 1}}}, false},
+		{"percentages short of 100", Cost{Cents: 100, Method: MethodPercentage, Shares: []Share{{MemberID: 1, Value: 5000}, {MemberID: 2, Value: 4000}}}, false},
+		{"zero percentage", Cost{Cents: 100, Method: MethodPercentage, Shares: []Share{{MemberID: 1, Value: 10000}, {MemberID: 2}}}, false},
+		{"fixed adds up", Cost{Cents: 100, Method: MethodFixed, Shares: []Share{{MemberID: 1, Value: 60}, {MemberID: 2, Value: 40}}}, true},
+		{"fixed doesn't add up", Cost{Cents: 100, Method: MethodFixed, Shares: []Share{{MemberID: 1, Value: 60}}}, false},
+		{"bad method", Cost{Cents: 100, Method: "thirds", Shares: []Share{{MemberID: 1}}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.cost.Validate(); (err == nil) != tt.valid {
+				t.Errorf("Validate = %v, want valid %v", err, tt.valid)
+			}
+		})
+	}
+}
+
+func TestBalances(t *testing.T) {
+	costs := []Cost{
+		// 1 paid 90.00 of groceries for everyone
+		{PaidBy: 1, Cents: 9000, Method: MethodEqual, Shares: []Share{{MemberID: 1}, {MemberID: 2}, {MemberID: 3}}},
+		// 2 paid 30.00 of takeout for 2 and 3
+		{PaidBy: 2, Cents: 3000, Method: MethodEqual, Shares: []Share{{MemberID: 2}, {MemberID: 3}}},
+	}
+	payments := []Payment{{From: 3, To: 1, Cents: 3000}}
+
+	got := Balances(costs, payments)
+	want := map[int64]int64{1: 3000, 2: -1500, 3: -1500}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Balances = %v, want %v", got, want)
+	}
+
+	if got := Balances(costs, append(payments, Payment{From: 2, To: 1, Cents: 1500}, Payment{From: 3, To: 1, Cents: 1500})); len(got) != 0 {
+		t.Errorf("settled Balances = %v, want none", got)
+	}
+}
+
+func TestSettle(t *testing.T) {
+	tests := []struct {
+		name     string
+		balances map[int64]int64
+		want     int // Payments needed
+	}{
+		{"nothing owed", map[int64]int64{}, 0},
+		{"one debt", map[int64]int64{1: 500, 2: -500}, 1},
+		{"one creditor", map[int64]int64{1: 900, 2: -300, 3: -600}, 2},
+		// Greedy pays 5 -> 1 first and needs four payments; pairing 1 with 4
+		// and 2, 3 with 5 needs three
+		{"independent groups", map[int64]int64{1: 600, 2: 500, 3: 400, 4: -600, 5: -900}, 3},
+		{"two pairs", map[int64]int64{1: 100, 2: -100, 3: 700, 4: -700}, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			payments := Settle(tt.balances)
+			if len(payments) != tt.want {
+				t.Errorf("Settle = %v, want %d payments", payments, tt.want)
+			}
+
+			left := make(map[int64]int64)
+			for m, b := range tt.balances {
+				left[m] = b
+			}
+			for _, p := range payments {
+				if p.Cents <= 0 || p.From == p.To {
+					t.Errorf("invalid payment %+v", p)
+				}
+				left[p.From] += p.Cents
+				left[p.To] -= p.Cents
+			}
+			for m, b := range left {
+				if b != 0 {
+					t.Errorf("member %d left with %d", m, b)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,194 @@
+// Package matcher scores how likely a bank transaction is the payment of an
+// expected bill occurrence.
+package matcher
+
+import (
+	"math"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Transaction is the part of a bank transaction the matcher looks at
+type Transaction struct {
+	Payee       string
+	Description string
+	AmountCents int64 // Negative for money leaving the account
+	PostedDate  time.Time
+}
+
+// Candidate is an expected bill occurrence a transaction may pay
+type Candidate struct {
+	Name        string
+	AmountCents int64
+	DueDate     time.Time
+}
+
+// Config controls how far a transaction can deviate from a candidate and
+// how confident a match must be to act on it
+type Config struct {
+	AmountTolerance float64 // Fraction of the expected amount, e.g. 0.1
+	MinTolerance    int64   // Absolute tolerance in cents for small bills
+	DaysEarly       int     // How long before the due date a payment may post
+	DaysLate        int     // How long after the due date a payment may post
+	AutoThreshold   float64 // Score at or above which a match is applied
+	ReviewThreshold float64 // Score at or above which a match is queued for review
+}
+
+// DefaultConfig is tuned for monthly bills paid by card or ACH
+var DefaultConfig = Config{
+	AmountTolerance: 0.10,
+	MinTolerance:    200,
+	DaysEarly:       10,
+	DaysLate:        5,
+	AutoThreshold:   0.85,
+	ReviewThreshold: 0.5,
+}
+
+// Score weights; the name matters most because amounts of fixed bills often
+// coincide
+const (
+	nameWeight   = 0.5
+	amountWeight = 0.3
+	dateWeight   = 0.2
+)
+
+// Score rates a transaction against a candidate from 0 to 1. It returns 0
+// when the amount or date falls outside the configured limits.
+func (c Config) Score(txn Transaction, cand Candidate) float64 {
+	expected := abs(cand.AmountCents)
+	tolerance := max(int64(float64(expected)*c.AmountTolerance), c.MinTolerance)
+	diff := abs(abs(txn.AmountCents) - expected)
+	if diff > tolerance {
+		return 0
+	}
+
+	days := int(txn.PostedDate.Sub(cand.DueDate).Hours() / 24)
+	window := c.DaysLate
+	if days < 0 {
+		window = c.DaysEarly
+	}
+	if abs(int64(days)) > int64(window) {
+		return 0
+	}
+
+	amountScore := 1.0
+	if tolerance > 0 {
+		amountScore = 1 - float64(diff)/float64(tolerance)
+	}
+	dateScore := 1.0
+	if window > 0 {
+		dateScore = 1 - math.Abs(float64(days))/float64(window+1)
+	}
+
+	name := Similarity(cand.Name, txn.Payee+" "+txn.Description)
+	return nameWeight*name + amountWeight*amountScore + dateWeight*dateScore
+}
+
+// Match is the best candidate for a transaction
+type Match struct {
+	Index int // Position in the candidate slice
+	Score float64
+}
+
+// Best returns the highest scoring candidate at or above the review
+// threshold. Ties go to the candidate due closest to the posted date.
+func (c Config) Best(txn Transaction, candidates []Candidate) (Match, bool) {
+	best := Match{Index: -1}
+	for i, cand := range candidates {
+		score := c.Score(txn, cand)
+		if score < c.ReviewThreshold {
+			continue
+		}
+		if score > best.Score || (score == best.Score && best.Index >= 0 &&
+			distance(txn.PostedDate, cand.DueDate) < distance(txn.PostedDate, candidates[best.Index].DueDate)) {
+			best = Match{Index: i, Score: score}
+		}
+	}
+	return best, best.Index >= 0
+}
+
+// noiseWords show up in bank descriptions of almost any bill payment
+var noiseWords = map[string]bool{
+	"ach": true, "autopay": true, "auto": true, "bill": true, "card": true, "debit": true,
+	"online": true, "payment": true, "pmt": true, "pos": true, "purchase": true,
+	"recurring": true, "web": true, "www": true, "com": true, "inc": true, "llc": true,
+}
+
+// Similarity compares an expense name to transaction text from 0 to 1. A name
+// whose words all appear in the text scores 1; otherwise character bigram
+// overlap is used so abbreviations still score partially.
+func Similarity(name, text string) float64 {
+	nameTokens := tokens(name)
+	textTokens := tokens(text)
+	if len(nameTokens) == 0 || len(textTokens) == 0 {
+		return 0
+	}
+
+	inText := make(map[string]bool, len(textTokens))
+	for _, t := range textTokens {
+		inText[t] = true
+	}
+	found := 0
+	for _, t := range nameTokens {
+		if inText[t] {
+			found++
+		}
+	}
+	if found == len(nameTokens) {
+		return 1
+	}
+
+	return max(float64(found)/float64(len(nameTokens)), dice(strings.Join(nameTokens, ""), strings.Join(textTokens, "")))
+}
+
+// tokens lowercases s and splits it into words, dropping numbers and noise
+func tokens(s string) []string {
+	fields := strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	var out []string
+	for _, f := range fields {
+		if noiseWords[f] || strings.IndexFunc(f, unicode.IsLetter) < 0 {
+			continue
+		}
+		out = append(out, f)
+	}
+	return out
+}
+
+// dice is the Sørensen–Dice coefficient of the character bigrams of a and b
+func dice(a, b string) float64 {
+	if len(a) < 2 || len(b) < 2 {
+		return 0
+	}
+
+	bigrams := make(map[string]int)
+	for i := 0; i+2 <= len(a); i++ {
+		bigrams[a[i:i+2]]++
+	}
+	shared := 0
+	for i := 0; i+2 <= len(b); i++ {
+		if bigrams[b[i:i+2]] > 0 {
+			bigrams[b[i:i+2]]--
+			shared++
+		}
+	}
+	return 2 * float64(shared) / float64(len(a)-1+len(b)-1)
+}
+
+func distance(a, b time.Time) time.Duration {
+	d := a.Sub(b)
+	if d < 0 {
+		return -d
+	}
+	return d
+}
+
+func abs(v int64) int64 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
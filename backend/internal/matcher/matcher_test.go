@@ -0,0 +1,74 @@
+package matcher
+
+import (
+	"testing"
+	"time"
+)
+
+func day(s string) time.Time {
+	d, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+func TestSimilarity(t *testing.T) {
+	tests := []struct {
+		name, text string
+		min, max   float64
+	}{
+		{"Electric", "CITY ELECTRIC AUTOPAY 0423", 1, 1},
+		{"Netflix", "NETFLIX.COM 866-579-7172", 1, 1},
+		{"Water Bill", "WEB PMT CITY WATER", 1, 1},
+		{"Comcast Internet", "COMCAST CABLE COMM", 0.5, 0.99},
+		{"Rent", "SAFEWAY #1234", 0, 0.3},
+		{"Rent", "", 0, 0},
+	}
+	for _, tt := range tests {
+		got := Similarity(tt.name, tt.text)
+		if got < tt.min || got > tt.max {
+			t.Errorf("Similarity(%q, %q) = %.2f, want between %.2f and %.2f", tt.name, tt.text, got, tt.min, tt.max)
+		}
+	}
+}
+
+func TestScore(t *testing.T) {
+	c := DefaultConfig
+	cand := Candidate{Name: "Electric", AmountCents: 12000, DueDate: day("2025-03-15")}
+
+	exact := c.Score(Transaction{Payee: "City Electric", AmountCents: -12000, PostedDate: day("2025-03-15")}, cand)
+	if exact < c.AutoThreshold {
+		t.Errorf("exact match scored %.2f, want at least %.2f", exact, c.AutoThreshold)
+	}
+
+	near := c.Score(Transaction{Description: "ELECTRIC CO", AmountCents: -12500, PostedDate: day("2025-03-12")}, cand)
+	if near >= exact || near < c.ReviewThreshold {
+		t.Errorf("near match scored %.2f, want between %.2f and %.2f", near, c.ReviewThreshold, exact)
+	}
+
+	if s := c.Score(Transaction{Payee: "City Electric", AmountCents: -20000, PostedDate: day("2025-03-15")}, cand); s != 0 {
+		t.Errorf("amount outside tolerance scored %.2f, want 0", s)
+	}
+	if s := c.Score(Transaction{Payee: "City Electric", AmountCents: -12000, PostedDate: day("2025-03-25")}, cand); s != 0 {
+		t.Errorf("date outside window scored %.2f, want 0", s)
+	}
+}
+
+func TestBest(t *testing.T) {
+	c := DefaultConfig
+	candidates := []Candidate{
+		{Name: "Gym", AmountCents: 5000, DueDate: day("2025-03-01")},
+		{Name: "Phone", AmountCents: 5000, DueDate: day("2025-03-03")},
+		{Name: "Phone", AmountCents: 5000, DueDate: day("2025-04-03")},
+	}
+
+	m, ok := c.Best(Transaction{Payee: "VERIZON PHONE", AmountCents: -5000, PostedDate: day("2025-03-03")}, candidates)
+	if !ok || m.Index != 1 {
+		t.Fatalf("Best = %+v, %v, want candidate 1", m, ok)
+	}
+
+	if _, ok := c.Best(Transaction{Payee: "Grocery", AmountCents: -999, PostedDate: day("2025-03-03")}, candidates); ok {
+		t.Error("Best matched an unrelated transaction")
+	}
+}
@@ -0,0 +1,83 @@
+// Package money converts between decimal amount strings and exact integer
+// cents so bank amounts are never rounded through floating point.
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+var ErrInvalidAmount = errors.New("invalid amount")
+
+// ParseCents parses a decimal amount such as "-1234.5" into cents. More than
+// two fractional digits are rejected rather than rounded.
+func ParseCents(s string) (int64, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, fmt.Errorf("%w: empty", ErrInvalidAmount)
+	}
+
+	negative := false
+	switch s[0] {
+	case '-':
+		negative = true
+		s = s[1:]
+	case '+':
+		s = s[1:]
+	}
+
+	whole, frac, _ := strings.Cut(s, ".")
+	if whole == "" && frac == "" || len(frac) > 2 || !digits(whole) || !digits(frac) {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidAmount, s)
+	}
+
+	var cents int64
+	if whole != "" {
+		w, err := strconv.ParseInt(whole, 10, 64)
+		if err != nil || w > math.MaxInt64/100 {
+			return 0, fmt.Errorf("%w: %q", ErrInvalidAmount, s)
+		}
+		cents = w * 100
+	}
+	if frac != "" {
+		f, _ := strconv.ParseInt((frac + "0")[:2], 10, 64)
+		cents += f
+	}
+
+	if negative {
+		cents = -cents
+	}
+	return cents, nil
+}
+
+// FormatCents formats cents as a decimal amount with two fractional digits
+func FormatCents(cents int64) string {
+	sign := ""
+	if cents < 0 {
+		sign = "-"
+		cents = -cents
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, cents/100, cents%100)
+}
+
+// FromFloat converts a dollar amount stored as a float to the nearest cent
+func FromFloat(amount float64) int64 {
+	return int64(math.Round(amount * 100))
+}
+
+// ToFloat converts cents to a dollar amount for APIs that use floats
+func ToFloat(cents int64) float64 {
+	return float64(cents) / 100
+}
+
+func digits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,48 @@
+package money
+
+import "testing"
+
+func TestParseCents(t *testing.T) {
+	tests := []struct {
+		in   string
+		want int64
+	}{
+		{"12.34", 1234},
+		{"-12.34", -1234},
+		{"+5", 500},
+		{"0.5", 50},
+		{".07", 7},
+		{"1000", 100000},
+		{" -0.01 ", -1},
+	}
+	for _, tt := range tests {
+		got, err := ParseCents(tt.in)
+		if err != nil {
+			t.Errorf("ParseCents(%q) error: %v", tt.in, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseCents(%q) = %d, want %d", tt.in, got, tt.want)
+		}
+	}
+
+	for _, in := range []string{"", "-", ".", "1.234", "1,00", "abc", "1e3", "--1"} {
+		if _, err := ParseCents(in); err == nil {
+			t.Errorf("ParseCents(%q) expected error", in)
+		}
+	}
+}
+
+func TestFormatCents(t *testing.T) {
+	tests := map[int64]string{
+		0:      "0.00",
+		7:      "0.07",
+		-1234:  "-12.34",
+		100000: "1000.00",
+	}
+	for in, want := range tests {
+		if got := FormatCents(in); got != want {
+			t.Errorf("FormatCents(%d) = %q, want %q", in, got, want)
+		}
+	}
+}
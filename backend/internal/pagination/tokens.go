@@ -0,0 +1,109 @@
+package pagination
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var ErrInvalidToken = errors.New("invalid page token")
+
+// Codec encodes keyset cursors into opaque page tokens. Tokens are signed with
+// HMAC-SHA256 so clients can't forge or edit them, and are bound to a scope
+// (typically the request filters) so a token can't be replayed against a
+// different query.
+type Codec struct {
+	key []byte
+}
+
+type envelope struct {
+	Scope  string          `json:"s,omitempty"`
+	Cursor json.RawMessage `json:"c"`
+}
+
+var encoding = base64.URLEncoding.WithPadding(base64.NoPadding)
+
+// NewCodec creates a token codec using the given secret. If the secret is empty
+// a random key is generated, which means tokens won't survive a restart.
+func NewCodec(secret []byte) *Codec {
+	if len(secret) == 0 {
+		secret = make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			panic(fmt.Sprintf("failed to generate page token key: %v", err))
+		}
+	}
+	return &Codec{key: secret}
+}
+
+// Encode serializes the cursor into a signed page token
+func (c *Codec) Encode(scope string, cursor any) (string, error) {
+	raw, err := json.Marshal(cursor)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal cursor: %w", err)
+	}
+
+	payload, err := json.Marshal(envelope{Scope: scope, Cursor: raw})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal page token: %w", err)
+	}
+
+	return encoding.EncodeToString(payload) + "." + encoding.EncodeToString(c.sign(payload)), nil
+}
+
+// Decode verifies a page token and unmarshals its cursor into dst. Returns
+// ErrInvalidToken if the token was tampered with or issued for another scope.
+func (c *Codec) Decode(token, scope string, dst any) error {
+	payloadPart, sigPart, ok := strings.Cut(token, ".")
+	if !ok {
+		return ErrInvalidToken
+	}
+
+	payload, err := encoding.DecodeString(payloadPart)
+	if err != nil {
+		return ErrInvalidToken
+	}
+	sig, err := encoding.DecodeString(sigPart)
+	if err != nil {
+		return ErrInvalidToken
+	}
+	if !hmac.Equal(sig, c.sign(payload)) {
+		return ErrInvalidToken
+	}
+
+	var env envelope
+	if err := json.Unmarshal(payload, &env); err != nil {
+		return ErrInvalidToken
+	}
+	if env.Scope != scope {
+		return ErrInvalidToken
+	}
+	if err := json.Unmarshal(env.Cursor, dst); err != nil {
+		return ErrInvalidToken
+	}
+
+	return nil
+}
+
+// ScopeValue formats an optional filter for a token scope: "-" when unset,
+// dates as YYYY-MM-DD and anything else with fmt
+func ScopeValue[T any](v *T) string {
+	if v == nil {
+		return "-"
+	}
+	if t, ok := any(*v).(time.Time); ok {
+		return t.Format(time.DateOnly)
+	}
+	return fmt.Sprint(*v)
+}
+
+func (c *Codec) sign(payload []byte) []byte {
+	mac := hmac.New(sha256.New, c.key)
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
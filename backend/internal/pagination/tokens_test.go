@@ -0,0 +1,86 @@
+package pagination
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+type testCursor struct {
+	Day int64 `json:"d"`
+	ID  int64 `json:"i"`
+}
+
+func TestCodecRoundTrip(t *testing.T) {
+	codec := NewCodec([]byte("test-secret"))
+
+	token, err := codec.Encode("scope", testCursor{Day: 15, ID: 42})
+	if err != nil {
+		t.Fatalf("Failed to encode token: %v", err)
+	}
+
+	var got testCursor
+	if err := codec.Decode(token, "scope", &got); err != nil {
+		t.Fatalf("Failed to decode token: %v", err)
+	}
+
+	if got.Day != 15 || got.ID != 42 {
+		t.Errorf("Decoded cursor = %+v, want {Day:15 ID:42}", got)
+	}
+}
+
+func TestCodecRejectsInvalidTokens(t *testing.T) {
+	codec := NewCodec([]byte("test-secret"))
+
+	token, err := codec.Encode("scope", testCursor{Day: 1, ID: 1})
+	if err != nil {
+		t.Fatalf("Failed to encode token: %v", err)
+	}
+
+	payload, sig, _ := strings.Cut(token, ".")
+	forged, err := NewCodec([]byte("other-secret")).Encode("scope", testCursor{Day: 1, ID: 1})
+	if err != nil {
+		t.Fatalf("Failed to encode token: %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		token string
+		scope string
+	}{
+		{"Empty", "", "scope"},
+		{"Missing signature", payload, "scope"},
+		{"Tampered payload", "x" + payload + "." + sig, "scope"},
+		{"Wrong key", forged, "scope"},
+		{"Wrong scope", token, "other"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got testCursor
+			if err := codec.Decode(tt.token, tt.scope, &got); !errors.Is(err, ErrInvalidToken) {
+				t.Errorf("Expected ErrInvalidToken, got %v", err)
+			}
+		})
+	}
+}
+
+func TestScopeValue(t *testing.T) {
+	day := int64(15)
+	date := time.Date(2025, 3, 9, 0, 0, 0, 0, time.UTC)
+	autopay := false
+
+	if got := ScopeValue[int64](nil); got != "-" {
+		t.Errorf("ScopeValue(nil) = %q, want %q", got, "-")
+	}
+	if got := ScopeValue(&day); got != "15" {
+		t.Errorf("ScopeValue(15) = %q, want %q", got, "15")
+	}
+	if got := ScopeValue(&date); got != "2025-03-09" {
+		t.Errorf("ScopeValue(date) = %q, want %q", got, "2025-03-09")
+	}
+	if got := ScopeValue(&autopay); got != "false" {
+		t.Errorf("ScopeValue(false) = %q, want %q", got, "false")
+	}
+}
@@ -0,0 +1,238 @@
+package recurrence
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Adjustment is how a due date that is not a business day gets moved
+type Adjustment string
+
+const (
+	AdjustNone     Adjustment = "none"     // Keep the scheduled date
+	AdjustPrevious Adjustment = "previous" // Move back to the previous business day
+	AdjustNext     Adjustment = "next"     // Move forward to the next business day
+)
+
+// Preset is a built-in set of holidays a calendar can include
+type Preset string
+
+const (
+	// PresetUSBank is the Federal Reserve holiday schedule. Holidays on a
+	// Sunday are observed the following Monday; holidays on a Saturday are
+	// not moved, matching when US banks are closed.
+	PresetUSBank Preset = "us_bank"
+)
+
+var (
+	ErrInvalidAdjustment = errors.New("invalid business day adjustment")
+	ErrInvalidPreset     = errors.New("invalid holiday preset")
+)
+
+// maxShiftDays bounds how far an adjustment can move a date, so a calendar
+// that marks every day as a holiday cannot loop forever
+const maxShiftDays = 31
+
+// Holiday is a single non-business day
+type Holiday struct {
+	Date string `json:"date"` // YYYY-MM-DD
+	Name string `json:"name,omitempty"`
+}
+
+// Calendar is a family's holiday configuration. Saturdays and Sundays are
+// never business days.
+type Calendar struct {
+	Presets  []Preset  `json:"presets,omitempty"`
+	Holidays []Holiday `json:"holidays,omitempty"`
+}
+
+// ParseAdjustment validates a stored adjustment, treating "" as AdjustNone
+func ParseAdjustment(s string) (Adjustment, error) {
+	switch a := Adjustment(s); a {
+	case "", AdjustNone:
+		return AdjustNone, nil
+	case AdjustPrevious, AdjustNext:
+		return a, nil
+	default:
+		return "", fmt.Errorf("%w: %q", ErrInvalidAdjustment, s)
+	}
+}
+
+// Validate checks that every preset is known and every holiday date parses
+func (c *Calendar) Validate() error {
+	for _, p := range c.Presets {
+		if p != PresetUSBank {
+			return fmt.Errorf("%w: %q", ErrInvalidPreset, p)
+		}
+	}
+	for _, h := range c.Holidays {
+		if _, err := ParseDate(h.Date); err != nil {
+			return fmt.Errorf("invalid holiday date %q: must be YYYY-MM-DD", h.Date)
+		}
+	}
+	return nil
+}
+
+// HolidaysIn returns the holidays observed in year, from presets and custom
+// dates, ordered by date
+func (c *Calendar) HolidaysIn(year int) []Holiday {
+	var holidays []Holiday
+	if c == nil {
+		return holidays
+	}
+
+	for _, p := range c.Presets {
+		if p == PresetUSBank {
+			holidays = append(holidays, usBankHolidays(year)...)
+		}
+	}
+	for _, h := range c.Holidays {
+		if d, err := ParseDate(h.Date); err == nil && d.Year() == year {
+			holidays = append(holidays, h)
+		}
+	}
+
+	// YYYY-MM-DD dates sort lexically
+	slices.SortStableFunc(holidays, func(a, b Holiday) int {
+		return strings.Compare(a.Date, b.Date)
+	})
+	return holidays
+}
+
+// Resolver turns scheduled due dates into concrete ones using a calendar.
+// It caches holidays per year and is not safe for concurrent use.
+type Resolver struct {
+	calendar *Calendar
+	holidays map[time.Time]bool
+	years    map[int]bool
+}
+
+// NewResolver creates a resolver for the calendar; a nil calendar only
+// treats weekends as non-business days
+func NewResolver(calendar *Calendar) *Resolver {
+	return &Resolver{
+		calendar: calendar,
+		holidays: make(map[time.Time]bool),
+		years:    make(map[int]bool),
+	}
+}
+
+// IsBusinessDay reports whether d is neither a weekend nor a holiday
+func (r *Resolver) IsBusinessDay(d time.Time) bool {
+	d = Date(d)
+	if wd := d.Weekday(); wd == time.Saturday || wd == time.Sunday {
+		return false
+	}
+
+	if !r.years[d.Year()] {
+		for _, h := range r.calendar.HolidaysIn(d.Year()) {
+			if hd, err := ParseDate(h.Date); err == nil {
+				r.holidays[hd] = true
+			}
+		}
+		r.years[d.Year()] = true
+	}
+	return !r.holidays[d]
+}
+
+// Resolve applies the adjustment to a scheduled date
+func (r *Resolver) Resolve(d time.Time, adj Adjustment) time.Time {
+	d = Date(d)
+
+	step := 0
+	switch adj {
+	case AdjustPrevious:
+		step = -1
+	case AdjustNext:
+		step = 1
+	default:
+		return d
+	}
+
+	for i := 0; i < maxShiftDays && !r.IsBusinessDay(d); i++ {
+		d = d.AddDate(0, 0, step)
+	}
+	return d
+}
+
+// DueDate is a scheduled date together with the date it is actually due
+type DueDate struct {
+	Scheduled time.Time
+	Due       time.Time
+}
+
+// Between returns the due dates of the rule that fall in [from, to] after
+// adjustment. A date scheduled just outside the range can be moved into it.
+func (r *Resolver) Between(rule Rule, adj Adjustment, from, to time.Time) []DueDate {
+	from, to = Date(from), Date(to)
+
+	var dates []DueDate
+	for _, s := range rule.Between(from.AddDate(0, 0, -maxShiftDays), to.AddDate(0, 0, maxShiftDays)) {
+		due := r.Resolve(s, adj)
+		if !due.Before(from) && !due.After(to) {
+			dates = append(dates, DueDate{Scheduled: s, Due: due})
+		}
+	}
+	return dates
+}
+
+// Next returns up to n due dates of the rule on or after from after adjustment
+func (r *Resolver) Next(rule Rule, adj Adjustment, from time.Time, n int) []DueDate {
+	from = Date(from)
+
+	var dates []DueDate
+	if n <= 0 {
+		return dates
+	}
+	rule.iterate(func(s time.Time) bool {
+		if s.Before(from.AddDate(0, 0, -maxShiftDays)) {
+			return true
+		}
+		if due := r.Resolve(s, adj); !due.Before(from) {
+			dates = append(dates, DueDate{Scheduled: s, Due: due})
+		}
+		return len(dates) < n
+	})
+	return dates
+}
+
+// usBankHolidays returns the Federal Reserve holidays for year
+func usBankHolidays(year int) []Holiday {
+	fixed := func(month time.Month, day int, name string) Holiday {
+		d := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+		if d.Weekday() == time.Sunday {
+			d = d.AddDate(0, 0, 1)
+		}
+		return Holiday{Date: d.Format(DateLayout), Name: name}
+	}
+	nth := func(month time.Month, weekday time.Weekday, n int, name string) Holiday {
+		return Holiday{Date: nthWeekday(year, month, weekday, n).Format(DateLayout), Name: name}
+	}
+
+	return []Holiday{
+		fixed(time.January, 1, "New Year's Day"),
+		nth(time.January, time.Monday, 3, "Birthday of Martin Luther King, Jr."),
+		nth(time.February, time.Monday, 3, "Washington's Birthday"),
+		nth(time.May, time.Monday, -1, "Memorial Day"),
+		fixed(time.June, 19, "Juneteenth National Independence Day"),
+		fixed(time.July, 4, "Independence Day"),
+		nth(time.September, time.Monday, 1, "Labor Day"),
+		nth(time.October, time.Monday, 2, "Columbus Day"),
+		fixed(time.November, 11, "Veterans Day"),
+		nth(time.November, time.Thursday, 4, "Thanksgiving Day"),
+		fixed(time.December, 25, "Christmas Day"),
+	}
+}
+
+// nthWeekday returns the nth weekday of the month; n = -1 is the last one
+func nthWeekday(year int, month time.Month, weekday time.Weekday, n int) time.Time {
+	if n < 0 {
+		last := dayInMonth(year, month, 31)
+		return last.AddDate(0, 0, -((int(last.Weekday()) - int(weekday) + 7) % 7))
+	}
+	first := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	return first.AddDate(0, 0, (int(weekday)-int(first.Weekday())+7)%7+7*(n-1))
+}
@@ -0,0 +1,77 @@
+package recurrence
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestUSBankHolidays(t *testing.T) {
+	cal := &Calendar{Presets: []Preset{PresetUSBank}}
+
+	var got []string
+	for _, h := range cal.HolidaysIn(2023) {
+		got = append(got, h.Date)
+	}
+	want := []string{
+		"2023-01-02", // New Year's Day on a Sunday
+		"2023-01-16",
+		"2023-02-20",
+		"2023-05-29",
+		"2023-06-19",
+		"2023-07-04",
+		"2023-09-04",
+		"2023-10-09",
+		"2023-11-11", // Veterans Day on a Saturday is not moved
+		"2023-11-23",
+		"2023-12-25",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("HolidaysIn(2023) = %v, want %v", got, want)
+	}
+}
+
+func TestResolve(t *testing.T) {
+	cal := &Calendar{
+		Presets:  []Preset{PresetUSBank},
+		Holidays: []Holiday{{Date: "2025-12-26", Name: "Office closed"}},
+	}
+
+	tests := []struct {
+		name string
+		date string
+		adj  Adjustment
+		want string
+	}{
+		{"Business day is unchanged", "2025-03-12", AdjustNext, "2025-03-12"},
+		{"No adjustment keeps weekend", "2025-03-15", AdjustNone, "2025-03-15"},
+		{"Saturday to previous Friday", "2025-03-15", AdjustPrevious, "2025-03-14"},
+		{"Sunday to next Monday", "2025-03-16", AdjustNext, "2025-03-17"},
+		{"Skips preset holiday", "2025-09-01", AdjustNext, "2025-09-02"},
+		{"Skips custom holiday and weekend", "2025-12-25", AdjustNext, "2025-12-29"},
+		{"Previous skips into prior month", "2025-06-01", AdjustPrevious, "2025-05-30"},
+	}
+
+	res := NewResolver(cal)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := res.Resolve(date(tt.date), tt.adj).Format(DateLayout); got != tt.want {
+				t.Errorf("Resolve(%s, %s) = %s, want %s", tt.date, tt.adj, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolverBetween(t *testing.T) {
+	rule := Rule{Frequency: Monthly, Interval: 1, Anchor: date("2025-01-01")}
+	res := NewResolver(nil)
+
+	// June 1st 2025 is a Sunday, so moving it back lands in May
+	var got []string
+	for _, d := range res.Between(rule, AdjustPrevious, date("2025-05-01"), date("2025-05-31")) {
+		got = append(got, d.Scheduled.Format(DateLayout)+">"+d.Due.Format(DateLayout))
+	}
+	want := []string{"2025-05-01>2025-05-01", "2025-06-01>2025-05-30"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Between = %v, want %v", got, want)
+	}
+}
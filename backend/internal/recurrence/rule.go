@@ -0,0 +1,169 @@
+package recurrence
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// DateLayout is the wire format for calendar dates
+const DateLayout = "2006-01-02"
+
+// Frequency is the base period of a recurring expense
+type Frequency string
+
+const (
+	Weekly     Frequency = "weekly"
+	Biweekly   Frequency = "biweekly"
+	Monthly    Frequency = "monthly"
+	Quarterly  Frequency = "quarterly"
+	Semiannual Frequency = "semiannual"
+	Annual     Frequency = "annual"
+)
+
+var (
+	ErrInvalidFrequency = errors.New("invalid frequency")
+	ErrInvalidInterval  = errors.New("interval must be at least 1")
+	ErrMissingAnchor    = errors.New("anchor date is required")
+	ErrEndBeforeAnchor  = errors.New("end date must not be before anchor date")
+	ErrInvalidCount     = errors.New("occurrence count must be positive")
+)
+
+// Rule describes when a recurring expense falls due. Day-based frequencies
+// repeat every 7 or 14 days from Anchor; month-based frequencies repeat on
+// Anchor's day of the month.
+type Rule struct {
+	Frequency Frequency
+	Interval  int        // Repeat every N periods
+	Anchor    time.Time  // A due date in the series; the series starts here
+	Until     *time.Time // Optional last possible due date (inclusive)
+	Count     int        // Optional number of payments, 0 means unlimited
+}
+
+// Validate checks that the rule is well formed
+func (r Rule) Validate() error {
+	if _, _, err := r.step(); err != nil {
+		return err
+	}
+	if r.Interval < 1 {
+		return ErrInvalidInterval
+	}
+	if r.Anchor.IsZero() {
+		return ErrMissingAnchor
+	}
+	if r.Until != nil && Date(*r.Until).Before(Date(r.Anchor)) {
+		return ErrEndBeforeAnchor
+	}
+	if r.Count < 0 {
+		return ErrInvalidCount
+	}
+	return nil
+}
+
+// Between returns every due date in the inclusive range [from, to]
+func (r Rule) Between(from, to time.Time) []time.Time {
+	from, to = Date(from), Date(to)
+
+	var dates []time.Time
+	r.iterate(func(d time.Time) bool {
+		if d.After(to) {
+			return false
+		}
+		if !d.Before(from) {
+			dates = append(dates, d)
+		}
+		return true
+	})
+	return dates
+}
+
+// Next returns up to n due dates on or after from
+func (r Rule) Next(from time.Time, n int) []time.Time {
+	from = Date(from)
+
+	var dates []time.Time
+	if n <= 0 {
+		return dates
+	}
+	r.iterate(func(d time.Time) bool {
+		if !d.Before(from) {
+			dates = append(dates, d)
+		}
+		return len(dates) < n
+	})
+	return dates
+}
+
+// iterate walks the series in order until fn returns false or the series ends
+func (r Rule) iterate(fn func(time.Time) bool) {
+	days, months, err := r.step()
+	if err != nil || r.Anchor.IsZero() {
+		return
+	}
+	interval := max(r.Interval, 1)
+	anchor := Date(r.Anchor)
+
+	for k := 0; r.Count == 0 || k < r.Count; k++ {
+		var d time.Time
+		if days > 0 {
+			d = anchor.AddDate(0, 0, k*days*interval)
+		} else {
+			d = dayInMonth(anchor.Year(), anchor.Month()+time.Month(k*months*interval), anchor.Day())
+		}
+
+		if r.Until != nil && d.After(Date(*r.Until)) {
+			return
+		}
+		if !fn(d) {
+			return
+		}
+	}
+}
+
+// step returns the length of one period in days or months
+func (r Rule) step() (days int, months int, err error) {
+	switch r.Frequency {
+	case Weekly:
+		return 7, 0, nil
+	case Biweekly:
+		return 14, 0, nil
+	case Monthly:
+		return 0, 1, nil
+	case Quarterly:
+		return 0, 3, nil
+	case Semiannual:
+		return 0, 6, nil
+	case Annual:
+		return 0, 12, nil
+	default:
+		return 0, 0, fmt.Errorf("%w: %q", ErrInvalidFrequency, r.Frequency)
+	}
+}
+
+// dayInMonth builds the date for day in the given month, clamping to the
+// month's last day so a rule anchored on the 31st still lands in short months
+func dayInMonth(year int, month time.Month, day int) time.Time {
+	first := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	last := first.AddDate(0, 1, -1).Day()
+	return first.AddDate(0, 0, min(day, last)-1)
+}
+
+// Date truncates t to midnight UTC of its calendar day
+func Date(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// ParseDate parses a YYYY-MM-DD date
+func ParseDate(s string) (time.Time, error) {
+	return time.ParseInLocation(DateLayout, s, time.UTC)
+}
+
+// ParseMonth parses a YYYY-MM month and returns its first and last day
+func ParseMonth(s string) (time.Time, time.Time, error) {
+	first, err := time.ParseInLocation("2006-01", s, time.UTC)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	return first, first.AddDate(0, 1, -1), nil
+}
@@ -0,0 +1,142 @@
+package recurrence
+
+import (
+	"testing"
+	"time"
+)
+
+func date(s string) time.Time {
+	d, err := ParseDate(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+func formatDates(dates []time.Time) []string {
+	out := make([]string, len(dates))
+	for i, d := range dates {
+		out[i] = d.Format(DateLayout)
+	}
+	return out
+}
+
+func TestRuleNext(t *testing.T) {
+	until := date("2025-03-01")
+
+	tests := []struct {
+		name string
+		rule Rule
+		from string
+		n    int
+		want []string
+	}{
+		{
+			"Monthly on the 15th",
+			Rule{Frequency: Monthly, Interval: 1, Anchor: date("2025-01-15")},
+			"2025-02-01", 3,
+			[]string{"2025-02-15", "2025-03-15", "2025-04-15"},
+		},
+		{
+			"Monthly on the 31st clamps to month end",
+			Rule{Frequency: Monthly, Interval: 1, Anchor: date("2025-01-31")},
+			"2025-01-01", 4,
+			[]string{"2025-01-31", "2025-02-28", "2025-03-31", "2025-04-30"},
+		},
+		{
+			"Biweekly",
+			Rule{Frequency: Biweekly, Interval: 1, Anchor: date("2025-01-03")},
+			"2025-01-10", 3,
+			[]string{"2025-01-17", "2025-01-31", "2025-02-14"},
+		},
+		{
+			"Every other week via interval",
+			Rule{Frequency: Weekly, Interval: 2, Anchor: date("2025-01-03")},
+			"2025-01-03", 2,
+			[]string{"2025-01-03", "2025-01-17"},
+		},
+		{
+			"Quarterly",
+			Rule{Frequency: Quarterly, Interval: 1, Anchor: date("2024-11-30")},
+			"2025-01-01", 2,
+			[]string{"2025-02-28", "2025-05-30"},
+		},
+		{
+			"Annual on leap day",
+			Rule{Frequency: Annual, Interval: 1, Anchor: date("2024-02-29")},
+			"2024-01-01", 2,
+			[]string{"2024-02-29", "2025-02-28"},
+		},
+		{
+			"Stops at end date",
+			Rule{Frequency: Weekly, Interval: 1, Anchor: date("2025-02-14"), Until: &until},
+			"2025-01-01", 5,
+			[]string{"2025-02-14", "2025-02-21", "2025-02-28"},
+		},
+		{
+			"Stops after occurrence count",
+			Rule{Frequency: Monthly, Interval: 1, Anchor: date("2025-01-05"), Count: 2},
+			"2025-01-01", 5,
+			[]string{"2025-01-05", "2025-02-05"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := formatDates(tt.rule.Next(date(tt.from), tt.n))
+			if len(got) != len(tt.want) {
+				t.Fatalf("Next() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("Next() = %v, want %v", got, tt.want)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestRuleBetween(t *testing.T) {
+	rule := Rule{Frequency: Weekly, Interval: 1, Anchor: date("2025-01-06")}
+
+	got := formatDates(rule.Between(date("2025-02-01"), date("2025-02-28")))
+	want := []string{"2025-02-03", "2025-02-10", "2025-02-17", "2025-02-24"}
+
+	if len(got) != len(want) {
+		t.Fatalf("Between() = %v, want %v", got, want)
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			t.Errorf("Between() = %v, want %v", got, want)
+			break
+		}
+	}
+}
+
+func TestRuleValidate(t *testing.T) {
+	anchor := date("2025-01-10")
+	before := date("2025-01-01")
+
+	tests := []struct {
+		name    string
+		rule    Rule
+		wantErr bool
+	}{
+		{"Valid", Rule{Frequency: Monthly, Interval: 1, Anchor: anchor}, false},
+		{"Unknown frequency", Rule{Frequency: "daily", Interval: 1, Anchor: anchor}, true},
+		{"Zero interval", Rule{Frequency: Monthly, Anchor: anchor}, true},
+		{"Missing anchor", Rule{Frequency: Monthly, Interval: 1}, true},
+		{"End before anchor", Rule{Frequency: Monthly, Interval: 1, Anchor: anchor, Until: &before}, true},
+		{"Negative count", Rule{Frequency: Monthly, Interval: 1, Anchor: anchor, Count: -1}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.rule.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,175 @@
+// Package rules matches transactions against user-defined rules and works
+// out what the rules change.
+package rules
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Field is the transaction text a rule's pattern is matched against
+type Field string
+
+const (
+	FieldPayee       Field = "payee"
+	FieldDescription Field = "description"
+	FieldAny         Field = "any" // Payee or description
+)
+
+// MatchType is how a rule's pattern is matched
+type MatchType string
+
+const (
+	MatchContains MatchType = "contains" // Case-insensitive substring
+	MatchRegex    MatchType = "regex"    // RE2 syntax, case-sensitive unless the pattern says (?i)
+)
+
+var (
+	ErrNoConditions = errors.New("rule needs a pattern, amount range or account")
+	ErrNoActions    = errors.New("rule needs at least one action")
+)
+
+// Conditions select the transactions a rule applies to; every condition
+// that is set must hold
+type Conditions struct {
+	Field     Field
+	Match     MatchType
+	Pattern   string // Empty matches any text
+	MinCents  *int64 // Inclusive, signed like transaction amounts
+	MaxCents  *int64
+	AccountID *int64
+}
+
+// Actions are what a rule does to the transactions it matches
+type Actions struct {
+	CategoryID *int64
+	Payee      *string
+	ExpenseID  *int64
+	Transfer   bool
+}
+
+func (a Actions) empty() bool {
+	return a.CategoryID == nil && a.Payee == nil && a.ExpenseID == nil && !a.Transfer
+}
+
+// Rule is a stored rule
+type Rule struct {
+	ID         int64
+	Conditions Conditions
+	Actions    Actions
+}
+
+// Transaction is the part of a transaction rules look at
+type Transaction struct {
+	AccountID   int64
+	Payee       string // As reported by the bank
+	Description string
+	AmountCents int64
+}
+
+// Compiled is a validated rule ready for matching
+type Compiled struct {
+	Rule
+	pattern string
+	re      *regexp.Regexp
+}
+
+// Compile validates a rule and prepares its pattern
+func Compile(r Rule) (*Compiled, error) {
+	c := r.Conditions
+	switch c.Field {
+	case FieldPayee, FieldDescription, FieldAny:
+	default:
+		return nil, fmt.Errorf("invalid match field %q", c.Field)
+	}
+	if c.Pattern == "" && c.MinCents == nil && c.MaxCents == nil && c.AccountID == nil {
+		return nil, ErrNoConditions
+	}
+	if c.MinCents != nil && c.MaxCents != nil && *c.MinCents > *c.MaxCents {
+		return nil, errors.New("minimum amount must not be greater than maximum amount")
+	}
+	if r.Actions.empty() {
+		return nil, ErrNoActions
+	}
+
+	compiled := &Compiled{Rule: r}
+	switch c.Match {
+	case MatchContains:
+		compiled.pattern = strings.ToLower(c.Pattern)
+	case MatchRegex:
+		re, err := regexp.Compile(c.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern: %w", err)
+		}
+		compiled.re = re
+	default:
+		return nil, fmt.Errorf("invalid match type %q", c.Match)
+	}
+	return compiled, nil
+}
+
+// Matches reports whether the rule applies to the transaction
+func (r *Compiled) Matches(t Transaction) bool {
+	c := r.Conditions
+	if c.AccountID != nil && *c.AccountID != t.AccountID {
+		return false
+	}
+	if c.MinCents != nil && t.AmountCents < *c.MinCents {
+		return false
+	}
+	if c.MaxCents != nil && t.AmountCents > *c.MaxCents {
+		return false
+	}
+	if c.Pattern == "" {
+		return true
+	}
+
+	switch c.Field {
+	case FieldPayee:
+		return r.matchText(t.Payee)
+	case FieldDescription:
+		return r.matchText(t.Description)
+	default:
+		return r.matchText(t.Payee) || r.matchText(t.Description)
+	}
+}
+
+func (r *Compiled) matchText(s string) bool {
+	if r.re != nil {
+		return r.re.MatchString(s)
+	}
+	return strings.Contains(strings.ToLower(s), r.pattern)
+}
+
+// Result is what a set of rules does to a transaction. Each action comes
+// from the first matching rule that sets it.
+type Result struct {
+	Actions
+	Matched []int64 // IDs of the matching rules, in order
+}
+
+// Apply runs the rules over the transaction in order
+func Apply(rules []*Compiled, t Transaction) Result {
+	var res Result
+	for _, r := range rules {
+		if !r.Matches(t) {
+			continue
+		}
+		res.Matched = append(res.Matched, r.ID)
+
+		a := r.Actions
+		if res.CategoryID == nil {
+			res.CategoryID = a.CategoryID
+		}
+		if res.Payee == nil {
+			res.Payee = a.Payee
+		}
+		if res.ExpenseID == nil {
+			res.ExpenseID = a.ExpenseID
+		}
+		res.Transfer = res.Transfer || a.Transfer
+	}
+	return res
+}
@@ -0,0 +1,99 @@
+package rules
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func ptr[T any](v T) *T { return &v }
+
+func compile(t *testing.T, r Rule) *Compiled {
+	t.Helper()
+	c, err := Compile(r)
+	if err != nil {
+		t.Fatalf("Compile(%+v): %v", r, err)
+	}
+	return c
+}
+
+func TestMatches(t *testing.T) {
+	txn := Transaction{AccountID: 1, Payee: "Comcast", Description: "ACH DEBIT COMCAST 8774", AmountCents: -8999}
+
+	tests := []struct {
+		name string
+		cond Conditions
+		want bool
+	}{
+		{"contains ignores case", Conditions{Field: FieldDescription, Match: MatchContains, Pattern: "comcast"}, true},
+		{"contains on payee only", Conditions{Field: FieldPayee, Match: MatchContains, Pattern: "ach debit"}, false},
+		{"any field", Conditions{Field: FieldAny, Match: MatchContains, Pattern: "ach debit"}, true},
+		{"regex", Conditions{Field: FieldDescription, Match: MatchRegex, Pattern: `^ACH DEBIT \w+ \d+$`}, true},
+		{"regex is case-sensitive", Conditions{Field: FieldDescription, Match: MatchRegex, Pattern: `comcast`}, false},
+		{"regex with flag", Conditions{Field: FieldDescription, Match: MatchRegex, Pattern: `(?i)comcast`}, true},
+		{"amount in range", Conditions{Field: FieldAny, Match: MatchContains, MinCents: ptr(int64(-10000)), MaxCents: ptr(int64(-5000))}, true},
+		{"amount bounds are inclusive", Conditions{Field: FieldAny, Match: MatchContains, MinCents: ptr(int64(-8999)), MaxCents: ptr(int64(-8999))}, true},
+		{"amount out of range", Conditions{Field: FieldAny, Match: MatchContains, MinCents: ptr(int64(-5000))}, false},
+		{"other account", Conditions{Field: FieldAny, Match: MatchContains, Pattern: "comcast", AccountID: ptr(int64(2))}, false},
+		{"same account", Conditions{Field: FieldAny, Match: MatchContains, AccountID: ptr(int64(1))}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := compile(t, Rule{Conditions: tt.cond, Actions: Actions{Transfer: true}})
+			if got := r.Matches(txn); got != tt.want {
+				t.Errorf("Matches = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	action := Actions{CategoryID: ptr(int64(1))}
+	tests := []struct {
+		name string
+		rule Rule
+		want error
+	}{
+		{"no conditions", Rule{Conditions: Conditions{Field: FieldAny, Match: MatchContains}, Actions: action}, ErrNoConditions},
+		{"no actions", Rule{Conditions: Conditions{Field: FieldAny, Match: MatchContains, Pattern: "x"}}, ErrNoActions},
+		{"bad regex", Rule{Conditions: Conditions{Field: FieldAny, Match: MatchRegex, Pattern: "("}, Actions: action}, nil},
+		{"bad field", Rule{Conditions: Conditions{Field: "memo", Match: MatchContains, Pattern: "x"}, Actions: action}, nil},
+		{"inverted range", Rule{Conditions: Conditions{Field: FieldAny, Match: MatchContains, MinCents: ptr(int64(5)), MaxCents: ptr(int64(1))}, Actions: action}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Compile(tt.rule)
+			if err == nil {
+				t.Fatal("Compile succeeded")
+			}
+			if tt.want != nil && !errors.Is(err, tt.want) {
+				t.Errorf("err = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestApply(t *testing.T) {
+	contains := func(pattern string) Conditions {
+		return Conditions{Field: FieldAny, Match: MatchContains, Pattern: pattern}
+	}
+	rules := []*Compiled{
+		compile(t, Rule{ID: 1, Conditions: contains("comcast"), Actions: Actions{Payee: ptr("Comcast Internet")}}),
+		compile(t, Rule{ID: 2, Conditions: contains("ach debit"), Actions: Actions{CategoryID: ptr(int64(7)), Payee: ptr("ACH")}}),
+		compile(t, Rule{ID: 3, Conditions: contains("8774"), Actions: Actions{CategoryID: ptr(int64(9)), ExpenseID: ptr(int64(4))}}),
+		compile(t, Rule{ID: 4, Conditions: contains("zelle"), Actions: Actions{Transfer: true}}),
+	}
+
+	got := Apply(rules, Transaction{Description: "ACH DEBIT COMCAST 8774"})
+	want := Result{
+		Actions: Actions{CategoryID: ptr(int64(7)), Payee: ptr("Comcast Internet"), ExpenseID: ptr(int64(4))},
+		Matched: []int64{1, 2, 3},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Apply = %+v, want %+v", got, want)
+	}
+
+	if got := Apply(rules, Transaction{Description: "GROCERY"}); len(got.Matched) != 0 || !got.empty() {
+		t.Errorf("Apply without matches = %+v", got)
+	}
+}
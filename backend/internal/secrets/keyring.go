@@ -0,0 +1,325 @@
+// Package secrets encrypts secret family settings with envelope encryption.
+//
+// Every family database holds its own data key, which seals the family's
+// secret settings. The data key is stored wrapped (encrypted) by a master key
+// from server configuration, so a database dump alone reveals nothing.
+package secrets
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"expenses-backend/internal/database/sql/familydb"
+)
+
+// DataType is the family_settings data_type of encrypted settings
+const DataType = "secret"
+
+const (
+	keySize = 32 // AES-256
+
+	// sealedPrefix starts every sealed setting value, followed by the data key ID
+	sealedPrefix = "enc:v1:"
+
+	// dataKeyAAD binds wrapped data keys to their purpose
+	dataKeyAAD = "family-data-key"
+)
+
+var (
+	ErrNoMasterKey      = errors.New("no master key configured")
+	ErrInvalidMasterKey = errors.New("master key must be 32 bytes, base64 encoded")
+	ErrUnknownMasterKey = errors.New("data key was wrapped by an unknown master key")
+	ErrMalformed        = errors.New("malformed sealed value")
+	ErrDecrypt          = errors.New("failed to decrypt")
+)
+
+type masterKey struct {
+	id   string
+	aead cipher.AEAD
+}
+
+// Keyring holds the server's master keys. The current key wraps new data
+// keys; previous keys can still unwrap data keys until they are rotated.
+type Keyring struct {
+	current *masterKey
+	byID    map[string]*masterKey
+}
+
+// NewKeyring parses base64 master keys. previous may be empty.
+func NewKeyring(current string, previous ...string) (*Keyring, error) {
+	if strings.TrimSpace(current) == "" {
+		return nil, ErrNoMasterKey
+	}
+
+	k := &Keyring{byID: make(map[string]*masterKey)}
+	for i, encoded := range append([]string{current}, previous...) {
+		encoded = strings.TrimSpace(encoded)
+		if encoded == "" {
+			continue
+		}
+		mk, err := parseMasterKey(encoded)
+		if err != nil {
+			return nil, err
+		}
+		if i == 0 {
+			k.current = mk
+		}
+		k.byID[mk.id] = mk
+	}
+	return k, nil
+}
+
+// GenerateKey returns a new random master key, base64 encoded
+func GenerateKey() (string, error) {
+	key := make([]byte, keySize)
+	if _, err := rand.Read(key); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(key), nil
+}
+
+// CurrentKeyID identifies the master key new data keys are wrapped with
+func (k *Keyring) CurrentKeyID() string {
+	return k.current.id
+}
+
+func parseMasterKey(encoded string) (*masterKey, error) {
+	raw, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil || len(raw) != keySize {
+		return nil, ErrInvalidMasterKey
+	}
+	aead, err := newAEAD(raw)
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(raw)
+	return &masterKey{id: hex.EncodeToString(sum[:4]), aead: aead}, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// seal encrypts plaintext with a random nonce, returning nonce||ciphertext
+func seal(aead cipher.AEAD, plaintext, aad []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, plaintext, aad), nil
+}
+
+// open reverses seal
+func open(aead cipher.AEAD, sealed, aad []byte) ([]byte, error) {
+	if len(sealed) < aead.NonceSize() {
+		return nil, ErrMalformed
+	}
+	plaintext, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], aad)
+	if err != nil {
+		return nil, ErrDecrypt
+	}
+	return plaintext, nil
+}
+
+// wrap encrypts a data key with the current master key
+func (k *Keyring) wrap(dataKey []byte) (string, error) {
+	sealed, err := seal(k.current.aead, dataKey, []byte(dataKeyAAD))
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// unwrap decrypts a stored data key with the master key that wrapped it
+func (k *Keyring) unwrap(dk *familydb.FamilyDataKey) (cipher.AEAD, error) {
+	mk, ok := k.byID[dk.MasterKeyID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownMasterKey, dk.MasterKeyID)
+	}
+	sealed, err := base64.StdEncoding.DecodeString(dk.WrappedKey)
+	if err != nil {
+		return nil, ErrMalformed
+	}
+	raw, err := open(mk.aead, sealed, []byte(dataKeyAAD))
+	if err != nil {
+		return nil, fmt.Errorf("data key %d: %w", dk.ID, err)
+	}
+	return newAEAD(raw)
+}
+
+// createDataKey generates a new active data key for the family
+func (k *Keyring) createDataKey(ctx context.Context, q *familydb.Queries) (*familydb.FamilyDataKey, error) {
+	raw := make([]byte, keySize)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, err
+	}
+	wrapped, err := k.wrap(raw)
+	if err != nil {
+		return nil, err
+	}
+	return q.CreateFamilyDataKey(ctx, familydb.CreateFamilyDataKeyParams{
+		WrappedKey:  wrapped,
+		MasterKeyID: k.current.id,
+		Active:      true,
+		CreatedAt:   time.Now(),
+	})
+}
+
+// Seal encrypts a setting value with the family's active data key, creating
+// the key on first use. The setting key is bound to the ciphertext so a value
+// cannot be moved to another setting.
+func (k *Keyring) Seal(ctx context.Context, q *familydb.Queries, settingKey, plaintext string) (string, error) {
+	if k == nil {
+		return "", ErrNoMasterKey
+	}
+
+	dk, err := q.GetActiveFamilyDataKey(ctx)
+	if err == sql.ErrNoRows {
+		dk, err = k.createDataKey(ctx, q)
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get data key: %w", err)
+	}
+
+	aead, err := k.unwrap(dk)
+	if err != nil {
+		return "", err
+	}
+	sealed, err := seal(aead, []byte(plaintext), []byte(settingKey))
+	if err != nil {
+		return "", err
+	}
+	return sealedPrefix + strconv.FormatInt(dk.ID, 10) + ":" + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Open decrypts a value produced by Seal for the same setting key
+func (k *Keyring) Open(ctx context.Context, q *familydb.Queries, settingKey, value string) (string, error) {
+	if k == nil {
+		return "", ErrNoMasterKey
+	}
+
+	dataKeyID, sealed, err := parseSealed(value)
+	if err != nil {
+		return "", err
+	}
+
+	dk, err := q.GetFamilyDataKey(ctx, dataKeyID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get data key %d: %w", dataKeyID, err)
+	}
+	aead, err := k.unwrap(dk)
+	if err != nil {
+		return "", err
+	}
+
+	plaintext, err := open(aead, sealed, []byte(settingKey))
+	if err != nil {
+		return "", fmt.Errorf("setting %s: %w", settingKey, err)
+	}
+	return string(plaintext), nil
+}
+
+// parseSealed splits a sealed value into its data key ID and ciphertext
+func parseSealed(value string) (int64, []byte, error) {
+	rest, ok := strings.CutPrefix(value, sealedPrefix)
+	if !ok {
+		return 0, nil, ErrMalformed
+	}
+	idPart, dataPart, ok := strings.Cut(rest, ":")
+	if !ok {
+		return 0, nil, ErrMalformed
+	}
+	id, err := strconv.ParseInt(idPart, 10, 64)
+	if err != nil {
+		return 0, nil, ErrMalformed
+	}
+	sealed, err := base64.StdEncoding.DecodeString(dataPart)
+	if err != nil {
+		return 0, nil, ErrMalformed
+	}
+	return id, sealed, nil
+}
+
+// Rotate gives the family a new data key wrapped by the current master key
+// and re-encrypts every secret setting with it. Settings named in
+// plaintextKeys that are still stored unencrypted are encrypted as well.
+// Run it inside a family transaction; it returns how many settings it sealed.
+func (k *Keyring) Rotate(ctx context.Context, q *familydb.Queries, plaintextKeys []string) (int, error) {
+	if k == nil {
+		return 0, ErrNoMasterKey
+	}
+
+	settings, err := q.ListFamilySettings(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list settings: %w", err)
+	}
+
+	// Decrypt everything with the old keys before retiring them
+	plaintexts := make(map[int64]*string)
+	for _, s := range settings {
+		switch {
+		case s.DataType == DataType && s.SettingValue != nil:
+			v, err := k.Open(ctx, q, s.SettingKey, *s.SettingValue)
+			if err != nil {
+				return 0, err
+			}
+			plaintexts[s.ID] = &v
+		case s.DataType == DataType:
+			plaintexts[s.ID] = nil
+		case slices.Contains(plaintextKeys, s.SettingKey):
+			plaintexts[s.ID] = s.SettingValue
+		}
+	}
+
+	if err := q.DeactivateFamilyDataKeys(ctx); err != nil {
+		return 0, fmt.Errorf("failed to deactivate data keys: %w", err)
+	}
+	if _, err := k.createDataKey(ctx, q); err != nil {
+		return 0, fmt.Errorf("failed to create data key: %w", err)
+	}
+
+	sealedCount := 0
+	for _, s := range settings {
+		plaintext, ok := plaintexts[s.ID]
+		if !ok {
+			continue
+		}
+		var value *string
+		if plaintext != nil {
+			sealed, err := k.Seal(ctx, q, s.SettingKey, *plaintext)
+			if err != nil {
+				return 0, err
+			}
+			value = &sealed
+			sealedCount++
+		}
+		if _, err := q.UpdateFamilySetting(ctx, familydb.UpdateFamilySettingParams{
+			SettingValue: value,
+			DataType:     DataType,
+			ID:           s.ID,
+		}); err != nil {
+			return 0, fmt.Errorf("failed to update setting %s: %w", s.SettingKey, err)
+		}
+	}
+
+	if err := q.DeleteInactiveFamilyDataKeys(ctx); err != nil {
+		return 0, fmt.Errorf("failed to delete old data keys: %w", err)
+	}
+	return sealedCount, nil
+}
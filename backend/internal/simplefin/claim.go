@@ -0,0 +1,68 @@
+package simplefin
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidSetupToken = errors.New("invalid setup token")
+	ErrSetupTokenClaimed = errors.New("setup token was already claimed")
+)
+
+// claimTimeout bounds the claim request when ctx has no deadline
+const claimTimeout = 30 * time.Second
+
+// Claim exchanges a setup token for an access token that NewClient accepts.
+// The setup token is the base64 encoded claim URL; POSTing to it returns the
+// access URL. A setup token can only be claimed once.
+func Claim(ctx context.Context, setupToken string) (string, error) {
+	claimURLBytes, err := base64.StdEncoding.DecodeString(strings.TrimSpace(setupToken))
+	if err != nil {
+		return "", ErrInvalidSetupToken
+	}
+
+	claimURL, err := cleanAndParseURL(string(claimURLBytes))
+	if err != nil || claimURL.Scheme == "" || claimURL.Host == "" {
+		return "", ErrInvalidSetupToken
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, claimURL.String(), nil)
+	if err != nil {
+		return "", fmt.Errorf("creating request: %w", err)
+	}
+
+	client := &http.Client{Timeout: claimTimeout}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrRequestFailed, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("reading response body: %w", err)
+	}
+
+	// SimpleFIN answers 403 when the token was claimed before or is unknown
+	if resp.StatusCode == http.StatusForbidden {
+		return "", ErrSetupTokenClaimed
+	}
+	if resp.StatusCode >= 400 {
+		return "", fmt.Errorf("%w: %s - %s", ErrRequestFailed, resp.Status, string(body))
+	}
+
+	accessURL, err := url.Parse(strings.TrimSpace(string(body)))
+	if err != nil || accessURL.Scheme == "" || accessURL.Host == "" {
+		return "", fmt.Errorf("%w: claim returned an invalid access URL", ErrInvalidBaseURL)
+	}
+
+	return base64.StdEncoding.EncodeToString([]byte(accessURL.String())), nil
+}
@@ -0,0 +1,82 @@
+package simplefin
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// newBridge starts a stand-in SimpleFIN bridge with a single setup token
+func newBridge(t *testing.T) (*httptest.Server, string) {
+	t.Helper()
+
+	claimed := false
+	mux := http.NewServeMux()
+	var server *httptest.Server
+
+	mux.HandleFunc("/claim/demo", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		if claimed {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		claimed = true
+		fmt.Fprintf(w, "%s/simplefin\n", strings.Replace(server.URL, "http://", "http://user:secret@", 1))
+	})
+	mux.HandleFunc("/simplefin/accounts", func(w http.ResponseWriter, r *http.Request) {
+		user, pass, ok := r.BasicAuth()
+		if !ok || user != "user" || pass != "secret" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		fmt.Fprint(w, `{"errors":[],"accounts":[{"id":"ACT-1","name":"Checking","currency":"USD","balance":"100.25"}]}`)
+	})
+
+	server = httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	setupToken := base64.StdEncoding.EncodeToString([]byte(server.URL + "/claim/demo"))
+	return server, setupToken
+}
+
+func TestClaim(t *testing.T) {
+	_, setupToken := newBridge(t)
+	ctx := context.Background()
+
+	accessToken, err := Claim(ctx, setupToken)
+	if err != nil {
+		t.Fatalf("Claim: %v", err)
+	}
+
+	client, err := NewClient(accessToken)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	accounts, err := client.Accounts(ctx)
+	if err != nil {
+		t.Fatalf("Accounts: %v", err)
+	}
+	if len(accounts.Accounts) != 1 || accounts.Accounts[0].ID != "ACT-1" {
+		t.Errorf("Accounts = %+v, want ACT-1", accounts.Accounts)
+	}
+
+	if _, err := Claim(ctx, setupToken); !errors.Is(err, ErrSetupTokenClaimed) {
+		t.Errorf("second Claim error = %v, want ErrSetupTokenClaimed", err)
+	}
+}
+
+func TestClaimInvalidToken(t *testing.T) {
+	for _, token := range []string{"", "not base64!", base64.StdEncoding.EncodeToString([]byte("no-scheme"))} {
+		if _, err := Claim(context.Background(), token); !errors.Is(err, ErrInvalidSetupToken) {
+			t.Errorf("Claim(%q) error = %v, want ErrInvalidSetupToken", token, err)
+		}
+	}
+}
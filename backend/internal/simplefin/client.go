@@ -20,6 +20,7 @@ var (
 	ErrNoAccessToken       = errors.New("no access token found")
 	ErrDecodeAccessToken   = errors.New("error decoding access token")
 	ErrRequestFailed       = errors.New("request failed")
+	ErrUnauthorized        = errors.New("access token was rejected")
 	ErrInvalidBaseURL      = errors.New("invalid base URL")
 	ErrAccountNotFound     = errors.New("account not found")
 	ErrTransactionNotFound = errors.New("transactions not found")
@@ -219,6 +220,10 @@ func (c *Client) doRequest(ctx context.Context, method, endpoint string, body io
 	if resp.StatusCode >= 400 {
 		defer resp.Body.Close()
 		bodyBytes, _ := io.ReadAll(resp.Body)
+		// A revoked or mistyped access URL is answered with 401 or 403
+		if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+			return nil, fmt.Errorf("%w: %w: %s", ErrRequestFailed, ErrUnauthorized, resp.Status)
+		}
 		return nil, fmt.Errorf("%w: %s - %s", ErrRequestFailed, resp.Status, string(bodyBytes))
 	}
 
@@ -264,7 +269,7 @@ func (c *Client) Accounts(ctx context.Context) (AccountsResponse, error) {
 
 // Account retrieves the specified account from the SimpleFin API
 func (c *Client) Account(ctx context.Context, account_id string) (AccountResponse, error) {
-	resp, err := c.doRequest(ctx, "GET", fmt.Sprintf("/accounts?account=%s", account_id), nil)
+	resp, err := c.doRequest(ctx, "GET", fmt.Sprintf("/accounts?account=%s&balances-only=1", url.QueryEscape(account_id)), nil)
 	if err != nil {
 		return AccountResponse{}, err
 	}
@@ -291,7 +296,10 @@ func (c *Client) Account(ctx context.Context, account_id string) (AccountRespons
 }
 
 func (c *Client) AccountTransactions(ctx context.Context, params AccountTransactionsRequest) (AccountTransactionsResponse, error) {
-	endpoint := fmt.Sprintf("/accounts?account=%s&start-date=%d&end-date=%d", params.AccountID, params.StartDate.Unix(), params.EndDate.Unix())
+	endpoint := fmt.Sprintf("/accounts?account=%s&start-date=%d&end-date=%d", url.QueryEscape(params.AccountID), params.StartDate.Unix(), params.EndDate.Unix())
+	if params.Pending {
+		endpoint += "&pending=1"
+	}
 
 	resp, err := c.doRequest(ctx, "GET", endpoint, nil)
 	if err != nil {
@@ -312,10 +320,15 @@ func (c *Client) AccountTransactions(ctx context.Context, params AccountTransact
 
 	for _, account := range accountsResponse.Accounts {
 		if account.ID == params.AccountID {
-			return AccountTransactionsResponse{Transactions: account.Transactions}, nil
-
+			return AccountTransactionsResponse{
+				Errors:           accountsResponse.Errors,
+				Transactions:     account.Transactions,
+				Balance:          account.Balance,
+				AvailableBalance: account.AvailableBalance,
+				BalanceDate:      account.BalanceDate,
+			}, nil
 		}
 	}
 
-	return AccountTransactionsResponse{}, ErrTransactionNotFound
+	return AccountTransactionsResponse{Errors: accountsResponse.Errors}, ErrTransactionNotFound
 }
@@ -0,0 +1,240 @@
+package simplefin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+var (
+	ErrNotConnected = errors.New("simplefin is not connected")
+	ErrAuthBackoff  = errors.New("simplefin requests are paused after repeated authentication failures")
+)
+
+const (
+	// authFailureThreshold is how many consecutive auth failures pause requests
+	authFailureThreshold = 3
+
+	// authBackoffBase is the first pause; each further failure doubles it
+	authBackoffBase = 15 * time.Minute
+
+	// authBackoffMax caps the pause
+	authBackoffMax = 24 * time.Hour
+)
+
+// HealthState summarizes how requests to a family's bridge are going
+type HealthState int
+
+const (
+	HealthNotConnected HealthState = iota // No access token stored
+	HealthUnchecked                       // Connected but no request made yet
+	HealthOK                              // The last request succeeded
+	HealthFailing                         // The last request failed
+	HealthAuthFailed                      // The token keeps being rejected; requests are paused
+)
+
+// Health is the state of a family's connection as seen by this server
+type Health struct {
+	State         HealthState
+	LastSuccessAt time.Time
+	LastErrorAt   time.Time
+	LastError     string
+	AuthFailures  int       // Consecutive requests rejected for the token
+	RetryAt       time.Time // Set while requests are paused
+}
+
+// TokenLoader returns the family's stored access token, or nil if it has none
+type TokenLoader func(ctx context.Context, familyID int64) (*string, error)
+
+// connection is the registry's state for one family
+type connection struct {
+	loaded     bool
+	generation uint64 // Bumped on Invalidate so in-flight loads are discarded
+	client     *Client
+	health     Health
+	failing    bool // Whether the last reported request failed
+}
+
+// Registry hands out one client per family, built from the family's stored
+// token, and tracks the health of each connection. Call Invalidate whenever
+// the token changes. It is safe for concurrent use.
+type Registry struct {
+	load TokenLoader
+	opts []ClientOption
+	now  func() time.Time
+
+	mu    sync.Mutex
+	conns map[int64]*connection
+}
+
+// NewRegistry creates a registry that reads tokens with load and builds
+// clients with opts
+func NewRegistry(load TokenLoader, opts ...ClientOption) *Registry {
+	return &Registry{
+		load:  load,
+		opts:  opts,
+		now:   time.Now,
+		conns: make(map[int64]*connection),
+	}
+}
+
+// conn returns the family's entry, creating it; r.mu must be held
+func (r *Registry) conn(familyID int64) *connection {
+	c, ok := r.conns[familyID]
+	if !ok {
+		c = &connection{}
+		r.conns[familyID] = c
+	}
+	return c
+}
+
+// Client returns the family's client. It fails with ErrNotConnected when the
+// family has no token and with ErrAuthBackoff while requests are paused.
+func (r *Registry) Client(ctx context.Context, familyID int64) (*Client, error) {
+	client, err := r.loaded(ctx, familyID)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// A client replaced in the meantime has no health of its own to check
+	c := r.conn(familyID)
+	if c.client == client && !c.health.RetryAt.IsZero() && r.now().Before(c.health.RetryAt) {
+		return nil, fmt.Errorf("%w until %s", ErrAuthBackoff, c.health.RetryAt.UTC().Format(time.RFC3339))
+	}
+	return client, nil
+}
+
+// loaded returns the family's client, reading the token on first use
+func (r *Registry) loaded(ctx context.Context, familyID int64) (*Client, error) {
+	r.mu.Lock()
+	c := r.conn(familyID)
+	if c.loaded {
+		client := c.client
+		r.mu.Unlock()
+		if client == nil {
+			return nil, ErrNotConnected
+		}
+		return client, nil
+	}
+	generation := c.generation
+	r.mu.Unlock()
+
+	// Load without the lock so a slow database doesn't block other families
+	token, err := r.load(ctx, familyID)
+	if err != nil {
+		return nil, err
+	}
+	var client *Client
+	if token != nil && *token != "" {
+		if client, err = NewClient(*token, r.opts...); err != nil {
+			return nil, err
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	c = r.conn(familyID)
+	if c.generation != generation {
+		return nil, ErrNotConnected
+	}
+	if !c.loaded {
+		c.loaded = true
+		c.client = client
+	}
+	if c.client == nil {
+		return nil, ErrNotConnected
+	}
+	return c.client, nil
+}
+
+// Report records the outcome of a request made with client. Results from a
+// client that was replaced since are ignored, as are canceled requests.
+func (r *Registry) Report(familyID int64, client *Client, err error) {
+	if errors.Is(err, context.Canceled) {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	c, ok := r.conns[familyID]
+	if !ok || c.client == nil || c.client != client {
+		return
+	}
+
+	now := r.now()
+	c.failing = err != nil
+	if err == nil {
+		c.health.LastSuccessAt = now
+		c.health.AuthFailures = 0
+		c.health.RetryAt = time.Time{}
+		return
+	}
+
+	c.health.LastErrorAt = now
+	c.health.LastError = err.Error()
+	if !errors.Is(err, ErrUnauthorized) {
+		return
+	}
+	c.health.AuthFailures++
+	if c.health.AuthFailures >= authFailureThreshold {
+		c.health.RetryAt = now.Add(authBackoff(c.health.AuthFailures))
+	}
+}
+
+// authBackoff is how long requests pause after the given number of
+// consecutive auth failures
+func authBackoff(failures int) time.Duration {
+	backoff := authBackoffBase
+	for i := authFailureThreshold; i < failures && backoff < authBackoffMax; i++ {
+		backoff *= 2
+	}
+	return min(backoff, authBackoffMax)
+}
+
+// Invalidate drops the family's client and health so the next request reads
+// the stored token again
+func (r *Registry) Invalidate(familyID int64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	c := r.conn(familyID)
+	c.generation++
+	c.loaded = false
+	c.client = nil
+	c.health = Health{}
+	c.failing = false
+}
+
+// Health returns the state of the family's connection, reading the stored
+// token if the family was not used yet
+func (r *Registry) Health(ctx context.Context, familyID int64) (Health, error) {
+	if _, err := r.loaded(ctx, familyID); err != nil && !errors.Is(err, ErrNotConnected) {
+		return Health{}, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	c := r.conn(familyID)
+	health := c.health
+	switch {
+	case c.client == nil:
+		health.State = HealthNotConnected
+	case health.AuthFailures >= authFailureThreshold:
+		health.State = HealthAuthFailed
+	case c.failing:
+		health.State = HealthFailing
+	case !health.LastSuccessAt.IsZero():
+		health.State = HealthOK
+	default:
+		health.State = HealthUnchecked
+	}
+	return health, nil
+}
@@ -0,0 +1,129 @@
+package simplefin
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+// fakeTokens is a TokenLoader over a map that counts loads
+type fakeTokens struct {
+	tokens map[int64]string
+	loads  int
+}
+
+func (f *fakeTokens) load(ctx context.Context, familyID int64) (*string, error) {
+	f.loads++
+	token, ok := f.tokens[familyID]
+	if !ok {
+		return nil, nil
+	}
+	return &token, nil
+}
+
+func accessToken(host string) string {
+	return base64.StdEncoding.EncodeToString([]byte("https://user:pass@" + host + "/simplefin"))
+}
+
+func TestRegistryCachesAndInvalidates(t *testing.T) {
+	tokens := &fakeTokens{tokens: map[int64]string{1: accessToken("one.example")}}
+	r := NewRegistry(tokens.load)
+	ctx := context.Background()
+
+	first, err := r.Client(ctx, 1)
+	if err != nil {
+		t.Fatalf("Client: %v", err)
+	}
+	again, _ := r.Client(ctx, 1)
+	if again != first || tokens.loads != 1 {
+		t.Errorf("second Client reloaded: same=%v loads=%d", again == first, tokens.loads)
+	}
+
+	tokens.tokens[1] = accessToken("two.example")
+	r.Invalidate(1)
+	replaced, err := r.Client(ctx, 1)
+	if err != nil {
+		t.Fatalf("Client after Invalidate: %v", err)
+	}
+	if replaced.BaseURL.Host != "two.example" {
+		t.Errorf("host = %s, want two.example", replaced.BaseURL.Host)
+	}
+
+	// Results from the old client must not affect the new connection
+	r.Report(1, first, fmt.Errorf("%w: 403", ErrUnauthorized))
+	if h, _ := r.Health(ctx, 1); h.State != HealthUnchecked || h.AuthFailures != 0 {
+		t.Errorf("health after stale report = %+v", h)
+	}
+
+	delete(tokens.tokens, 1)
+	r.Invalidate(1)
+	if _, err := r.Client(ctx, 1); !errors.Is(err, ErrNotConnected) {
+		t.Errorf("Client without token error = %v, want ErrNotConnected", err)
+	}
+	if h, _ := r.Health(ctx, 1); h.State != HealthNotConnected {
+		t.Errorf("state = %v, want HealthNotConnected", h.State)
+	}
+}
+
+func TestRegistryAuthBackoff(t *testing.T) {
+	tokens := &fakeTokens{tokens: map[int64]string{1: accessToken("bridge.example")}}
+	r := NewRegistry(tokens.load)
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	r.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	client, err := r.Client(ctx, 1)
+	if err != nil {
+		t.Fatalf("Client: %v", err)
+	}
+
+	r.Report(1, client, nil)
+	if h, _ := r.Health(ctx, 1); h.State != HealthOK {
+		t.Errorf("state = %v, want HealthOK", h.State)
+	}
+
+	r.Report(1, client, errors.New("timeout"))
+	if h, _ := r.Health(ctx, 1); h.State != HealthFailing || h.AuthFailures != 0 {
+		t.Errorf("health after timeout = %+v", h)
+	}
+
+	unauthorized := fmt.Errorf("%w: %w: 403 Forbidden", ErrRequestFailed, ErrUnauthorized)
+	for range authFailureThreshold {
+		if _, err := r.Client(ctx, 1); err != nil {
+			t.Fatalf("Client before threshold: %v", err)
+		}
+		r.Report(1, client, unauthorized)
+	}
+
+	h, _ := r.Health(ctx, 1)
+	if h.State != HealthAuthFailed || !h.RetryAt.Equal(now.Add(authBackoffBase)) {
+		t.Errorf("health after auth failures = %+v", h)
+	}
+	if _, err := r.Client(ctx, 1); !errors.Is(err, ErrAuthBackoff) {
+		t.Errorf("Client while paused error = %v, want ErrAuthBackoff", err)
+	}
+
+	// After the pause one attempt is let through; failing again doubles the pause
+	now = now.Add(authBackoffBase)
+	if _, err := r.Client(ctx, 1); err != nil {
+		t.Fatalf("Client after pause: %v", err)
+	}
+	r.Report(1, client, unauthorized)
+	if h, _ := r.Health(ctx, 1); !h.RetryAt.Equal(now.Add(2 * authBackoffBase)) {
+		t.Errorf("RetryAt = %v, want %v", h.RetryAt, now.Add(2*authBackoffBase))
+	}
+
+	r.Report(1, client, nil)
+	if h, _ := r.Health(ctx, 1); h.State != HealthOK || h.AuthFailures != 0 || !h.RetryAt.IsZero() {
+		t.Errorf("health after success = %+v", h)
+	}
+}
+
+func TestAuthBackoffCapped(t *testing.T) {
+	if got := authBackoff(100); got != authBackoffMax {
+		t.Errorf("authBackoff(100) = %v, want %v", got, authBackoffMax)
+	}
+}
@@ -18,11 +18,15 @@ type AccountTransactionsRequest struct {
 	AccountID string
 	StartDate time.Time
 	EndDate   time.Time
+	Pending   bool // Include transactions that have not posted yet
 }
 
 type AccountTransactionsResponse struct {
-	Errors       []string       `json:"errors"`
-	Transactions []Transactions `json:"transactions"`
+	Errors           []string       `json:"errors"`
+	Transactions     []Transactions `json:"transactions"`
+	Balance          string         `json:"balance"`
+	AvailableBalance string         `json:"available-balance"`
+	BalanceDate      int            `json:"balance-date"` // Unix time the balance was computed
 }
 
 type Account struct {
@@ -43,11 +47,13 @@ type Org struct {
 }
 
 type Transactions struct {
-	ID          string `json:"id"`
-	Posted      int    `json:"posted"`
-	Amount      string `json:"amount"`
-	Description string `json:"description"`
-	Payee       string `json:"payee"`
+	ID           string `json:"id"`
+	Posted       int64  `json:"posted"` // Unix time, 0 while pending
+	Amount       string `json:"amount"` // Decimal string, parse with money.ParseCents
+	Description  string `json:"description"`
+	Payee        string `json:"payee"`
+	TransactedAt int64  `json:"transacted_at,omitempty"` // Unix time the purchase was made, if known
+	Pending      bool   `json:"pending,omitempty"`
 }
 
 type Extra struct {
@@ -0,0 +1,282 @@
+package statement
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"expenses-backend/internal/money"
+)
+
+// autoDateLayouts are tried in turn when a mapping has no date format
+var autoDateLayouts = []string{"2006-1-2", "1/2/2006", "1/2/06", "20060102"}
+
+// dateTokens turns a date format such as MM/DD/YYYY into a time layout. Months
+// and days parse with or without a leading zero either way.
+var dateTokens = strings.NewReplacer("YYYY", "2006", "YY", "06", "MM", "1", "M", "1", "DD", "2", "D", "2")
+
+// Mapping says where a bank's CSV export keeps each field. Columns are header
+// names, matched ignoring case, or column numbers counting from 1.
+type Mapping struct {
+	Delimiter         rune // Defaults to a comma
+	HasHeader         bool
+	SkipRows          int // Lines before the header or first row, such as an account summary
+	DateColumn        string
+	DateFormat        string // Such as MM/DD/YYYY; empty tries ISO dates, then US ones
+	DescriptionColumn string
+	PayeeColumn       string // Optional; the description is used when unset
+	AmountColumn      string // Signed amount; leave empty to use debit and credit columns
+	DebitColumn       string // Money out
+	CreditColumn      string // Money in
+	IDColumn          string // Optional transaction ID
+	NegateAmounts     bool   // For exports that show purchases as positive, as card statements often do
+}
+
+// Validate checks that the mapping names the columns it needs
+func (m Mapping) Validate() error {
+	if strings.TrimSpace(m.DateColumn) == "" {
+		return errors.New("date column is required")
+	}
+	if strings.TrimSpace(m.DescriptionColumn) == "" {
+		return errors.New("description column is required")
+	}
+	if m.AmountColumn == "" && m.DebitColumn == "" && m.CreditColumn == "" {
+		return errors.New("an amount column, or debit and credit columns, is required")
+	}
+	if m.AmountColumn != "" && (m.DebitColumn != "" || m.CreditColumn != "") {
+		return errors.New("use either an amount column or debit and credit columns")
+	}
+	if m.SkipRows < 0 {
+		return errors.New("skip rows must not be negative")
+	}
+	if f := m.DateFormat; f != "" && (!strings.Contains(f, "YY") || !strings.Contains(f, "M") || !strings.Contains(f, "D")) {
+		return fmt.Errorf("date format %q needs a year (YYYY or YY), month (MM) and day (DD)", f)
+	}
+	if !m.HasHeader {
+		for _, col := range []string{m.DateColumn, m.DescriptionColumn, m.PayeeColumn, m.AmountColumn, m.DebitColumn, m.CreditColumn, m.IDColumn} {
+			if n, err := strconv.Atoi(col); col != "" && (err != nil || n < 1) {
+				return fmt.Errorf("column %q must be a number counting from 1 when the file has no header", col)
+			}
+		}
+	}
+	return nil
+}
+
+// csvColumns holds the index of each mapped column, or -1 when unmapped
+type csvColumns struct {
+	date, description, payee, amount, debit, credit, id int
+}
+
+// ParseCSV reads a CSV export with the mapping. Blank lines and lines without
+// a date, such as totals at the end, are skipped.
+func ParseCSV(data []byte, m Mapping) (Statement, error) {
+	if err := m.Validate(); err != nil {
+		return Statement{}, err
+	}
+
+	r := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte("\ufeff"))))
+	r.Comma = ','
+	if m.Delimiter != 0 {
+		r.Comma = m.Delimiter
+	}
+	r.FieldsPerRecord = -1
+	r.LazyQuotes = true
+	r.TrimLeadingSpace = true
+
+	for i := 0; i < m.SkipRows; i++ {
+		if _, err := r.Read(); err != nil {
+			if errors.Is(err, io.EOF) {
+				return Statement{}, nil
+			}
+			return Statement{}, fmt.Errorf("line %d: %w", i+1, err)
+		}
+	}
+
+	var header []string
+	if m.HasHeader {
+		record, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			return Statement{}, nil
+		}
+		if err != nil {
+			return Statement{}, err
+		}
+		header = record
+	}
+	cols, err := m.columns(header)
+	if err != nil {
+		return Statement{}, err
+	}
+
+	var stmt Statement
+	for {
+		record, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return Statement{}, err
+		}
+		line, _ := r.FieldPos(0)
+
+		if strings.TrimSpace(field(record, cols.date)) == "" {
+			continue
+		}
+		row, err := m.row(record, cols)
+		if err != nil {
+			return Statement{}, fmt.Errorf("line %d: %w", line, err)
+		}
+		row.Line = line
+		stmt.Rows = append(stmt.Rows, row)
+	}
+	return stmt, nil
+}
+
+// columns finds the mapped columns in the header
+func (m Mapping) columns(header []string) (csvColumns, error) {
+	find := func(name string) (int, error) {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			return -1, nil
+		}
+		for i, h := range header {
+			if strings.EqualFold(strings.TrimSpace(h), name) {
+				return i, nil
+			}
+		}
+		if n, err := strconv.Atoi(name); err == nil && n >= 1 {
+			return n - 1, nil
+		}
+		return -1, fmt.Errorf("column %q is not in the header", name)
+	}
+
+	var cols csvColumns
+	for _, c := range []struct {
+		name string
+		idx  *int
+	}{
+		{m.DateColumn, &cols.date},
+		{m.DescriptionColumn, &cols.description},
+		{m.PayeeColumn, &cols.payee},
+		{m.AmountColumn, &cols.amount},
+		{m.DebitColumn, &cols.debit},
+		{m.CreditColumn, &cols.credit},
+		{m.IDColumn, &cols.id},
+	} {
+		idx, err := find(c.name)
+		if err != nil {
+			return csvColumns{}, err
+		}
+		*c.idx = idx
+	}
+	return cols, nil
+}
+
+// row reads a transaction from a record
+func (m Mapping) row(record []string, cols csvColumns) (Row, error) {
+	posted, err := m.parseDate(field(record, cols.date))
+	if err != nil {
+		return Row{}, err
+	}
+
+	var cents int64
+	if cols.amount >= 0 {
+		if cents, err = parseAmount(field(record, cols.amount)); err != nil {
+			return Row{}, err
+		}
+	} else {
+		debit, credit := field(record, cols.debit), field(record, cols.credit)
+		if strings.TrimSpace(debit) == "" && strings.TrimSpace(credit) == "" {
+			return Row{}, errors.New("no debit or credit amount")
+		}
+		// Either column may hold a signed or unsigned amount; which column
+		// it is in gives the direction
+		for _, v := range []struct {
+			value string
+			sign  int64
+		}{{debit, -1}, {credit, 1}} {
+			if strings.TrimSpace(v.value) == "" {
+				continue
+			}
+			c, err := parseAmount(v.value)
+			if err != nil {
+				return Row{}, err
+			}
+			cents += v.sign * max(c, -c)
+		}
+	}
+	if m.NegateAmounts {
+		cents = -cents
+	}
+
+	row := Row{
+		ExternalID:  text(field(record, cols.id)),
+		PostedDate:  posted,
+		Description: text(field(record, cols.description)),
+		Payee:       text(field(record, cols.payee)),
+		AmountCents: cents,
+	}
+	if row.Payee == "" {
+		row.Payee = row.Description
+	}
+	return row, nil
+}
+
+// parseDate reads a date with the mapping's format, ignoring a time after it
+func (m Mapping) parseDate(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	layouts := autoDateLayouts
+	if m.DateFormat != "" {
+		layouts = []string{dateTokens.Replace(m.DateFormat)}
+	}
+	for _, layout := range layouts {
+		v := value
+		if !strings.Contains(layout, " ") {
+			v, _, _ = strings.Cut(v, " ")
+			v, _, _ = strings.Cut(v, "T")
+		}
+		if t, err := time.Parse(layout, v); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid date %q", value)
+}
+
+// parseAmount reads an amount as banks format them, such as "$1,234.56",
+// "(12.00)" for a negative amount or "12.00-"
+func parseAmount(value string) (int64, error) {
+	s := strings.TrimSpace(value)
+	negative := false
+	if strings.HasPrefix(s, "(") && strings.HasSuffix(s, ")") {
+		negative, s = true, s[1:len(s)-1]
+	}
+	if strings.HasSuffix(s, "-") {
+		negative, s = true, strings.TrimSuffix(s, "-")
+	}
+	if strings.HasPrefix(s, "-") {
+		negative, s = !negative, s[1:]
+	}
+	s = strings.NewReplacer("$", "", ",", "", " ", "").Replace(s)
+	s = strings.TrimPrefix(s, "+")
+
+	cents, err := money.ParseCents(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid amount %q", strings.TrimSpace(value))
+	}
+	if negative {
+		cents = -cents
+	}
+	return cents, nil
+}
+
+func field(record []string, idx int) string {
+	if idx < 0 || idx >= len(record) {
+		return ""
+	}
+	return record[idx]
+}
@@ -0,0 +1,120 @@
+package statement
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseCSV(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		mapping Mapping
+		want    []Row
+		wantErr string
+	}{
+		{
+			name: "signed amounts",
+			data: "\ufeffDate,Description,Amount,Ref\n" +
+				"2025-03-01,COFFEE  SHOP,-4.50,r1\n" +
+				"2025-03-02,\"PAYROLL, INC\",\"1,200.00\",r2\n",
+			mapping: Mapping{HasHeader: true, DateColumn: "date", DescriptionColumn: "Description", AmountColumn: "Amount", IDColumn: "Ref"},
+			want: []Row{
+				{Line: 2, ExternalID: "r1", PostedDate: date("2025-03-01"), Description: "COFFEE SHOP", Payee: "COFFEE SHOP", AmountCents: -450},
+				{Line: 3, ExternalID: "r2", PostedDate: date("2025-03-02"), Description: "PAYROLL, INC", Payee: "PAYROLL, INC", AmountCents: 120000},
+			},
+		},
+		{
+			name: "debit and credit columns with a preamble and totals",
+			data: "Account,1234\n" +
+				"\n" +
+				"Posted,Memo,Debit,Credit\n" +
+				"03/01/2025,Groceries,$52.10,\n" +
+				"3/2/2025,Refund,,(5.00)\n" +
+				",Total,52.10,5.00\n",
+			mapping: Mapping{HasHeader: true, SkipRows: 1, DateColumn: "Posted", DateFormat: "MM/DD/YYYY", DescriptionColumn: "Memo", DebitColumn: "Debit", CreditColumn: "Credit"},
+			want: []Row{
+				{Line: 4, PostedDate: date("2025-03-01"), Description: "Groceries", Payee: "Groceries", AmountCents: -5210},
+				{Line: 5, PostedDate: date("2025-03-02"), Description: "Refund", Payee: "Refund", AmountCents: 500},
+			},
+		},
+		{
+			name:    "no header, semicolons and positive purchases",
+			data:    "01.03.2025;Fuel;Shell;30.00\n",
+			mapping: Mapping{Delimiter: ';', DateColumn: "1", DateFormat: "DD.MM.YYYY", DescriptionColumn: "2", PayeeColumn: "3", AmountColumn: "4", NegateAmounts: true},
+			want: []Row{
+				{Line: 1, PostedDate: date("2025-03-01"), Description: "Fuel", Payee: "Shell", AmountCents: -3000},
+			},
+		},
+		{
+			name:    "date with a time",
+			data:    "Date,Description,Amount\n2025-03-01T10:30:00,Coffee,12.00-\n",
+			mapping: Mapping{HasHeader: true, DateColumn: "Date", DescriptionColumn: "Description", AmountColumn: "Amount"},
+			want: []Row{
+				{Line: 2, PostedDate: date("2025-03-01"), Description: "Coffee", Payee: "Coffee", AmountCents: -1200},
+			},
+		},
+		{
+			name:    "missing column",
+			data:    "Date,Description\n",
+			mapping: Mapping{HasHeader: true, DateColumn: "Date", DescriptionColumn: "Description", AmountColumn: "Amount"},
+			wantErr: `column "Amount" is not in the header`,
+		},
+		{
+			name:    "bad amount",
+			data:    "Date,Description,Amount\n2025-03-01,Coffee,abc\n",
+			mapping: Mapping{HasHeader: true, DateColumn: "Date", DescriptionColumn: "Description", AmountColumn: "Amount"},
+			wantErr: `line 2: invalid amount "abc"`,
+		},
+		{
+			name:    "bad date",
+			data:    "Date,Description,Amount\n31/12/2025,Coffee,1\n",
+			mapping: Mapping{HasHeader: true, DateColumn: "Date", DateFormat: "MM/DD/YYYY", DescriptionColumn: "Description", AmountColumn: "Amount"},
+			wantErr: `line 2: invalid date "31/12/2025"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseCSV([]byte(tt.data), tt.mapping)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("ParseCSV() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseCSV() error = %v", err)
+			}
+			if !reflect.DeepEqual(got.Rows, tt.want) {
+				t.Errorf("ParseCSV() = %+v, want %+v", got.Rows, tt.want)
+			}
+		})
+	}
+}
+
+func TestMappingValidate(t *testing.T) {
+	base := Mapping{HasHeader: true, DateColumn: "Date", DescriptionColumn: "Description", AmountColumn: "Amount"}
+	tests := []struct {
+		name    string
+		modify  func(*Mapping)
+		wantErr bool
+	}{
+		{name: "valid", modify: func(m *Mapping) {}},
+		{name: "no date", modify: func(m *Mapping) { m.DateColumn = "" }, wantErr: true},
+		{name: "no amount", modify: func(m *Mapping) { m.AmountColumn = "" }, wantErr: true},
+		{name: "amount and debit", modify: func(m *Mapping) { m.DebitColumn = "Debit" }, wantErr: true},
+		{name: "debit only", modify: func(m *Mapping) { m.AmountColumn, m.DebitColumn = "", "Debit" }},
+		{name: "format without a year", modify: func(m *Mapping) { m.DateFormat = "MM/DD" }, wantErr: true},
+		{name: "names without a header", modify: func(m *Mapping) { m.HasHeader = false }, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := base
+			tt.modify(&m)
+			if err := m.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,175 @@
+package statement
+
+import (
+	"errors"
+	"fmt"
+	"html"
+	"strings"
+	"time"
+
+	"expenses-backend/internal/money"
+)
+
+// ofxTransaction holds the values of an STMTTRN aggregate by tag
+type ofxTransaction map[string]string
+
+// ParseOFX reads an OFX or QFX file. Version 1 files are SGML, where elements
+// holding a value have no end tag, and version 2 files are XML; both are read
+// as a stream of tags, each value belonging to the tag just before it. A file
+// may only hold statements for one account.
+func ParseOFX(data []byte) (Statement, error) {
+	s := string(data)
+	start := strings.Index(strings.ToUpper(s), "<OFX>")
+	if start < 0 {
+		return Statement{}, errors.New("not an OFX file")
+	}
+	s = s[start:]
+
+	var stmt Statement
+	var txn ofxTransaction
+	var inBalance, inAccount bool
+	var balanceAmount, balanceDate string
+	accounts := make(map[string]bool)
+	for {
+		open := strings.IndexByte(s, '<')
+		if open < 0 {
+			break
+		}
+		end := strings.IndexByte(s[open:], '>')
+		if end < 0 {
+			break
+		}
+		tag := s[open+1 : open+end]
+		s = s[open+end+1:]
+		if tag == "" || tag[0] == '?' || tag[0] == '!' {
+			continue
+		}
+
+		value := s
+		if next := strings.IndexByte(s, '<'); next >= 0 {
+			value = s[:next]
+		}
+		value = html.UnescapeString(text(value))
+		closing := strings.HasPrefix(tag, "/")
+		name := strings.ToUpper(strings.TrimSuffix(strings.TrimPrefix(tag, "/"), "/"))
+
+		switch {
+		case name == "STMTTRN":
+			if !closing {
+				txn = make(ofxTransaction)
+				continue
+			}
+			if txn == nil {
+				continue
+			}
+			row, err := txn.row(len(stmt.Rows) + 1)
+			if err != nil {
+				return Statement{}, err
+			}
+			stmt.Rows = append(stmt.Rows, row)
+			txn = nil
+		case name == "LEDGERBAL":
+			inBalance = !closing
+		case name == "BANKACCTFROM" || name == "CCACCTFROM":
+			inAccount = !closing
+		case closing || value == "":
+		case txn != nil:
+			// Accounts a transfer went to are inside the transaction too,
+			// and are not the statement's account
+			txn[name] = value
+		case inBalance && name == "BALAMT":
+			balanceAmount = value
+		case inBalance && name == "DTASOF":
+			balanceDate = value
+		case inAccount && name == "ACCTID":
+			accounts[value] = true
+			stmt.AccountID = value
+		}
+	}
+
+	if len(accounts) > 1 {
+		return Statement{}, errors.New("the file holds statements for more than one account")
+	}
+	if balanceAmount != "" {
+		cents, err := parseOFXAmount(balanceAmount)
+		if err != nil {
+			return Statement{}, fmt.Errorf("balance: %w", err)
+		}
+		asOf, err := parseOFXDate(balanceDate)
+		if err != nil {
+			return Statement{}, fmt.Errorf("balance: %w", err)
+		}
+		stmt.Balance = &Balance{Cents: cents, AsOf: asOf}
+	}
+	return stmt, nil
+}
+
+// row converts the transaction at position n of the file
+func (v ofxTransaction) row(n int) (Row, error) {
+	posted := v["DTPOSTED"]
+	if posted == "" {
+		posted = v["DTUSER"]
+	}
+	date, err := parseOFXDate(posted)
+	if err != nil {
+		return Row{}, fmt.Errorf("transaction %d: %w", n, err)
+	}
+	cents, err := parseOFXAmount(v["TRNAMT"])
+	if err != nil {
+		return Row{}, fmt.Errorf("transaction %d: %w", n, err)
+	}
+
+	// NAME is short, often cut off, so MEMO carries the rest of the bank's text
+	name, memo := v["NAME"], v["MEMO"]
+	description := name
+	switch {
+	case name == "":
+		description = memo
+	case memo != "" && !strings.Contains(name, memo):
+		description = name + " " + memo
+	}
+	if description == "" && v["CHECKNUM"] != "" {
+		description = "Check " + v["CHECKNUM"]
+	}
+	if description == "" {
+		description = v["TRNTYPE"]
+	}
+	payee := name
+	if payee == "" {
+		payee = description
+	}
+
+	return Row{
+		Line:        n,
+		ExternalID:  v["FITID"],
+		PostedDate:  date,
+		Description: description,
+		Payee:       payee,
+		AmountCents: cents,
+	}, nil
+}
+
+// parseOFXDate reads the date part of an OFX date time such as
+// 20250301120000.000[-5:EST]
+func parseOFXDate(value string) (time.Time, error) {
+	if len(value) < 8 {
+		return time.Time{}, fmt.Errorf("invalid date %q", value)
+	}
+	t, err := time.Parse("20060102", value[:8])
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date %q", value)
+	}
+	return t, nil
+}
+
+// parseOFXAmount reads an OFX amount, which may use a decimal comma
+func parseOFXAmount(value string) (int64, error) {
+	if !strings.Contains(value, ".") {
+		value = strings.Replace(value, ",", ".", 1)
+	}
+	cents, err := money.ParseCents(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid amount %q", value)
+	}
+	return cents, nil
+}
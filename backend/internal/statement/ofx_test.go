@@ -0,0 +1,149 @@
+package statement
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const ofxSGML = `OFXHEADER:100
+DATA:OFXSGML
+VERSION:102
+CHARSET:1252
+
+<OFX>
+<SIGNONMSGSRSV1><SONRS><STATUS><CODE>0<SEVERITY>INFO</STATUS><DTSERVER>20250305</SONRS></SIGNONMSGSRSV1>
+<BANKMSGSRSV1><STMTTRNRS><TRNUID>1<STMTRS>
+<CURDEF>USD
+<BANKACCTFROM><BANKID>123<ACCTID>9876<ACCTTYPE>CHECKING</BANKACCTFROM>
+<BANKTRANLIST>
+<DTSTART>20250301<DTEND>20250305
+<STMTTRN>
+<TRNTYPE>DEBIT
+<DTPOSTED>20250301120000.000[-5:EST]
+<TRNAMT>-42.17
+<FITID>T1
+<NAME>CAF` + "\xc9" + ` &amp; BAKERY
+<MEMO>POS PURCHASE
+</STMTTRN>
+<STMTTRN>
+<TRNTYPE>XFER
+<DTPOSTED>20250303
+<TRNAMT>100.00
+<FITID>T2
+<NAME>TRANSFER
+<BANKACCTTO><BANKID>123<ACCTID>5555<ACCTTYPE>SAVINGS</BANKACCTTO>
+</STMTTRN>
+<STMTTRN>
+<TRNTYPE>CHECK
+<DTPOSTED>20250304
+<TRNAMT>-75,00
+<FITID>T3
+<CHECKNUM>1042
+</STMTTRN>
+</BANKTRANLIST>
+<LEDGERBAL><BALAMT>1234.56<DTASOF>20250305</LEDGERBAL>
+</STMTRS></STMTTRNRS></BANKMSGSRSV1>
+</OFX>
+`
+
+const ofxXML = `<?xml version="1.0" encoding="UTF-8" standalone="no"?>
+<?OFX OFXHEADER="200" VERSION="220" SECURITY="NONE" OLDFILEUID="NONE" NEWFILEUID="NONE"?>
+<OFX>
+  <CREDITCARDMSGSRSV1>
+    <CCSTMTTRNRS>
+      <CCSTMTRS>
+        <CCACCTFROM><ACCTID>4111</ACCTID></CCACCTFROM>
+        <BANKTRANLIST>
+          <STMTTRN>
+            <TRNTYPE>DEBIT</TRNTYPE>
+            <DTPOSTED>20250302000000</DTPOSTED>
+            <TRNAMT>-19.99</TRNAMT>
+            <FITID>C1</FITID>
+            <NAME>STREAMING CO</NAME>
+            <MEMO>STREAMING CO</MEMO>
+          </STMTTRN>
+        </BANKTRANLIST>
+        <LEDGERBAL><BALAMT>-250.00</BALAMT><DTASOF>20250305</DTASOF></LEDGERBAL>
+      </CCSTMTRS>
+    </CCSTMTTRNRS>
+  </CREDITCARDMSGSRSV1>
+  <INTU.BID>1234</INTU.BID>
+</OFX>
+`
+
+func TestParseOFX(t *testing.T) {
+	tests := []struct {
+		name        string
+		data        string
+		wantAccount string
+		wantRows    []Row
+		wantBalance *Balance
+	}{
+		{
+			name:        "version 1 SGML",
+			data:        ofxSGML,
+			wantAccount: "9876",
+			wantRows: []Row{
+				{Line: 1, ExternalID: "T1", PostedDate: date("2025-03-01"), Description: "CAFÉ & BAKERY POS PURCHASE", Payee: "CAFÉ & BAKERY", AmountCents: -4217},
+				{Line: 2, ExternalID: "T2", PostedDate: date("2025-03-03"), Description: "TRANSFER", Payee: "TRANSFER", AmountCents: 10000},
+				{Line: 3, ExternalID: "T3", PostedDate: date("2025-03-04"), Description: "Check 1042", Payee: "Check 1042", AmountCents: -7500},
+			},
+			wantBalance: &Balance{Cents: 123456, AsOf: date("2025-03-05")},
+		},
+		{
+			name:        "version 2 XML credit card",
+			data:        ofxXML,
+			wantAccount: "4111",
+			wantRows: []Row{
+				{Line: 1, ExternalID: "C1", PostedDate: date("2025-03-02"), Description: "STREAMING CO", Payee: "STREAMING CO", AmountCents: -1999},
+			},
+			wantBalance: &Balance{Cents: -25000, AsOf: date("2025-03-05")},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseOFX([]byte(tt.data))
+			if err != nil {
+				t.Fatalf("ParseOFX() error = %v", err)
+			}
+			if got.AccountID != tt.wantAccount {
+				t.Errorf("AccountID = %q, want %q", got.AccountID, tt.wantAccount)
+			}
+			if !reflect.DeepEqual(got.Rows, tt.wantRows) {
+				t.Errorf("Rows = %+v, want %+v", got.Rows, tt.wantRows)
+			}
+			if !reflect.DeepEqual(got.Balance, tt.wantBalance) {
+				t.Errorf("Balance = %+v, want %+v", got.Balance, tt.wantBalance)
+			}
+		})
+	}
+}
+
+func TestParseOFXErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr string
+	}{
+		{name: "not OFX", data: "Date,Amount\n", wantErr: "not an OFX file"},
+		{
+			name:    "bad amount",
+			data:    "<OFX><STMTTRN><DTPOSTED>20250301<TRNAMT>abc</STMTTRN></OFX>",
+			wantErr: `transaction 1: invalid amount "abc"`,
+		},
+		{
+			name:    "two accounts",
+			data:    "<OFX><BANKACCTFROM><ACCTID>1</BANKACCTFROM><BANKACCTFROM><ACCTID>2</BANKACCTFROM></OFX>",
+			wantErr: "more than one account",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseOFX([]byte(tt.data))
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ParseOFX() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
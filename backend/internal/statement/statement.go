@@ -0,0 +1,149 @@
+// Package statement reads the statement files banks offer for download, CSV
+// exports and OFX or QFX files, and finds the transactions in them that are
+// already stored.
+package statement
+
+import (
+	"bytes"
+	"cmp"
+	"errors"
+	"path"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"expenses-backend/internal/recurrence"
+)
+
+// Format is the kind of statement file
+type Format string
+
+const (
+	FormatCSV Format = "csv"
+	FormatOFX Format = "ofx" // OFX 1.x SGML, OFX 2.x XML and Quicken's QFX, which is OFX with extra tags
+)
+
+var ErrUnknownFormat = errors.New("unrecognized statement format")
+
+// Row is one transaction read from a statement
+type Row struct {
+	Line        int    // Line of a CSV file, or position of an OFX transaction, counting from 1
+	ExternalID  string // The bank's transaction ID, when the file has one
+	PostedDate  time.Time
+	Description string
+	Payee       string
+	AmountCents int64 // Negative for money leaving the account
+}
+
+// Balance is the account balance a statement reports
+type Balance struct {
+	Cents int64
+	AsOf  time.Time
+}
+
+// Statement is what a statement file holds
+type Statement struct {
+	AccountID string // The bank's account number, when the file names one
+	Rows      []Row
+	Balance   *Balance
+}
+
+// DetectFormat tells the format from the file name, or failing that from the
+// content. CSV files are only recognized by name.
+func DetectFormat(name string, data []byte) (Format, error) {
+	switch strings.ToLower(path.Ext(name)) {
+	case ".csv":
+		return FormatCSV, nil
+	case ".ofx", ".qfx":
+		return FormatOFX, nil
+	}
+
+	head := bytes.ToUpper(data[:min(len(data), 1024)])
+	if bytes.Contains(head, []byte("OFXHEADER")) || bytes.Contains(head, []byte("<OFX>")) {
+		return FormatOFX, nil
+	}
+	return "", ErrUnknownFormat
+}
+
+// Stored is a transaction already stored for the account a statement is
+// imported into
+type Stored struct {
+	ID          int64
+	ExternalID  string
+	PostedDate  time.Time
+	AmountCents int64
+}
+
+// Match says whether a row is already stored
+type Match struct {
+	Duplicate     bool
+	TransactionID int64 // The stored transaction the row duplicates; 0 when it repeats an earlier row of the file
+}
+
+// Dedupe matches each row to a stored transaction it duplicates. A row whose
+// transaction ID is stored is a duplicate of that transaction, and so is a
+// repeat of an ID earlier in the file. Otherwise a row duplicates a stored
+// transaction posted the same day for the same amount, unless both have IDs
+// and they differ. Each stored transaction is matched at most once, so two
+// identical purchases on one day stay two transactions.
+func Dedupe(rows []Row, stored []Stored) []Match {
+	byID := make(map[string]int64)
+	for _, s := range stored {
+		if s.ExternalID != "" {
+			byID[s.ExternalID] = s.ID
+		}
+	}
+
+	matches := make([]Match, len(rows))
+	claimed := make(map[int64]bool)
+	seen := make(map[string]bool)
+	for i, r := range rows {
+		if r.ExternalID == "" {
+			continue
+		}
+		if seen[r.ExternalID] {
+			matches[i] = Match{Duplicate: true}
+			continue
+		}
+		seen[r.ExternalID] = true
+		if id, ok := byID[r.ExternalID]; ok {
+			matches[i] = Match{Duplicate: true, TransactionID: id}
+			claimed[id] = true
+		}
+	}
+
+	// Stored transactions are tried oldest first
+	stored = slices.Clone(stored)
+	slices.SortFunc(stored, func(a, b Stored) int { return cmp.Compare(a.ID, b.ID) })
+	for i, r := range rows {
+		if matches[i].Duplicate {
+			continue
+		}
+		for _, s := range stored {
+			if claimed[s.ID] || s.AmountCents != r.AmountCents || !recurrence.Date(s.PostedDate).Equal(recurrence.Date(r.PostedDate)) {
+				continue
+			}
+			if r.ExternalID != "" && s.ExternalID != "" {
+				continue
+			}
+			matches[i] = Match{Duplicate: true, TransactionID: s.ID}
+			claimed[s.ID] = true
+			break
+		}
+	}
+	return matches
+}
+
+// text cleans up a value read from a file. Files that are not UTF-8 are
+// assumed to be Latin-1, which older OFX files and bank CSVs often are.
+func text(s string) string {
+	if !utf8.ValidString(s) {
+		runes := make([]rune, len(s))
+		for i := 0; i < len(s); i++ {
+			runes[i] = rune(s[i])
+		}
+		s = string(runes)
+	}
+	return strings.Join(strings.Fields(s), " ")
+}
@@ -0,0 +1,114 @@
+package statement
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"expenses-backend/internal/recurrence"
+)
+
+func date(s string) time.Time {
+	d, err := recurrence.ParseDate(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+func TestDetectFormat(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    string
+		data    string
+		want    Format
+		wantErr bool
+	}{
+		{name: "csv by name", file: "export.CSV", data: "Date,Amount\n", want: FormatCSV},
+		{name: "qfx by name", file: "statement.qfx", want: FormatOFX},
+		{name: "ofx 1 header", file: "download", data: "OFXHEADER:100\nDATA:OFXSGML\n\n<OFX>", want: FormatOFX},
+		{name: "ofx 2 header", file: "download", data: `<?xml version="1.0"?><?OFX OFXHEADER="200"?><OFX>`, want: FormatOFX},
+		{name: "unknown", file: "download.txt", data: "Date,Amount\n", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DetectFormat(tt.file, []byte(tt.data))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("DetectFormat() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("DetectFormat() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDedupe(t *testing.T) {
+	tests := []struct {
+		name   string
+		rows   []Row
+		stored []Stored
+		want   []Match
+	}{
+		{
+			name:   "same ID",
+			rows:   []Row{{ExternalID: "a", PostedDate: date("2025-03-02"), AmountCents: -500}},
+			stored: []Stored{{ID: 7, ExternalID: "a", PostedDate: date("2025-03-01"), AmountCents: -400}},
+			want:   []Match{{Duplicate: true, TransactionID: 7}},
+		},
+		{
+			name: "ID repeated in the file",
+			rows: []Row{
+				{ExternalID: "a", PostedDate: date("2025-03-01"), AmountCents: -500},
+				{ExternalID: "a", PostedDate: date("2025-03-01"), AmountCents: -500},
+			},
+			want: []Match{{}, {Duplicate: true}},
+		},
+		{
+			name:   "different IDs are different transactions",
+			rows:   []Row{{ExternalID: "b", PostedDate: date("2025-03-01"), AmountCents: -500}},
+			stored: []Stored{{ID: 7, ExternalID: "a", PostedDate: date("2025-03-01"), AmountCents: -500}},
+			want:   []Match{{}},
+		},
+		{
+			name:   "same day and amount without IDs",
+			rows:   []Row{{PostedDate: date("2025-03-01"), AmountCents: -500}},
+			stored: []Stored{{ID: 7, ExternalID: "a", PostedDate: date("2025-03-01"), AmountCents: -500}},
+			want:   []Match{{Duplicate: true, TransactionID: 7}},
+		},
+		{
+			name: "each stored transaction matches once",
+			rows: []Row{
+				{PostedDate: date("2025-03-01"), AmountCents: -500},
+				{PostedDate: date("2025-03-01"), AmountCents: -500},
+			},
+			stored: []Stored{{ID: 7, PostedDate: date("2025-03-01"), AmountCents: -500}},
+			want:   []Match{{Duplicate: true, TransactionID: 7}, {}},
+		},
+		{
+			name: "ID matches claim first",
+			rows: []Row{
+				{PostedDate: date("2025-03-01"), AmountCents: -500},
+				{ExternalID: "a", PostedDate: date("2025-03-01"), AmountCents: -500},
+			},
+			stored: []Stored{
+				{ID: 7, ExternalID: "a", PostedDate: date("2025-03-01"), AmountCents: -500},
+				{ID: 8, PostedDate: date("2025-03-01"), AmountCents: -500},
+			},
+			want: []Match{{Duplicate: true, TransactionID: 8}, {Duplicate: true, TransactionID: 7}},
+		},
+		{
+			name:   "other day",
+			rows:   []Row{{PostedDate: date("2025-03-02"), AmountCents: -500}},
+			stored: []Stored{{ID: 7, PostedDate: date("2025-03-01"), AmountCents: -500}},
+			want:   []Match{{}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Dedupe(tt.rows, tt.stored); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Dedupe() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
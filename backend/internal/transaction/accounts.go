@@ -0,0 +1,191 @@
+package transaction
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"expenses-backend/internal/database/sql/familydb"
+	"expenses-backend/internal/simplefin"
+	v1 "expenses-backend/pkg/transaction/v1"
+)
+
+// Account types stored in accounts.account_type
+const (
+	accountTypeChecking   = "checking"
+	accountTypeSavings    = "savings"
+	accountTypeCreditCard = "credit_card"
+	accountTypeLoan       = "loan"
+	accountTypeInvestment = "investment"
+	accountTypeCash       = "cash"
+	accountTypeOther      = "other"
+)
+
+// Account sources stored in accounts.source
+const (
+	accountSourceSimplefin = "simplefin"
+	accountSourceImport    = "import"
+)
+
+var accountSourceToProto = map[string]v1.AccountSource{
+	accountSourceSimplefin: v1.AccountSource_ACCOUNT_SOURCE_SIMPLEFIN,
+	accountSourceImport:    v1.AccountSource_ACCOUNT_SOURCE_IMPORT,
+}
+
+var accountTypeToProto = map[string]v1.AccountType{
+	accountTypeChecking:   v1.AccountType_ACCOUNT_TYPE_CHECKING,
+	accountTypeSavings:    v1.AccountType_ACCOUNT_TYPE_SAVINGS,
+	accountTypeCreditCard: v1.AccountType_ACCOUNT_TYPE_CREDIT_CARD,
+	accountTypeLoan:       v1.AccountType_ACCOUNT_TYPE_LOAN,
+	accountTypeInvestment: v1.AccountType_ACCOUNT_TYPE_INVESTMENT,
+	accountTypeCash:       v1.AccountType_ACCOUNT_TYPE_CASH,
+	accountTypeOther:      v1.AccountType_ACCOUNT_TYPE_OTHER,
+}
+
+// isLiability reports whether accounts of the type hold money owed
+func isLiability(accountType string) bool {
+	return accountType == accountTypeCreditCard || accountType == accountTypeLoan
+}
+
+// accountTypeFromProto converts an account type from the API; unspecified
+// is not a valid type
+func accountTypeFromProto(t v1.AccountType) (string, error) {
+	for stored, pb := range accountTypeToProto {
+		if pb == t {
+			return stored, nil
+		}
+	}
+	return "", fmt.Errorf("type must be a known account type")
+}
+
+func accountToProto(a *familydb.Account) *v1.Account {
+	class := v1.AccountClass_ACCOUNT_CLASS_ASSET
+	if isLiability(a.AccountType) {
+		class = v1.AccountClass_ACCOUNT_CLASS_LIABILITY
+	}
+	return &v1.Account{
+		Id:              a.ID,
+		AccountId:       a.AccountID,
+		Name:            a.Name,
+		Type:            accountTypeToProto[a.AccountType],
+		Hidden:          a.Hidden,
+		IncludeInBudget: a.IncludeInBudget,
+		UnlinkedAt:      timestampOrNil(a.UnlinkedAt),
+		RelinkedAt:      timestampOrNil(a.RelinkedAt),
+		Class:           class,
+		Source:          accountSourceToProto[a.Source],
+	}
+}
+
+// lookupSimplefinAccount fetches an account of the family's SimpleFIN
+// connection, failing with simplefin.ErrAccountNotFound if there is none
+func (s *Service) lookupSimplefinAccount(ctx context.Context, familyID int64, simplefinID string) (simplefin.Account, error) {
+	client, err := s.getSimplefinClient(ctx, familyID)
+	if err != nil {
+		return simplefin.Account{}, err
+	}
+
+	resp, err := client.Account(ctx, simplefinID)
+	if errors.Is(err, simplefin.ErrAccountNotFound) {
+		// The request itself went through
+		s.connections.Report(familyID, client, nil)
+		return simplefin.Account{}, err
+	}
+	s.connections.Report(familyID, client, err)
+	if err != nil {
+		return simplefin.Account{}, err
+	}
+	return resp.Account, nil
+}
+
+// linkedAccounts keeps the accounts synced from SimpleFIN, dropping ones that
+// were unlinked and ones fed by statement imports
+func linkedAccounts(accounts []*familydb.Account) []*familydb.Account {
+	linked := make([]*familydb.Account, 0, len(accounts))
+	for _, a := range accounts {
+		if a.UnlinkedAt == nil && a.Source == accountSourceSimplefin {
+			linked = append(linked, a)
+		}
+	}
+	return linked
+}
+
+// purgeAccount deletes an account with its transactions, splits, shared costs,
+// balances and sync state. Payments recorded from its transactions are kept
+// without the link, and transactions it was paired with as transfers count as
+// spending again.
+func purgeAccount(ctx context.Context, q *familydb.Queries, accountID int64) error {
+	if err := q.DetachPaymentsFromAccount(ctx, accountID); err != nil {
+		return fmt.Errorf("failed to detach payments: %w", err)
+	}
+	if err := q.DeleteTransactionMatchesByAccount(ctx, accountID); err != nil {
+		return fmt.Errorf("failed to delete match reviews: %w", err)
+	}
+	if err := q.UnmarkTransferPartnersByAccount(ctx, accountID); err != nil {
+		return fmt.Errorf("failed to unmark transfers: %w", err)
+	}
+	if err := q.DeleteTransferPairsByAccount(ctx, accountID); err != nil {
+		return fmt.Errorf("failed to delete transfer pairs: %w", err)
+	}
+	if err := q.DeleteSharedCostsByAccount(ctx, accountID); err != nil {
+		return fmt.Errorf("failed to delete shared costs: %w", err)
+	}
+	if err := q.DeleteOrphanedSharedCostShares(ctx); err != nil {
+		return fmt.Errorf("failed to delete shared cost shares: %w", err)
+	}
+	if err := q.DeleteTransactionSplitsByAccount(ctx, accountID); err != nil {
+		return fmt.Errorf("failed to delete transaction splits: %w", err)
+	}
+	if err := q.DeleteTransactionsByAccount(ctx, accountID); err != nil {
+		return fmt.Errorf("failed to delete transactions: %w", err)
+	}
+	if err := q.DeleteBalanceSnapshotsByAccount(ctx, accountID); err != nil {
+		return fmt.Errorf("failed to delete balance snapshots: %w", err)
+	}
+	if err := q.DeleteAccountSyncState(ctx, accountID); err != nil {
+		return fmt.Errorf("failed to delete sync state: %w", err)
+	}
+	if err := q.DeleteSyncErrorsByAccount(ctx, &accountID); err != nil {
+		return fmt.Errorf("failed to delete sync errors: %w", err)
+	}
+	if err := q.DeleteTransactionRulesByAccount(ctx, &accountID); err != nil {
+		return fmt.Errorf("failed to delete rules: %w", err)
+	}
+	if err := q.DeleteAccount(ctx, accountID); err != nil {
+		return fmt.Errorf("failed to delete account: %w", err)
+	}
+	return nil
+}
+
+// adoptTransaction finds a stored transaction of a relinked account that a
+// newly fetched one replaces: same posted date and amount, with a SimpleFIN ID
+// that is not in the fetched batch. Banks that reissue account IDs often
+// reissue transaction IDs too, and this keeps them from being stored twice.
+// It returns sql.ErrNoRows when there is nothing to adopt.
+func adoptTransaction(ctx context.Context, q *familydb.Queries, accountID int64, externalID string, posted time.Time, amountCents int64, fetched map[string]bool) (*familydb.Transaction, error) {
+	candidates, err := q.ListTransactionsForAdoption(ctx, familydb.ListTransactionsForAdoptionParams{
+		AccountID:   accountID,
+		PostedDate:  posted,
+		AmountCents: amountCents,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up relinked transaction: %w", err)
+	}
+
+	for _, c := range candidates {
+		if c.ExternalID != nil && fetched[*c.ExternalID] {
+			continue
+		}
+		if err := q.SetTransactionExternalID(ctx, familydb.SetTransactionExternalIDParams{
+			ExternalID: &externalID,
+			ID:         c.ID,
+		}); err != nil {
+			return nil, fmt.Errorf("failed to adopt transaction: %w", err)
+		}
+		c.ExternalID = &externalID
+		return c, nil
+	}
+	return nil, sql.ErrNoRows
+}
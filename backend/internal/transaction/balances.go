@@ -0,0 +1,146 @@
+package transaction
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"expenses-backend/internal/balances"
+	"expenses-backend/internal/database/sql/familydb"
+	"expenses-backend/internal/money"
+	"expenses-backend/internal/recurrence"
+	v1 "expenses-backend/pkg/transaction/v1"
+)
+
+var granularityFromProto = map[v1.Granularity]balances.Granularity{
+	v1.Granularity_GRANULARITY_UNSPECIFIED: balances.Day,
+	v1.Granularity_GRANULARITY_DAY:         balances.Day,
+	v1.Granularity_GRANULARITY_WEEK:        balances.Week,
+	v1.Granularity_GRANULARITY_MONTH:       balances.Month,
+}
+
+// balancePeriods validates the range of a balance history request and returns
+// the dates of its points
+func balancePeriods(startDate, endDate *string, granularity v1.Granularity) ([]time.Time, error) {
+	end := recurrence.Date(time.Now().UTC())
+	if endDate != nil {
+		d, err := recurrence.ParseDate(*endDate)
+		if err != nil {
+			return nil, fmt.Errorf("end_date must be YYYY-MM-DD")
+		}
+		end = d
+	}
+	start := end.AddDate(-1, 0, 0)
+	if startDate != nil {
+		d, err := recurrence.ParseDate(*startDate)
+		if err != nil {
+			return nil, fmt.Errorf("start_date must be YYYY-MM-DD")
+		}
+		start = d
+	}
+
+	g, ok := granularityFromProto[granularity]
+	if !ok {
+		return nil, fmt.Errorf("granularity must be day, week or month")
+	}
+
+	dates, err := balances.Periods(start, end, g)
+	switch {
+	case errors.Is(err, balances.ErrEndBeforeStart):
+		return nil, fmt.Errorf("start_date must not be after end_date")
+	case err != nil:
+		return nil, err
+	}
+	return dates, nil
+}
+
+// accountHistory is an account's balance as of each date of a series; nil
+// before its first snapshot and after it was unlinked
+type accountHistory struct {
+	balance   []*int64
+	available []*int64
+}
+
+// snapshotsByAccount loads the family's balance snapshots up to end
+func snapshotsByAccount(ctx context.Context, q *familydb.Queries, end time.Time) (map[int64][]*familydb.BalanceSnapshot, error) {
+	snapshots, err := q.ListBalanceSnapshots(ctx, end)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list balance snapshots: %w", err)
+	}
+
+	byAccount := make(map[int64][]*familydb.BalanceSnapshot)
+	for _, snap := range snapshots {
+		byAccount[snap.AccountID] = append(byAccount[snap.AccountID], snap)
+	}
+	return byAccount, nil
+}
+
+// historyOf carries the account's snapshots, sorted by date, forward to dates
+func historyOf(account *familydb.Account, snapshots []*familydb.BalanceSnapshot, dates []time.Time) accountHistory {
+	var bal, avail []balances.Snapshot
+	for _, snap := range snapshots {
+		bal = append(bal, balances.Snapshot{Date: snap.SnapshotDate, Cents: snap.BalanceCents})
+		if snap.AvailableBalanceCents != nil {
+			avail = append(avail, balances.Snapshot{Date: snap.SnapshotDate, Cents: *snap.AvailableBalanceCents})
+		}
+	}
+
+	h := accountHistory{
+		balance:   balances.AsOf(bal, dates),
+		available: balances.AsOf(avail, dates),
+	}
+
+	// An unlinked account's last balance is stale, so it stops counting
+	if account.UnlinkedAt != nil {
+		unlinked := recurrence.Date(*account.UnlinkedAt)
+		for i, d := range dates {
+			if d.After(unlinked) {
+				h.balance[i], h.available[i] = nil, nil
+			}
+		}
+	}
+	return h
+}
+
+func optionalCents(cents *int64) *string {
+	if cents == nil {
+		return nil
+	}
+	s := money.FormatCents(*cents)
+	return &s
+}
+
+// netWorthPoints totals the accounts' histories per date. Liabilities are
+// counted by the amount owed, whichever sign the bank reports it with.
+func netWorthPoints(accounts []*familydb.Account, histories map[int64]accountHistory, dates []time.Time) []*v1.NetWorthPoint {
+	points := make([]*v1.NetWorthPoint, len(dates))
+	for i, d := range dates {
+		var assets, liabilities int64
+		for _, a := range accounts {
+			cents := histories[a.ID].balance[i]
+			if cents == nil {
+				continue
+			}
+			if isLiability(a.AccountType) {
+				liabilities += abs(*cents)
+			} else {
+				assets += *cents
+			}
+		}
+		points[i] = &v1.NetWorthPoint{
+			Date:        d.Format(recurrence.DateLayout),
+			Assets:      money.FormatCents(assets),
+			Liabilities: money.FormatCents(liabilities),
+			NetWorth:    money.FormatCents(assets - liabilities),
+		}
+	}
+	return points
+}
+
+func abs(n int64) int64 {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
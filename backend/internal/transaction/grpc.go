@@ -2,13 +2,23 @@ package transaction
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"expenses-backend/internal/database"
 	"expenses-backend/internal/database/sql/familydb"
+	"expenses-backend/internal/expense"
+	"expenses-backend/internal/family"
 	"expenses-backend/internal/logger"
+	"expenses-backend/internal/money"
+	"expenses-backend/internal/pagination"
+	"expenses-backend/internal/recurrence"
+	"expenses-backend/internal/rules"
+	"expenses-backend/internal/secrets"
 	"expenses-backend/internal/simplefin"
 	"fmt"
 	"strings"
 	"sync"
+	"time"
 
 	appcontext "expenses-backend/internal/context"
 	v1 "expenses-backend/pkg/transaction/v1"
@@ -18,19 +28,37 @@ import (
 )
 
 type Service struct {
-	dbManager *database.DatabaseManager
-	logger    logger.Logger
-	mu        sync.RWMutex
-	fin       map[int64]*simplefin.Client
+	dbManager      *database.DatabaseManager
+	familyService  *family.Service
+	expenseService *expense.Service
+	pageTokens     *pagination.Codec
+	logger         logger.Logger
+	connections    *simplefin.Registry
+	syncMu         sync.Mutex
+	syncRuns       map[int64]*syncRun
 }
 
-func NewService(dbManager *database.DatabaseManager, log logger.Logger) *Service {
-	return &Service{
-		dbManager: dbManager,
-		logger:    log,
-		fin:       make(map[int64]*simplefin.Client),
+func NewService(dbManager *database.DatabaseManager, familyService *family.Service, expenseService *expense.Service, pageTokens *pagination.Codec, log logger.Logger) *Service {
+	s := &Service{
+		dbManager:      dbManager,
+		familyService:  familyService,
+		expenseService: expenseService,
+		pageTokens:     pageTokens,
+		logger:         log,
+		syncRuns:       make(map[int64]*syncRun),
 	}
+	s.connections = simplefin.NewRegistry(s.loadSimplefinToken)
+
+	// Drop the cached client as soon as the token is replaced or removed
+	familyService.OnSettingChange(func(familyID int, key string) {
+		if key == simplefinTokenKey {
+			s.connections.Invalidate(int64(familyID))
+		}
+	})
+
+	return s
 }
+
 func (s *Service) GetSimplefinAccounts(ctx context.Context, req *connect.Request[v1.GetSimplefinAccountsRequest]) (*connect.Response[v1.GetSimplefinAccountsResponse], error) {
 	authCtx, err := appcontext.RequireFamily(ctx)
 	if err != nil {
@@ -44,7 +72,7 @@ func (s *Service) GetSimplefinAccounts(ctx context.Context, req *connect.Request
 
 	sfc, err := s.getSimplefinClient(ctx, authCtx.FamilyID)
 	if err != nil {
-		return nil, err
+		return nil, simplefinError(err, "failed to load accounts from simplefin")
 	}
 
 	savedAccounts, err := queries.GetAccounts(ctx)
@@ -53,9 +81,10 @@ func (s *Service) GetSimplefinAccounts(ctx context.Context, req *connect.Request
 	}
 
 	accounts, err := sfc.Accounts(ctx)
+	s.connections.Report(authCtx.FamilyID, sfc, err)
 	if err != nil {
 		s.logger.Error("failed to load accounts from simplefin", err)
-		return nil, err
+		return nil, simplefinError(err, "failed to load accounts from simplefin")
 	}
 
 	if len(accounts.Errors) > 0 {
@@ -65,7 +94,7 @@ func (s *Service) GetSimplefinAccounts(ctx context.Context, req *connect.Request
 
 	sa := make(map[string]bool)
 
-	for _, a := range savedAccounts {
+	for _, a := range linkedAccounts(savedAccounts) {
 		sa[a.AccountID] = true
 	}
 
@@ -73,22 +102,31 @@ func (s *Service) GetSimplefinAccounts(ctx context.Context, req *connect.Request
 
 	for _, a := range accounts.Accounts {
 		if _, ok := sa[a.ID]; !ok {
-			resp.Accounts = append(resp.Accounts, &v1.SimplefinAccount{
+			account := &v1.SimplefinAccount{
 				Id: a.ID,
 				Org: &v1.Organization{
 					Domain: a.Org.Domain,
 					Name:   a.Org.Name,
 				},
-				Name:             a.Name,
-				Currency:         a.Currency,
-				Balance:          a.Balance,
-				AvailableBalance: &a.AvailableBalance,
-				BalanceDate:      &timestamppb.Timestamp{},
-				Transactions:     []*v1.Transaction{},
-			})
+				Name:         a.Name,
+				Currency:     a.Currency,
+				Balance:      a.Balance,
+				Transactions: []*v1.Transaction{},
+			}
+			if a.AvailableBalance != "" {
+				account.AvailableBalance = &a.AvailableBalance
+			}
+			if a.BalanceDate > 0 {
+				account.BalanceDate = timestamppb.New(time.Unix(int64(a.BalanceDate), 0))
+			}
+			resp.Accounts = append(resp.Accounts, account)
 		}
 	}
 
+	if resp.Health, err = s.simplefinHealth(ctx, authCtx.FamilyID); err != nil {
+		return nil, err
+	}
+
 	return connect.NewResponse(&resp), nil
 }
 
@@ -110,15 +148,15 @@ func (s *Service) GetAccounts(ctx context.Context, req *connect.Request[v1.GetAc
 
 	resp := v1.GetAccountsResponse{}
 
-	sa := make(map[string]bool)
-
 	for _, a := range savedAccounts {
-		resp.Accounts = append(resp.Accounts, &v1.Account{
-			Id:        a.ID,
-			Name:      a.Name,
-			AccountId: a.AccountID,
-		})
-		sa[a.AccountID] = true
+		if a.Hidden && !req.Msg.IncludeHidden {
+			continue
+		}
+		resp.Accounts = append(resp.Accounts, accountToProto(a))
+	}
+
+	if resp.Health, err = s.simplefinHealth(ctx, authCtx.FamilyID); err != nil {
+		s.logger.Error("Failed to get SimpleFIN health", err, logger.Int64("family_id", authCtx.FamilyID))
 	}
 
 	return connect.NewResponse(&resp), nil
@@ -130,25 +168,1892 @@ func (s *Service) AddAccount(ctx context.Context, req *connect.Request[v1.AddAcc
 		return nil, err
 	}
 
+	accountType := accountTypeOther
+	if req.Msg.Type != v1.AccountType_ACCOUNT_TYPE_UNSPECIFIED {
+		if accountType, err = accountTypeFromProto(req.Msg.Type); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+	}
+	if req.Msg.Source == v1.AccountSource_ACCOUNT_SOURCE_IMPORT {
+		return s.addImportAccount(ctx, authCtx.FamilyID, authCtx.UserID, req.Msg, accountType)
+	}
+
+	simplefinID := strings.TrimSpace(req.Msg.AccountId)
+	if simplefinID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("account_id is required"))
+	}
+
+	remote, err := s.lookupSimplefinAccount(ctx, authCtx.FamilyID, simplefinID)
+	if err != nil {
+		if errors.Is(err, simplefin.ErrAccountNotFound) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("account_id is not an account of the family's simplefin connection"))
+		}
+		s.logger.Error("Failed to look up SimpleFIN account", err, logger.Int64("family_id", authCtx.FamilyID))
+		return nil, simplefinError(err, "failed to look up account in simplefin")
+	}
+
+	name := strings.TrimSpace(req.Msg.Name)
+	if name == "" {
+		name = remote.Name
+	}
+
+	var account *familydb.Account
+	err = s.dbManager.WithFamilyTx(ctx, int(authCtx.FamilyID), func(q *familydb.Queries) error {
+		existing, err := q.GetAccountBySimplefinID(ctx, simplefinID)
+		if err != nil && err != sql.ErrNoRows {
+			return err
+		}
+		if err == sql.ErrNoRows {
+			account, err = q.CreateAccount(ctx, familydb.CreateAccountParams{
+				AccountID:   simplefinID,
+				Name:        name,
+				AccountType: accountType,
+				Source:      accountSourceSimplefin,
+			})
+			return err
+		}
+
+		if existing.UnlinkedAt == nil {
+			return connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("account is already linked"))
+		}
+		// Adding an unlinked account again resumes syncing it with its
+		// transactions, keeping its settings unless the request changes them
+		relinkedType := existing.AccountType
+		if req.Msg.Type != v1.AccountType_ACCOUNT_TYPE_UNSPECIFIED {
+			relinkedType = accountType
+		}
+		relinkedName := existing.Name
+		if strings.TrimSpace(req.Msg.Name) != "" {
+			relinkedName = name
+		}
+		if _, err := q.RelinkAccount(ctx, familydb.RelinkAccountParams{
+			AccountID:  existing.AccountID,
+			RelinkedAt: existing.RelinkedAt,
+			ID:         existing.ID,
+		}); err != nil {
+			return err
+		}
+		account, err = q.UpdateAccount(ctx, familydb.UpdateAccountParams{
+			Name:            relinkedName,
+			AccountType:     relinkedType,
+			Hidden:          existing.Hidden,
+			IncludeInBudget: existing.IncludeInBudget,
+			ID:              existing.ID,
+		})
+		return err
+	})
+	if err != nil {
+		var connectErr *connect.Error
+		if errors.As(err, &connectErr) {
+			return nil, err
+		}
+		s.logger.Error("Failed to add account", err, logger.Int64("family_id", authCtx.FamilyID))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to add account"))
+	}
+
+	s.logger.Info("Account added successfully",
+		logger.Int64("account_id", account.ID),
+		logger.Int64("user_id", authCtx.UserID))
+
+	return connect.NewResponse(&v1.AddAccountResponse{
+		Account: accountToProto(account),
+	}), nil
+}
+
+// addImportAccount adds an account whose transactions come from statement
+// files rather than SimpleFIN
+func (s *Service) addImportAccount(ctx context.Context, familyID, userID int64, msg *v1.AddAccountRequest, accountType string) (*connect.Response[v1.AddAccountResponse], error) {
+	if strings.TrimSpace(msg.AccountId) != "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("import accounts have no account_id"))
+	}
+	name := strings.TrimSpace(msg.Name)
+	if name == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name is required for import accounts"))
+	}
+
+	queries, err := s.dbManager.GetFamilyQueries(int(familyID))
+	if err != nil {
+		return nil, err
+	}
+	account, err := queries.CreateAccount(ctx, familydb.CreateAccountParams{
+		Name:        name,
+		AccountType: accountType,
+		Source:      accountSourceImport,
+	})
+	if err != nil {
+		s.logger.Error("Failed to add account", err, logger.Int64("family_id", familyID))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to add account"))
+	}
+
+	s.logger.Info("Account added successfully",
+		logger.Int64("account_id", account.ID),
+		logger.Int64("user_id", userID))
+
+	return connect.NewResponse(&v1.AddAccountResponse{
+		Account: accountToProto(account),
+	}), nil
+}
+
+func (s *Service) UpdateAccount(ctx context.Context, req *connect.Request[v1.UpdateAccountRequest]) (*connect.Response[v1.UpdateAccountResponse], error) {
+	authCtx, err := appcontext.RequireFamily(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	queries, err := s.dbManager.GetFamilyQueries(int(authCtx.FamilyID))
 	if err != nil {
 		return nil, err
 	}
 
-	account, err := queries.CreateAccount(ctx, familydb.CreateAccountParams{
-		AccountID: req.Msg.AccountId,
-		Name:      req.Msg.Name,
+	existing, err := queries.GetAccountByID(ctx, req.Msg.Id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("account not found"))
+		}
+		s.logger.Error("Failed to get account", err, logger.Int64("account_id", req.Msg.Id))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get account"))
+	}
+
+	params := familydb.UpdateAccountParams{
+		Name:            existing.Name,
+		AccountType:     existing.AccountType,
+		Hidden:          existing.Hidden,
+		IncludeInBudget: existing.IncludeInBudget,
+		ID:              existing.ID,
+	}
+	if req.Msg.Name != nil {
+		params.Name = strings.TrimSpace(req.Msg.GetName())
+		if params.Name == "" {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name must not be empty"))
+		}
+	}
+	if req.Msg.Type != nil {
+		if params.AccountType, err = accountTypeFromProto(req.Msg.GetType()); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+	}
+	if req.Msg.Hidden != nil {
+		params.Hidden = req.Msg.GetHidden()
+	}
+	if req.Msg.IncludeInBudget != nil {
+		params.IncludeInBudget = req.Msg.GetIncludeInBudget()
+	}
+
+	account, err := queries.UpdateAccount(ctx, params)
+	if err != nil {
+		s.logger.Error("Failed to update account", err, logger.Int64("account_id", existing.ID))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update account"))
+	}
+
+	s.logger.Info("Account updated successfully",
+		logger.Int64("account_id", account.ID),
+		logger.Int64("user_id", authCtx.UserID))
+
+	return connect.NewResponse(&v1.UpdateAccountResponse{
+		Account: accountToProto(account),
+	}), nil
+}
+
+func (s *Service) RemoveAccount(ctx context.Context, req *connect.Request[v1.RemoveAccountRequest]) (*connect.Response[v1.RemoveAccountResponse], error) {
+	authCtx, err := appcontext.RequireFamilyManager(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.dbManager.WithFamilyTx(ctx, int(authCtx.FamilyID), func(q *familydb.Queries) error {
+		account, err := q.GetAccountByID(ctx, req.Msg.Id)
+		if err != nil {
+			return err
+		}
+		if req.Msg.PurgeTransactions {
+			return purgeAccount(ctx, q, account.ID)
+		}
+		if account.UnlinkedAt != nil {
+			return nil
+		}
+		now := time.Now().UTC()
+		return q.UnlinkAccount(ctx, familydb.UnlinkAccountParams{
+			UnlinkedAt: &now,
+			ID:         account.ID,
+		})
 	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("account not found"))
+		}
+		s.logger.Error("Failed to remove account", err, logger.Int64("account_id", req.Msg.Id))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to remove account"))
+	}
+
+	s.logger.Info("Account removed successfully",
+		logger.Int64("account_id", req.Msg.Id),
+		logger.Bool("purged", req.Msg.PurgeTransactions),
+		logger.Int64("user_id", authCtx.UserID))
+
+	return connect.NewResponse(&v1.RemoveAccountResponse{
+		Success: true,
+	}), nil
+}
+
+func (s *Service) RelinkAccount(ctx context.Context, req *connect.Request[v1.RelinkAccountRequest]) (*connect.Response[v1.RelinkAccountResponse], error) {
+	authCtx, err := appcontext.RequireFamily(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	return connect.NewResponse(&v1.AddAccountResponse{
-		Account: &v1.Account{
-			Id:        account.ID,
-			Name:      account.Name,
-			AccountId: account.AccountID,
-		},
+	simplefinID := strings.TrimSpace(req.Msg.AccountId)
+	if simplefinID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("account_id is required"))
+	}
+
+	queries, err := s.dbManager.GetFamilyQueries(int(authCtx.FamilyID))
+	if err != nil {
+		return nil, err
+	}
+	existing, err := queries.GetAccountByID(ctx, req.Msg.Id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("account not found"))
+		}
+		s.logger.Error("Failed to get account", err, logger.Int64("account_id", req.Msg.Id))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get account"))
+	}
+
+	if _, err := s.lookupSimplefinAccount(ctx, authCtx.FamilyID, simplefinID); err != nil {
+		if errors.Is(err, simplefin.ErrAccountNotFound) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("account_id is not an account of the family's simplefin connection"))
+		}
+		s.logger.Error("Failed to look up SimpleFIN account", err, logger.Int64("family_id", authCtx.FamilyID))
+		return nil, simplefinError(err, "failed to look up account in simplefin")
+	}
+
+	var account *familydb.Account
+	err = s.dbManager.WithFamilyTx(ctx, int(authCtx.FamilyID), func(q *familydb.Queries) error {
+		other, err := q.GetAccountBySimplefinID(ctx, simplefinID)
+		if err != nil && err != sql.ErrNoRows {
+			return err
+		}
+		if err == nil && other.ID != existing.ID {
+			return connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("account_id is already linked to another account"))
+		}
+
+		relinkedAt := existing.RelinkedAt
+		if simplefinID != existing.AccountID {
+			now := time.Now().UTC()
+			relinkedAt = &now
+		}
+		account, err = q.RelinkAccount(ctx, familydb.RelinkAccountParams{
+			AccountID:  simplefinID,
+			RelinkedAt: relinkedAt,
+			ID:         existing.ID,
+		})
+		return err
+	})
+	if err != nil {
+		var connectErr *connect.Error
+		if errors.As(err, &connectErr) {
+			return nil, err
+		}
+		s.logger.Error("Failed to relink account", err, logger.Int64("account_id", existing.ID))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to relink account"))
+	}
+
+	s.logger.Info("Account relinked successfully",
+		logger.Int64("account_id", account.ID),
+		logger.Int64("user_id", authCtx.UserID))
+
+	return connect.NewResponse(&v1.RelinkAccountResponse{
+		Account: accountToProto(account),
+	}), nil
+}
+
+func (s *Service) ConnectSimplefin(ctx context.Context, req *connect.Request[v1.ConnectSimplefinRequest]) (*connect.Response[v1.ConnectSimplefinResponse], error) {
+	authCtx, err := appcontext.RequireFamilyManager(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.TrimSpace(req.Msg.SetupToken) == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("setup_token is required"))
+	}
+	// The access token is stored as a secret setting; check that can work
+	// before the single-use setup token is claimed
+	if !s.familyService.SecretsEnabled() {
+		return nil, family.SecretsDisabledError()
+	}
+
+	accessToken, err := simplefin.Claim(ctx, req.Msg.SetupToken)
+	if err != nil {
+		switch {
+		case errors.Is(err, simplefin.ErrInvalidSetupToken):
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		case errors.Is(err, simplefin.ErrSetupTokenClaimed):
+			return nil, connect.NewError(connect.CodeFailedPrecondition,
+				fmt.Errorf("setup token was already used: create a new one in SimpleFIN"))
+		}
+		s.logger.Error("Failed to claim SimpleFIN setup token", err, logger.Int64("family_id", authCtx.FamilyID))
+		return nil, connect.NewError(connect.CodeUnavailable, fmt.Errorf("failed to claim setup token"))
+	}
+
+	// An access URL that doesn't parse can never work, but one that can't list
+	// accounts right now is kept: the setup token can't be claimed again
+	if _, err := simplefin.NewClient(accessToken); err != nil {
+		s.logger.Error("SimpleFIN returned an unusable access URL", err, logger.Int64("family_id", authCtx.FamilyID))
+		return nil, connect.NewError(connect.CodeUnavailable, fmt.Errorf("simplefin returned an unusable access URL"))
+	}
+
+	if err := s.storeSimplefinToken(ctx, authCtx.FamilyID, &accessToken); err != nil {
+		if errors.Is(err, secrets.ErrNoMasterKey) {
+			return nil, family.SecretsDisabledError()
+		}
+		s.logger.Error("Failed to store SimpleFIN token", err, logger.Int64("family_id", authCtx.FamilyID))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to store simplefin connection"))
+	}
+
+	// Checking lists the accounts; a failure shows up in the connection's
+	// errors and health rather than failing the request
+	conn, err := s.simplefinConnection(ctx, authCtx.FamilyID, true)
+	if err != nil {
+		s.logger.Error("Failed to get SimpleFIN connection", err, logger.Int64("family_id", authCtx.FamilyID))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get simplefin connection"))
+	}
+	if conn.Health.GetState() != v1.SimplefinHealthState_SIMPLEFIN_HEALTH_STATE_HEALTHY {
+		s.logger.Warn("SimpleFIN connected but could not list accounts", nil,
+			logger.Int64("family_id", authCtx.FamilyID),
+			logger.Str("last_error", conn.Health.GetLastError()))
+	}
+
+	s.logger.Info("SimpleFIN connected successfully",
+		logger.Int64("family_id", authCtx.FamilyID),
+		logger.Str("server", conn.Server),
+		logger.Int64("user_id", authCtx.UserID))
+
+	return connect.NewResponse(&v1.ConnectSimplefinResponse{
+		Connection: conn,
+	}), nil
+}
+
+func (s *Service) DisconnectSimplefin(ctx context.Context, req *connect.Request[v1.DisconnectSimplefinRequest]) (*connect.Response[v1.DisconnectSimplefinResponse], error) {
+	authCtx, err := appcontext.RequireFamilyManager(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Linked accounts and their transactions are kept for a later reconnect
+	if err := s.storeSimplefinToken(ctx, authCtx.FamilyID, nil); err != nil {
+		s.logger.Error("Failed to remove SimpleFIN token", err, logger.Int64("family_id", authCtx.FamilyID))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to disconnect simplefin"))
+	}
+
+	s.logger.Info("SimpleFIN disconnected successfully",
+		logger.Int64("family_id", authCtx.FamilyID),
+		logger.Int64("user_id", authCtx.UserID))
+
+	return connect.NewResponse(&v1.DisconnectSimplefinResponse{
+		Success: true,
+	}), nil
+}
+
+func (s *Service) GetSimplefinConnection(ctx context.Context, req *connect.Request[v1.GetSimplefinConnectionRequest]) (*connect.Response[v1.GetSimplefinConnectionResponse], error) {
+	authCtx, err := appcontext.RequireFamily(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := s.simplefinConnection(ctx, authCtx.FamilyID, req.Msg.Check)
+	if err != nil {
+		s.logger.Error("Failed to get SimpleFIN connection", err, logger.Int64("family_id", authCtx.FamilyID))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get simplefin connection"))
+	}
+
+	return connect.NewResponse(&v1.GetSimplefinConnectionResponse{
+		Connection: conn,
+	}), nil
+}
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 500
+)
+
+// matchReviewCursor is the position after the last review of a ListMatchReviews page
+type matchReviewCursor struct {
+	ID int64 `json:"i"`
+}
+
+func (s *Service) ListTransactions(ctx context.Context, req *connect.Request[v1.ListTransactionsRequest]) (*connect.Response[v1.ListTransactionsResponse], error) {
+	authCtx, err := appcontext.RequireFamily(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int64(defaultPageSize)
+	if req.Msg.PageSize > 0 {
+		limit = min(int64(req.Msg.PageSize), maxPageSize)
+	}
+
+	filters, err := transactionFiltersFromProto(req.Msg)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	listParams := familydb.ListTransactionsParams{
+		AccountID:      filters.AccountID,
+		StartDate:      filters.StartDate,
+		EndDate:        filters.EndDate,
+		MinAmountCents: filters.MinAmountCents,
+		MaxAmountCents: filters.MaxAmountCents,
+		Pending:        filters.Pending,
+		CategoryID:     filters.CategoryID,
+		Search:         filters.Search,
+		Limit:          limit + 1, // fetch one extra row to detect another page
+	}
+
+	if req.Msg.PageToken != "" {
+		var cursor transactionCursor
+		if err := s.pageTokens.Decode(req.Msg.PageToken, filters.scope(), &cursor); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid page_token"))
+		}
+		listParams.BeforeDate = &cursor.Date
+		listParams.BeforeID = cursor.ID
+	}
+
+	queries, err := s.dbManager.GetFamilyQueries(int(authCtx.FamilyID))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to access family database"))
+	}
+
+	txns, err := queries.ListTransactions(ctx, listParams)
+	if err != nil {
+		s.logger.Error("Failed to list transactions", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list transactions"))
+	}
+
+	totalCount, err := queries.CountTransactions(ctx, familydb.CountTransactionsParams{
+		AccountID:      filters.AccountID,
+		StartDate:      filters.StartDate,
+		EndDate:        filters.EndDate,
+		MinAmountCents: filters.MinAmountCents,
+		MaxAmountCents: filters.MaxAmountCents,
+		Pending:        filters.Pending,
+		CategoryID:     filters.CategoryID,
+		Search:         filters.Search,
+	})
+	if err != nil {
+		s.logger.Error("Failed to count transactions", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list transactions"))
+	}
+
+	nextPageToken := ""
+	if int64(len(txns)) > limit {
+		txns = txns[:limit]
+		last := txns[len(txns)-1]
+		nextPageToken, err = s.pageTokens.Encode(filters.scope(), transactionCursor{Date: last.PostedDate, ID: last.ID})
+		if err != nil {
+			s.logger.Error("Failed to encode page token", err)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list transactions"))
+		}
+	}
+
+	pbTxns := make([]*v1.AccountTransaction, 0, len(txns))
+	for _, t := range txns {
+		pbTxns = append(pbTxns, convertToProtoAccountTransaction(t))
+	}
+	if err := attachSplits(ctx, queries, txns, pbTxns); err != nil {
+		s.logger.Error("Failed to load transaction splits", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list transactions"))
+	}
+
+	return connect.NewResponse(&v1.ListTransactionsResponse{
+		Transactions:  pbTxns,
+		NextPageToken: nextPageToken,
+		TotalCount:    totalCount,
+	}), nil
+}
+
+func (s *Service) GetTransaction(ctx context.Context, req *connect.Request[v1.GetTransactionRequest]) (*connect.Response[v1.GetTransactionResponse], error) {
+	authCtx, err := appcontext.RequireFamily(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Msg.Id == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("id is required"))
+	}
+
+	queries, err := s.dbManager.GetFamilyQueries(int(authCtx.FamilyID))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to access family database"))
+	}
+
+	txn, err := queries.GetTransactionByID(ctx, req.Msg.Id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("transaction not found"))
+		}
+		s.logger.Error("Failed to get transaction", err, logger.Int64("transaction_id", req.Msg.Id))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get transaction"))
+	}
+
+	pb, err := transactionWithSplits(ctx, queries, txn)
+	if err != nil {
+		s.logger.Error("Failed to load transaction splits", err, logger.Int64("transaction_id", txn.ID))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get transaction"))
+	}
+
+	return connect.NewResponse(&v1.GetTransactionResponse{
+		Transaction: pb,
 	}), nil
+}
+
+func (s *Service) UpdateTransaction(ctx context.Context, req *connect.Request[v1.UpdateTransactionRequest]) (*connect.Response[v1.UpdateTransactionResponse], error) {
+	authCtx, err := appcontext.RequireFamily(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Msg.Id == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("id is required"))
+	}
+
+	queries, err := s.dbManager.GetFamilyQueries(int(authCtx.FamilyID))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to access family database"))
+	}
+
+	txn, err := queries.GetTransactionByID(ctx, req.Msg.Id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("transaction not found"))
+		}
+		s.logger.Error("Failed to get transaction", err, logger.Int64("transaction_id", req.Msg.Id))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update transaction"))
+	}
 
+	categoryID, note, displayPayee, isTransfer := txn.CategoryID, txn.Note, txn.DisplayPayee, txn.IsTransfer
+	if req.Msg.CategoryId != nil {
+		categoryID = nil
+		if id := req.Msg.GetCategoryId(); id != 0 {
+			if _, err := queries.GetCategoryByID(ctx, id); err != nil {
+				if err == sql.ErrNoRows {
+					return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("category not found"))
+				}
+				s.logger.Error("Failed to get category", err, logger.Int64("category_id", id))
+				return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update transaction"))
+			}
+			categoryID = &id
+		}
+	}
+	if req.Msg.Note != nil {
+		note = nil
+		if n := strings.TrimSpace(req.Msg.GetNote()); n != "" {
+			note = &n
+		}
+	}
+	if req.Msg.DisplayPayee != nil {
+		displayPayee = nil
+		if p := strings.TrimSpace(req.Msg.GetDisplayPayee()); p != "" {
+			displayPayee = &p
+		}
+	}
+	if req.Msg.IsTransfer != nil {
+		isTransfer = req.Msg.GetIsTransfer()
+	}
+
+	// Resolve the requested match before writing anything
+	var link *expenseLink
+	if req.Msg.MatchedExpenseId != nil && req.Msg.GetMatchedExpenseId() != 0 {
+		link, err = s.resolveExpenseLink(ctx, queries, txn, req.Msg.GetMatchedExpenseId(), req.Msg.MatchedScheduledDate)
+		if err != nil {
+			return nil, err
+		}
+	}
+	unlink := req.Msg.MatchedExpenseId != nil && txn.MatchedExpenseID != nil &&
+		(link == nil || !link.sameAs(txn))
+
+	var updated *familydb.Transaction
+	err = s.dbManager.WithFamilyTx(ctx, int(authCtx.FamilyID), func(q *familydb.Queries) error {
+		if unlink {
+			if err := unlinkMatch(ctx, q, txn); err != nil {
+				return fmt.Errorf("failed to unlink expense: %w", err)
+			}
+		}
+		if link != nil && !link.sameAs(txn) {
+			if err := linkMatch(ctx, q, txn, link.expenseID, link.scheduledDate, 1); err != nil {
+				return fmt.Errorf("failed to link expense: %w", err)
+			}
+		}
+
+		// Choosing a category by hand settles the suggestion
+		if req.Msg.CategoryId != nil && txn.SuggestedCategoryID != nil {
+			if err := q.ClearTransactionSuggestion(ctx, txn.ID); err != nil {
+				return fmt.Errorf("failed to clear category suggestion: %w", err)
+			}
+		}
+
+		var err error
+		updated, err = q.UpdateTransactionDetails(ctx, familydb.UpdateTransactionDetailsParams{
+			CategoryID:   categoryID,
+			Note:         note,
+			DisplayPayee: displayPayee,
+			IsTransfer:   isTransfer,
+			ID:           txn.ID,
+		})
+		return err
+	})
+	if err != nil {
+		s.logger.Error("Failed to update transaction", err, logger.Int64("transaction_id", txn.ID))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update transaction"))
+	}
+
+	s.logger.Info("Transaction updated successfully",
+		logger.Int64("transaction_id", txn.ID),
+		logger.Int64("user_id", authCtx.UserID))
+
+	pb, err := transactionWithSplits(ctx, queries, updated)
+	if err != nil {
+		s.logger.Error("Failed to load transaction splits", err, logger.Int64("transaction_id", txn.ID))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update transaction"))
+	}
+
+	return connect.NewResponse(&v1.UpdateTransactionResponse{
+		Transaction: pb,
+	}), nil
+}
+
+// expenseLink is an expense occurrence a transaction is being linked to
+type expenseLink struct {
+	expenseID     int64
+	scheduledDate time.Time
+}
+
+// sameAs reports whether the transaction is already linked to the occurrence
+func (l *expenseLink) sameAs(txn *familydb.Transaction) bool {
+	return txn.MatchedExpenseID != nil && *txn.MatchedExpenseID == l.expenseID &&
+		txn.MatchedScheduledDate != nil && txn.MatchedScheduledDate.Equal(l.scheduledDate)
+}
+
+// resolveExpenseLink validates that the transaction can be linked to the
+// expense occurrence, returning a connect error when it cannot
+func (s *Service) resolveExpenseLink(ctx context.Context, queries *familydb.Queries, txn *familydb.Transaction, expenseID int64, scheduledDate *string) (*expenseLink, error) {
+	if scheduledDate == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("matched_scheduled_date is required with matched_expense_id"))
+	}
+	date, err := recurrence.ParseDate(*scheduledDate)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("matched_scheduled_date must be YYYY-MM-DD"))
+	}
+
+	exp, err := queries.GetExpenseByID(ctx, expenseID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("expense not found"))
+		}
+		s.logger.Error("Failed to get expense", err, logger.Int64("expense_id", expenseID))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update transaction"))
+	}
+	if !expense.IsOccurrence(exp, date) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("matched_scheduled_date is not a scheduled date of the expense"))
+	}
+
+	link := &expenseLink{expenseID: exp.ID, scheduledDate: date}
+	if link.sameAs(txn) {
+		return link, nil
+	}
+
+	payment, err := queries.GetExpensePayment(ctx, familydb.GetExpensePaymentParams{
+		ExpenseID:     exp.ID,
+		ScheduledDate: date,
+	})
+	if err != nil && err != sql.ErrNoRows {
+		s.logger.Error("Failed to get expense payment", err, logger.Int64("expense_id", exp.ID))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update transaction"))
+	}
+	if err == nil && payment.TransactionID != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("expense occurrence is already matched to a transaction"))
+	}
+
+	return link, nil
+}
+
+func (s *Service) MatchTransactions(ctx context.Context, req *connect.Request[v1.MatchTransactionsRequest]) (*connect.Response[v1.MatchTransactionsResponse], error) {
+	authCtx, err := appcontext.RequireFamily(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	since := recurrence.Date(time.Now()).AddDate(0, 0, -matchLookbackDays)
+	if req.Msg.SinceDate != nil {
+		since, err = recurrence.ParseDate(req.Msg.GetSinceDate())
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("since_date must be YYYY-MM-DD"))
+		}
+	}
+
+	summary, err := s.RunMatcher(ctx, authCtx.FamilyID, since)
+	if err != nil {
+		s.logger.Error("Failed to match transactions", err, logger.Int64("family_id", authCtx.FamilyID))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to match transactions"))
+	}
+
+	return connect.NewResponse(&v1.MatchTransactionsResponse{
+		Matched:         int32(summary.Matched),
+		QueuedForReview: int32(summary.Queued),
+	}), nil
+}
+
+func (s *Service) ListMatchReviews(ctx context.Context, req *connect.Request[v1.ListMatchReviewsRequest]) (*connect.Response[v1.ListMatchReviewsResponse], error) {
+	authCtx, err := appcontext.RequireFamily(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int64(defaultPageSize)
+	if req.Msg.PageSize > 0 {
+		limit = min(int64(req.Msg.PageSize), maxPageSize)
+	}
+
+	var cursor matchReviewCursor
+	if req.Msg.PageToken != "" {
+		if err := s.pageTokens.Decode(req.Msg.PageToken, "match-reviews", &cursor); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid page_token"))
+		}
+	}
+
+	queries, err := s.dbManager.GetFamilyQueries(int(authCtx.FamilyID))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to access family database"))
+	}
+
+	matches, err := queries.ListPendingTransactionMatches(ctx, familydb.ListPendingTransactionMatchesParams{
+		AfterID: cursor.ID,
+		Limit:   limit + 1, // fetch one extra row to detect another page
+	})
+	if err != nil {
+		s.logger.Error("Failed to list match reviews", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list match reviews"))
+	}
+
+	totalCount, err := queries.CountPendingTransactionMatches(ctx)
+	if err != nil {
+		s.logger.Error("Failed to count match reviews", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list match reviews"))
+	}
+
+	nextPageToken := ""
+	if int64(len(matches)) > limit {
+		matches = matches[:limit]
+		nextPageToken, err = s.pageTokens.Encode("match-reviews", matchReviewCursor{ID: matches[len(matches)-1].ID})
+		if err != nil {
+			s.logger.Error("Failed to encode page token", err)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list match reviews"))
+		}
+	}
+
+	reviews := make([]*v1.MatchReview, 0, len(matches))
+	for _, m := range matches {
+		txn, err := queries.GetTransactionByID(ctx, m.TransactionID)
+		if err != nil {
+			s.logger.Error("Failed to get matched transaction", err, logger.Int64("transaction_id", m.TransactionID))
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list match reviews"))
+		}
+		exp, err := queries.GetExpenseByID(ctx, m.ExpenseID)
+		if err != nil {
+			s.logger.Error("Failed to get matched expense", err, logger.Int64("expense_id", m.ExpenseID))
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list match reviews"))
+		}
+
+		reviews = append(reviews, &v1.MatchReview{
+			Id:            m.ID,
+			Transaction:   convertToProtoAccountTransaction(txn),
+			ExpenseId:     exp.ID,
+			ExpenseName:   exp.Name,
+			ExpenseAmount: exp.Amount,
+			ScheduledDate: m.ScheduledDate.Format(recurrence.DateLayout),
+			Score:         m.Score,
+		})
+	}
+
+	return connect.NewResponse(&v1.ListMatchReviewsResponse{
+		Reviews:       reviews,
+		NextPageToken: nextPageToken,
+		TotalCount:    totalCount,
+	}), nil
+}
+
+func (s *Service) ResolveMatchReview(ctx context.Context, req *connect.Request[v1.ResolveMatchReviewRequest]) (*connect.Response[v1.ResolveMatchReviewResponse], error) {
+	authCtx, err := appcontext.RequireFamily(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Msg.Id == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("id is required"))
+	}
+
+	queries, err := s.dbManager.GetFamilyQueries(int(authCtx.FamilyID))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to access family database"))
+	}
+
+	match, err := queries.GetTransactionMatch(ctx, req.Msg.Id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("match review not found"))
+		}
+		s.logger.Error("Failed to get match review", err, logger.Int64("match_id", req.Msg.Id))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to resolve match review"))
+	}
+	if match.Status != matchStatusPending {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("match review was already %s", match.Status))
+	}
+
+	if !req.Msg.Accept {
+		err = queries.UpdateTransactionMatchStatus(ctx, familydb.UpdateTransactionMatchStatusParams{
+			Status:    matchStatusRejected,
+			UpdatedAt: time.Now(),
+			ID:        match.ID,
+		})
+		if err != nil {
+			s.logger.Error("Failed to reject match review", err, logger.Int64("match_id", match.ID))
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to resolve match review"))
+		}
+		return connect.NewResponse(&v1.ResolveMatchReviewResponse{Success: true}), nil
+	}
+
+	txn, err := queries.GetTransactionByID(ctx, match.TransactionID)
+	if err != nil {
+		s.logger.Error("Failed to get matched transaction", err, logger.Int64("transaction_id", match.TransactionID))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to resolve match review"))
+	}
+	if txn.MatchedExpenseID != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("transaction is already matched to an expense"))
+	}
+
+	payment, err := queries.GetExpensePayment(ctx, familydb.GetExpensePaymentParams{
+		ExpenseID:     match.ExpenseID,
+		ScheduledDate: match.ScheduledDate,
+	})
+	if err != nil && err != sql.ErrNoRows {
+		s.logger.Error("Failed to get expense payment", err, logger.Int64("expense_id", match.ExpenseID))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to resolve match review"))
+	}
+	if err == nil && payment.TransactionID != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("expense occurrence is already matched to a transaction"))
+	}
+
+	if err := s.applyMatch(ctx, authCtx.FamilyID, txn, match.ExpenseID, match.ScheduledDate, match.Score); err != nil {
+		s.logger.Error("Failed to accept match review", err, logger.Int64("match_id", match.ID))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to resolve match review"))
+	}
+
+	s.logger.Info("Match review accepted",
+		logger.Int64("match_id", match.ID),
+		logger.Int64("transaction_id", txn.ID),
+		logger.Int64("user_id", authCtx.UserID))
+
+	return connect.NewResponse(&v1.ResolveMatchReviewResponse{
+		Success: true,
+	}), nil
+}
+
+func (s *Service) SyncNow(ctx context.Context, req *connect.Request[v1.SyncNowRequest]) (*connect.Response[v1.SyncNowResponse], error) {
+	authCtx, err := appcontext.RequireFamily(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getSimplefinClient(ctx, authCtx.FamilyID); err != nil {
+		if errors.Is(err, simplefin.ErrNotConnected) || errors.Is(err, simplefin.ErrAuthBackoff) {
+			return nil, simplefinError(err, "failed to start sync")
+		}
+		s.logger.Error("Failed to get SimpleFIN client", err, logger.Int64("family_id", authCtx.FamilyID))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to start sync"))
+	}
+
+	started := s.beginSync(authCtx.FamilyID)
+	if started {
+		familyID := authCtx.FamilyID
+		// The sync outlives the request, so it must not be canceled with it
+		syncCtx := context.WithoutCancel(ctx)
+		go func() {
+			defer s.endSync(familyID)
+
+			summary, err := s.syncFamily(syncCtx, familyID)
+			if err != nil {
+				s.logger.Error("Failed to sync family transactions", err, logger.Int64("family_id", familyID))
+				return
+			}
+			s.logger.Info("Synced family transactions",
+				logger.Int64("family_id", familyID),
+				logger.Int("accounts", summary.Accounts),
+				logger.Int("failed", summary.Failed),
+				logger.Int("added", summary.Added),
+				logger.Int("updated", summary.Updated))
+		}()
+	}
+
+	status, err := s.syncStatus(ctx, authCtx.FamilyID)
+	if err != nil {
+		s.logger.Error("Failed to get sync status", err, logger.Int64("family_id", authCtx.FamilyID))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get sync status"))
+	}
+
+	return connect.NewResponse(&v1.SyncNowResponse{
+		Started: started,
+		Status:  status,
+	}), nil
+}
+
+func (s *Service) GetSyncStatus(ctx context.Context, req *connect.Request[v1.GetSyncStatusRequest]) (*connect.Response[v1.GetSyncStatusResponse], error) {
+	authCtx, err := appcontext.RequireFamily(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := s.syncStatus(ctx, authCtx.FamilyID)
+	if err != nil {
+		s.logger.Error("Failed to get sync status", err, logger.Int64("family_id", authCtx.FamilyID))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get sync status"))
+	}
+
+	return connect.NewResponse(&v1.GetSyncStatusResponse{
+		Status: status,
+	}), nil
+}
+
+func (s *Service) GetAccountBalances(ctx context.Context, req *connect.Request[v1.GetAccountBalancesRequest]) (*connect.Response[v1.GetAccountBalancesResponse], error) {
+	authCtx, err := appcontext.RequireFamily(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dates, err := balancePeriods(req.Msg.StartDate, req.Msg.EndDate, req.Msg.Granularity)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	queries, err := s.dbManager.GetFamilyQueries(int(authCtx.FamilyID))
+	if err != nil {
+		return nil, err
+	}
+
+	accounts, err := queries.GetAccounts(ctx)
+	if err != nil {
+		s.logger.Error("Failed to get accounts", err, logger.Int64("family_id", authCtx.FamilyID))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get account balances"))
+	}
+	if len(req.Msg.AccountIds) > 0 {
+		byID := make(map[int64]*familydb.Account, len(accounts))
+		for _, a := range accounts {
+			byID[a.ID] = a
+		}
+		accounts = accounts[:0]
+		for _, id := range req.Msg.AccountIds {
+			a, ok := byID[id]
+			if !ok {
+				return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("account %d not found", id))
+			}
+			accounts = append(accounts, a)
+		}
+	}
+
+	snapshots, err := snapshotsByAccount(ctx, queries, dates[len(dates)-1])
+	if err != nil {
+		s.logger.Error("Failed to get balance snapshots", err, logger.Int64("family_id", authCtx.FamilyID))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get account balances"))
+	}
+
+	resp := &v1.GetAccountBalancesResponse{}
+	for _, a := range accounts {
+		h := historyOf(a, snapshots[a.ID], dates)
+		series := &v1.AccountBalanceSeries{
+			Account: accountToProto(a),
+			Points:  make([]*v1.BalancePoint, len(dates)),
+		}
+		for i, d := range dates {
+			series.Points[i] = &v1.BalancePoint{
+				Date:             d.Format(recurrence.DateLayout),
+				Balance:          optionalCents(h.balance[i]),
+				AvailableBalance: optionalCents(h.available[i]),
+			}
+		}
+		resp.Accounts = append(resp.Accounts, series)
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+func (s *Service) GetNetWorthHistory(ctx context.Context, req *connect.Request[v1.GetNetWorthHistoryRequest]) (*connect.Response[v1.GetNetWorthHistoryResponse], error) {
+	authCtx, err := appcontext.RequireFamily(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dates, err := balancePeriods(req.Msg.StartDate, req.Msg.EndDate, req.Msg.Granularity)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	queries, err := s.dbManager.GetFamilyQueries(int(authCtx.FamilyID))
+	if err != nil {
+		return nil, err
+	}
+
+	accounts, err := queries.GetAccounts(ctx)
+	if err != nil {
+		s.logger.Error("Failed to get accounts", err, logger.Int64("family_id", authCtx.FamilyID))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get net worth history"))
+	}
+
+	snapshots, err := snapshotsByAccount(ctx, queries, dates[len(dates)-1])
+	if err != nil {
+		s.logger.Error("Failed to get balance snapshots", err, logger.Int64("family_id", authCtx.FamilyID))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get net worth history"))
+	}
+
+	histories := make(map[int64]accountHistory, len(accounts))
+	for _, a := range accounts {
+		histories[a.ID] = historyOf(a, snapshots[a.ID], dates)
+	}
+
+	return connect.NewResponse(&v1.GetNetWorthHistoryResponse{
+		Points: netWorthPoints(accounts, histories, dates),
+	}), nil
+}
+
+func (s *Service) CreateRule(ctx context.Context, req *connect.Request[v1.CreateRuleRequest]) (*connect.Response[v1.CreateRuleResponse], error) {
+	authCtx, err := appcontext.RequireFamily(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	queries, err := s.dbManager.GetFamilyQueries(int(authCtx.FamilyID))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to access family database"))
+	}
+
+	p, err := ruleFromProto(ctx, queries, req.Msg.Rule)
+	if err != nil {
+		var connectErr *connect.Error
+		if errors.As(err, &connectErr) {
+			return nil, err
+		}
+		s.logger.Error("Failed to validate rule", err, logger.Int64("family_id", authCtx.FamilyID))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create rule"))
+	}
+
+	now := time.Now()
+	c, a := p.rule.Conditions, p.rule.Actions
+	rule, err := queries.CreateTransactionRule(ctx, familydb.CreateTransactionRuleParams{
+		Name:           p.name,
+		Position:       p.position,
+		IsActive:       p.isActive,
+		MatchField:     string(c.Field),
+		MatchType:      string(c.Match),
+		Pattern:        c.Pattern,
+		MinAmountCents: c.MinCents,
+		MaxAmountCents: c.MaxCents,
+		AccountID:      c.AccountID,
+		SetCategoryID:  a.CategoryID,
+		SetPayee:       a.Payee,
+		LinkExpenseID:  a.ExpenseID,
+		MarkTransfer:   a.Transfer,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	})
+	if err != nil {
+		s.logger.Error("Failed to create rule", err, logger.Int64("family_id", authCtx.FamilyID))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create rule"))
+	}
+
+	s.logger.Info("Transaction rule created",
+		logger.Int64("rule_id", rule.ID),
+		logger.Int64("family_id", authCtx.FamilyID))
+
+	return connect.NewResponse(&v1.CreateRuleResponse{
+		Rule: convertToProtoRule(rule),
+	}), nil
+}
+
+func (s *Service) UpdateRule(ctx context.Context, req *connect.Request[v1.UpdateRuleRequest]) (*connect.Response[v1.UpdateRuleResponse], error) {
+	authCtx, err := appcontext.RequireFamily(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Msg.Rule.GetId() == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("rule id is required"))
+	}
+
+	queries, err := s.dbManager.GetFamilyQueries(int(authCtx.FamilyID))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to access family database"))
+	}
+
+	if _, err := queries.GetTransactionRule(ctx, req.Msg.Rule.Id); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("rule not found"))
+		}
+		s.logger.Error("Failed to get rule", err, logger.Int64("rule_id", req.Msg.Rule.Id))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update rule"))
+	}
+
+	p, err := ruleFromProto(ctx, queries, req.Msg.Rule)
+	if err != nil {
+		var connectErr *connect.Error
+		if errors.As(err, &connectErr) {
+			return nil, err
+		}
+		s.logger.Error("Failed to validate rule", err, logger.Int64("rule_id", req.Msg.Rule.Id))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update rule"))
+	}
+
+	c, a := p.rule.Conditions, p.rule.Actions
+	rule, err := queries.UpdateTransactionRule(ctx, familydb.UpdateTransactionRuleParams{
+		Name:           p.name,
+		Position:       p.position,
+		IsActive:       p.isActive,
+		MatchField:     string(c.Field),
+		MatchType:      string(c.Match),
+		Pattern:        c.Pattern,
+		MinAmountCents: c.MinCents,
+		MaxAmountCents: c.MaxCents,
+		AccountID:      c.AccountID,
+		SetCategoryID:  a.CategoryID,
+		SetPayee:       a.Payee,
+		LinkExpenseID:  a.ExpenseID,
+		MarkTransfer:   a.Transfer,
+		UpdatedAt:      time.Now(),
+		ID:             req.Msg.Rule.Id,
+	})
+	if err != nil {
+		s.logger.Error("Failed to update rule", err, logger.Int64("rule_id", req.Msg.Rule.Id))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update rule"))
+	}
+
+	return connect.NewResponse(&v1.UpdateRuleResponse{
+		Rule: convertToProtoRule(rule),
+	}), nil
+}
+
+func (s *Service) DeleteRule(ctx context.Context, req *connect.Request[v1.DeleteRuleRequest]) (*connect.Response[v1.DeleteRuleResponse], error) {
+	authCtx, err := appcontext.RequireFamily(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Msg.Id == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("id is required"))
+	}
+
+	queries, err := s.dbManager.GetFamilyQueries(int(authCtx.FamilyID))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to access family database"))
+	}
+
+	if err := queries.DeleteTransactionRule(ctx, req.Msg.Id); err != nil {
+		s.logger.Error("Failed to delete rule", err, logger.Int64("rule_id", req.Msg.Id))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to delete rule"))
+	}
+
+	return connect.NewResponse(&v1.DeleteRuleResponse{
+		Success: true,
+	}), nil
+}
+
+func (s *Service) ListRules(ctx context.Context, req *connect.Request[v1.ListRulesRequest]) (*connect.Response[v1.ListRulesResponse], error) {
+	authCtx, err := appcontext.RequireFamily(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	queries, err := s.dbManager.GetFamilyQueries(int(authCtx.FamilyID))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to access family database"))
+	}
+
+	rows, err := queries.ListTransactionRules(ctx)
+	if err != nil {
+		s.logger.Error("Failed to list rules", err, logger.Int64("family_id", authCtx.FamilyID))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list rules"))
+	}
+
+	resp := &v1.ListRulesResponse{Rules: make([]*v1.TransactionRule, len(rows))}
+	for i, r := range rows {
+		resp.Rules[i] = convertToProtoRule(r)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *Service) ApplyRules(ctx context.Context, req *connect.Request[v1.ApplyRulesRequest]) (*connect.Response[v1.ApplyRulesResponse], error) {
+	authCtx, err := appcontext.RequireFamily(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	start, end, err := ruleDateRange(req.Msg.StartDate, req.Msg.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	queries, err := s.dbManager.GetFamilyQueries(int(authCtx.FamilyID))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to access family database"))
+	}
+
+	txns, err := queries.ListTransactionsBetween(ctx, familydb.ListTransactionsBetweenParams{
+		StartDate: start,
+		EndDate:   end,
+	})
+	if err != nil {
+		s.logger.Error("Failed to list transactions", err, logger.Int64("family_id", authCtx.FamilyID))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to apply rules"))
+	}
+
+	summary, err := s.RunRules(ctx, authCtx.FamilyID, txns, req.Msg.Overwrite)
+	if err != nil {
+		s.logger.Error("Failed to apply rules", err, logger.Int64("family_id", authCtx.FamilyID))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to apply rules"))
+	}
+
+	s.logger.Info("Applied transaction rules",
+		logger.Int64("family_id", authCtx.FamilyID),
+		logger.Int("transactions", len(txns)),
+		logger.Int("matched", summary.Matched),
+		logger.Int("updated", summary.Updated))
+
+	return connect.NewResponse(&v1.ApplyRulesResponse{
+		Matched: int32(summary.Matched),
+		Updated: int32(summary.Updated),
+	}), nil
+}
+
+func (s *Service) PreviewRule(ctx context.Context, req *connect.Request[v1.PreviewRuleRequest]) (*connect.Response[v1.PreviewRuleResponse], error) {
+	authCtx, err := appcontext.RequireFamily(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	start, end, err := ruleDateRange(req.Msg.StartDate, req.Msg.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	limit := int(req.Msg.Limit)
+	if limit <= 0 {
+		limit = defaultRulePreviewLimit
+	}
+	if limit > maxRulePreviewLimit {
+		limit = maxRulePreviewLimit
+	}
+
+	queries, err := s.dbManager.GetFamilyQueries(int(authCtx.FamilyID))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to access family database"))
+	}
+
+	p, err := ruleFromProto(ctx, queries, req.Msg.Rule)
+	if err != nil {
+		var connectErr *connect.Error
+		if errors.As(err, &connectErr) {
+			return nil, err
+		}
+		s.logger.Error("Failed to validate rule", err, logger.Int64("family_id", authCtx.FamilyID))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to preview rule"))
+	}
+	compiled, err := rules.Compile(p.rule)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	txns, err := queries.ListTransactionsBetween(ctx, familydb.ListTransactionsBetweenParams{
+		StartDate: start,
+		EndDate:   end,
+	})
+	if err != nil {
+		s.logger.Error("Failed to list transactions", err, logger.Int64("family_id", authCtx.FamilyID))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to preview rule"))
+	}
+
+	resp := &v1.PreviewRuleResponse{}
+	var changes []ruleChange
+	if len(txns) > 0 {
+		from, to := postedRange(txns)
+		linker := s.newRuleLinker(ctx, authCtx.FamilyID, from, to)
+		for _, txn := range txns {
+			res := rules.Apply([]*rules.Compiled{compiled}, ruleTransaction(txn))
+			if len(res.Matched) == 0 {
+				continue
+			}
+			resp.Matched++
+
+			c, err := planRuleChange(txn, res, req.Msg.Overwrite, linker)
+			if err != nil {
+				s.logger.Error("Failed to preview rule", err, logger.Int64("family_id", authCtx.FamilyID))
+				return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to preview rule"))
+			}
+			if c.changed() {
+				changes = append(changes, c)
+			}
+		}
+	}
+	resp.Changed = int32(len(changes))
+
+	// Transactions are listed oldest first
+	for i := len(changes) - 1; i >= 0 && len(resp.Changes) < limit; i-- {
+		resp.Changes = append(resp.Changes, &v1.RuleChange{
+			Transaction: convertToProtoAccountTransaction(changes[i].txn),
+			Updated:     convertToProtoAccountTransaction(changes[i].applied()),
+		})
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *Service) SuggestCategories(ctx context.Context, req *connect.Request[v1.SuggestCategoriesRequest]) (*connect.Response[v1.SuggestCategoriesResponse], error) {
+	authCtx, err := appcontext.RequireFamily(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	start, end, err := ruleDateRange(req.Msg.StartDate, req.Msg.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	queries, err := s.dbManager.GetFamilyQueries(int(authCtx.FamilyID))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to access family database"))
+	}
+
+	txns, err := queries.ListUncategorizedTransactions(ctx, familydb.ListUncategorizedTransactionsParams{
+		StartDate: start,
+		EndDate:   end,
+	})
+	if err != nil {
+		s.logger.Error("Failed to list uncategorized transactions", err, logger.Int64("family_id", authCtx.FamilyID))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to suggest categories"))
+	}
+
+	summary, err := s.RunSuggestions(ctx, authCtx.FamilyID, txns)
+	if err != nil {
+		s.logger.Error("Failed to suggest categories", err, logger.Int64("family_id", authCtx.FamilyID))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to suggest categories"))
+	}
+
+	s.logger.Info("Suggested transaction categories",
+		logger.Int64("family_id", authCtx.FamilyID),
+		logger.Int("transactions", len(txns)),
+		logger.Int("suggested", summary.Suggested),
+		logger.Int("applied", summary.Applied))
+
+	return connect.NewResponse(&v1.SuggestCategoriesResponse{
+		Suggested:        int32(summary.Suggested),
+		Applied:          int32(summary.Applied),
+		TrainingExamples: int32(summary.Examples),
+	}), nil
+}
+
+func (s *Service) DetectTransfers(ctx context.Context, req *connect.Request[v1.DetectTransfersRequest]) (*connect.Response[v1.DetectTransfersResponse], error) {
+	authCtx, err := appcontext.RequireFamily(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	since := recurrence.Date(time.Now()).AddDate(0, 0, -matchLookbackDays)
+	if req.Msg.SinceDate != nil {
+		since, err = recurrence.ParseDate(req.Msg.GetSinceDate())
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("since_date must be YYYY-MM-DD"))
+		}
+	}
+
+	pairs, err := s.RunTransferDetection(ctx, authCtx.FamilyID, since)
+	if err != nil {
+		s.logger.Error("Failed to detect transfers", err, logger.Int64("family_id", authCtx.FamilyID))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to detect transfers"))
+	}
+
+	return connect.NewResponse(&v1.DetectTransfersResponse{
+		Paired: int32(len(pairs)),
+	}), nil
+}
+
+func (s *Service) ListTransferPairs(ctx context.Context, req *connect.Request[v1.ListTransferPairsRequest]) (*connect.Response[v1.ListTransferPairsResponse], error) {
+	authCtx, err := appcontext.RequireFamily(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var status *string
+	if req.Msg.Status != v1.TransferPairStatus_TRANSFER_PAIR_STATUS_UNSPECIFIED {
+		name, ok := transferStatuses[req.Msg.Status]
+		if !ok {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid status"))
+		}
+		status = &name
+	}
+
+	limit := int64(defaultPageSize)
+	if req.Msg.PageSize > 0 {
+		limit = min(int64(req.Msg.PageSize), maxPageSize)
+	}
+
+	var cursor transferPairCursor
+	if req.Msg.PageToken != "" {
+		if err := s.pageTokens.Decode(req.Msg.PageToken, "transfer-pairs", &cursor); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid page_token"))
+		}
+	}
+
+	queries, err := s.dbManager.GetFamilyQueries(int(authCtx.FamilyID))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to access family database"))
+	}
+
+	pairs, err := queries.ListTransferPairs(ctx, familydb.ListTransferPairsParams{
+		Status:  status,
+		AfterID: cursor.ID,
+		Limit:   limit + 1, // fetch one extra row to detect another page
+	})
+	if err != nil {
+		s.logger.Error("Failed to list transfer pairs", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list transfer pairs"))
+	}
+
+	totalCount, err := queries.CountTransferPairs(ctx, status)
+	if err != nil {
+		s.logger.Error("Failed to count transfer pairs", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list transfer pairs"))
+	}
+
+	nextPageToken := ""
+	if int64(len(pairs)) > limit {
+		pairs = pairs[:limit]
+		nextPageToken, err = s.pageTokens.Encode("transfer-pairs", transferPairCursor{ID: pairs[len(pairs)-1].ID})
+		if err != nil {
+			s.logger.Error("Failed to encode page token", err)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list transfer pairs"))
+		}
+	}
+
+	resp := &v1.ListTransferPairsResponse{
+		Pairs:         make([]*v1.TransferPair, 0, len(pairs)),
+		NextPageToken: nextPageToken,
+		TotalCount:    totalCount,
+	}
+	for _, p := range pairs {
+		pair, err := transferPairToProto(ctx, queries, p)
+		if err != nil {
+			s.logger.Error("Failed to convert transfer pair", err, logger.Int64("pair_id", p.ID))
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list transfer pairs"))
+		}
+		resp.Pairs = append(resp.Pairs, pair)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *Service) ConfirmTransferPair(ctx context.Context, req *connect.Request[v1.ConfirmTransferPairRequest]) (*connect.Response[v1.ConfirmTransferPairResponse], error) {
+	pair, err := s.resolveTransferPair(ctx, req.Msg.Id, transferStatusConfirmed)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&v1.ConfirmTransferPairResponse{Pair: pair}), nil
+}
+
+func (s *Service) SplitTransferPair(ctx context.Context, req *connect.Request[v1.SplitTransferPairRequest]) (*connect.Response[v1.SplitTransferPairResponse], error) {
+	pair, err := s.resolveTransferPair(ctx, req.Msg.Id, transferStatusSplit)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&v1.SplitTransferPairResponse{Pair: pair}), nil
+}
+
+// resolveTransferPair confirms or splits a pair. Confirming marks both sides
+// as transfers again in case someone unmarked one; splitting unmarks both.
+// A split pair stays split.
+func (s *Service) resolveTransferPair(ctx context.Context, id int64, status string) (*v1.TransferPair, error) {
+	authCtx, err := appcontext.RequireFamily(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if id == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("id is required"))
+	}
+
+	var pair *v1.TransferPair
+	err = s.dbManager.WithFamilyTx(ctx, int(authCtx.FamilyID), func(q *familydb.Queries) error {
+		p, err := q.GetTransferPair(ctx, id)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return connect.NewError(connect.CodeNotFound, fmt.Errorf("transfer pair not found"))
+			}
+			return fmt.Errorf("failed to get transfer pair: %w", err)
+		}
+		if p.Status == transferStatusSplit {
+			return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("transfer pair was already split"))
+		}
+
+		if err := q.UpdateTransferPairStatus(ctx, familydb.UpdateTransferPairStatusParams{
+			Status:    status,
+			UpdatedAt: time.Now(),
+			ID:        p.ID,
+		}); err != nil {
+			return fmt.Errorf("failed to update transfer pair: %w", err)
+		}
+		p.Status = status
+		if err := markTransferPair(ctx, q, p.OutflowTransactionID, p.InflowTransactionID, status != transferStatusSplit); err != nil {
+			return err
+		}
+
+		pair, err = transferPairToProto(ctx, q, p)
+		return err
+	})
+	if err != nil {
+		var connectErr *connect.Error
+		if errors.As(err, &connectErr) {
+			return nil, err
+		}
+		s.logger.Error("Failed to resolve transfer pair", err, logger.Int64("pair_id", id))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to resolve transfer pair"))
+	}
+
+	s.logger.Info("Transfer pair resolved",
+		logger.Int64("pair_id", id),
+		logger.Str("status", status),
+		logger.Int64("user_id", authCtx.UserID))
+
+	return pair, nil
+}
+
+func (s *Service) SetTransactionSplits(ctx context.Context, req *connect.Request[v1.SetTransactionSplitsRequest]) (*connect.Response[v1.SetTransactionSplitsResponse], error) {
+	authCtx, err := appcontext.RequireFamily(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Msg.TransactionId == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("transaction_id is required"))
+	}
+
+	var pb *v1.AccountTransaction
+	err = s.dbManager.WithFamilyTx(ctx, int(authCtx.FamilyID), func(q *familydb.Queries) error {
+		txn, err := q.GetTransactionByID(ctx, req.Msg.TransactionId)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return connect.NewError(connect.CodeNotFound, fmt.Errorf("transaction not found"))
+			}
+			return fmt.Errorf("failed to get transaction: %w", err)
+		}
+
+		splits, err := splitsFromProto(ctx, q, txn, req.Msg.Splits)
+		if err != nil {
+			return err
+		}
+
+		if err := q.DeleteTransactionSplits(ctx, txn.ID); err != nil {
+			return fmt.Errorf("failed to delete transaction splits: %w", err)
+		}
+		for _, split := range splits {
+			if _, err := q.CreateTransactionSplit(ctx, split); err != nil {
+				return fmt.Errorf("failed to create transaction split: %w", err)
+			}
+		}
+
+		pb, err = transactionWithSplits(ctx, q, txn)
+		return err
+	})
+	if err != nil {
+		var connectErr *connect.Error
+		if errors.As(err, &connectErr) {
+			return nil, err
+		}
+		s.logger.Error("Failed to split transaction", err, logger.Int64("transaction_id", req.Msg.TransactionId))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to split transaction"))
+	}
+
+	s.logger.Info("Transaction splits set",
+		logger.Int64("transaction_id", req.Msg.TransactionId),
+		logger.Int("splits", len(req.Msg.Splits)),
+		logger.Int64("user_id", authCtx.UserID))
+
+	return connect.NewResponse(&v1.SetTransactionSplitsResponse{
+		Transaction: pb,
+	}), nil
+}
+
+func (s *Service) ImportStatement(ctx context.Context, req *connect.Request[v1.ImportStatementRequest]) (*connect.Response[v1.ImportStatementResponse], error) {
+	authCtx, err := appcontext.RequireFamily(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.Msg.Content) == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("content is required"))
+	}
+	if len(req.Msg.Content) > maxStatementBytes {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("statement files are limited to %d MiB", maxStatementBytes>>20))
+	}
+
+	queries, err := s.dbManager.GetFamilyQueries(int(authCtx.FamilyID))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to access family database"))
+	}
+
+	account, err := queries.GetAccountByID(ctx, req.Msg.AccountId)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("account not found"))
+		}
+		s.logger.Error("Failed to get account", err, logger.Int64("account_id", req.Msg.AccountId))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to import statement"))
+	}
+	if account.Source != accountSourceImport {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("statements can only be imported into import accounts; this account is synced from simplefin"))
+	}
+	if account.UnlinkedAt != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("account was removed"))
+	}
+
+	stmt, err := readStatement(ctx, queries, req.Msg)
+	if err != nil {
+		var connectErr *connect.Error
+		if errors.As(err, &connectErr) {
+			return nil, err
+		}
+		s.logger.Error("Failed to read statement", err, logger.Int64("account_id", account.ID))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to import statement"))
+	}
+
+	skip := make(map[int32]bool, len(req.Msg.SkipRows))
+	for _, row := range req.Msg.SkipRows {
+		skip[row] = true
+	}
+
+	resp := &v1.ImportStatementResponse{Confirmed: req.Msg.Confirm}
+	var created []*familydb.Transaction
+	if req.Msg.Confirm {
+		// Rows are checked against stored transactions again, in the same
+		// transaction that stores them
+		err = s.dbManager.WithFamilyTx(ctx, int(authCtx.FamilyID), func(q *familydb.Queries) error {
+			rows, err := previewStatement(ctx, q, account.ID, stmt, skip)
+			if err != nil {
+				return err
+			}
+			created, err = storeStatement(ctx, q, account.ID, stmt, rows, time.Now().UTC())
+			resp.Rows = rows
+			return err
+		})
+	} else {
+		resp.Rows, err = previewStatement(ctx, queries, account.ID, stmt, skip)
+	}
+	if err != nil {
+		s.logger.Error("Failed to import statement", err, logger.Int64("account_id", account.ID))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to import statement"))
+	}
+
+	for _, row := range resp.Rows {
+		switch row.Status {
+		case v1.StatementRowStatus_STATEMENT_ROW_STATUS_NEW:
+			resp.NewCount++
+		case v1.StatementRowStatus_STATEMENT_ROW_STATUS_DUPLICATE:
+			resp.DuplicateCount++
+		}
+	}
+	resp.Imported = int32(len(created))
+	if stmt.AccountID != "" {
+		resp.StatementAccountId = &stmt.AccountID
+	}
+	if b := stmt.Balance; b != nil {
+		balance, date := money.FormatCents(b.Cents), b.AsOf.Format(recurrence.DateLayout)
+		resp.Balance, resp.BalanceDate = &balance, &date
+	}
+
+	if len(created) > 0 {
+		since := created[0].PostedDate
+		for _, t := range created {
+			if t.PostedDate.Before(since) {
+				since = t.PostedDate
+			}
+		}
+		// The transactions are stored either way, so a failure here is not
+		// reported as a failed import
+		if err := s.processNewTransactions(ctx, authCtx.FamilyID, created, since, true); err != nil {
+			s.logger.Error("Failed to process imported transactions", err, logger.Int64("account_id", account.ID))
+		}
+
+		s.logger.Info("Statement imported",
+			logger.Int64("account_id", account.ID),
+			logger.Int("imported", len(created)),
+			logger.Int64("user_id", authCtx.UserID))
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+func (s *Service) CreateStatementMapping(ctx context.Context, req *connect.Request[v1.CreateStatementMappingRequest]) (*connect.Response[v1.CreateStatementMappingResponse], error) {
+	authCtx, err := appcontext.RequireFamily(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	queries, err := s.dbManager.GetFamilyQueries(int(authCtx.FamilyID))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to access family database"))
+	}
+
+	if req.Msg.Mapping != nil {
+		req.Msg.Mapping.Id = 0
+	}
+	p, err := statementMappingFromProto(ctx, queries, req.Msg.Mapping)
+	if err != nil {
+		var connectErr *connect.Error
+		if errors.As(err, &connectErr) {
+			return nil, err
+		}
+		s.logger.Error("Failed to validate statement mapping", err, logger.Int64("family_id", authCtx.FamilyID))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create statement mapping"))
+	}
+
+	now := time.Now()
+	m := p.mapping
+	mapping, err := queries.CreateStatementMapping(ctx, familydb.CreateStatementMappingParams{
+		Name:              p.name,
+		Delimiter:         string(m.Delimiter),
+		HasHeader:         m.HasHeader,
+		SkipRows:          int64(m.SkipRows),
+		DateColumn:        m.DateColumn,
+		DateFormat:        m.DateFormat,
+		DescriptionColumn: m.DescriptionColumn,
+		PayeeColumn:       stringOrNil(m.PayeeColumn),
+		AmountColumn:      stringOrNil(m.AmountColumn),
+		DebitColumn:       stringOrNil(m.DebitColumn),
+		CreditColumn:      stringOrNil(m.CreditColumn),
+		IDColumn:          stringOrNil(m.IDColumn),
+		NegateAmounts:     m.NegateAmounts,
+		CreatedAt:         now,
+		UpdatedAt:         now,
+	})
+	if err != nil {
+		s.logger.Error("Failed to create statement mapping", err, logger.Int64("family_id", authCtx.FamilyID))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create statement mapping"))
+	}
+
+	s.logger.Info("Statement mapping created",
+		logger.Int64("mapping_id", mapping.ID),
+		logger.Int64("family_id", authCtx.FamilyID))
+
+	return connect.NewResponse(&v1.CreateStatementMappingResponse{
+		Mapping: convertToProtoStatementMapping(mapping),
+	}), nil
+}
+
+func (s *Service) UpdateStatementMapping(ctx context.Context, req *connect.Request[v1.UpdateStatementMappingRequest]) (*connect.Response[v1.UpdateStatementMappingResponse], error) {
+	authCtx, err := appcontext.RequireFamily(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Msg.Mapping.GetId() == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("mapping id is required"))
+	}
+
+	queries, err := s.dbManager.GetFamilyQueries(int(authCtx.FamilyID))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to access family database"))
+	}
+
+	if _, err := queries.GetStatementMapping(ctx, req.Msg.Mapping.Id); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("mapping not found"))
+		}
+		s.logger.Error("Failed to get statement mapping", err, logger.Int64("mapping_id", req.Msg.Mapping.Id))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update statement mapping"))
+	}
+
+	p, err := statementMappingFromProto(ctx, queries, req.Msg.Mapping)
+	if err != nil {
+		var connectErr *connect.Error
+		if errors.As(err, &connectErr) {
+			return nil, err
+		}
+		s.logger.Error("Failed to validate statement mapping", err, logger.Int64("mapping_id", req.Msg.Mapping.Id))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update statement mapping"))
+	}
+
+	m := p.mapping
+	mapping, err := queries.UpdateStatementMapping(ctx, familydb.UpdateStatementMappingParams{
+		Name:              p.name,
+		Delimiter:         string(m.Delimiter),
+		HasHeader:         m.HasHeader,
+		SkipRows:          int64(m.SkipRows),
+		DateColumn:        m.DateColumn,
+		DateFormat:        m.DateFormat,
+		DescriptionColumn: m.DescriptionColumn,
+		PayeeColumn:       stringOrNil(m.PayeeColumn),
+		AmountColumn:      stringOrNil(m.AmountColumn),
+		DebitColumn:       stringOrNil(m.DebitColumn),
+		CreditColumn:      stringOrNil(m.CreditColumn),
+		IDColumn:          stringOrNil(m.IDColumn),
+		NegateAmounts:     m.NegateAmounts,
+		UpdatedAt:         time.Now(),
+		ID:                req.Msg.Mapping.Id,
+	})
+	if err != nil {
+		s.logger.Error("Failed to update statement mapping", err, logger.Int64("mapping_id", req.Msg.Mapping.Id))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update statement mapping"))
+	}
+
+	return connect.NewResponse(&v1.UpdateStatementMappingResponse{
+		Mapping: convertToProtoStatementMapping(mapping),
+	}), nil
+}
+
+func (s *Service) DeleteStatementMapping(ctx context.Context, req *connect.Request[v1.DeleteStatementMappingRequest]) (*connect.Response[v1.DeleteStatementMappingResponse], error) {
+	authCtx, err := appcontext.RequireFamily(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Msg.Id == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("id is required"))
+	}
+
+	queries, err := s.dbManager.GetFamilyQueries(int(authCtx.FamilyID))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to access family database"))
+	}
+
+	if err := queries.DeleteStatementMapping(ctx, req.Msg.Id); err != nil {
+		s.logger.Error("Failed to delete statement mapping", err, logger.Int64("mapping_id", req.Msg.Id))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to delete statement mapping"))
+	}
+
+	return connect.NewResponse(&v1.DeleteStatementMappingResponse{
+		Success: true,
+	}), nil
+}
+
+func (s *Service) ListStatementMappings(ctx context.Context, req *connect.Request[v1.ListStatementMappingsRequest]) (*connect.Response[v1.ListStatementMappingsResponse], error) {
+	authCtx, err := appcontext.RequireFamily(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	queries, err := s.dbManager.GetFamilyQueries(int(authCtx.FamilyID))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to access family database"))
+	}
+
+	rows, err := queries.ListStatementMappings(ctx)
+	if err != nil {
+		s.logger.Error("Failed to list statement mappings", err, logger.Int64("family_id", authCtx.FamilyID))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list statement mappings"))
+	}
+
+	resp := &v1.ListStatementMappingsResponse{Mappings: make([]*v1.StatementMapping, len(rows))}
+	for i, m := range rows {
+		resp.Mappings[i] = convertToProtoStatementMapping(m)
+	}
+	return connect.NewResponse(resp), nil
 }
@@ -0,0 +1,259 @@
+package transaction
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"expenses-backend/internal/database/sql/familydb"
+	"expenses-backend/internal/expense"
+	"expenses-backend/internal/logger"
+	"expenses-backend/internal/matcher"
+	"expenses-backend/internal/money"
+	"expenses-backend/internal/recurrence"
+	v1 "expenses-backend/pkg/transaction/v1"
+)
+
+const (
+	matchStatusPending  = "pending"
+	matchStatusAccepted = "accepted"
+	matchStatusRejected = "rejected"
+
+	// matchLookbackDays is how far back MatchTransactions looks by default
+	matchLookbackDays = 60
+)
+
+// MatchSummary counts what a matching run did
+type MatchSummary struct {
+	Matched int // Confident matches marked paid
+	Queued  int // Matches added to the review queue
+}
+
+// RunMatcher compares unmatched outgoing transactions posted on or
+// after since to expense occurrences. Confident matches mark the occurrence
+// paid and link the transaction; weaker ones are queued for review.
+func (s *Service) RunMatcher(ctx context.Context, familyID int64, since time.Time) (MatchSummary, error) {
+	var summary MatchSummary
+
+	queries, err := s.dbManager.GetFamilyQueries(int(familyID))
+	if err != nil {
+		return summary, err
+	}
+
+	txns, err := queries.ListUnmatchedTransactions(ctx, recurrence.Date(since))
+	if err != nil {
+		return summary, fmt.Errorf("failed to list unmatched transactions: %w", err)
+	}
+	if len(txns) == 0 {
+		return summary, nil
+	}
+
+	cfg := matcher.DefaultConfig
+	latest := txns[len(txns)-1].PostedDate
+	occurrences, err := s.expenseService.Occurrences(ctx, familyID,
+		recurrence.Date(since).AddDate(0, 0, -cfg.DaysLate),
+		recurrence.Date(latest).AddDate(0, 0, cfg.DaysEarly))
+	if err != nil {
+		return summary, fmt.Errorf("failed to compute expense occurrences: %w", err)
+	}
+
+	// An occurrence already backed by a transaction cannot match another one
+	claimed := make([]bool, len(occurrences))
+	for i, o := range occurrences {
+		claimed[i] = o.Payment != nil && o.Payment.TransactionID != nil
+	}
+
+	for _, txn := range txns {
+		var candidates []matcher.Candidate
+		var index []int
+		for i, o := range occurrences {
+			if claimed[i] {
+				continue
+			}
+			candidates = append(candidates, matcher.Candidate{
+				Name:        o.Expense.Name,
+				AmountCents: money.FromFloat(o.Expense.Amount),
+				DueDate:     o.DueDate,
+			})
+			index = append(index, i)
+		}
+
+		m, ok := cfg.Best(matcher.Transaction{
+			Payee:       txn.Payee,
+			Description: txn.Description,
+			AmountCents: txn.AmountCents,
+			PostedDate:  recurrence.Date(txn.PostedDate),
+		}, candidates)
+		if !ok {
+			continue
+		}
+		o := occurrences[index[m.Index]]
+
+		// A pairing that was already queued or rejected is left to people
+		_, err := queries.GetTransactionMatchByPair(ctx, familydb.GetTransactionMatchByPairParams{
+			TransactionID: txn.ID,
+			ExpenseID:     o.Expense.ID,
+			ScheduledDate: o.ScheduledDate,
+		})
+		if err == nil {
+			continue
+		}
+		if err != sql.ErrNoRows {
+			return summary, fmt.Errorf("failed to check existing match: %w", err)
+		}
+
+		// Someone explicitly marked the occurrence unpaid, so ask before changing it
+		overridden := o.Payment != nil && o.Payment.Status == expense.PaymentStatusUnpaid
+		if m.Score >= cfg.AutoThreshold && !overridden {
+			if err := s.applyMatch(ctx, familyID, txn, o.Expense.ID, o.ScheduledDate, m.Score); err != nil {
+				return summary, err
+			}
+			claimed[index[m.Index]] = true
+			summary.Matched++
+			continue
+		}
+
+		now := time.Now()
+		queued, err := queries.CreateTransactionMatch(ctx, familydb.CreateTransactionMatchParams{
+			TransactionID: txn.ID,
+			ExpenseID:     o.Expense.ID,
+			ScheduledDate: o.ScheduledDate,
+			Score:         m.Score,
+			Status:        matchStatusPending,
+			CreatedAt:     now,
+			UpdatedAt:     now,
+		})
+		if err != nil {
+			return summary, fmt.Errorf("failed to queue match for review: %w", err)
+		}
+		summary.Queued += int(queued)
+	}
+
+	s.logger.Info("Matched transactions to expenses",
+		logger.Int64("family_id", familyID),
+		logger.Int("transactions", len(txns)),
+		logger.Int("matched", summary.Matched),
+		logger.Int("queued", summary.Queued))
+
+	return summary, nil
+}
+
+// applyMatch marks the occurrence paid by the transaction and links them
+func (s *Service) applyMatch(ctx context.Context, familyID int64, txn *familydb.Transaction, expenseID int64, scheduledDate time.Time, score float64) error {
+	err := s.dbManager.WithFamilyTx(ctx, int(familyID), func(q *familydb.Queries) error {
+		return linkMatch(ctx, q, txn, expenseID, scheduledDate, score)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to apply match: %w", err)
+	}
+	return nil
+}
+
+// linkMatch records the transaction as the payment of the occurrence, links
+// them and settles the transaction's review queue; run it in a transaction
+func linkMatch(ctx context.Context, q *familydb.Queries, txn *familydb.Transaction, expenseID int64, scheduledDate time.Time, score float64) error {
+	now := time.Now()
+	paidDate := recurrence.Date(txn.PostedDate)
+	amount := money.ToFloat(-txn.AmountCents)
+
+	if _, err := q.RecordTransactionPayment(ctx, familydb.RecordTransactionPaymentParams{
+		ExpenseID:     expenseID,
+		ScheduledDate: scheduledDate,
+		PaidDate:      &paidDate,
+		Amount:        &amount,
+		TransactionID: &txn.ID,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}); err != nil {
+		return err
+	}
+
+	if err := q.LinkTransactionToExpense(ctx, familydb.LinkTransactionToExpenseParams{
+		MatchedExpenseID:     &expenseID,
+		MatchedScheduledDate: &scheduledDate,
+		ID:                   txn.ID,
+	}); err != nil {
+		return err
+	}
+
+	if _, err := q.UpsertTransactionMatch(ctx, familydb.UpsertTransactionMatchParams{
+		TransactionID: txn.ID,
+		ExpenseID:     expenseID,
+		ScheduledDate: scheduledDate,
+		Score:         score,
+		Status:        matchStatusAccepted,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}); err != nil {
+		return err
+	}
+
+	// Other suggestions for this transaction are now moot
+	return q.RejectPendingTransactionMatches(ctx, familydb.RejectPendingTransactionMatchesParams{
+		UpdatedAt:     now,
+		TransactionID: txn.ID,
+	})
+}
+
+// unlinkMatch removes the payment the transaction recorded and its link to
+// an expense. A recorded pairing is marked rejected so matching won't restore it.
+func unlinkMatch(ctx context.Context, q *familydb.Queries, txn *familydb.Transaction) error {
+	if txn.MatchedExpenseID == nil || txn.MatchedScheduledDate == nil {
+		return nil
+	}
+
+	if err := q.DeletePaymentByTransaction(ctx, &txn.ID); err != nil {
+		return err
+	}
+
+	if err := q.LinkTransactionToExpense(ctx, familydb.LinkTransactionToExpenseParams{
+		ID: txn.ID,
+	}); err != nil {
+		return err
+	}
+
+	match, err := q.GetTransactionMatchByPair(ctx, familydb.GetTransactionMatchByPairParams{
+		TransactionID: txn.ID,
+		ExpenseID:     *txn.MatchedExpenseID,
+		ScheduledDate: *txn.MatchedScheduledDate,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil
+		}
+		return err
+	}
+	return q.UpdateTransactionMatchStatus(ctx, familydb.UpdateTransactionMatchStatusParams{
+		Status:    matchStatusRejected,
+		UpdatedAt: time.Now(),
+		ID:        match.ID,
+	})
+}
+
+// convertToProtoAccountTransaction converts SQLC transaction to protobuf
+func convertToProtoAccountTransaction(t *familydb.Transaction) *v1.AccountTransaction {
+	pb := &v1.AccountTransaction{
+		Id:                   t.ID,
+		AccountId:            t.AccountID,
+		PostedDate:           t.PostedDate.Format(recurrence.DateLayout),
+		Description:          t.Description,
+		Payee:                t.Payee,
+		Amount:               money.FormatCents(t.AmountCents),
+		MatchedExpenseId:     t.MatchedExpenseID,
+		Pending:              t.Pending,
+		CategoryId:           t.CategoryID,
+		Note:                 t.Note,
+		ExternalId:           t.ExternalID,
+		TransactedAt:         timestampOrNil(t.TransactedAt),
+		DisplayPayee:         t.DisplayPayee,
+		IsTransfer:           t.IsTransfer,
+		SuggestedCategoryId:  t.SuggestedCategoryID,
+		SuggestionConfidence: t.SuggestionConfidence,
+	}
+	if t.MatchedScheduledDate != nil {
+		date := t.MatchedScheduledDate.Format(recurrence.DateLayout)
+		pb.MatchedScheduledDate = &date
+	}
+	return pb
+}
@@ -0,0 +1,473 @@
+package transaction
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"expenses-backend/internal/database/sql/familydb"
+	"expenses-backend/internal/expense"
+	"expenses-backend/internal/logger"
+	"expenses-backend/internal/matcher"
+	"expenses-backend/internal/money"
+	"expenses-backend/internal/recurrence"
+	"expenses-backend/internal/rules"
+	v1 "expenses-backend/pkg/transaction/v1"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// defaultRulePreviewLimit and maxRulePreviewLimit bound the changes
+	// PreviewRule returns
+	defaultRulePreviewLimit = 50
+	maxRulePreviewLimit     = 500
+)
+
+var ruleMatchFields = map[rules.Field]v1.RuleMatchField{
+	rules.FieldPayee:       v1.RuleMatchField_RULE_MATCH_FIELD_PAYEE,
+	rules.FieldDescription: v1.RuleMatchField_RULE_MATCH_FIELD_DESCRIPTION,
+	rules.FieldAny:         v1.RuleMatchField_RULE_MATCH_FIELD_ANY,
+}
+
+var ruleMatchTypes = map[rules.MatchType]v1.RuleMatchType{
+	rules.MatchContains: v1.RuleMatchType_RULE_MATCH_TYPE_CONTAINS,
+	rules.MatchRegex:    v1.RuleMatchType_RULE_MATCH_TYPE_REGEX,
+}
+
+// RuleSummary counts what running rules over transactions did
+type RuleSummary struct {
+	Matched int // Transactions at least one rule matched
+	Updated int // Transactions the rules changed
+}
+
+// ruleFromRow converts a stored rule for the rules package
+func ruleFromRow(r *familydb.TransactionRule) rules.Rule {
+	return rules.Rule{
+		ID: r.ID,
+		Conditions: rules.Conditions{
+			Field:     rules.Field(r.MatchField),
+			Match:     rules.MatchType(r.MatchType),
+			Pattern:   r.Pattern,
+			MinCents:  r.MinAmountCents,
+			MaxCents:  r.MaxAmountCents,
+			AccountID: r.AccountID,
+		},
+		Actions: rules.Actions{
+			CategoryID: r.SetCategoryID,
+			Payee:      r.SetPayee,
+			ExpenseID:  r.LinkExpenseID,
+			Transfer:   r.MarkTransfer,
+		},
+	}
+}
+
+// activeRules compiles the family's active rules in the order they run.
+// Rules are validated when saved, so one that no longer compiles is logged
+// and skipped rather than failing the run.
+func (s *Service) activeRules(ctx context.Context, q *familydb.Queries) ([]*rules.Compiled, error) {
+	rows, err := q.ListTransactionRules(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list rules: %w", err)
+	}
+
+	var compiled []*rules.Compiled
+	for _, row := range rows {
+		if !row.IsActive {
+			continue
+		}
+		c, err := rules.Compile(ruleFromRow(row))
+		if err != nil {
+			s.logger.Warn("Skipping invalid transaction rule", err, logger.Int64("rule_id", row.ID))
+			continue
+		}
+		compiled = append(compiled, c)
+	}
+	return compiled, nil
+}
+
+func ruleTransaction(t *familydb.Transaction) rules.Transaction {
+	return rules.Transaction{
+		AccountID:   t.AccountID,
+		Payee:       t.Payee,
+		Description: t.Description,
+		AmountCents: t.AmountCents,
+	}
+}
+
+// ruleChange is what rules do to a single transaction
+type ruleChange struct {
+	txn          *familydb.Transaction
+	categoryID   *int64
+	displayPayee *string
+	isTransfer   bool
+	link         *expenseLink
+}
+
+// changed reports whether the change differs from the stored transaction
+func (c ruleChange) changed() bool {
+	return c.link != nil || !sameInt64(c.categoryID, c.txn.CategoryID) ||
+		!sameString(c.displayPayee, c.txn.DisplayPayee) || c.isTransfer != c.txn.IsTransfer
+}
+
+// applied returns the transaction as it looks with the change made
+func (c ruleChange) applied() *familydb.Transaction {
+	t := *c.txn
+	t.CategoryID = c.categoryID
+	t.DisplayPayee = c.displayPayee
+	t.IsTransfer = c.isTransfer
+	if !sameInt64(c.categoryID, c.txn.CategoryID) {
+		t.SuggestedCategoryID, t.SuggestionConfidence = nil, nil
+	}
+	if c.link != nil {
+		t.MatchedExpenseID = &c.link.expenseID
+		t.MatchedScheduledDate = &c.link.scheduledDate
+	}
+	return &t
+}
+
+// ruleLinker finds the expense occurrences rules link transactions to.
+// Occurrences are loaded on first use, since most rules don't link.
+type ruleLinker struct {
+	load        func() ([]expense.Occurrence, error)
+	occurrences []expense.Occurrence
+	claimed     []bool
+	loaded      bool
+}
+
+// newRuleLinker looks for occurrences around transactions posted in [from, to]
+func (s *Service) newRuleLinker(ctx context.Context, familyID int64, from, to time.Time) *ruleLinker {
+	cfg := matcher.DefaultConfig
+	return &ruleLinker{load: func() ([]expense.Occurrence, error) {
+		return s.expenseService.Occurrences(ctx, familyID,
+			recurrence.Date(from).AddDate(0, 0, -cfg.DaysLate),
+			recurrence.Date(to).AddDate(0, 0, cfg.DaysEarly))
+	}}
+}
+
+// find returns the occurrence of the expense due nearest to the transaction's
+// posted date that no transaction pays yet, or nil when there is none within
+// the matcher's window. The occurrence is claimed so no other transaction of
+// the run gets it.
+func (l *ruleLinker) find(txn *familydb.Transaction, expenseID int64) (*expenseLink, error) {
+	if !l.loaded {
+		occurrences, err := l.load()
+		if err != nil {
+			return nil, fmt.Errorf("failed to compute expense occurrences: %w", err)
+		}
+		l.occurrences = occurrences
+		l.claimed = make([]bool, len(occurrences))
+		for i, o := range occurrences {
+			l.claimed[i] = o.Payment != nil && o.Payment.TransactionID != nil
+		}
+		l.loaded = true
+	}
+
+	cfg := matcher.DefaultConfig
+	posted := recurrence.Date(txn.PostedDate)
+	best := -1
+	var bestDistance time.Duration
+	for i, o := range l.occurrences {
+		if l.claimed[i] || o.Expense.ID != expenseID {
+			continue
+		}
+		if o.DueDate.Before(posted.AddDate(0, 0, -cfg.DaysLate)) || o.DueDate.After(posted.AddDate(0, 0, cfg.DaysEarly)) {
+			continue
+		}
+		distance := posted.Sub(o.DueDate).Abs()
+		if best < 0 || distance < bestDistance {
+			best, bestDistance = i, distance
+		}
+	}
+	if best < 0 {
+		return nil, nil
+	}
+	l.claimed[best] = true
+	return &expenseLink{expenseID: expenseID, scheduledDate: l.occurrences[best].ScheduledDate}, nil
+}
+
+// planRuleChange works out what the rules' result does to the transaction.
+// A category or display payee that is already set is only replaced when
+// overwrite is set. Only posted outgoing transactions that are not linked yet
+// are linked to an expense.
+func planRuleChange(txn *familydb.Transaction, res rules.Result, overwrite bool, linker *ruleLinker) (ruleChange, error) {
+	c := ruleChange{
+		txn:          txn,
+		categoryID:   txn.CategoryID,
+		displayPayee: txn.DisplayPayee,
+		isTransfer:   txn.IsTransfer || res.Transfer,
+	}
+	if res.CategoryID != nil && (c.categoryID == nil || overwrite) {
+		c.categoryID = res.CategoryID
+	}
+	if res.Payee != nil && (c.displayPayee == nil || overwrite) {
+		c.displayPayee = res.Payee
+	}
+	if res.ExpenseID != nil && txn.MatchedExpenseID == nil && txn.AmountCents < 0 && !txn.Pending {
+		link, err := linker.find(txn, *res.ExpenseID)
+		if err != nil {
+			return c, err
+		}
+		c.link = link
+	}
+	return c, nil
+}
+
+// saveRuleChange writes a planned change; run it in a transaction
+func saveRuleChange(ctx context.Context, q *familydb.Queries, c ruleChange) error {
+	if c.link != nil {
+		if err := linkMatch(ctx, q, c.txn, c.link.expenseID, c.link.scheduledDate, 1); err != nil {
+			return fmt.Errorf("failed to link expense: %w", err)
+		}
+	}
+	// A suggested category no longer applies once a rule picked another
+	if !sameInt64(c.categoryID, c.txn.CategoryID) {
+		if err := q.ClearTransactionSuggestion(ctx, c.txn.ID); err != nil {
+			return fmt.Errorf("failed to clear category suggestion: %w", err)
+		}
+	}
+	if _, err := q.UpdateTransactionDetails(ctx, familydb.UpdateTransactionDetailsParams{
+		CategoryID:   c.categoryID,
+		Note:         c.txn.Note,
+		DisplayPayee: c.displayPayee,
+		IsTransfer:   c.isTransfer,
+		ID:           c.txn.ID,
+	}); err != nil {
+		return fmt.Errorf("failed to update transaction: %w", err)
+	}
+	return nil
+}
+
+// RunRules runs the family's active rules over the transactions and saves
+// what they change. The transactions are updated in place with the changes.
+func (s *Service) RunRules(ctx context.Context, familyID int64, txns []*familydb.Transaction, overwrite bool) (RuleSummary, error) {
+	var summary RuleSummary
+	if len(txns) == 0 {
+		return summary, nil
+	}
+
+	queries, err := s.dbManager.GetFamilyQueries(int(familyID))
+	if err != nil {
+		return summary, err
+	}
+	compiled, err := s.activeRules(ctx, queries)
+	if err != nil || len(compiled) == 0 {
+		return summary, err
+	}
+
+	from, to := postedRange(txns)
+	linker := s.newRuleLinker(ctx, familyID, from, to)
+	var changes []ruleChange
+	for _, txn := range txns {
+		res := rules.Apply(compiled, ruleTransaction(txn))
+		if len(res.Matched) == 0 {
+			continue
+		}
+		summary.Matched++
+
+		c, err := planRuleChange(txn, res, overwrite, linker)
+		if err != nil {
+			return summary, err
+		}
+		if c.changed() {
+			changes = append(changes, c)
+		}
+	}
+	if len(changes) == 0 {
+		return summary, nil
+	}
+
+	err = s.dbManager.WithFamilyTx(ctx, int(familyID), func(q *familydb.Queries) error {
+		for _, c := range changes {
+			if err := saveRuleChange(ctx, q, c); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return summary, fmt.Errorf("failed to apply rules: %w", err)
+	}
+	for _, c := range changes {
+		*c.txn = *c.applied()
+	}
+	summary.Updated = len(changes)
+	return summary, nil
+}
+
+// postedRange returns the first and last posted date of the transactions
+func postedRange(txns []*familydb.Transaction) (time.Time, time.Time) {
+	from, to := txns[0].PostedDate, txns[0].PostedDate
+	for _, t := range txns[1:] {
+		if t.PostedDate.Before(from) {
+			from = t.PostedDate
+		}
+		if t.PostedDate.After(to) {
+			to = t.PostedDate
+		}
+	}
+	return from, to
+}
+
+// ruleDateRange validates the optional date range of ApplyRules and
+// PreviewRule, returning an exclusive end
+func ruleDateRange(startDate, endDate *string) (*time.Time, *time.Time, error) {
+	var start, end *time.Time
+	if startDate != nil {
+		d, err := recurrence.ParseDate(*startDate)
+		if err != nil {
+			return nil, nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("start_date must be YYYY-MM-DD"))
+		}
+		start = &d
+	}
+	if endDate != nil {
+		d, err := recurrence.ParseDate(*endDate)
+		if err != nil {
+			return nil, nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("end_date must be YYYY-MM-DD"))
+		}
+		// Posted dates carry a time, so include the whole end day
+		d = d.AddDate(0, 0, 1)
+		end = &d
+	}
+	if start != nil && end != nil && !start.Before(*end) {
+		return nil, nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("start_date must not be after end_date"))
+	}
+	return start, end, nil
+}
+
+// ruleParams are the stored fields of a rule
+type ruleParams struct {
+	name     string
+	position int64
+	isActive bool
+	rule     rules.Rule
+}
+
+// ruleFromProto validates a rule from the API, checking that the category,
+// expense and account it refers to exist
+func ruleFromProto(ctx context.Context, q *familydb.Queries, pb *v1.TransactionRule) (ruleParams, error) {
+	if pb == nil {
+		return ruleParams{}, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("rule is required"))
+	}
+
+	p := ruleParams{
+		name:     strings.TrimSpace(pb.Name),
+		position: int64(pb.Position),
+		isActive: pb.IsActive,
+		rule: rules.Rule{
+			ID: pb.Id,
+			Conditions: rules.Conditions{
+				Field:     rules.FieldAny,
+				Match:     rules.MatchContains,
+				Pattern:   pb.Pattern,
+				AccountID: pb.AccountId,
+			},
+			Actions: rules.Actions{
+				CategoryID: pb.SetCategoryId,
+				ExpenseID:  pb.LinkExpenseId,
+				Transfer:   pb.MarkTransfer,
+			},
+		},
+	}
+	if p.name == "" {
+		return ruleParams{}, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name is required"))
+	}
+	for field, protoField := range ruleMatchFields {
+		if protoField == pb.MatchField {
+			p.rule.Conditions.Field = field
+		}
+	}
+	for matchType, protoType := range ruleMatchTypes {
+		if protoType == pb.MatchType {
+			p.rule.Conditions.Match = matchType
+		}
+	}
+	if payee := strings.TrimSpace(pb.GetSetPayee()); payee != "" {
+		p.rule.Actions.Payee = &payee
+	}
+
+	var err error
+	if p.rule.Conditions.MinCents, err = parseOptionalCents(pb.MinAmount); err != nil {
+		return ruleParams{}, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("min_amount must be a decimal amount"))
+	}
+	if p.rule.Conditions.MaxCents, err = parseOptionalCents(pb.MaxAmount); err != nil {
+		return ruleParams{}, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("max_amount must be a decimal amount"))
+	}
+	if _, err := rules.Compile(p.rule); err != nil {
+		return ruleParams{}, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if id := pb.AccountId; id != nil {
+		if _, err := q.GetAccountByID(ctx, *id); err != nil {
+			return ruleParams{}, ruleReferenceError(err, "account", *id)
+		}
+	}
+	if id := pb.SetCategoryId; id != nil {
+		if _, err := q.GetCategoryByID(ctx, *id); err != nil {
+			return ruleParams{}, ruleReferenceError(err, "category", *id)
+		}
+	}
+	if id := pb.LinkExpenseId; id != nil {
+		if _, err := q.GetExpenseByID(ctx, *id); err != nil {
+			return ruleParams{}, ruleReferenceError(err, "expense", *id)
+		}
+	}
+	return p, nil
+}
+
+func ruleReferenceError(err error, kind string, id int64) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%s %d not found", kind, id))
+	}
+	return fmt.Errorf("failed to get %s: %w", kind, err)
+}
+
+func parseOptionalCents(s *string) (*int64, error) {
+	if s == nil {
+		return nil, nil
+	}
+	cents, err := money.ParseCents(*s)
+	if err != nil {
+		return nil, err
+	}
+	return &cents, nil
+}
+
+func convertToProtoRule(r *familydb.TransactionRule) *v1.TransactionRule {
+	return &v1.TransactionRule{
+		Id:            r.ID,
+		Name:          r.Name,
+		Position:      int32(r.Position),
+		IsActive:      r.IsActive,
+		MatchField:    ruleMatchFields[rules.Field(r.MatchField)],
+		MatchType:     ruleMatchTypes[rules.MatchType(r.MatchType)],
+		Pattern:       r.Pattern,
+		AccountId:     r.AccountID,
+		SetCategoryId: r.SetCategoryID,
+		SetPayee:      r.SetPayee,
+		LinkExpenseId: r.LinkExpenseID,
+		MarkTransfer:  r.MarkTransfer,
+		MinAmount:     optionalCents(r.MinAmountCents),
+		MaxAmount:     optionalCents(r.MaxAmountCents),
+		CreatedAt:     timestamppb.New(r.CreatedAt),
+		UpdatedAt:     timestamppb.New(r.UpdatedAt),
+	}
+}
+
+func sameInt64(a, b *int64) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func sameString(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -4,36 +4,120 @@ import (
 	"context"
 	"errors"
 	"expenses-backend/internal/simplefin"
+	"fmt"
+
+	v1 "expenses-backend/pkg/transaction/v1"
+
+	"connectrpc.com/connect"
 )
 
+// simplefinTokenKey is the family setting holding the SimpleFIN access token
+const simplefinTokenKey = "simplefin_token"
+
+// getSimplefinClient returns the family's client from the connection
+// registry. It fails with simplefin.ErrNotConnected when no token is stored.
 func (s *Service) getSimplefinClient(ctx context.Context, familyID int64) (*simplefin.Client, error) {
-	if client, ok := s.fin[familyID]; ok {
-		return client, nil
-	}
+	return s.connections.Client(ctx, familyID)
+}
 
-	queries, err := s.dbManager.GetFamilyQueries(int(familyID))
+// loadSimplefinToken reads the family's access token for the registry
+func (s *Service) loadSimplefinToken(ctx context.Context, familyID int64) (*string, error) {
+	return s.familyService.Secret(ctx, int(familyID), simplefinTokenKey)
+}
+
+// storeSimplefinToken saves the access token in the family's encrypted
+// settings, replacing any earlier one; a nil token removes it. The registry
+// picks up the change through the family service's setting hook.
+func (s *Service) storeSimplefinToken(ctx context.Context, familyID int64, token *string) error {
+	return s.familyService.SetSecret(ctx, int(familyID), simplefinTokenKey, token)
+}
+
+// simplefinHealth reports the family's connection health for RPC responses
+func (s *Service) simplefinHealth(ctx context.Context, familyID int64) (*v1.SimplefinHealth, error) {
+	health, err := s.connections.Health(ctx, familyID)
 	if err != nil {
 		return nil, err
 	}
 
-	res, err := queries.GetFamilySettingByKey(ctx, "simplefin_token")
-	if err != nil {
-		return nil, err
+	pb := &v1.SimplefinHealth{
+		State:         simplefinHealthStates[health.State],
+		LastSuccessAt: optionalTimestamp(health.LastSuccessAt),
+		LastErrorAt:   optionalTimestamp(health.LastErrorAt),
+		AuthFailures:  int32(health.AuthFailures),
+		RetryAt:       optionalTimestamp(health.RetryAt),
+	}
+	if health.LastError != "" {
+		pb.LastError = &health.LastError
 	}
-	if res.SettingValue == nil || *res.SettingValue == "" {
-		return nil, errors.New("simplefin_token not set")
+	return pb, nil
+}
+
+var simplefinHealthStates = map[simplefin.HealthState]v1.SimplefinHealthState{
+	simplefin.HealthNotConnected: v1.SimplefinHealthState_SIMPLEFIN_HEALTH_STATE_NOT_CONNECTED,
+	simplefin.HealthUnchecked:    v1.SimplefinHealthState_SIMPLEFIN_HEALTH_STATE_UNCHECKED,
+	simplefin.HealthOK:           v1.SimplefinHealthState_SIMPLEFIN_HEALTH_STATE_HEALTHY,
+	simplefin.HealthFailing:      v1.SimplefinHealthState_SIMPLEFIN_HEALTH_STATE_FAILING,
+	simplefin.HealthAuthFailed:   v1.SimplefinHealthState_SIMPLEFIN_HEALTH_STATE_AUTH_FAILED,
+}
+
+// simplefinError converts an error from the connection registry or a
+// SimpleFIN request into an RPC error the user can act on
+func simplefinError(err error, msg string) error {
+	switch {
+	case errors.Is(err, simplefin.ErrNotConnected):
+		return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("simplefin is not connected"))
+	case errors.Is(err, simplefin.ErrAuthBackoff):
+		return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("%w: reconnect simplefin with a new setup token", err))
+	case errors.Is(err, simplefin.ErrUnauthorized):
+		return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("simplefin rejected the access token: reconnect simplefin with a new setup token"))
 	}
+	return connect.NewError(connect.CodeUnavailable, fmt.Errorf("%s", msg))
+}
 
-	c, err := simplefin.NewClient(*res.SettingValue)
+// simplefinConnection describes the family's SimpleFIN connection. With check
+// set it calls SimpleFIN and reports the accounts and errors it returns.
+func (s *Service) simplefinConnection(ctx context.Context, familyID int64, check bool) (*v1.SimplefinConnection, error) {
+	queries, err := s.dbManager.GetFamilyQueries(int(familyID))
 	if err != nil {
 		return nil, err
 	}
 
-	s.mu.Lock()
+	conn := &v1.SimplefinConnection{}
+	client, err := s.getSimplefinClient(ctx, familyID)
+	switch {
+	case errors.Is(err, simplefin.ErrNotConnected):
+		conn.Health = &v1.SimplefinHealth{State: v1.SimplefinHealthState_SIMPLEFIN_HEALTH_STATE_NOT_CONNECTED}
+		return conn, nil
+	case errors.Is(err, simplefin.ErrAuthBackoff):
+		// Still connected, but SimpleFIN is not called until the pause ends
+		check = false
+	case err != nil:
+		return nil, err
+	default:
+		conn.Server = client.BaseURL.Host
+	}
+	conn.Connected = true
 
-	s.fin[familyID] = c
+	linked, err := queries.GetAccounts(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get accounts: %w", err)
+	}
+	conn.LinkedAccounts = int32(len(linked))
 
-	s.mu.Unlock()
+	if check {
+		accounts, err := client.Accounts(ctx)
+		s.connections.Report(familyID, client, err)
+		if err != nil {
+			conn.Errors = append(conn.Errors, err.Error())
+		} else {
+			available := int32(len(accounts.Accounts))
+			conn.AvailableAccounts = &available
+			conn.Errors = append(conn.Errors, accounts.Errors...)
+		}
+	}
 
-	return c, nil
+	if conn.Health, err = s.simplefinHealth(ctx, familyID); err != nil {
+		return nil, err
+	}
+	return conn, nil
 }
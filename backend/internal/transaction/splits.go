@@ -0,0 +1,144 @@
+package transaction
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"expenses-backend/internal/database/sql/familydb"
+	"expenses-backend/internal/money"
+	v1 "expenses-backend/pkg/transaction/v1"
+
+	"connectrpc.com/connect"
+)
+
+// convertToProtoSplit converts SQLC transaction split to protobuf
+func convertToProtoSplit(s *familydb.TransactionSplit) *v1.TransactionSplit {
+	return &v1.TransactionSplit{
+		Id:         s.ID,
+		Amount:     money.FormatCents(s.AmountCents),
+		CategoryId: s.CategoryID,
+		Note:       s.Note,
+		MemberId:   s.MemberID,
+	}
+}
+
+// transactionWithSplits converts a transaction along with its allocations
+func transactionWithSplits(ctx context.Context, q *familydb.Queries, txn *familydb.Transaction) (*v1.AccountTransaction, error) {
+	splits, err := q.ListTransactionSplits(ctx, txn.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list transaction splits: %w", err)
+	}
+	pb := convertToProtoAccountTransaction(txn)
+	for _, s := range splits {
+		pb.Splits = append(pb.Splits, convertToProtoSplit(s))
+	}
+	return pb, nil
+}
+
+// attachSplits adds their allocations to a page of transactions ordered by
+// posted date, newest first, loading the allocations of the page's dates at once
+func attachSplits(ctx context.Context, q *familydb.Queries, txns []*familydb.Transaction, pbTxns []*v1.AccountTransaction) error {
+	if len(txns) == 0 {
+		return nil
+	}
+	splits, err := q.ListTransactionSplitsByPostedDate(ctx, familydb.ListTransactionSplitsByPostedDateParams{
+		StartDate: txns[len(txns)-1].PostedDate,
+		EndDate:   txns[0].PostedDate,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list transaction splits: %w", err)
+	}
+
+	byTransaction := make(map[int64][]*v1.TransactionSplit)
+	for _, s := range splits {
+		byTransaction[s.TransactionID] = append(byTransaction[s.TransactionID], convertToProtoSplit(s))
+	}
+	for _, pb := range pbTxns {
+		pb.Splits = byTransaction[pb.Id]
+	}
+	return nil
+}
+
+// splitsFromProto validates the requested allocations of a transaction: at
+// least two, each with a non-zero amount and existing category and member, and
+// adding up to the transaction amount
+func splitsFromProto(ctx context.Context, q *familydb.Queries, txn *familydb.Transaction, pbSplits []*v1.TransactionSplit) ([]familydb.CreateTransactionSplitParams, error) {
+	params, err := buildSplits(txn, pbSplits, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, split := range params {
+		if split.CategoryID != nil {
+			if _, err := q.GetCategoryByID(ctx, *split.CategoryID); err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("allocation %d: category not found", split.Position+1))
+				}
+				return nil, fmt.Errorf("failed to get category: %w", err)
+			}
+		}
+		if split.MemberID != nil {
+			if _, err := q.GetFamilyMemberByID(ctx, *split.MemberID); err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("allocation %d: member %d is not in the family", split.Position+1, *split.MemberID))
+				}
+				return nil, fmt.Errorf("failed to get family member: %w", err)
+			}
+		}
+	}
+	return params, nil
+}
+
+// buildSplits turns requested allocations into rows in request order, checking
+// everything that does not need the database. No allocations clears a split.
+func buildSplits(txn *familydb.Transaction, pbSplits []*v1.TransactionSplit, now time.Time) ([]familydb.CreateTransactionSplitParams, error) {
+	if len(pbSplits) == 1 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("a split needs at least two allocations"))
+	}
+
+	var total int64
+	params := make([]familydb.CreateTransactionSplitParams, 0, len(pbSplits))
+	for i, pb := range pbSplits {
+		amount, err := money.ParseCents(pb.Amount)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("allocation %d: invalid amount: %w", i+1, err))
+		}
+		if amount == 0 {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("allocation %d: amount must not be zero", i+1))
+		}
+		total += amount
+
+		var categoryID *int64
+		if id := pb.GetCategoryId(); id != 0 {
+			categoryID = &id
+		}
+		var memberID *int64
+		if id := pb.GetMemberId(); id != 0 {
+			memberID = &id
+		}
+		var note *string
+		if n := strings.TrimSpace(pb.GetNote()); n != "" {
+			note = &n
+		}
+
+		params = append(params, familydb.CreateTransactionSplitParams{
+			TransactionID: txn.ID,
+			Position:      int64(i),
+			AmountCents:   amount,
+			CategoryID:    categoryID,
+			Note:          note,
+			MemberID:      memberID,
+			CreatedAt:     now,
+		})
+	}
+
+	if len(params) > 0 && total != txn.AmountCents {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("allocations add up to %s, not the transaction amount %s",
+			money.FormatCents(total), money.FormatCents(txn.AmountCents)))
+	}
+	return params, nil
+}
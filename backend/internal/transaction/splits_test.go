@@ -0,0 +1,112 @@
+package transaction
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"expenses-backend/internal/database/sql/familydb"
+	v1 "expenses-backend/pkg/transaction/v1"
+
+	"connectrpc.com/connect"
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestBuildSplits(t *testing.T) {
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	txn := &familydb.Transaction{ID: 7, AmountCents: -10000}
+
+	tests := []struct {
+		name    string
+		splits  []*v1.TransactionSplit
+		want    []familydb.CreateTransactionSplitParams
+		wantErr bool
+	}{
+		{
+			name: "allocations in request order",
+			splits: []*v1.TransactionSplit{
+				{Amount: "-60.00", CategoryId: ptr(int64(3)), Note: ptr("  groceries ")},
+				{Amount: "-25.50", MemberId: ptr(int64(2))},
+				{Amount: "-14.50", CategoryId: ptr(int64(0)), Note: ptr("  ")},
+			},
+			want: []familydb.CreateTransactionSplitParams{
+				{TransactionID: 7, Position: 0, AmountCents: -6000, CategoryID: ptr(int64(3)), Note: ptr("groceries"), CreatedAt: now},
+				{TransactionID: 7, Position: 1, AmountCents: -2550, MemberID: ptr(int64(2)), CreatedAt: now},
+				{TransactionID: 7, Position: 2, AmountCents: -1450, CreatedAt: now},
+			},
+		},
+		{
+			name: "a refund inside a purchase",
+			splits: []*v1.TransactionSplit{
+				{Amount: "-120.00"},
+				{Amount: "20.00"},
+			},
+			want: []familydb.CreateTransactionSplitParams{
+				{TransactionID: 7, Position: 0, AmountCents: -12000, CreatedAt: now},
+				{TransactionID: 7, Position: 1, AmountCents: 2000, CreatedAt: now},
+			},
+		},
+		{
+			name: "no allocations clears the split",
+			want: []familydb.CreateTransactionSplitParams{},
+		},
+		{
+			name:    "one allocation",
+			splits:  []*v1.TransactionSplit{{Amount: "-100.00"}},
+			wantErr: true,
+		},
+		{
+			name: "sum short of the amount",
+			splits: []*v1.TransactionSplit{
+				{Amount: "-60.00"},
+				{Amount: "-39.99"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "sum with the wrong sign",
+			splits: []*v1.TransactionSplit{
+				{Amount: "60.00"},
+				{Amount: "40.00"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "zero allocation",
+			splits: []*v1.TransactionSplit{
+				{Amount: "-100.00"},
+				{Amount: "0"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid amount",
+			splits: []*v1.TransactionSplit{
+				{Amount: "-50.001"},
+				{Amount: "-49.999"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := buildSplits(txn, tt.splits, now)
+			if tt.wantErr {
+				if connect.CodeOf(err) != connect.CodeInvalidArgument {
+					t.Fatalf("buildSplits error = %v, want InvalidArgument", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("buildSplits: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("buildSplits = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
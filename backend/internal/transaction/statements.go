@@ -0,0 +1,274 @@
+package transaction
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"expenses-backend/internal/database/sql/familydb"
+	"expenses-backend/internal/money"
+	"expenses-backend/internal/recurrence"
+	"expenses-backend/internal/statement"
+	v1 "expenses-backend/pkg/transaction/v1"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxStatementBytes bounds the statement files ImportStatement reads
+const maxStatementBytes = 10 << 20
+
+// statementMappingParams is a validated statement mapping from the API
+type statementMappingParams struct {
+	name    string
+	mapping statement.Mapping
+}
+
+// statementMappingFromProto validates a statement mapping. The name must not
+// be taken by another of the family's mappings.
+func statementMappingFromProto(ctx context.Context, q *familydb.Queries, pb *v1.StatementMapping) (statementMappingParams, error) {
+	if pb == nil {
+		return statementMappingParams{}, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("mapping is required"))
+	}
+
+	p := statementMappingParams{
+		name: strings.TrimSpace(pb.Name),
+		mapping: statement.Mapping{
+			Delimiter:         ',',
+			HasHeader:         pb.HasHeader,
+			SkipRows:          int(pb.SkipRows),
+			DateColumn:        strings.TrimSpace(pb.DateColumn),
+			DateFormat:        strings.TrimSpace(pb.DateFormat),
+			DescriptionColumn: strings.TrimSpace(pb.DescriptionColumn),
+			PayeeColumn:       strings.TrimSpace(pb.GetPayeeColumn()),
+			AmountColumn:      strings.TrimSpace(pb.GetAmountColumn()),
+			DebitColumn:       strings.TrimSpace(pb.GetDebitColumn()),
+			CreditColumn:      strings.TrimSpace(pb.GetCreditColumn()),
+			IDColumn:          strings.TrimSpace(pb.GetIdColumn()),
+			NegateAmounts:     pb.NegateAmounts,
+		},
+	}
+	if p.name == "" {
+		return statementMappingParams{}, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name is required"))
+	}
+	if pb.Delimiter != "" {
+		r, size := utf8.DecodeRuneInString(pb.Delimiter)
+		if size != len(pb.Delimiter) || r == utf8.RuneError || r == '"' || r == '\r' || r == '\n' {
+			return statementMappingParams{}, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("delimiter must be a single character other than a quote or line break"))
+		}
+		p.mapping.Delimiter = r
+	}
+	if err := p.mapping.Validate(); err != nil {
+		return statementMappingParams{}, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	existing, err := q.ListStatementMappings(ctx)
+	if err != nil {
+		return statementMappingParams{}, fmt.Errorf("failed to list statement mappings: %w", err)
+	}
+	for _, m := range existing {
+		if m.ID != pb.Id && strings.EqualFold(m.Name, p.name) {
+			return statementMappingParams{}, connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("a mapping named %q already exists", m.Name))
+		}
+	}
+	return p, nil
+}
+
+// mappingFromRow converts a stored statement mapping for the statement package
+func mappingFromRow(m *familydb.StatementMapping) statement.Mapping {
+	pb := convertToProtoStatementMapping(m)
+	delimiter, _ := utf8.DecodeRuneInString(pb.Delimiter)
+	return statement.Mapping{
+		Delimiter:         delimiter,
+		HasHeader:         pb.HasHeader,
+		SkipRows:          int(pb.SkipRows),
+		DateColumn:        pb.DateColumn,
+		DateFormat:        pb.DateFormat,
+		DescriptionColumn: pb.DescriptionColumn,
+		PayeeColumn:       pb.GetPayeeColumn(),
+		AmountColumn:      pb.GetAmountColumn(),
+		DebitColumn:       pb.GetDebitColumn(),
+		CreditColumn:      pb.GetCreditColumn(),
+		IDColumn:          pb.GetIdColumn(),
+		NegateAmounts:     pb.NegateAmounts,
+	}
+}
+
+// convertToProtoStatementMapping converts SQLC statement mapping to protobuf
+func convertToProtoStatementMapping(m *familydb.StatementMapping) *v1.StatementMapping {
+	return &v1.StatementMapping{
+		Id:                m.ID,
+		Name:              m.Name,
+		Delimiter:         m.Delimiter,
+		HasHeader:         m.HasHeader,
+		SkipRows:          int32(m.SkipRows),
+		DateColumn:        m.DateColumn,
+		DateFormat:        m.DateFormat,
+		DescriptionColumn: m.DescriptionColumn,
+		PayeeColumn:       m.PayeeColumn,
+		AmountColumn:      m.AmountColumn,
+		DebitColumn:       m.DebitColumn,
+		CreditColumn:      m.CreditColumn,
+		IdColumn:          m.IDColumn,
+		NegateAmounts:     m.NegateAmounts,
+		CreatedAt:         timestamppb.New(m.CreatedAt),
+		UpdatedAt:         timestamppb.New(m.UpdatedAt),
+	}
+}
+
+// readStatement parses an uploaded statement with the format it was sent as,
+// or the one its name or content shows
+func readStatement(ctx context.Context, q *familydb.Queries, req *v1.ImportStatementRequest) (statement.Statement, error) {
+	var format statement.Format
+	switch req.Format {
+	case v1.StatementFormat_STATEMENT_FORMAT_CSV:
+		format = statement.FormatCSV
+	case v1.StatementFormat_STATEMENT_FORMAT_OFX, v1.StatementFormat_STATEMENT_FORMAT_QFX:
+		format = statement.FormatOFX
+	default:
+		var err error
+		if format, err = statement.DetectFormat(req.FileName, req.Content); err != nil {
+			return statement.Statement{}, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("format is required: the file is not recognizably CSV, OFX or QFX"))
+		}
+	}
+
+	if format == statement.FormatOFX {
+		stmt, err := statement.ParseOFX(req.Content)
+		if err != nil {
+			return statement.Statement{}, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("failed to read statement: %w", err))
+		}
+		return stmt, nil
+	}
+
+	if req.MappingId == nil {
+		return statement.Statement{}, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("mapping_id is required for CSV files"))
+	}
+	m, err := q.GetStatementMapping(ctx, req.GetMappingId())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return statement.Statement{}, connect.NewError(connect.CodeNotFound, fmt.Errorf("mapping not found"))
+		}
+		return statement.Statement{}, fmt.Errorf("failed to get statement mapping: %w", err)
+	}
+	stmt, err := statement.ParseCSV(req.Content, mappingFromRow(m))
+	if err != nil {
+		return statement.Statement{}, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("failed to read statement: %w", err))
+	}
+	return stmt, nil
+}
+
+// previewStatement lists the statement's rows with whether each is new, already
+// stored for the account or skipped
+func previewStatement(ctx context.Context, q *familydb.Queries, accountID int64, stmt statement.Statement, skip map[int32]bool) ([]*v1.StatementRow, error) {
+	var stored []statement.Stored
+	if len(stmt.Rows) > 0 {
+		start, end := stmt.Rows[0].PostedDate, stmt.Rows[0].PostedDate
+		for _, r := range stmt.Rows {
+			if r.PostedDate.Before(start) {
+				start = r.PostedDate
+			}
+			if r.PostedDate.After(end) {
+				end = r.PostedDate
+			}
+		}
+		txns, err := q.ListAccountTransactionsBetween(ctx, familydb.ListAccountTransactionsBetweenParams{
+			AccountID: accountID,
+			StartDate: recurrence.Date(start),
+			EndDate:   recurrence.Date(end).AddDate(0, 0, 1),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list account transactions: %w", err)
+		}
+		for _, t := range txns {
+			st := statement.Stored{
+				ID:          t.ID,
+				PostedDate:  t.PostedDate,
+				AmountCents: t.AmountCents,
+			}
+			if t.ExternalID != nil {
+				st.ExternalID = *t.ExternalID
+			}
+			stored = append(stored, st)
+		}
+	}
+
+	matches := statement.Dedupe(stmt.Rows, stored)
+	rows := make([]*v1.StatementRow, len(stmt.Rows))
+	for i, r := range stmt.Rows {
+		pb := &v1.StatementRow{
+			Row:         int32(i + 1),
+			Line:        int32(r.Line),
+			PostedDate:  r.PostedDate.Format(recurrence.DateLayout),
+			Description: r.Description,
+			Payee:       r.Payee,
+			Amount:      money.FormatCents(r.AmountCents),
+			Status:      v1.StatementRowStatus_STATEMENT_ROW_STATUS_NEW,
+		}
+		if r.ExternalID != "" {
+			pb.ExternalId = &r.ExternalID
+		}
+		switch m := matches[i]; {
+		case m.Duplicate:
+			pb.Status = v1.StatementRowStatus_STATEMENT_ROW_STATUS_DUPLICATE
+			if m.TransactionID != 0 {
+				pb.TransactionId = &m.TransactionID
+			}
+		case skip[pb.Row]:
+			pb.Status = v1.StatementRowStatus_STATEMENT_ROW_STATUS_SKIPPED
+		}
+		rows[i] = pb
+	}
+	return rows, nil
+}
+
+// storeStatement saves the new rows of a previewed statement and its balance,
+// setting the ID of each created transaction on its row
+func storeStatement(ctx context.Context, q *familydb.Queries, accountID int64, stmt statement.Statement, rows []*v1.StatementRow, now time.Time) ([]*familydb.Transaction, error) {
+	var created []*familydb.Transaction
+	for i, r := range stmt.Rows {
+		if rows[i].Status != v1.StatementRowStatus_STATEMENT_ROW_STATUS_NEW {
+			continue
+		}
+		var externalID *string
+		if r.ExternalID != "" {
+			externalID = &r.ExternalID
+		}
+		txn, err := q.CreateTransaction(ctx, familydb.CreateTransactionParams{
+			AccountID:   accountID,
+			PostedDate:  recurrence.Date(r.PostedDate),
+			Description: r.Description,
+			Payee:       r.Payee,
+			AmountCents: r.AmountCents,
+			ExternalID:  externalID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create transaction: %w", err)
+		}
+		rows[i].TransactionId = &txn.ID
+		created = append(created, txn)
+	}
+
+	if b := stmt.Balance; b != nil {
+		if err := q.UpsertBalanceSnapshot(ctx, familydb.UpsertBalanceSnapshotParams{
+			AccountID:    accountID,
+			SnapshotDate: recurrence.Date(b.AsOf),
+			BalanceCents: b.Cents,
+			BalanceDate:  b.AsOf,
+			CreatedAt:    now,
+		}); err != nil {
+			return nil, fmt.Errorf("failed to store balance snapshot: %w", err)
+		}
+	}
+	return created, nil
+}
+
+func stringOrNil(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
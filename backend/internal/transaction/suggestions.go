@@ -0,0 +1,137 @@
+package transaction
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"expenses-backend/internal/classify"
+	"expenses-backend/internal/database/sql/familydb"
+	"expenses-backend/internal/logger"
+)
+
+const (
+	// categoryThresholdKey is the family setting holding the confidence at
+	// or above which suggested categories are applied
+	categoryThresholdKey = "category_auto_apply_threshold"
+
+	// trainingLimit is how many of the newest categorized transactions the
+	// classifier learns from
+	trainingLimit = 5000
+)
+
+// SuggestionSummary counts what suggesting categories did
+type SuggestionSummary struct {
+	Suggested int // Transactions given a suggestion, including applied ones
+	Applied   int // Suggestions confident enough to be applied
+	Examples  int // Categorized transactions the model learned from
+}
+
+// suggestionThreshold reads the family's auto-apply threshold. A missing or
+// invalid setting means classify.DefaultThreshold; values above 1 turn
+// automatic categorizing off.
+func (s *Service) suggestionThreshold(ctx context.Context, q *familydb.Queries) (float64, error) {
+	setting, err := q.GetFamilySettingByKey(ctx, categoryThresholdKey)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return classify.DefaultThreshold, nil
+		}
+		return 0, fmt.Errorf("failed to get suggestion threshold: %w", err)
+	}
+	if setting.SettingValue == nil {
+		return classify.DefaultThreshold, nil
+	}
+
+	threshold, err := strconv.ParseFloat(*setting.SettingValue, 64)
+	if err != nil || threshold <= 0 {
+		s.logger.Warn("Ignoring invalid category suggestion threshold", err,
+			logger.Str("value", *setting.SettingValue))
+		return classify.DefaultThreshold, nil
+	}
+	return threshold, nil
+}
+
+// categoryModel trains the family's classifier. Categories the classifier
+// applied itself are left out so it doesn't learn from its own guesses.
+func categoryModel(ctx context.Context, q *familydb.Queries) (*classify.Model, error) {
+	rows, err := q.ListCategoryTrainingTransactions(ctx, trainingLimit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list categorized transactions: %w", err)
+	}
+
+	examples := make([]classify.Example, 0, len(rows))
+	for _, r := range rows {
+		examples = append(examples, classify.Example{
+			Text:       suggestionText(r.Payee, r.Description),
+			CategoryID: *r.CategoryID,
+		})
+	}
+	return classify.Train(examples), nil
+}
+
+// suggestionText is the text the classifier reads: the bank's payee and
+// description, which rules and people don't change
+func suggestionText(payee, description string) string {
+	return payee + " " + description
+}
+
+// RunSuggestions suggests a category for each uncategorized transaction that
+// is not a transfer, applying the suggestions the family's threshold allows
+func (s *Service) RunSuggestions(ctx context.Context, familyID int64, txns []*familydb.Transaction) (SuggestionSummary, error) {
+	var summary SuggestionSummary
+
+	var uncategorized []*familydb.Transaction
+	for _, t := range txns {
+		if t.CategoryID == nil && !t.IsTransfer {
+			uncategorized = append(uncategorized, t)
+		}
+	}
+	if len(uncategorized) == 0 {
+		return summary, nil
+	}
+
+	queries, err := s.dbManager.GetFamilyQueries(int(familyID))
+	if err != nil {
+		return summary, err
+	}
+	model, err := categoryModel(ctx, queries)
+	if err != nil {
+		return summary, err
+	}
+	summary.Examples = model.Examples()
+	threshold, err := s.suggestionThreshold(ctx, queries)
+	if err != nil {
+		return summary, err
+	}
+
+	err = s.dbManager.WithFamilyTx(ctx, int(familyID), func(q *familydb.Queries) error {
+		for _, t := range uncategorized {
+			p, ok := model.Predict(suggestionText(t.Payee, t.Description))
+			if !ok {
+				continue
+			}
+
+			var categoryID *int64
+			if p.Confidence >= threshold {
+				categoryID = &p.CategoryID
+				summary.Applied++
+			}
+			if _, err := q.SetTransactionSuggestion(ctx, familydb.SetTransactionSuggestionParams{
+				CategoryID:           categoryID,
+				SuggestedCategoryID:  &p.CategoryID,
+				SuggestionConfidence: &p.Confidence,
+				ID:                   t.ID,
+			}); err != nil {
+				return fmt.Errorf("failed to store category suggestion: %w", err)
+			}
+			summary.Suggested++
+		}
+		return nil
+	})
+	if err != nil {
+		return SuggestionSummary{Examples: summary.Examples}, err
+	}
+	return summary, nil
+}
@@ -0,0 +1,576 @@
+package transaction
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"expenses-backend/internal/database/sql/familydb"
+	"expenses-backend/internal/logger"
+	"expenses-backend/internal/money"
+	"expenses-backend/internal/recurrence"
+	"expenses-backend/internal/simplefin"
+	v1 "expenses-backend/pkg/transaction/v1"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// DefaultSyncInterval is how often the scheduler syncs every family
+	DefaultSyncInterval = 6 * time.Hour
+
+	// initialSyncDays is how far back the first sync of an account reaches
+	initialSyncDays = 90
+
+	// syncOverlapDays is how far before the cursor each sync starts, so
+	// pending transactions that post later are picked up again
+	syncOverlapDays = 7
+
+	// syncErrorRetentionDays is how long recorded sync errors are kept
+	syncErrorRetentionDays = 30
+
+	// recentSyncErrorCount is how many errors GetSyncStatus returns
+	recentSyncErrorCount = 20
+)
+
+// syncRun is the in-memory state of a family's sync
+type syncRun struct {
+	running    bool
+	startedAt  time.Time
+	finishedAt time.Time
+}
+
+// SyncSummary counts what a sync of a family did
+type SyncSummary struct {
+	Accounts int // Accounts synced without error
+	Failed   int // Accounts whose sync failed
+	Added    int // New transactions stored
+	Updated  int // Stored transactions that changed, e.g. pending ones that posted
+}
+
+// StartSyncScheduler syncs every loaded family each interval until ctx is done
+func (s *Service) StartSyncScheduler(ctx context.Context, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				s.syncAllFamilies(ctx)
+			}
+		}
+	}()
+
+	s.logger.Info("SimpleFIN sync scheduler started", logger.Duration("interval", interval))
+}
+
+// syncAllFamilies syncs each loaded family in turn, skipping families that
+// are already syncing or have no SimpleFIN connection
+func (s *Service) syncAllFamilies(ctx context.Context) {
+	for _, familyID := range s.dbManager.FamilyIDs() {
+		if ctx.Err() != nil {
+			return
+		}
+		if !s.beginSync(familyID) {
+			continue
+		}
+
+		summary, err := s.syncFamily(ctx, familyID)
+		s.endSync(familyID)
+		if errors.Is(err, simplefin.ErrNotConnected) {
+			continue
+		}
+		if errors.Is(err, simplefin.ErrAuthBackoff) {
+			s.logger.Info("Skipping family sync while SimpleFIN auth is failing", logger.Int64("family_id", familyID))
+			continue
+		}
+		if err != nil {
+			s.logger.Error("Failed to sync family transactions", err, logger.Int64("family_id", familyID))
+			continue
+		}
+
+		s.logger.Info("Synced family transactions",
+			logger.Int64("family_id", familyID),
+			logger.Int("accounts", summary.Accounts),
+			logger.Int("failed", summary.Failed),
+			logger.Int("added", summary.Added),
+			logger.Int("updated", summary.Updated))
+	}
+}
+
+// beginSync marks the family as syncing, returning false if it already was
+func (s *Service) beginSync(familyID int64) bool {
+	s.syncMu.Lock()
+	defer s.syncMu.Unlock()
+
+	run, ok := s.syncRuns[familyID]
+	if !ok {
+		run = &syncRun{}
+		s.syncRuns[familyID] = run
+	}
+	if run.running {
+		return false
+	}
+	run.running = true
+	run.startedAt = time.Now()
+	return true
+}
+
+// endSync marks the family's sync as finished
+func (s *Service) endSync(familyID int64) {
+	s.syncMu.Lock()
+	defer s.syncMu.Unlock()
+
+	if run, ok := s.syncRuns[familyID]; ok {
+		run.running = false
+		run.finishedAt = time.Now()
+	}
+}
+
+// syncRunOf returns a copy of the family's in-memory sync state
+func (s *Service) syncRunOf(familyID int64) syncRun {
+	s.syncMu.Lock()
+	defer s.syncMu.Unlock()
+
+	if run, ok := s.syncRuns[familyID]; ok {
+		return *run
+	}
+	return syncRun{}
+}
+
+// syncFamily fetches new transactions for every linked account of the family
+// and matches them to expenses. A failing account is recorded and skipped.
+func (s *Service) syncFamily(ctx context.Context, familyID int64) (SyncSummary, error) {
+	var summary SyncSummary
+
+	queries, err := s.dbManager.GetFamilyQueries(int(familyID))
+	if err != nil {
+		return summary, err
+	}
+
+	accounts, err := queries.GetAccounts(ctx)
+	if err != nil {
+		return summary, fmt.Errorf("failed to get accounts: %w", err)
+	}
+	accounts = linkedAccounts(accounts)
+	if len(accounts) == 0 {
+		return summary, nil
+	}
+
+	client, err := s.getSimplefinClient(ctx, familyID)
+	if err != nil {
+		return summary, err
+	}
+
+	now := time.Now().UTC()
+	since := now
+	var added []*familydb.Transaction
+	for i, account := range accounts {
+		result, err := s.syncAccount(ctx, familyID, queries, client, account, now)
+		if err != nil {
+			s.logger.Error("Failed to sync account", err,
+				logger.Int64("family_id", familyID),
+				logger.Int64("account_id", account.ID))
+			summary.Failed++
+			if errors.Is(err, simplefin.ErrUnauthorized) {
+				// All accounts share the token, so the rest would be rejected too
+				summary.Failed += len(accounts) - i - 1
+				break
+			}
+			continue
+		}
+
+		summary.Accounts++
+		summary.Added += len(result.added)
+		summary.Updated += result.updated
+		added = append(added, result.added...)
+		if result.start.Before(since) {
+			since = result.start
+		}
+	}
+
+	if err := s.processNewTransactions(ctx, familyID, added, since, summary.Added > 0 || summary.Updated > 0); err != nil {
+		return summary, fmt.Errorf("failed to process synced transactions: %w", err)
+	}
+
+	if err := queries.DeleteSyncErrorsBefore(ctx, now.AddDate(0, 0, -syncErrorRetentionDays)); err != nil {
+		s.logger.Warn("Failed to prune sync errors", err, logger.Int64("family_id", familyID))
+	}
+
+	return summary, nil
+}
+
+// processNewTransactions pairs transfers, applies rules, suggests categories
+// and matches expenses for newly stored transactions. since is the earliest
+// posted date that changed; transfers and matches are only looked for when
+// changed is set.
+func (s *Service) processNewTransactions(ctx context.Context, familyID int64, added []*familydb.Transaction, since time.Time, changed bool) error {
+	// Transfers are paired first so rules and suggestions see them marked
+	if changed {
+		pairs, err := s.RunTransferDetection(ctx, familyID, since)
+		if err != nil {
+			return fmt.Errorf("failed to detect transfers: %w", err)
+		}
+		transferIDs := make(map[int64]bool, 2*len(pairs))
+		for _, p := range pairs {
+			transferIDs[p.OutflowID], transferIDs[p.InflowID] = true, true
+		}
+		for _, t := range added {
+			if transferIDs[t.ID] {
+				t.IsTransfer = true
+			}
+		}
+	}
+
+	// Rules run before matching, so transactions they link are not matched
+	// again, and before suggestions, which only fill in what rules leave
+	if _, err := s.RunRules(ctx, familyID, added, false); err != nil {
+		return fmt.Errorf("failed to apply rules: %w", err)
+	}
+	if _, err := s.RunSuggestions(ctx, familyID, added); err != nil {
+		return fmt.Errorf("failed to suggest categories: %w", err)
+	}
+
+	if changed {
+		if _, err := s.RunMatcher(ctx, familyID, since); err != nil {
+			return fmt.Errorf("failed to match transactions: %w", err)
+		}
+	}
+	return nil
+}
+
+// accountSyncResult is what syncing a single account did
+type accountSyncResult struct {
+	start   time.Time               // Start of the fetched window
+	added   []*familydb.Transaction // New transactions
+	updated int
+}
+
+// syncAccount fetches the account's transactions from just before its cursor
+// up to now, stores new ones and updates changed ones, then advances the cursor
+func (s *Service) syncAccount(ctx context.Context, familyID int64, queries *familydb.Queries, client *simplefin.Client, account *familydb.Account, now time.Time) (accountSyncResult, error) {
+	result := accountSyncResult{start: now.AddDate(0, 0, -initialSyncDays)}
+
+	state, err := queries.GetAccountSyncState(ctx, account.ID)
+	if err != nil {
+		if err != sql.ErrNoRows {
+			return result, fmt.Errorf("failed to get sync state: %w", err)
+		}
+		state = &familydb.AccountSyncState{AccountID: account.ID}
+	}
+	if state.SyncCursor != nil {
+		result.start = state.SyncCursor.AddDate(0, 0, -syncOverlapDays)
+	}
+	state.LastAttemptAt = &now
+
+	resp, fetchErr := client.AccountTransactions(ctx, simplefin.AccountTransactionsRequest{
+		AccountID: account.AccountID,
+		StartDate: result.start,
+		EndDate:   now,
+		Pending:   true,
+	})
+	s.connections.Report(familyID, client, fetchErr)
+
+	// SimpleFIN reports problems such as a bank connection needing attention
+	// in the errors array, even when transactions are returned
+	for _, msg := range resp.Errors {
+		if err := queries.CreateSyncError(ctx, familydb.CreateSyncErrorParams{
+			AccountID: &account.ID,
+			Message:   msg,
+			CreatedAt: now,
+		}); err != nil {
+			return result, fmt.Errorf("failed to record sync error: %w", err)
+		}
+	}
+
+	if fetchErr != nil {
+		return result, s.recordAccountSyncFailure(ctx, queries, state, now, fmt.Errorf("failed to fetch transactions: %w", fetchErr))
+	}
+
+	fetched := make(map[string]bool, len(resp.Transactions))
+	for _, t := range resp.Transactions {
+		fetched[t.ID] = true
+	}
+
+	err = s.dbManager.WithFamilyTx(ctx, int(familyID), func(q *familydb.Queries) error {
+		for _, t := range resp.Transactions {
+			created, changed, err := storeSyncedTransaction(ctx, q, account, t, fetched, now)
+			if err != nil {
+				return err
+			}
+			if created != nil {
+				result.added = append(result.added, created)
+			} else if changed {
+				result.updated++
+			}
+		}
+		return storeBalanceSnapshot(ctx, q, account.ID, resp, now)
+	})
+	if err != nil {
+		result.added, result.updated = nil, 0
+		return result, s.recordAccountSyncFailure(ctx, queries, state, now, fmt.Errorf("failed to store transactions: %w", err))
+	}
+
+	state.SyncCursor = &now
+	state.LastSuccessAt = &now
+	state.LastError = nil
+	if len(resp.Errors) > 0 {
+		msg := strings.Join(resp.Errors, "; ")
+		state.LastError = &msg
+	}
+	state.TransactionsAdded = int64(len(result.added))
+	if err := upsertAccountSyncState(ctx, queries, state); err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
+
+// recordAccountSyncFailure stores err as the account's last error and in the
+// error log, leaving the cursor where it was so the next sync retries
+func (s *Service) recordAccountSyncFailure(ctx context.Context, queries *familydb.Queries, state *familydb.AccountSyncState, now time.Time, syncErr error) error {
+	msg := syncErr.Error()
+	state.LastError = &msg
+
+	if err := queries.CreateSyncError(ctx, familydb.CreateSyncErrorParams{
+		AccountID: &state.AccountID,
+		Message:   msg,
+		CreatedAt: now,
+	}); err != nil {
+		s.logger.Error("Failed to record sync error", err, logger.Int64("account_id", state.AccountID))
+	}
+	if err := upsertAccountSyncState(ctx, queries, state); err != nil {
+		s.logger.Error("Failed to update sync state", err, logger.Int64("account_id", state.AccountID))
+	}
+	return syncErr
+}
+
+func upsertAccountSyncState(ctx context.Context, queries *familydb.Queries, state *familydb.AccountSyncState) error {
+	err := queries.UpsertAccountSyncState(ctx, familydb.UpsertAccountSyncStateParams{
+		AccountID:         state.AccountID,
+		SyncCursor:        state.SyncCursor,
+		LastAttemptAt:     state.LastAttemptAt,
+		LastSuccessAt:     state.LastSuccessAt,
+		LastError:         state.LastError,
+		TransactionsAdded: state.TransactionsAdded,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update sync state: %w", err)
+	}
+	return nil
+}
+
+// storeSyncedTransaction inserts a SimpleFIN transaction, returning the new
+// row, or updates the stored copy with the same SimpleFIN ID when its details
+// changed. fetched holds the SimpleFIN IDs of the whole batch.
+func storeSyncedTransaction(ctx context.Context, q *familydb.Queries, account *familydb.Account, t simplefin.Transactions, fetched map[string]bool, now time.Time) (created *familydb.Transaction, changed bool, err error) {
+	accountID := account.ID
+	amount, err := money.ParseCents(t.Amount)
+	if err != nil {
+		return nil, false, fmt.Errorf("transaction %s: %w", t.ID, err)
+	}
+
+	var transactedAt *time.Time
+	if t.TransactedAt > 0 {
+		at := time.Unix(t.TransactedAt, 0).UTC()
+		transactedAt = &at
+	}
+
+	// Pending transactions may not have a posted time yet
+	posted := now
+	switch {
+	case t.Posted > 0:
+		posted = time.Unix(t.Posted, 0).UTC()
+	case transactedAt != nil:
+		posted = *transactedAt
+	}
+
+	existing, err := q.GetTransactionByExternalID(ctx, familydb.GetTransactionByExternalIDParams{
+		AccountID:  accountID,
+		ExternalID: &t.ID,
+	})
+	if err == sql.ErrNoRows && account.RelinkedAt != nil && !posted.After(*account.RelinkedAt) {
+		existing, err = adoptTransaction(ctx, q, accountID, t.ID, posted, amount, fetched)
+	}
+	if err == sql.ErrNoRows {
+		created, err = q.CreateTransaction(ctx, familydb.CreateTransactionParams{
+			AccountID:    accountID,
+			PostedDate:   posted,
+			Description:  t.Description,
+			Payee:        t.Payee,
+			AmountCents:  amount,
+			ExternalID:   &t.ID,
+			Pending:      t.Pending,
+			TransactedAt: transactedAt,
+		})
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to create transaction: %w", err)
+		}
+		return created, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to look up transaction: %w", err)
+	}
+
+	// A pending transaction without dates keeps its first-seen date until it posts
+	if t.Posted == 0 && transactedAt == nil {
+		posted = existing.PostedDate
+	}
+	if existing.PostedDate.Equal(posted) && existing.Description == t.Description && existing.Payee == t.Payee &&
+		existing.AmountCents == amount && existing.Pending == t.Pending && sameTime(existing.TransactedAt, transactedAt) {
+		return nil, false, nil
+	}
+
+	// Allocations that no longer add up would skew reports, so the
+	// transaction counts whole again until someone splits it anew
+	if existing.AmountCents != amount {
+		if err := q.DeleteTransactionSplits(ctx, existing.ID); err != nil {
+			return nil, false, fmt.Errorf("failed to delete transaction splits: %w", err)
+		}
+	}
+
+	err = q.UpdateSyncedTransaction(ctx, familydb.UpdateSyncedTransactionParams{
+		PostedDate:   posted,
+		Description:  t.Description,
+		Payee:        t.Payee,
+		AmountCents:  amount,
+		Pending:      t.Pending,
+		TransactedAt: transactedAt,
+		ID:           existing.ID,
+	})
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to update transaction: %w", err)
+	}
+	return nil, true, nil
+}
+
+// storeBalanceSnapshot records the account balance SimpleFIN returned as the
+// snapshot of the day it was computed, replacing an earlier one of that day
+func storeBalanceSnapshot(ctx context.Context, q *familydb.Queries, accountID int64, resp simplefin.AccountTransactionsResponse, now time.Time) error {
+	if resp.Balance == "" {
+		return nil
+	}
+	balance, err := money.ParseCents(resp.Balance)
+	if err != nil {
+		return fmt.Errorf("balance: %w", err)
+	}
+
+	var available *int64
+	if resp.AvailableBalance != "" {
+		cents, err := money.ParseCents(resp.AvailableBalance)
+		if err != nil {
+			return fmt.Errorf("available balance: %w", err)
+		}
+		available = &cents
+	}
+
+	balanceDate := now
+	if resp.BalanceDate > 0 {
+		balanceDate = time.Unix(int64(resp.BalanceDate), 0).UTC()
+	}
+
+	if err := q.UpsertBalanceSnapshot(ctx, familydb.UpsertBalanceSnapshotParams{
+		AccountID:             accountID,
+		SnapshotDate:          recurrence.Date(balanceDate),
+		BalanceCents:          balance,
+		AvailableBalanceCents: available,
+		BalanceDate:           balanceDate,
+		CreatedAt:             now,
+	}); err != nil {
+		return fmt.Errorf("failed to store balance snapshot: %w", err)
+	}
+	return nil
+}
+
+// syncStatus builds the family's sync status from its stored sync state and
+// the in-memory run
+func (s *Service) syncStatus(ctx context.Context, familyID int64) (*v1.SyncStatus, error) {
+	queries, err := s.dbManager.GetFamilyQueries(int(familyID))
+	if err != nil {
+		return nil, err
+	}
+
+	accounts, err := queries.GetAccounts(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get accounts: %w", err)
+	}
+	accounts = linkedAccounts(accounts)
+
+	states, err := queries.ListAccountSyncStates(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sync states: %w", err)
+	}
+	byAccount := make(map[int64]*familydb.AccountSyncState, len(states))
+	for _, st := range states {
+		byAccount[st.AccountID] = st
+	}
+
+	syncErrors, err := queries.ListRecentSyncErrors(ctx, recentSyncErrorCount)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sync errors: %w", err)
+	}
+
+	run := s.syncRunOf(familyID)
+	status := &v1.SyncStatus{
+		Running:        run.running,
+		LastStartedAt:  optionalTimestamp(run.startedAt),
+		LastFinishedAt: optionalTimestamp(run.finishedAt),
+	}
+
+	for _, a := range accounts {
+		pb := &v1.AccountSyncStatus{
+			AccountId:   a.ID,
+			AccountName: a.Name,
+		}
+		if st, ok := byAccount[a.ID]; ok {
+			pb.Cursor = timestampOrNil(st.SyncCursor)
+			pb.LastAttemptAt = timestampOrNil(st.LastAttemptAt)
+			pb.LastSuccessAt = timestampOrNil(st.LastSuccessAt)
+			pb.LastError = st.LastError
+			pb.TransactionsAdded = int32(st.TransactionsAdded)
+		}
+		status.Accounts = append(status.Accounts, pb)
+	}
+
+	if status.Health, err = s.simplefinHealth(ctx, familyID); err != nil {
+		return nil, err
+	}
+
+	for _, e := range syncErrors {
+		status.RecentErrors = append(status.RecentErrors, &v1.SyncError{
+			Id:        e.ID,
+			AccountId: e.AccountID,
+			Message:   e.Message,
+			CreatedAt: timestamppb.New(e.CreatedAt),
+		})
+	}
+
+	return status, nil
+}
+
+func sameTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
+
+func optionalTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+func timestampOrNil(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
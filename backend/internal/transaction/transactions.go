@@ -0,0 +1,96 @@
+package transaction
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"expenses-backend/internal/money"
+	"expenses-backend/internal/pagination"
+	"expenses-backend/internal/recurrence"
+	v1 "expenses-backend/pkg/transaction/v1"
+)
+
+// transactionCursor is the position after the last transaction of a
+// ListTransactions page
+type transactionCursor struct {
+	Date time.Time `json:"d"`
+	ID   int64     `json:"i"`
+}
+
+// transactionFilters are the server-side filters supported by ListTransactions
+type transactionFilters struct {
+	AccountID      *int64
+	StartDate      *time.Time
+	EndDate        *time.Time // Exclusive
+	MinAmountCents *int64
+	MaxAmountCents *int64
+	Pending        *bool
+	CategoryID     *int64
+	Search         *string
+}
+
+// transactionFiltersFromProto validates the filters of a ListTransactions request
+func transactionFiltersFromProto(req *v1.ListTransactionsRequest) (transactionFilters, error) {
+	filters := transactionFilters{
+		AccountID:  req.AccountId,
+		Pending:    req.Pending,
+		CategoryID: req.CategoryId,
+	}
+
+	if req.StartDate != nil {
+		start, err := recurrence.ParseDate(req.GetStartDate())
+		if err != nil {
+			return filters, fmt.Errorf("start_date must be YYYY-MM-DD")
+		}
+		filters.StartDate = &start
+	}
+	if req.EndDate != nil {
+		end, err := recurrence.ParseDate(req.GetEndDate())
+		if err != nil {
+			return filters, fmt.Errorf("end_date must be YYYY-MM-DD")
+		}
+		// Posted dates carry a time, so include the whole end day
+		end = end.AddDate(0, 0, 1)
+		filters.EndDate = &end
+	}
+	if filters.StartDate != nil && filters.EndDate != nil && !filters.StartDate.Before(*filters.EndDate) {
+		return filters, fmt.Errorf("start_date must not be after end_date")
+	}
+
+	if req.MinAmount != nil {
+		cents, err := money.ParseCents(req.GetMinAmount())
+		if err != nil {
+			return filters, fmt.Errorf("min_amount must be a decimal amount")
+		}
+		filters.MinAmountCents = &cents
+	}
+	if req.MaxAmount != nil {
+		cents, err := money.ParseCents(req.GetMaxAmount())
+		if err != nil {
+			return filters, fmt.Errorf("max_amount must be a decimal amount")
+		}
+		filters.MaxAmountCents = &cents
+	}
+	if filters.MinAmountCents != nil && filters.MaxAmountCents != nil && *filters.MinAmountCents > *filters.MaxAmountCents {
+		return filters, fmt.Errorf("min_amount must not be greater than max_amount")
+	}
+
+	if q := strings.TrimSpace(req.Query); q != "" {
+		filters.Search = &q
+	}
+
+	return filters, nil
+}
+
+// scope identifies the filter set a page token was issued for
+func (f transactionFilters) scope() string {
+	search := "-"
+	if f.Search != nil {
+		search = fmt.Sprintf("%q", *f.Search)
+	}
+	return fmt.Sprintf("transactions:%s:%s:%s:%s:%s:%s:%s:%s",
+		pagination.ScopeValue(f.AccountID), pagination.ScopeValue(f.StartDate), pagination.ScopeValue(f.EndDate),
+		pagination.ScopeValue(f.MinAmountCents), pagination.ScopeValue(f.MaxAmountCents),
+		pagination.ScopeValue(f.Pending), pagination.ScopeValue(f.CategoryID), search)
+}
@@ -0,0 +1,147 @@
+package transaction
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"expenses-backend/internal/database/sql/familydb"
+	"expenses-backend/internal/logger"
+	"expenses-backend/internal/recurrence"
+	"expenses-backend/internal/transfers"
+	v1 "expenses-backend/pkg/transaction/v1"
+)
+
+const (
+	transferStatusDetected  = "detected"
+	transferStatusConfirmed = "confirmed"
+	transferStatusSplit     = "split"
+)
+
+var transferStatuses = map[v1.TransferPairStatus]string{
+	v1.TransferPairStatus_TRANSFER_PAIR_STATUS_DETECTED:  transferStatusDetected,
+	v1.TransferPairStatus_TRANSFER_PAIR_STATUS_CONFIRMED: transferStatusConfirmed,
+	v1.TransferPairStatus_TRANSFER_PAIR_STATUS_SPLIT:     transferStatusSplit,
+}
+
+// transferPairCursor is the position after the last pair of a ListTransferPairs page
+type transferPairCursor struct {
+	ID int64 `json:"i"`
+}
+
+// RunTransferDetection pairs the two sides of transfers between the family's
+// linked accounts among transactions posted on or after since, and marks both
+// sides as transfers. Transactions up to transfers.DefaultWindowDays earlier
+// are considered too, so new transactions pair with ones already stored.
+// Transactions that are already paired are left alone, and split pairs are
+// not made again. It returns the new pairs.
+func (s *Service) RunTransferDetection(ctx context.Context, familyID int64, since time.Time) ([]transfers.Pair, error) {
+	queries, err := s.dbManager.GetFamilyQueries(int(familyID))
+	if err != nil {
+		return nil, err
+	}
+
+	start := recurrence.Date(since).AddDate(0, 0, -transfers.DefaultWindowDays)
+	txns, err := queries.ListTransferCandidates(ctx, start)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list transfer candidates: %w", err)
+	}
+	existing, err := queries.ListTransferPairsSince(ctx, start)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list transfer pairs: %w", err)
+	}
+
+	paired := make(map[int64]bool)
+	rejected := make(map[transfers.Pair]bool)
+	for _, p := range existing {
+		if p.Status == transferStatusSplit {
+			rejected[transfers.Pair{OutflowID: p.OutflowTransactionID, InflowID: p.InflowTransactionID}] = true
+			continue
+		}
+		paired[p.OutflowTransactionID], paired[p.InflowTransactionID] = true, true
+	}
+
+	candidates := make([]transfers.Transaction, 0, len(txns))
+	for _, t := range txns {
+		if paired[t.ID] {
+			continue
+		}
+		candidates = append(candidates, transfers.Transaction{
+			ID:          t.ID,
+			AccountID:   t.AccountID,
+			PostedDate:  t.PostedDate,
+			AmountCents: t.AmountCents,
+		})
+	}
+
+	pairs := transfers.Detect(candidates, transfers.DefaultWindowDays, rejected)
+	if len(pairs) == 0 {
+		return nil, nil
+	}
+
+	err = s.dbManager.WithFamilyTx(ctx, int(familyID), func(q *familydb.Queries) error {
+		now := time.Now()
+		for _, p := range pairs {
+			if _, err := q.CreateTransferPair(ctx, familydb.CreateTransferPairParams{
+				OutflowTransactionID: p.OutflowID,
+				InflowTransactionID:  p.InflowID,
+				Status:               transferStatusDetected,
+				CreatedAt:            now,
+				UpdatedAt:            now,
+			}); err != nil {
+				return fmt.Errorf("failed to store transfer pair: %w", err)
+			}
+			if err := markTransferPair(ctx, q, p.OutflowID, p.InflowID, true); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Paired transfers",
+		logger.Int64("family_id", familyID),
+		logger.Int("transactions", len(candidates)),
+		logger.Int("paired", len(pairs)))
+
+	return pairs, nil
+}
+
+// markTransferPair sets whether both sides of a pair are transfers
+func markTransferPair(ctx context.Context, q *familydb.Queries, outflowID, inflowID int64, isTransfer bool) error {
+	for _, id := range []int64{outflowID, inflowID} {
+		if err := q.SetTransactionTransfer(ctx, familydb.SetTransactionTransferParams{
+			IsTransfer: isTransfer,
+			ID:         id,
+		}); err != nil {
+			return fmt.Errorf("failed to mark transfer: %w", err)
+		}
+	}
+	return nil
+}
+
+// transferPairToProto converts a pair with both of its transactions
+func transferPairToProto(ctx context.Context, q *familydb.Queries, p *familydb.TransferPair) (*v1.TransferPair, error) {
+	outflow, err := q.GetTransactionByID(ctx, p.OutflowTransactionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get outflow transaction: %w", err)
+	}
+	inflow, err := q.GetTransactionByID(ctx, p.InflowTransactionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get inflow transaction: %w", err)
+	}
+
+	pair := &v1.TransferPair{
+		Id:      p.ID,
+		Outflow: convertToProtoAccountTransaction(outflow),
+		Inflow:  convertToProtoAccountTransaction(inflow),
+	}
+	for status, name := range transferStatuses {
+		if name == p.Status {
+			pair.Status = status
+		}
+	}
+	return pair, nil
+}
@@ -0,0 +1,92 @@
+// Package transfers finds the two sides of money moved between a family's own
+// accounts, such as paying a credit card from checking.
+package transfers
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	"expenses-backend/internal/recurrence"
+)
+
+// DefaultWindowDays is how many days apart the two sides of a transfer may post
+const DefaultWindowDays = 4
+
+// Transaction is the part of a transaction the detector looks at
+type Transaction struct {
+	ID          int64
+	AccountID   int64
+	PostedDate  time.Time
+	AmountCents int64 // Negative for money leaving the account
+}
+
+// Pair is a detected transfer
+type Pair struct {
+	OutflowID int64
+	InflowID  int64
+}
+
+// candidate is a possible pairing and how many days apart its sides posted
+type candidate struct {
+	Pair
+	days int
+}
+
+// Detect pairs outflows with inflows of the same amount on a different account
+// posted at most windowDays apart. Closer dates pair first, so each transaction
+// ends up in at most one pair; ties go to the older transactions. Pairs in
+// rejected are never made.
+func Detect(txns []Transaction, windowDays int, rejected map[Pair]bool) []Pair {
+	inflows := make(map[int64][]Transaction)
+	for _, t := range txns {
+		if t.AmountCents > 0 {
+			inflows[t.AmountCents] = append(inflows[t.AmountCents], t)
+		}
+	}
+
+	var candidates []candidate
+	for _, out := range txns {
+		if out.AmountCents >= 0 {
+			continue
+		}
+		for _, in := range inflows[-out.AmountCents] {
+			if in.AccountID == out.AccountID {
+				continue
+			}
+			days := daysBetween(out.PostedDate, in.PostedDate)
+			pair := Pair{OutflowID: out.ID, InflowID: in.ID}
+			if days > windowDays || rejected[pair] {
+				continue
+			}
+			candidates = append(candidates, candidate{Pair: pair, days: days})
+		}
+	}
+	slices.SortFunc(candidates, func(a, b candidate) int {
+		return cmp.Or(
+			cmp.Compare(a.days, b.days),
+			cmp.Compare(a.OutflowID, b.OutflowID),
+			cmp.Compare(a.InflowID, b.InflowID),
+		)
+	})
+
+	paired := make(map[int64]bool)
+	var pairs []Pair
+	for _, c := range candidates {
+		if paired[c.OutflowID] || paired[c.InflowID] {
+			continue
+		}
+		paired[c.OutflowID], paired[c.InflowID] = true, true
+		pairs = append(pairs, c.Pair)
+	}
+	return pairs
+}
+
+// daysBetween counts whole days between the dates, in either order
+func daysBetween(a, b time.Time) int {
+	d := int(recurrence.Date(b).Sub(recurrence.Date(a)).Hours() / 24)
+	if d < 0 {
+		return -d
+	}
+	return d
+}
@@ -0,0 +1,103 @@
+package transfers
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"expenses-backend/internal/recurrence"
+)
+
+func date(s string) time.Time {
+	d, err := recurrence.ParseDate(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+func TestDetect(t *testing.T) {
+	const checking, card, savings = 1, 2, 3
+
+	tests := []struct {
+		name     string
+		txns     []Transaction
+		rejected map[Pair]bool
+		want     []Pair
+	}{
+		{
+			name: "card payment",
+			txns: []Transaction{
+				{ID: 1, AccountID: checking, PostedDate: date("2025-03-01"), AmountCents: -50000},
+				{ID: 2, AccountID: card, PostedDate: date("2025-03-03"), AmountCents: 50000},
+			},
+			want: []Pair{{OutflowID: 1, InflowID: 2}},
+		},
+		{
+			name: "inflow can post first",
+			txns: []Transaction{
+				{ID: 1, AccountID: checking, PostedDate: date("2025-03-05"), AmountCents: -50000},
+				{ID: 2, AccountID: card, PostedDate: date("2025-03-01"), AmountCents: 50000},
+			},
+			want: []Pair{{OutflowID: 1, InflowID: 2}},
+		},
+		{
+			name: "outside the window",
+			txns: []Transaction{
+				{ID: 1, AccountID: checking, PostedDate: date("2025-03-01"), AmountCents: -50000},
+				{ID: 2, AccountID: card, PostedDate: date("2025-03-06"), AmountCents: 50000},
+			},
+		},
+		{
+			name: "same account is a refund, not a transfer",
+			txns: []Transaction{
+				{ID: 1, AccountID: card, PostedDate: date("2025-03-01"), AmountCents: -2500},
+				{ID: 2, AccountID: card, PostedDate: date("2025-03-02"), AmountCents: 2500},
+			},
+		},
+		{
+			name: "amounts must match",
+			txns: []Transaction{
+				{ID: 1, AccountID: checking, PostedDate: date("2025-03-01"), AmountCents: -50000},
+				{ID: 2, AccountID: card, PostedDate: date("2025-03-01"), AmountCents: 49999},
+			},
+		},
+		{
+			name: "closest dates pair first",
+			txns: []Transaction{
+				{ID: 1, AccountID: checking, PostedDate: date("2025-03-01"), AmountCents: -10000},
+				{ID: 2, AccountID: checking, PostedDate: date("2025-03-04"), AmountCents: -10000},
+				{ID: 3, AccountID: savings, PostedDate: date("2025-03-04"), AmountCents: 10000},
+				{ID: 4, AccountID: savings, PostedDate: date("2025-03-02"), AmountCents: 10000},
+			},
+			want: []Pair{{OutflowID: 2, InflowID: 3}, {OutflowID: 1, InflowID: 4}},
+		},
+		{
+			name: "each side pairs once",
+			txns: []Transaction{
+				{ID: 1, AccountID: checking, PostedDate: date("2025-03-01"), AmountCents: -10000},
+				{ID: 2, AccountID: card, PostedDate: date("2025-03-01"), AmountCents: 10000},
+				{ID: 3, AccountID: savings, PostedDate: date("2025-03-01"), AmountCents: 10000},
+			},
+			want: []Pair{{OutflowID: 1, InflowID: 2}},
+		},
+		{
+			name: "rejected pair falls back to the next match",
+			txns: []Transaction{
+				{ID: 1, AccountID: checking, PostedDate: date("2025-03-01"), AmountCents: -10000},
+				{ID: 2, AccountID: card, PostedDate: date("2025-03-01"), AmountCents: 10000},
+				{ID: 3, AccountID: savings, PostedDate: date("2025-03-02"), AmountCents: 10000},
+			},
+			rejected: map[Pair]bool{{OutflowID: 1, InflowID: 2}: true},
+			want:     []Pair{{OutflowID: 1, InflowID: 3}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Detect(tt.txns, DefaultWindowDays, tt.rejected)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Detect = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
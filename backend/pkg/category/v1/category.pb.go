@@ -0,0 +1,1497 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: category/v1/category.proto
+
+package categoryv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Category struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Color         *string                `protobuf:"bytes,4,opt,name=color,proto3,oneof" json:"color,omitempty"` // Hex color for UI
+	Icon          *string                `protobuf:"bytes,5,opt,name=icon,proto3,oneof" json:"icon,omitempty"`   // Icon identifier
+	CreatedAt     int64                  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     int64                  `protobuf:"varint,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Category) Reset() {
+	*x = Category{}
+	mi := &file_category_v1_category_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Category) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Category) ProtoMessage() {}
+
+func (x *Category) ProtoReflect() protoreflect.Message {
+	mi := &file_category_v1_category_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Category.ProtoReflect.Descriptor instead.
+func (*Category) Descriptor() ([]byte, []int) {
+	return file_category_v1_category_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Category) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Category) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Category) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *Category) GetColor() string {
+	if x != nil && x.Color != nil {
+		return *x.Color
+	}
+	return ""
+}
+
+func (x *Category) GetIcon() string {
+	if x != nil && x.Icon != nil {
+		return *x.Icon
+	}
+	return ""
+}
+
+func (x *Category) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Category) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type CreateCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   *string                `protobuf:"bytes,2,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Color         *string                `protobuf:"bytes,3,opt,name=color,proto3,oneof" json:"color,omitempty"`
+	Icon          *string                `protobuf:"bytes,4,opt,name=icon,proto3,oneof" json:"icon,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCategoryRequest) Reset() {
+	*x = CreateCategoryRequest{}
+	mi := &file_category_v1_category_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryRequest) ProtoMessage() {}
+
+func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_category_v1_category_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*CreateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_category_v1_category_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateCategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetColor() string {
+	if x != nil && x.Color != nil {
+		return *x.Color
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetIcon() string {
+	if x != nil && x.Icon != nil {
+		return *x.Icon
+	}
+	return ""
+}
+
+type CreateCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCategoryResponse) Reset() {
+	*x = CreateCategoryResponse{}
+	mi := &file_category_v1_category_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryResponse) ProtoMessage() {}
+
+func (x *CreateCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_category_v1_category_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryResponse.ProtoReflect.Descriptor instead.
+func (*CreateCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_category_v1_category_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateCategoryResponse) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+type GetCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryRequest) Reset() {
+	*x = GetCategoryRequest{}
+	mi := &file_category_v1_category_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryRequest) ProtoMessage() {}
+
+func (x *GetCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_category_v1_category_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryRequest.ProtoReflect.Descriptor instead.
+func (*GetCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_category_v1_category_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetCategoryRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryResponse) Reset() {
+	*x = GetCategoryResponse{}
+	mi := &file_category_v1_category_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryResponse) ProtoMessage() {}
+
+func (x *GetCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_category_v1_category_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryResponse.ProtoReflect.Descriptor instead.
+func (*GetCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_category_v1_category_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetCategoryResponse) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+type UpdateCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Color         *string                `protobuf:"bytes,4,opt,name=color,proto3,oneof" json:"color,omitempty"`
+	Icon          *string                `protobuf:"bytes,5,opt,name=icon,proto3,oneof" json:"icon,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCategoryRequest) Reset() {
+	*x = UpdateCategoryRequest{}
+	mi := &file_category_v1_category_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCategoryRequest) ProtoMessage() {}
+
+func (x *UpdateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_category_v1_category_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_category_v1_category_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateCategoryRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateCategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRequest) GetColor() string {
+	if x != nil && x.Color != nil {
+		return *x.Color
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRequest) GetIcon() string {
+	if x != nil && x.Icon != nil {
+		return *x.Icon
+	}
+	return ""
+}
+
+type UpdateCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCategoryResponse) Reset() {
+	*x = UpdateCategoryResponse{}
+	mi := &file_category_v1_category_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCategoryResponse) ProtoMessage() {}
+
+func (x *UpdateCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_category_v1_category_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCategoryResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_category_v1_category_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateCategoryResponse) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+type DeleteCategoryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Required when anything still references the category (expenses,
+	// transactions, split allocations, rules or category suggestions): either
+	// move them to another category or leave them uncategorized.
+	//
+	// Types that are valid to be assigned to ExpenseAction:
+	//
+	//	*DeleteCategoryRequest_ReassignToCategoryId
+	//	*DeleteCategoryRequest_Uncategorize
+	ExpenseAction isDeleteCategoryRequest_ExpenseAction `protobuf_oneof:"expense_action"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryRequest) Reset() {
+	*x = DeleteCategoryRequest{}
+	mi := &file_category_v1_category_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryRequest) ProtoMessage() {}
+
+func (x *DeleteCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_category_v1_category_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_category_v1_category_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteCategoryRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeleteCategoryRequest) GetExpenseAction() isDeleteCategoryRequest_ExpenseAction {
+	if x != nil {
+		return x.ExpenseAction
+	}
+	return nil
+}
+
+func (x *DeleteCategoryRequest) GetReassignToCategoryId() int64 {
+	if x != nil {
+		if x, ok := x.ExpenseAction.(*DeleteCategoryRequest_ReassignToCategoryId); ok {
+			return x.ReassignToCategoryId
+		}
+	}
+	return 0
+}
+
+func (x *DeleteCategoryRequest) GetUncategorize() bool {
+	if x != nil {
+		if x, ok := x.ExpenseAction.(*DeleteCategoryRequest_Uncategorize); ok {
+			return x.Uncategorize
+		}
+	}
+	return false
+}
+
+type isDeleteCategoryRequest_ExpenseAction interface {
+	isDeleteCategoryRequest_ExpenseAction()
+}
+
+type DeleteCategoryRequest_ReassignToCategoryId struct {
+	ReassignToCategoryId int64 `protobuf:"varint,2,opt,name=reassign_to_category_id,json=reassignToCategoryId,proto3,oneof"`
+}
+
+type DeleteCategoryRequest_Uncategorize struct {
+	Uncategorize bool `protobuf:"varint,3,opt,name=uncategorize,proto3,oneof"`
+}
+
+func (*DeleteCategoryRequest_ReassignToCategoryId) isDeleteCategoryRequest_ExpenseAction() {}
+
+func (*DeleteCategoryRequest_Uncategorize) isDeleteCategoryRequest_ExpenseAction() {}
+
+type DeleteCategoryResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Success              bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	AffectedExpenses     int64                  `protobuf:"varint,2,opt,name=affected_expenses,json=affectedExpenses,proto3" json:"affected_expenses,omitempty"`
+	AffectedTransactions int64                  `protobuf:"varint,3,opt,name=affected_transactions,json=affectedTransactions,proto3" json:"affected_transactions,omitempty"`
+	AffectedSplits       int64                  `protobuf:"varint,4,opt,name=affected_splits,json=affectedSplits,proto3" json:"affected_splits,omitempty"`
+	AffectedRules        int64                  `protobuf:"varint,5,opt,name=affected_rules,json=affectedRules,proto3" json:"affected_rules,omitempty"`
+	// Suggestions are cleared rather than moved
+	ClearedSuggestions int64 `protobuf:"varint,6,opt,name=cleared_suggestions,json=clearedSuggestions,proto3" json:"cleared_suggestions,omitempty"`
+	// The category's budget, if it had one, is deleted with it
+	BudgetDeleted bool `protobuf:"varint,7,opt,name=budget_deleted,json=budgetDeleted,proto3" json:"budget_deleted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryResponse) Reset() {
+	*x = DeleteCategoryResponse{}
+	mi := &file_category_v1_category_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryResponse) ProtoMessage() {}
+
+func (x *DeleteCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_category_v1_category_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_category_v1_category_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteCategoryResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteCategoryResponse) GetAffectedExpenses() int64 {
+	if x != nil {
+		return x.AffectedExpenses
+	}
+	return 0
+}
+
+func (x *DeleteCategoryResponse) GetAffectedTransactions() int64 {
+	if x != nil {
+		return x.AffectedTransactions
+	}
+	return 0
+}
+
+func (x *DeleteCategoryResponse) GetAffectedSplits() int64 {
+	if x != nil {
+		return x.AffectedSplits
+	}
+	return 0
+}
+
+func (x *DeleteCategoryResponse) GetAffectedRules() int64 {
+	if x != nil {
+		return x.AffectedRules
+	}
+	return 0
+}
+
+func (x *DeleteCategoryResponse) GetClearedSuggestions() int64 {
+	if x != nil {
+		return x.ClearedSuggestions
+	}
+	return 0
+}
+
+func (x *DeleteCategoryResponse) GetBudgetDeleted() bool {
+	if x != nil {
+		return x.BudgetDeleted
+	}
+	return false
+}
+
+type ListCategoriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoriesRequest) Reset() {
+	*x = ListCategoriesRequest{}
+	mi := &file_category_v1_category_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesRequest) ProtoMessage() {}
+
+func (x *ListCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_category_v1_category_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*ListCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_category_v1_category_proto_rawDescGZIP(), []int{9}
+}
+
+type ListCategoriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Categories    []*Category            `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoriesResponse) Reset() {
+	*x = ListCategoriesResponse{}
+	mi := &file_category_v1_category_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesResponse) ProtoMessage() {}
+
+func (x *ListCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_category_v1_category_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesResponse.ProtoReflect.Descriptor instead.
+func (*ListCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_category_v1_category_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListCategoriesResponse) GetCategories() []*Category {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+// A category's monthly budget. Amounts are decimal strings, e.g. "250.00".
+type CategoryBudget struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    int64                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Amount        string                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Rollover      bool                   `protobuf:"varint,3,opt,name=rollover,proto3" json:"rollover,omitempty"`                      // Unspent money carries into the next month
+	StartMonth    string                 `protobuf:"bytes,4,opt,name=start_month,json=startMonth,proto3" json:"start_month,omitempty"` // YYYY-MM, the first budgeted month
+	UpdatedAt     int64                  `protobuf:"varint,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryBudget) Reset() {
+	*x = CategoryBudget{}
+	mi := &file_category_v1_category_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryBudget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryBudget) ProtoMessage() {}
+
+func (x *CategoryBudget) ProtoReflect() protoreflect.Message {
+	mi := &file_category_v1_category_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryBudget.ProtoReflect.Descriptor instead.
+func (*CategoryBudget) Descriptor() ([]byte, []int) {
+	return file_category_v1_category_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CategoryBudget) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *CategoryBudget) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *CategoryBudget) GetRollover() bool {
+	if x != nil {
+		return x.Rollover
+	}
+	return false
+}
+
+func (x *CategoryBudget) GetStartMonth() string {
+	if x != nil {
+		return x.StartMonth
+	}
+	return ""
+}
+
+func (x *CategoryBudget) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type SetCategoryBudgetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    int64                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Amount        string                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Rollover      bool                   `protobuf:"varint,3,opt,name=rollover,proto3" json:"rollover,omitempty"`
+	StartMonth    *string                `protobuf:"bytes,4,opt,name=start_month,json=startMonth,proto3,oneof" json:"start_month,omitempty"` // YYYY-MM; defaults to the current month
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCategoryBudgetRequest) Reset() {
+	*x = SetCategoryBudgetRequest{}
+	mi := &file_category_v1_category_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCategoryBudgetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCategoryBudgetRequest) ProtoMessage() {}
+
+func (x *SetCategoryBudgetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_category_v1_category_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCategoryBudgetRequest.ProtoReflect.Descriptor instead.
+func (*SetCategoryBudgetRequest) Descriptor() ([]byte, []int) {
+	return file_category_v1_category_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SetCategoryBudgetRequest) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *SetCategoryBudgetRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *SetCategoryBudgetRequest) GetRollover() bool {
+	if x != nil {
+		return x.Rollover
+	}
+	return false
+}
+
+func (x *SetCategoryBudgetRequest) GetStartMonth() string {
+	if x != nil && x.StartMonth != nil {
+		return *x.StartMonth
+	}
+	return ""
+}
+
+type SetCategoryBudgetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Budget        *CategoryBudget        `protobuf:"bytes,1,opt,name=budget,proto3" json:"budget,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCategoryBudgetResponse) Reset() {
+	*x = SetCategoryBudgetResponse{}
+	mi := &file_category_v1_category_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCategoryBudgetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCategoryBudgetResponse) ProtoMessage() {}
+
+func (x *SetCategoryBudgetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_category_v1_category_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCategoryBudgetResponse.ProtoReflect.Descriptor instead.
+func (*SetCategoryBudgetResponse) Descriptor() ([]byte, []int) {
+	return file_category_v1_category_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SetCategoryBudgetResponse) GetBudget() *CategoryBudget {
+	if x != nil {
+		return x.Budget
+	}
+	return nil
+}
+
+type DeleteCategoryBudgetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    int64                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryBudgetRequest) Reset() {
+	*x = DeleteCategoryBudgetRequest{}
+	mi := &file_category_v1_category_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryBudgetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryBudgetRequest) ProtoMessage() {}
+
+func (x *DeleteCategoryBudgetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_category_v1_category_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryBudgetRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryBudgetRequest) Descriptor() ([]byte, []int) {
+	return file_category_v1_category_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DeleteCategoryBudgetRequest) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+type DeleteCategoryBudgetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryBudgetResponse) Reset() {
+	*x = DeleteCategoryBudgetResponse{}
+	mi := &file_category_v1_category_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryBudgetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryBudgetResponse) ProtoMessage() {}
+
+func (x *DeleteCategoryBudgetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_category_v1_category_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryBudgetResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryBudgetResponse) Descriptor() ([]byte, []int) {
+	return file_category_v1_category_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DeleteCategoryBudgetResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListCategoryBudgetsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoryBudgetsRequest) Reset() {
+	*x = ListCategoryBudgetsRequest{}
+	mi := &file_category_v1_category_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoryBudgetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoryBudgetsRequest) ProtoMessage() {}
+
+func (x *ListCategoryBudgetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_category_v1_category_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoryBudgetsRequest.ProtoReflect.Descriptor instead.
+func (*ListCategoryBudgetsRequest) Descriptor() ([]byte, []int) {
+	return file_category_v1_category_proto_rawDescGZIP(), []int{16}
+}
+
+type ListCategoryBudgetsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Budgets       []*CategoryBudget      `protobuf:"bytes,1,rep,name=budgets,proto3" json:"budgets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoryBudgetsResponse) Reset() {
+	*x = ListCategoryBudgetsResponse{}
+	mi := &file_category_v1_category_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoryBudgetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoryBudgetsResponse) ProtoMessage() {}
+
+func (x *ListCategoryBudgetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_category_v1_category_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoryBudgetsResponse.ProtoReflect.Descriptor instead.
+func (*ListCategoryBudgetsResponse) Descriptor() ([]byte, []int) {
+	return file_category_v1_category_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListCategoryBudgetsResponse) GetBudgets() []*CategoryBudget {
+	if x != nil {
+		return x.Budgets
+	}
+	return nil
+}
+
+type GetBudgetReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Month         string                 `protobuf:"bytes,1,opt,name=month,proto3" json:"month,omitempty"` // YYYY-MM
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBudgetReportRequest) Reset() {
+	*x = GetBudgetReportRequest{}
+	mi := &file_category_v1_category_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBudgetReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBudgetReportRequest) ProtoMessage() {}
+
+func (x *GetBudgetReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_category_v1_category_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBudgetReportRequest.ProtoReflect.Descriptor instead.
+func (*GetBudgetReportRequest) Descriptor() ([]byte, []int) {
+	return file_category_v1_category_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetBudgetReportRequest) GetMonth() string {
+	if x != nil {
+		return x.Month
+	}
+	return ""
+}
+
+// One category's budget for a month. Spending counts the transactions of
+// accounts included in the budget, net of refunds, leaving out transfers.
+// Split transactions count by their allocations.
+type BudgetLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    *int64                 `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"` // Unset for uncategorized spending
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Budgeted      string                 `protobuf:"bytes,3,opt,name=budgeted,proto3" json:"budgeted,omitempty"`
+	RolledOver    string                 `protobuf:"bytes,4,opt,name=rolled_over,json=rolledOver,proto3" json:"rolled_over,omitempty"` // Unspent money carried in from earlier months
+	Available     string                 `protobuf:"bytes,5,opt,name=available,proto3" json:"available,omitempty"`                     // budgeted + rolled_over
+	Planned       string                 `protobuf:"bytes,6,opt,name=planned,proto3" json:"planned,omitempty"`                         // Bills due in the month
+	Spent         string                 `protobuf:"bytes,7,opt,name=spent,proto3" json:"spent,omitempty"`
+	Remaining     string                 `protobuf:"bytes,8,opt,name=remaining,proto3" json:"remaining,omitempty"`                                // available - spent; negative when over budget
+	PercentUsed   *float64               `protobuf:"fixed64,9,opt,name=percent_used,json=percentUsed,proto3,oneof" json:"percent_used,omitempty"` // Unset when nothing is available
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BudgetLine) Reset() {
+	*x = BudgetLine{}
+	mi := &file_category_v1_category_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BudgetLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BudgetLine) ProtoMessage() {}
+
+func (x *BudgetLine) ProtoReflect() protoreflect.Message {
+	mi := &file_category_v1_category_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BudgetLine.ProtoReflect.Descriptor instead.
+func (*BudgetLine) Descriptor() ([]byte, []int) {
+	return file_category_v1_category_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *BudgetLine) GetCategoryId() int64 {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return 0
+}
+
+func (x *BudgetLine) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BudgetLine) GetBudgeted() string {
+	if x != nil {
+		return x.Budgeted
+	}
+	return ""
+}
+
+func (x *BudgetLine) GetRolledOver() string {
+	if x != nil {
+		return x.RolledOver
+	}
+	return ""
+}
+
+func (x *BudgetLine) GetAvailable() string {
+	if x != nil {
+		return x.Available
+	}
+	return ""
+}
+
+func (x *BudgetLine) GetPlanned() string {
+	if x != nil {
+		return x.Planned
+	}
+	return ""
+}
+
+func (x *BudgetLine) GetSpent() string {
+	if x != nil {
+		return x.Spent
+	}
+	return ""
+}
+
+func (x *BudgetLine) GetRemaining() string {
+	if x != nil {
+		return x.Remaining
+	}
+	return ""
+}
+
+func (x *BudgetLine) GetPercentUsed() float64 {
+	if x != nil && x.PercentUsed != nil {
+		return *x.PercentUsed
+	}
+	return 0
+}
+
+type GetBudgetReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Month         string                 `protobuf:"bytes,1,opt,name=month,proto3" json:"month,omitempty"`
+	Lines         []*BudgetLine          `protobuf:"bytes,2,rep,name=lines,proto3" json:"lines,omitempty"` // Ordered by category name, uncategorized last
+	Total         *BudgetLine            `protobuf:"bytes,3,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBudgetReportResponse) Reset() {
+	*x = GetBudgetReportResponse{}
+	mi := &file_category_v1_category_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBudgetReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBudgetReportResponse) ProtoMessage() {}
+
+func (x *GetBudgetReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_category_v1_category_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBudgetReportResponse.ProtoReflect.Descriptor instead.
+func (*GetBudgetReportResponse) Descriptor() ([]byte, []int) {
+	return file_category_v1_category_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetBudgetReportResponse) GetMonth() string {
+	if x != nil {
+		return x.Month
+	}
+	return ""
+}
+
+func (x *GetBudgetReportResponse) GetLines() []*BudgetLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *GetBudgetReportResponse) GetTotal() *BudgetLine {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+var File_category_v1_category_proto protoreflect.FileDescriptor
+
+const file_category_v1_category_proto_rawDesc = "" +
+	"\n" +
+	"\x1acategory/v1/category.proto\x12\vcategory.v1\"\xea\x01\n" +
+	"\bCategory\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x00R\vdescription\x88\x01\x01\x12\x19\n" +
+	"\x05color\x18\x04 \x01(\tH\x01R\x05color\x88\x01\x01\x12\x17\n" +
+	"\x04icon\x18\x05 \x01(\tH\x02R\x04icon\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\x03R\tupdatedAtB\x0e\n" +
+	"\f_descriptionB\b\n" +
+	"\x06_colorB\a\n" +
+	"\x05_icon\"\xa9\x01\n" +
+	"\x15CreateCategoryRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12%\n" +
+	"\vdescription\x18\x02 \x01(\tH\x00R\vdescription\x88\x01\x01\x12\x19\n" +
+	"\x05color\x18\x03 \x01(\tH\x01R\x05color\x88\x01\x01\x12\x17\n" +
+	"\x04icon\x18\x04 \x01(\tH\x02R\x04icon\x88\x01\x01B\x0e\n" +
+	"\f_descriptionB\b\n" +
+	"\x06_colorB\a\n" +
+	"\x05_icon\"K\n" +
+	"\x16CreateCategoryResponse\x121\n" +
+	"\bcategory\x18\x01 \x01(\v2\x15.category.v1.CategoryR\bcategory\"$\n" +
+	"\x12GetCategoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"H\n" +
+	"\x13GetCategoryResponse\x121\n" +
+	"\bcategory\x18\x01 \x01(\v2\x15.category.v1.CategoryR\bcategory\"\xb9\x01\n" +
+	"\x15UpdateCategoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x00R\vdescription\x88\x01\x01\x12\x19\n" +
+	"\x05color\x18\x04 \x01(\tH\x01R\x05color\x88\x01\x01\x12\x17\n" +
+	"\x04icon\x18\x05 \x01(\tH\x02R\x04icon\x88\x01\x01B\x0e\n" +
+	"\f_descriptionB\b\n" +
+	"\x06_colorB\a\n" +
+	"\x05_icon\"K\n" +
+	"\x16UpdateCategoryResponse\x121\n" +
+	"\bcategory\x18\x01 \x01(\v2\x15.category.v1.CategoryR\bcategory\"\x98\x01\n" +
+	"\x15DeleteCategoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x127\n" +
+	"\x17reassign_to_category_id\x18\x02 \x01(\x03H\x00R\x14reassignToCategoryId\x12$\n" +
+	"\funcategorize\x18\x03 \x01(\bH\x00R\funcategorizeB\x10\n" +
+	"\x0eexpense_action\"\xbc\x02\n" +
+	"\x16DeleteCategoryResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12+\n" +
+	"\x11affected_expenses\x18\x02 \x01(\x03R\x10affectedExpenses\x123\n" +
+	"\x15affected_transactions\x18\x03 \x01(\x03R\x14affectedTransactions\x12'\n" +
+	"\x0faffected_splits\x18\x04 \x01(\x03R\x0eaffectedSplits\x12%\n" +
+	"\x0eaffected_rules\x18\x05 \x01(\x03R\raffectedRules\x12/\n" +
+	"\x13cleared_suggestions\x18\x06 \x01(\x03R\x12clearedSuggestions\x12%\n" +
+	"\x0ebudget_deleted\x18\a \x01(\bR\rbudgetDeleted\"\x17\n" +
+	"\x15ListCategoriesRequest\"O\n" +
+	"\x16ListCategoriesResponse\x125\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\v2\x15.category.v1.CategoryR\n" +
+	"categories\"\xa5\x01\n" +
+	"\x0eCategoryBudget\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\x03R\n" +
+	"categoryId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\tR\x06amount\x12\x1a\n" +
+	"\brollover\x18\x03 \x01(\bR\brollover\x12\x1f\n" +
+	"\vstart_month\x18\x04 \x01(\tR\n" +
+	"startMonth\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\x03R\tupdatedAt\"\xa5\x01\n" +
+	"\x18SetCategoryBudgetRequest\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\x03R\n" +
+	"categoryId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\tR\x06amount\x12\x1a\n" +
+	"\brollover\x18\x03 \x01(\bR\brollover\x12$\n" +
+	"\vstart_month\x18\x04 \x01(\tH\x00R\n" +
+	"startMonth\x88\x01\x01B\x0e\n" +
+	"\f_start_month\"P\n" +
+	"\x19SetCategoryBudgetResponse\x123\n" +
+	"\x06budget\x18\x01 \x01(\v2\x1b.category.v1.CategoryBudgetR\x06budget\">\n" +
+	"\x1bDeleteCategoryBudgetRequest\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\x03R\n" +
+	"categoryId\"8\n" +
+	"\x1cDeleteCategoryBudgetResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x1c\n" +
+	"\x1aListCategoryBudgetsRequest\"T\n" +
+	"\x1bListCategoryBudgetsResponse\x125\n" +
+	"\abudgets\x18\x01 \x03(\v2\x1b.category.v1.CategoryBudgetR\abudgets\".\n" +
+	"\x16GetBudgetReportRequest\x12\x14\n" +
+	"\x05month\x18\x01 \x01(\tR\x05month\"\xb8\x02\n" +
+	"\n" +
+	"BudgetLine\x12$\n" +
+	"\vcategory_id\x18\x01 \x01(\x03H\x00R\n" +
+	"categoryId\x88\x01\x01\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bbudgeted\x18\x03 \x01(\tR\bbudgeted\x12\x1f\n" +
+	"\vrolled_over\x18\x04 \x01(\tR\n" +
+	"rolledOver\x12\x1c\n" +
+	"\tavailable\x18\x05 \x01(\tR\tavailable\x12\x18\n" +
+	"\aplanned\x18\x06 \x01(\tR\aplanned\x12\x14\n" +
+	"\x05spent\x18\a \x01(\tR\x05spent\x12\x1c\n" +
+	"\tremaining\x18\b \x01(\tR\tremaining\x12&\n" +
+	"\fpercent_used\x18\t \x01(\x01H\x01R\vpercentUsed\x88\x01\x01B\x0e\n" +
+	"\f_category_idB\x0f\n" +
+	"\r_percent_used\"\x8d\x01\n" +
+	"\x17GetBudgetReportResponse\x12\x14\n" +
+	"\x05month\x18\x01 \x01(\tR\x05month\x12-\n" +
+	"\x05lines\x18\x02 \x03(\v2\x17.category.v1.BudgetLineR\x05lines\x12-\n" +
+	"\x05total\x18\x03 \x01(\v2\x17.category.v1.BudgetLineR\x05total2\xe8\x06\n" +
+	"\x0fCategoryService\x12Y\n" +
+	"\x0eCreateCategory\x12\".category.v1.CreateCategoryRequest\x1a#.category.v1.CreateCategoryResponse\x12P\n" +
+	"\vGetCategory\x12\x1f.category.v1.GetCategoryRequest\x1a .category.v1.GetCategoryResponse\x12Y\n" +
+	"\x0eUpdateCategory\x12\".category.v1.UpdateCategoryRequest\x1a#.category.v1.UpdateCategoryResponse\x12Y\n" +
+	"\x0eDeleteCategory\x12\".category.v1.DeleteCategoryRequest\x1a#.category.v1.DeleteCategoryResponse\x12Y\n" +
+	"\x0eListCategories\x12\".category.v1.ListCategoriesRequest\x1a#.category.v1.ListCategoriesResponse\x12b\n" +
+	"\x11SetCategoryBudget\x12%.category.v1.SetCategoryBudgetRequest\x1a&.category.v1.SetCategoryBudgetResponse\x12k\n" +
+	"\x14DeleteCategoryBudget\x12(.category.v1.DeleteCategoryBudgetRequest\x1a).category.v1.DeleteCategoryBudgetResponse\x12h\n" +
+	"\x13ListCategoryBudgets\x12'.category.v1.ListCategoryBudgetsRequest\x1a(.category.v1.ListCategoryBudgetsResponse\x12\\\n" +
+	"\x0fGetBudgetReport\x12#.category.v1.GetBudgetReportRequest\x1a$.category.v1.GetBudgetReportResponseB-Z+expenses-backend/pkg/category/v1;categoryv1b\x06proto3"
+
+var (
+	file_category_v1_category_proto_rawDescOnce sync.Once
+	file_category_v1_category_proto_rawDescData []byte
+)
+
+func file_category_v1_category_proto_rawDescGZIP() []byte {
+	file_category_v1_category_proto_rawDescOnce.Do(func() {
+		file_category_v1_category_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_category_v1_category_proto_rawDesc), len(file_category_v1_category_proto_rawDesc)))
+	})
+	return file_category_v1_category_proto_rawDescData
+}
+
+var file_category_v1_category_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_category_v1_category_proto_goTypes = []any{
+	(*Category)(nil),                     // 0: category.v1.Category
+	(*CreateCategoryRequest)(nil),        // 1: category.v1.CreateCategoryRequest
+	(*CreateCategoryResponse)(nil),       // 2: category.v1.CreateCategoryResponse
+	(*GetCategoryRequest)(nil),           // 3: category.v1.GetCategoryRequest
+	(*GetCategoryResponse)(nil),          // 4: category.v1.GetCategoryResponse
+	(*UpdateCategoryRequest)(nil),        // 5: category.v1.UpdateCategoryRequest
+	(*UpdateCategoryResponse)(nil),       // 6: category.v1.UpdateCategoryResponse
+	(*DeleteCategoryRequest)(nil),        // 7: category.v1.DeleteCategoryRequest
+	(*DeleteCategoryResponse)(nil),       // 8: category.v1.DeleteCategoryResponse
+	(*ListCategoriesRequest)(nil),        // 9: category.v1.ListCategoriesRequest
+	(*ListCategoriesResponse)(nil),       // 10: category.v1.ListCategoriesResponse
+	(*CategoryBudget)(nil),               // 11: category.v1.CategoryBudget
+	(*SetCategoryBudgetRequest)(nil),     // 12: category.v1.SetCategoryBudgetRequest
+	(*SetCategoryBudgetResponse)(nil),    // 13: category.v1.SetCategoryBudgetResponse
+	(*DeleteCategoryBudgetRequest)(nil),  // 14: category.v1.DeleteCategoryBudgetRequest
+	(*DeleteCategoryBudgetResponse)(nil), // 15: category.v1.DeleteCategoryBudgetResponse
+	(*ListCategoryBudgetsRequest)(nil),   // 16: category.v1.ListCategoryBudgetsRequest
+	(*ListCategoryBudgetsResponse)(nil),  // 17: category.v1.ListCategoryBudgetsResponse
+	(*GetBudgetReportRequest)(nil),       // 18: category.v1.GetBudgetReportRequest
+	(*BudgetLine)(nil),                   // 19: category.v1.BudgetLine
+	(*GetBudgetReportResponse)(nil),      // 20: category.v1.GetBudgetReportResponse
+}
+var file_category_v1_category_proto_depIdxs = []int32{
+	0,  // 0: category.v1.CreateCategoryResponse.category:type_name -> category.v1.Category
+	0,  // 1: category.v1.GetCategoryResponse.category:type_name -> category.v1.Category
+	0,  // 2: category.v1.UpdateCategoryResponse.category:type_name -> category.v1.Category
+	0,  // 3: category.v1.ListCategoriesResponse.categories:type_name -> category.v1.Category
+	11, // 4: category.v1.SetCategoryBudgetResponse.budget:type_name -> category.v1.CategoryBudget
+	11, // 5: category.v1.ListCategoryBudgetsResponse.budgets:type_name -> category.v1.CategoryBudget
+	19, // 6: category.v1.GetBudgetReportResponse.lines:type_name -> category.v1.BudgetLine
+	19, // 7: category.v1.GetBudgetReportResponse.total:type_name -> category.v1.BudgetLine
+	1,  // 8: category.v1.CategoryService.CreateCategory:input_type -> category.v1.CreateCategoryRequest
+	3,  // 9: category.v1.CategoryService.GetCategory:input_type -> category.v1.GetCategoryRequest
+	5,  // 10: category.v1.CategoryService.UpdateCategory:input_type -> category.v1.UpdateCategoryRequest
+	7,  // 11: category.v1.CategoryService.DeleteCategory:input_type -> category.v1.DeleteCategoryRequest
+	9,  // 12: category.v1.CategoryService.ListCategories:input_type -> category.v1.ListCategoriesRequest
+	12, // 13: category.v1.CategoryService.SetCategoryBudget:input_type -> category.v1.SetCategoryBudgetRequest
+	14, // 14: category.v1.CategoryService.DeleteCategoryBudget:input_type -> category.v1.DeleteCategoryBudgetRequest
+	16, // 15: category.v1.CategoryService.ListCategoryBudgets:input_type -> category.v1.ListCategoryBudgetsRequest
+	18, // 16: category.v1.CategoryService.GetBudgetReport:input_type -> category.v1.GetBudgetReportRequest
+	2,  // 17: category.v1.CategoryService.CreateCategory:output_type -> category.v1.CreateCategoryResponse
+	4,  // 18: category.v1.CategoryService.GetCategory:output_type -> category.v1.GetCategoryResponse
+	6,  // 19: category.v1.CategoryService.UpdateCategory:output_type -> category.v1.UpdateCategoryResponse
+	8,  // 20: category.v1.CategoryService.DeleteCategory:output_type -> category.v1.DeleteCategoryResponse
+	10, // 21: category.v1.CategoryService.ListCategories:output_type -> category.v1.ListCategoriesResponse
+	13, // 22: category.v1.CategoryService.SetCategoryBudget:output_type -> category.v1.SetCategoryBudgetResponse
+	15, // 23: category.v1.CategoryService.DeleteCategoryBudget:output_type -> category.v1.DeleteCategoryBudgetResponse
+	17, // 24: category.v1.CategoryService.ListCategoryBudgets:output_type -> category.v1.ListCategoryBudgetsResponse
+	20, // 25: category.v1.CategoryService.GetBudgetReport:output_type -> category.v1.GetBudgetReportResponse
+	17, // [17:26] is the sub-list for method output_type
+	8,  // [8:17] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_category_v1_category_proto_init() }
+func file_category_v1_category_proto_init() {
+	if File_category_v1_category_proto != nil {
+		return
+	}
+	file_category_v1_category_proto_msgTypes[0].OneofWrappers = []any{}
+	file_category_v1_category_proto_msgTypes[1].OneofWrappers = []any{}
+	file_category_v1_category_proto_msgTypes[5].OneofWrappers = []any{}
+	file_category_v1_category_proto_msgTypes[7].OneofWrappers = []any{
+		(*DeleteCategoryRequest_ReassignToCategoryId)(nil),
+		(*DeleteCategoryRequest_Uncategorize)(nil),
+	}
+	file_category_v1_category_proto_msgTypes[12].OneofWrappers = []any{}
+	file_category_v1_category_proto_msgTypes[19].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_category_v1_category_proto_rawDesc), len(file_category_v1_category_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   21,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_category_v1_category_proto_goTypes,
+		DependencyIndexes: file_category_v1_category_proto_depIdxs,
+		MessageInfos:      file_category_v1_category_proto_msgTypes,
+	}.Build()
+	File_category_v1_category_proto = out.File
+	file_category_v1_category_proto_goTypes = nil
+	file_category_v1_category_proto_depIdxs = nil
+}
@@ -0,0 +1,343 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: category/v1/category.proto
+
+package categoryv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "expenses-backend/pkg/category/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// CategoryServiceName is the fully-qualified name of the CategoryService service.
+	CategoryServiceName = "category.v1.CategoryService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// CategoryServiceCreateCategoryProcedure is the fully-qualified name of the CategoryService's
+	// CreateCategory RPC.
+	CategoryServiceCreateCategoryProcedure = "/category.v1.CategoryService/CreateCategory"
+	// CategoryServiceGetCategoryProcedure is the fully-qualified name of the CategoryService's
+	// GetCategory RPC.
+	CategoryServiceGetCategoryProcedure = "/category.v1.CategoryService/GetCategory"
+	// CategoryServiceUpdateCategoryProcedure is the fully-qualified name of the CategoryService's
+	// UpdateCategory RPC.
+	CategoryServiceUpdateCategoryProcedure = "/category.v1.CategoryService/UpdateCategory"
+	// CategoryServiceDeleteCategoryProcedure is the fully-qualified name of the CategoryService's
+	// DeleteCategory RPC.
+	CategoryServiceDeleteCategoryProcedure = "/category.v1.CategoryService/DeleteCategory"
+	// CategoryServiceListCategoriesProcedure is the fully-qualified name of the CategoryService's
+	// ListCategories RPC.
+	CategoryServiceListCategoriesProcedure = "/category.v1.CategoryService/ListCategories"
+	// CategoryServiceSetCategoryBudgetProcedure is the fully-qualified name of the CategoryService's
+	// SetCategoryBudget RPC.
+	CategoryServiceSetCategoryBudgetProcedure = "/category.v1.CategoryService/SetCategoryBudget"
+	// CategoryServiceDeleteCategoryBudgetProcedure is the fully-qualified name of the CategoryService's
+	// DeleteCategoryBudget RPC.
+	CategoryServiceDeleteCategoryBudgetProcedure = "/category.v1.CategoryService/DeleteCategoryBudget"
+	// CategoryServiceListCategoryBudgetsProcedure is the fully-qualified name of the CategoryService's
+	// ListCategoryBudgets RPC.
+	CategoryServiceListCategoryBudgetsProcedure = "/category.v1.CategoryService/ListCategoryBudgets"
+	// CategoryServiceGetBudgetReportProcedure is the fully-qualified name of the CategoryService's
+	// GetBudgetReport RPC.
+	CategoryServiceGetBudgetReportProcedure = "/category.v1.CategoryService/GetBudgetReport"
+)
+
+// CategoryServiceClient is a client for the category.v1.CategoryService service.
+type CategoryServiceClient interface {
+	CreateCategory(context.Context, *connect.Request[v1.CreateCategoryRequest]) (*connect.Response[v1.CreateCategoryResponse], error)
+	GetCategory(context.Context, *connect.Request[v1.GetCategoryRequest]) (*connect.Response[v1.GetCategoryResponse], error)
+	UpdateCategory(context.Context, *connect.Request[v1.UpdateCategoryRequest]) (*connect.Response[v1.UpdateCategoryResponse], error)
+	DeleteCategory(context.Context, *connect.Request[v1.DeleteCategoryRequest]) (*connect.Response[v1.DeleteCategoryResponse], error)
+	ListCategories(context.Context, *connect.Request[v1.ListCategoriesRequest]) (*connect.Response[v1.ListCategoriesResponse], error)
+	// Budgets
+	SetCategoryBudget(context.Context, *connect.Request[v1.SetCategoryBudgetRequest]) (*connect.Response[v1.SetCategoryBudgetResponse], error)
+	DeleteCategoryBudget(context.Context, *connect.Request[v1.DeleteCategoryBudgetRequest]) (*connect.Response[v1.DeleteCategoryBudgetResponse], error)
+	ListCategoryBudgets(context.Context, *connect.Request[v1.ListCategoryBudgetsRequest]) (*connect.Response[v1.ListCategoryBudgetsResponse], error)
+	GetBudgetReport(context.Context, *connect.Request[v1.GetBudgetReportRequest]) (*connect.Response[v1.GetBudgetReportResponse], error)
+}
+
+// NewCategoryServiceClient constructs a client for the category.v1.CategoryService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewCategoryServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) CategoryServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	categoryServiceMethods := v1.File_category_v1_category_proto.Services().ByName("CategoryService").Methods()
+	return &categoryServiceClient{
+		createCategory: connect.NewClient[v1.CreateCategoryRequest, v1.CreateCategoryResponse](
+			httpClient,
+			baseURL+CategoryServiceCreateCategoryProcedure,
+			connect.WithSchema(categoryServiceMethods.ByName("CreateCategory")),
+			connect.WithClientOptions(opts...),
+		),
+		getCategory: connect.NewClient[v1.GetCategoryRequest, v1.GetCategoryResponse](
+			httpClient,
+			baseURL+CategoryServiceGetCategoryProcedure,
+			connect.WithSchema(categoryServiceMethods.ByName("GetCategory")),
+			connect.WithClientOptions(opts...),
+		),
+		updateCategory: connect.NewClient[v1.UpdateCategoryRequest, v1.UpdateCategoryResponse](
+			httpClient,
+			baseURL+CategoryServiceUpdateCategoryProcedure,
+			connect.WithSchema(categoryServiceMethods.ByName("UpdateCategory")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteCategory: connect.NewClient[v1.DeleteCategoryRequest, v1.DeleteCategoryResponse](
+			httpClient,
+			baseURL+CategoryServiceDeleteCategoryProcedure,
+			connect.WithSchema(categoryServiceMethods.ByName("DeleteCategory")),
+			connect.WithClientOptions(opts...),
+		),
+		listCategories: connect.NewClient[v1.ListCategoriesRequest, v1.ListCategoriesResponse](
+			httpClient,
+			baseURL+CategoryServiceListCategoriesProcedure,
+			connect.WithSchema(categoryServiceMethods.ByName("ListCategories")),
+			connect.WithClientOptions(opts...),
+		),
+		setCategoryBudget: connect.NewClient[v1.SetCategoryBudgetRequest, v1.SetCategoryBudgetResponse](
+			httpClient,
+			baseURL+CategoryServiceSetCategoryBudgetProcedure,
+			connect.WithSchema(categoryServiceMethods.ByName("SetCategoryBudget")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteCategoryBudget: connect.NewClient[v1.DeleteCategoryBudgetRequest, v1.DeleteCategoryBudgetResponse](
+			httpClient,
+			baseURL+CategoryServiceDeleteCategoryBudgetProcedure,
+			connect.WithSchema(categoryServiceMethods.ByName("DeleteCategoryBudget")),
+			connect.WithClientOptions(opts...),
+		),
+		listCategoryBudgets: connect.NewClient[v1.ListCategoryBudgetsRequest, v1.ListCategoryBudgetsResponse](
+			httpClient,
+			baseURL+CategoryServiceListCategoryBudgetsProcedure,
+			connect.WithSchema(categoryServiceMethods.ByName("ListCategoryBudgets")),
+			connect.WithClientOptions(opts...),
+		),
+		getBudgetReport: connect.NewClient[v1.GetBudgetReportRequest, v1.GetBudgetReportResponse](
+			httpClient,
+			baseURL+CategoryServiceGetBudgetReportProcedure,
+			connect.WithSchema(categoryServiceMethods.ByName("GetBudgetReport")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// categoryServiceClient implements CategoryServiceClient.
+type categoryServiceClient struct {
+	createCategory       *connect.Client[v1.CreateCategoryRequest, v1.CreateCategoryResponse]
+	getCategory          *connect.Client[v1.GetCategoryRequest, v1.GetCategoryResponse]
+	updateCategory       *connect.Client[v1.UpdateCategoryRequest, v1.UpdateCategoryResponse]
+	deleteCategory       *connect.Client[v1.DeleteCategoryRequest, v1.DeleteCategoryResponse]
+	listCategories       *connect.Client[v1.ListCategoriesRequest, v1.ListCategoriesResponse]
+	setCategoryBudget    *connect.Client[v1.SetCategoryBudgetRequest, v1.SetCategoryBudgetResponse]
+	deleteCategoryBudget *connect.Client[v1.DeleteCategoryBudgetRequest, v1.DeleteCategoryBudgetResponse]
+	listCategoryBudgets  *connect.Client[v1.ListCategoryBudgetsRequest, v1.ListCategoryBudgetsResponse]
+	getBudgetReport      *connect.Client[v1.GetBudgetReportRequest, v1.GetBudgetReportResponse]
+}
+
+// CreateCategory calls category.v1.CategoryService.CreateCategory.
+func (c *categoryServiceClient) CreateCategory(ctx context.Context, req *connect.Request[v1.CreateCategoryRequest]) (*connect.Response[v1.CreateCategoryResponse], error) {
+	return c.createCategory.CallUnary(ctx, req)
+}
+
+// GetCategory calls category.v1.CategoryService.GetCategory.
+func (c *categoryServiceClient) GetCategory(ctx context.Context, req *connect.Request[v1.GetCategoryRequest]) (*connect.Response[v1.GetCategoryResponse], error) {
+	return c.getCategory.CallUnary(ctx, req)
+}
+
+// UpdateCategory calls category.v1.CategoryService.UpdateCategory.
+func (c *categoryServiceClient) UpdateCategory(ctx context.Context, req *connect.Request[v1.UpdateCategoryRequest]) (*connect.Response[v1.UpdateCategoryResponse], error) {
+	return c.updateCategory.CallUnary(ctx, req)
+}
+
+// DeleteCategory calls category.v1.CategoryService.DeleteCategory.
+func (c *categoryServiceClient) DeleteCategory(ctx context.Context, req *connect.Request[v1.DeleteCategoryRequest]) (*connect.Response[v1.DeleteCategoryResponse], error) {
+	return c.deleteCategory.CallUnary(ctx, req)
+}
+
+// ListCategories calls category.v1.CategoryService.ListCategories.
+func (c *categoryServiceClient) ListCategories(ctx context.Context, req *connect.Request[v1.ListCategoriesRequest]) (*connect.Response[v1.ListCategoriesResponse], error) {
+	return c.listCategories.CallUnary(ctx, req)
+}
+
+// SetCategoryBudget calls category.v1.CategoryService.SetCategoryBudget.
+func (c *categoryServiceClient) SetCategoryBudget(ctx context.Context, req *connect.Request[v1.SetCategoryBudgetRequest]) (*connect.Response[v1.SetCategoryBudgetResponse], error) {
+	return c.setCategoryBudget.CallUnary(ctx, req)
+}
+
+// DeleteCategoryBudget calls category.v1.CategoryService.DeleteCategoryBudget.
+func (c *categoryServiceClient) DeleteCategoryBudget(ctx context.Context, req *connect.Request[v1.DeleteCategoryBudgetRequest]) (*connect.Response[v1.DeleteCategoryBudgetResponse], error) {
+	return c.deleteCategoryBudget.CallUnary(ctx, req)
+}
+
+// ListCategoryBudgets calls category.v1.CategoryService.ListCategoryBudgets.
+func (c *categoryServiceClient) ListCategoryBudgets(ctx context.Context, req *connect.Request[v1.ListCategoryBudgetsRequest]) (*connect.Response[v1.ListCategoryBudgetsResponse], error) {
+	return c.listCategoryBudgets.CallUnary(ctx, req)
+}
+
+// GetBudgetReport calls category.v1.CategoryService.GetBudgetReport.
+func (c *categoryServiceClient) GetBudgetReport(ctx context.Context, req *connect.Request[v1.GetBudgetReportRequest]) (*connect.Response[v1.GetBudgetReportResponse], error) {
+	return c.getBudgetReport.CallUnary(ctx, req)
+}
+
+// CategoryServiceHandler is an implementation of the category.v1.CategoryService service.
+type CategoryServiceHandler interface {
+	CreateCategory(context.Context, *connect.Request[v1.CreateCategoryRequest]) (*connect.Response[v1.CreateCategoryResponse], error)
+	GetCategory(context.Context, *connect.Request[v1.GetCategoryRequest]) (*connect.Response[v1.GetCategoryResponse], error)
+	UpdateCategory(context.Context, *connect.Request[v1.UpdateCategoryRequest]) (*connect.Response[v1.UpdateCategoryResponse], error)
+	DeleteCategory(context.Context, *connect.Request[v1.DeleteCategoryRequest]) (*connect.Response[v1.DeleteCategoryResponse], error)
+	ListCategories(context.Context, *connect.Request[v1.ListCategoriesRequest]) (*connect.Response[v1.ListCategoriesResponse], error)
+	// Budgets
+	SetCategoryBudget(context.Context, *connect.Request[v1.SetCategoryBudgetRequest]) (*connect.Response[v1.SetCategoryBudgetResponse], error)
+	DeleteCategoryBudget(context.Context, *connect.Request[v1.DeleteCategoryBudgetRequest]) (*connect.Response[v1.DeleteCategoryBudgetResponse], error)
+	ListCategoryBudgets(context.Context, *connect.Request[v1.ListCategoryBudgetsRequest]) (*connect.Response[v1.ListCategoryBudgetsResponse], error)
+	GetBudgetReport(context.Context, *connect.Request[v1.GetBudgetReportRequest]) (*connect.Response[v1.GetBudgetReportResponse], error)
+}
+
+// NewCategoryServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewCategoryServiceHandler(svc CategoryServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	categoryServiceMethods := v1.File_category_v1_category_proto.Services().ByName("CategoryService").Methods()
+	categoryServiceCreateCategoryHandler := connect.NewUnaryHandler(
+		CategoryServiceCreateCategoryProcedure,
+		svc.CreateCategory,
+		connect.WithSchema(categoryServiceMethods.ByName("CreateCategory")),
+		connect.WithHandlerOptions(opts...),
+	)
+	categoryServiceGetCategoryHandler := connect.NewUnaryHandler(
+		CategoryServiceGetCategoryProcedure,
+		svc.GetCategory,
+		connect.WithSchema(categoryServiceMethods.ByName("GetCategory")),
+		connect.WithHandlerOptions(opts...),
+	)
+	categoryServiceUpdateCategoryHandler := connect.NewUnaryHandler(
+		CategoryServiceUpdateCategoryProcedure,
+		svc.UpdateCategory,
+		connect.WithSchema(categoryServiceMethods.ByName("UpdateCategory")),
+		connect.WithHandlerOptions(opts...),
+	)
+	categoryServiceDeleteCategoryHandler := connect.NewUnaryHandler(
+		CategoryServiceDeleteCategoryProcedure,
+		svc.DeleteCategory,
+		connect.WithSchema(categoryServiceMethods.ByName("DeleteCategory")),
+		connect.WithHandlerOptions(opts...),
+	)
+	categoryServiceListCategoriesHandler := connect.NewUnaryHandler(
+		CategoryServiceListCategoriesProcedure,
+		svc.ListCategories,
+		connect.WithSchema(categoryServiceMethods.ByName("ListCategories")),
+		connect.WithHandlerOptions(opts...),
+	)
+	categoryServiceSetCategoryBudgetHandler := connect.NewUnaryHandler(
+		CategoryServiceSetCategoryBudgetProcedure,
+		svc.SetCategoryBudget,
+		connect.WithSchema(categoryServiceMethods.ByName("SetCategoryBudget")),
+		connect.WithHandlerOptions(opts...),
+	)
+	categoryServiceDeleteCategoryBudgetHandler := connect.NewUnaryHandler(
+		CategoryServiceDeleteCategoryBudgetProcedure,
+		svc.DeleteCategoryBudget,
+		connect.WithSchema(categoryServiceMethods.ByName("DeleteCategoryBudget")),
+		connect.WithHandlerOptions(opts...),
+	)
+	categoryServiceListCategoryBudgetsHandler := connect.NewUnaryHandler(
+		CategoryServiceListCategoryBudgetsProcedure,
+		svc.ListCategoryBudgets,
+		connect.WithSchema(categoryServiceMethods.ByName("ListCategoryBudgets")),
+		connect.WithHandlerOptions(opts...),
+	)
+	categoryServiceGetBudgetReportHandler := connect.NewUnaryHandler(
+		CategoryServiceGetBudgetReportProcedure,
+		svc.GetBudgetReport,
+		connect.WithSchema(categoryServiceMethods.ByName("GetBudgetReport")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/category.v1.CategoryService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case CategoryServiceCreateCategoryProcedure:
+			categoryServiceCreateCategoryHandler.ServeHTTP(w, r)
+		case CategoryServiceGetCategoryProcedure:
+			categoryServiceGetCategoryHandler.ServeHTTP(w, r)
+		case CategoryServiceUpdateCategoryProcedure:
+			categoryServiceUpdateCategoryHandler.ServeHTTP(w, r)
+		case CategoryServiceDeleteCategoryProcedure:
+			categoryServiceDeleteCategoryHandler.ServeHTTP(w, r)
+		case CategoryServiceListCategoriesProcedure:
+			categoryServiceListCategoriesHandler.ServeHTTP(w, r)
+		case CategoryServiceSetCategoryBudgetProcedure:
+			categoryServiceSetCategoryBudgetHandler.ServeHTTP(w, r)
+		case CategoryServiceDeleteCategoryBudgetProcedure:
+			categoryServiceDeleteCategoryBudgetHandler.ServeHTTP(w, r)
+		case CategoryServiceListCategoryBudgetsProcedure:
+			categoryServiceListCategoryBudgetsHandler.ServeHTTP(w, r)
+		case CategoryServiceGetBudgetReportProcedure:
+			categoryServiceGetBudgetReportHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedCategoryServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedCategoryServiceHandler struct{}
+
+func (UnimplementedCategoryServiceHandler) CreateCategory(context.Context, *connect.Request[v1.CreateCategoryRequest]) (*connect.Response[v1.CreateCategoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("category.v1.CategoryService.CreateCategory is not implemented"))
+}
+
+func (UnimplementedCategoryServiceHandler) GetCategory(context.Context, *connect.Request[v1.GetCategoryRequest]) (*connect.Response[v1.GetCategoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("category.v1.CategoryService.GetCategory is not implemented"))
+}
+
+func (UnimplementedCategoryServiceHandler) UpdateCategory(context.Context, *connect.Request[v1.UpdateCategoryRequest]) (*connect.Response[v1.UpdateCategoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("category.v1.CategoryService.UpdateCategory is not implemented"))
+}
+
+func (UnimplementedCategoryServiceHandler) DeleteCategory(context.Context, *connect.Request[v1.DeleteCategoryRequest]) (*connect.Response[v1.DeleteCategoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("category.v1.CategoryService.DeleteCategory is not implemented"))
+}
+
+func (UnimplementedCategoryServiceHandler) ListCategories(context.Context, *connect.Request[v1.ListCategoriesRequest]) (*connect.Response[v1.ListCategoriesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("category.v1.CategoryService.ListCategories is not implemented"))
+}
+
+func (UnimplementedCategoryServiceHandler) SetCategoryBudget(context.Context, *connect.Request[v1.SetCategoryBudgetRequest]) (*connect.Response[v1.SetCategoryBudgetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("category.v1.CategoryService.SetCategoryBudget is not implemented"))
+}
+
+func (UnimplementedCategoryServiceHandler) DeleteCategoryBudget(context.Context, *connect.Request[v1.DeleteCategoryBudgetRequest]) (*connect.Response[v1.DeleteCategoryBudgetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("category.v1.CategoryService.DeleteCategoryBudget is not implemented"))
+}
+
+func (UnimplementedCategoryServiceHandler) ListCategoryBudgets(context.Context, *connect.Request[v1.ListCategoryBudgetsRequest]) (*connect.Response[v1.ListCategoryBudgetsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("category.v1.CategoryService.ListCategoryBudgets is not implemented"))
+}
+
+func (UnimplementedCategoryServiceHandler) GetBudgetReport(context.Context, *connect.Request[v1.GetBudgetReportRequest]) (*connect.Response[v1.GetBudgetReportResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("category.v1.CategoryService.GetBudgetReport is not implemented"))
+}
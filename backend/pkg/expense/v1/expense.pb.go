@@ -21,22 +21,270 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type Frequency int32
+
+const (
+	Frequency_FREQUENCY_UNSPECIFIED Frequency = 0
+	Frequency_FREQUENCY_WEEKLY      Frequency = 1
+	Frequency_FREQUENCY_BIWEEKLY    Frequency = 2
+	Frequency_FREQUENCY_MONTHLY     Frequency = 3
+	Frequency_FREQUENCY_QUARTERLY   Frequency = 4
+	Frequency_FREQUENCY_SEMIANNUAL  Frequency = 5
+	Frequency_FREQUENCY_ANNUAL      Frequency = 6
+)
+
+// Enum value maps for Frequency.
+var (
+	Frequency_name = map[int32]string{
+		0: "FREQUENCY_UNSPECIFIED",
+		1: "FREQUENCY_WEEKLY",
+		2: "FREQUENCY_BIWEEKLY",
+		3: "FREQUENCY_MONTHLY",
+		4: "FREQUENCY_QUARTERLY",
+		5: "FREQUENCY_SEMIANNUAL",
+		6: "FREQUENCY_ANNUAL",
+	}
+	Frequency_value = map[string]int32{
+		"FREQUENCY_UNSPECIFIED": 0,
+		"FREQUENCY_WEEKLY":      1,
+		"FREQUENCY_BIWEEKLY":    2,
+		"FREQUENCY_MONTHLY":     3,
+		"FREQUENCY_QUARTERLY":   4,
+		"FREQUENCY_SEMIANNUAL":  5,
+		"FREQUENCY_ANNUAL":      6,
+	}
+)
+
+func (x Frequency) Enum() *Frequency {
+	p := new(Frequency)
+	*p = x
+	return p
+}
+
+func (x Frequency) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Frequency) Descriptor() protoreflect.EnumDescriptor {
+	return file_expense_v1_expense_proto_enumTypes[0].Descriptor()
+}
+
+func (Frequency) Type() protoreflect.EnumType {
+	return &file_expense_v1_expense_proto_enumTypes[0]
+}
+
+func (x Frequency) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Frequency.Descriptor instead.
+func (Frequency) EnumDescriptor() ([]byte, []int) {
+	return file_expense_v1_expense_proto_rawDescGZIP(), []int{0}
+}
+
+// How a due date that lands on a weekend or family holiday is moved
+type BusinessDayAdjustment int32
+
+const (
+	BusinessDayAdjustment_BUSINESS_DAY_ADJUSTMENT_UNSPECIFIED BusinessDayAdjustment = 0 // Same as NONE
+	BusinessDayAdjustment_BUSINESS_DAY_ADJUSTMENT_NONE        BusinessDayAdjustment = 1
+	BusinessDayAdjustment_BUSINESS_DAY_ADJUSTMENT_PREVIOUS    BusinessDayAdjustment = 2
+	BusinessDayAdjustment_BUSINESS_DAY_ADJUSTMENT_NEXT        BusinessDayAdjustment = 3
+)
+
+// Enum value maps for BusinessDayAdjustment.
+var (
+	BusinessDayAdjustment_name = map[int32]string{
+		0: "BUSINESS_DAY_ADJUSTMENT_UNSPECIFIED",
+		1: "BUSINESS_DAY_ADJUSTMENT_NONE",
+		2: "BUSINESS_DAY_ADJUSTMENT_PREVIOUS",
+		3: "BUSINESS_DAY_ADJUSTMENT_NEXT",
+	}
+	BusinessDayAdjustment_value = map[string]int32{
+		"BUSINESS_DAY_ADJUSTMENT_UNSPECIFIED": 0,
+		"BUSINESS_DAY_ADJUSTMENT_NONE":        1,
+		"BUSINESS_DAY_ADJUSTMENT_PREVIOUS":    2,
+		"BUSINESS_DAY_ADJUSTMENT_NEXT":        3,
+	}
+)
+
+func (x BusinessDayAdjustment) Enum() *BusinessDayAdjustment {
+	p := new(BusinessDayAdjustment)
+	*p = x
+	return p
+}
+
+func (x BusinessDayAdjustment) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BusinessDayAdjustment) Descriptor() protoreflect.EnumDescriptor {
+	return file_expense_v1_expense_proto_enumTypes[1].Descriptor()
+}
+
+func (BusinessDayAdjustment) Type() protoreflect.EnumType {
+	return &file_expense_v1_expense_proto_enumTypes[1]
+}
+
+func (x BusinessDayAdjustment) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BusinessDayAdjustment.Descriptor instead.
+func (BusinessDayAdjustment) EnumDescriptor() ([]byte, []int) {
+	return file_expense_v1_expense_proto_rawDescGZIP(), []int{1}
+}
+
+type OccurrenceStatus int32
+
+const (
+	OccurrenceStatus_OCCURRENCE_STATUS_UNSPECIFIED OccurrenceStatus = 0
+	OccurrenceStatus_OCCURRENCE_STATUS_UPCOMING    OccurrenceStatus = 1 // Due more than a week from today
+	OccurrenceStatus_OCCURRENCE_STATUS_DUE         OccurrenceStatus = 2 // Due today or within the next week
+	OccurrenceStatus_OCCURRENCE_STATUS_OVERDUE     OccurrenceStatus = 3 // Due date has passed without a payment
+	OccurrenceStatus_OCCURRENCE_STATUS_PAID        OccurrenceStatus = 4
+)
+
+// Enum value maps for OccurrenceStatus.
+var (
+	OccurrenceStatus_name = map[int32]string{
+		0: "OCCURRENCE_STATUS_UNSPECIFIED",
+		1: "OCCURRENCE_STATUS_UPCOMING",
+		2: "OCCURRENCE_STATUS_DUE",
+		3: "OCCURRENCE_STATUS_OVERDUE",
+		4: "OCCURRENCE_STATUS_PAID",
+	}
+	OccurrenceStatus_value = map[string]int32{
+		"OCCURRENCE_STATUS_UNSPECIFIED": 0,
+		"OCCURRENCE_STATUS_UPCOMING":    1,
+		"OCCURRENCE_STATUS_DUE":         2,
+		"OCCURRENCE_STATUS_OVERDUE":     3,
+		"OCCURRENCE_STATUS_PAID":        4,
+	}
+)
+
+func (x OccurrenceStatus) Enum() *OccurrenceStatus {
+	p := new(OccurrenceStatus)
+	*p = x
+	return p
+}
+
+func (x OccurrenceStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OccurrenceStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_expense_v1_expense_proto_enumTypes[2].Descriptor()
+}
+
+func (OccurrenceStatus) Type() protoreflect.EnumType {
+	return &file_expense_v1_expense_proto_enumTypes[2]
+}
+
+func (x OccurrenceStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OccurrenceStatus.Descriptor instead.
+func (OccurrenceStatus) EnumDescriptor() ([]byte, []int) {
+	return file_expense_v1_expense_proto_rawDescGZIP(), []int{2}
+}
+
+type Recurrence struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Frequency       Frequency              `protobuf:"varint,1,opt,name=frequency,proto3,enum=expense.v1.Frequency" json:"frequency,omitempty"`
+	Interval        int32                  `protobuf:"varint,2,opt,name=interval,proto3" json:"interval,omitempty"`                                            // Repeat every N periods, defaults to 1
+	AnchorDate      string                 `protobuf:"bytes,3,opt,name=anchor_date,json=anchorDate,proto3" json:"anchor_date,omitempty"`                       // YYYY-MM-DD, first due date of the series
+	EndDate         *string                `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3,oneof" json:"end_date,omitempty"`                          // YYYY-MM-DD, last possible due date
+	OccurrenceCount *int32                 `protobuf:"varint,5,opt,name=occurrence_count,json=occurrenceCount,proto3,oneof" json:"occurrence_count,omitempty"` // Stop after this many payments
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Recurrence) Reset() {
+	*x = Recurrence{}
+	mi := &file_expense_v1_expense_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Recurrence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Recurrence) ProtoMessage() {}
+
+func (x *Recurrence) ProtoReflect() protoreflect.Message {
+	mi := &file_expense_v1_expense_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Recurrence.ProtoReflect.Descriptor instead.
+func (*Recurrence) Descriptor() ([]byte, []int) {
+	return file_expense_v1_expense_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Recurrence) GetFrequency() Frequency {
+	if x != nil {
+		return x.Frequency
+	}
+	return Frequency_FREQUENCY_UNSPECIFIED
+}
+
+func (x *Recurrence) GetInterval() int32 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *Recurrence) GetAnchorDate() string {
+	if x != nil {
+		return x.AnchorDate
+	}
+	return ""
+}
+
+func (x *Recurrence) GetEndDate() string {
+	if x != nil && x.EndDate != nil {
+		return *x.EndDate
+	}
+	return ""
+}
+
+func (x *Recurrence) GetOccurrenceCount() int32 {
+	if x != nil && x.OccurrenceCount != nil {
+		return *x.OccurrenceCount
+	}
+	return 0
+}
+
 type Expense struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	DayOfMonthDue int32                  `protobuf:"varint,4,opt,name=day_of_month_due,json=dayOfMonthDue,proto3" json:"day_of_month_due,omitempty"`
-	IsAutopay     bool                   `protobuf:"varint,5,opt,name=is_autopay,json=isAutopay,proto3" json:"is_autopay,omitempty"`
-	CreatedAt     int64                  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     int64                  `protobuf:"varint,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Id                    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Amount                float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	DayOfMonthDue         int32                  `protobuf:"varint,4,opt,name=day_of_month_due,json=dayOfMonthDue,proto3" json:"day_of_month_due,omitempty"`
+	IsAutopay             bool                   `protobuf:"varint,5,opt,name=is_autopay,json=isAutopay,proto3" json:"is_autopay,omitempty"`
+	CreatedAt             int64                  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt             int64                  `protobuf:"varint,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CategoryId            *int64                 `protobuf:"varint,8,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	Recurrence            *Recurrence            `protobuf:"bytes,9,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	BusinessDayAdjustment BusinessDayAdjustment  `protobuf:"varint,10,opt,name=business_day_adjustment,json=businessDayAdjustment,proto3,enum=expense.v1.BusinessDayAdjustment" json:"business_day_adjustment,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
 func (x *Expense) Reset() {
 	*x = Expense{}
-	mi := &file_expense_v1_expense_proto_msgTypes[0]
+	mi := &file_expense_v1_expense_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -48,7 +296,7 @@ func (x *Expense) String() string {
 func (*Expense) ProtoMessage() {}
 
 func (x *Expense) ProtoReflect() protoreflect.Message {
-	mi := &file_expense_v1_expense_proto_msgTypes[0]
+	mi := &file_expense_v1_expense_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -61,7 +309,7 @@ func (x *Expense) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Expense.ProtoReflect.Descriptor instead.
 func (*Expense) Descriptor() ([]byte, []int) {
-	return file_expense_v1_expense_proto_rawDescGZIP(), []int{0}
+	return file_expense_v1_expense_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *Expense) GetId() int64 {
@@ -113,6 +361,27 @@ func (x *Expense) GetUpdatedAt() int64 {
 	return 0
 }
 
+func (x *Expense) GetCategoryId() int64 {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return 0
+}
+
+func (x *Expense) GetRecurrence() *Recurrence {
+	if x != nil {
+		return x.Recurrence
+	}
+	return nil
+}
+
+func (x *Expense) GetBusinessDayAdjustment() BusinessDayAdjustment {
+	if x != nil {
+		return x.BusinessDayAdjustment
+	}
+	return BusinessDayAdjustment_BUSINESS_DAY_ADJUSTMENT_UNSPECIFIED
+}
+
 type SortedExpense struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Day           int32                  `protobuf:"varint,1,opt,name=day,proto3" json:"day,omitempty"`
@@ -123,7 +392,7 @@ type SortedExpense struct {
 
 func (x *SortedExpense) Reset() {
 	*x = SortedExpense{}
-	mi := &file_expense_v1_expense_proto_msgTypes[1]
+	mi := &file_expense_v1_expense_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -135,7 +404,7 @@ func (x *SortedExpense) String() string {
 func (*SortedExpense) ProtoMessage() {}
 
 func (x *SortedExpense) ProtoReflect() protoreflect.Message {
-	mi := &file_expense_v1_expense_proto_msgTypes[1]
+	mi := &file_expense_v1_expense_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -148,7 +417,7 @@ func (x *SortedExpense) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SortedExpense.ProtoReflect.Descriptor instead.
 func (*SortedExpense) Descriptor() ([]byte, []int) {
-	return file_expense_v1_expense_proto_rawDescGZIP(), []int{1}
+	return file_expense_v1_expense_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *SortedExpense) GetDay() int32 {
@@ -162,34 +431,805 @@ func (x *SortedExpense) GetExpenses() []*Expense {
 	if x != nil {
 		return x.Expenses
 	}
-	return nil
+	return nil
+}
+
+type ExpenseOccurrence struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExpenseId     int64                  `protobuf:"varint,1,opt,name=expense_id,json=expenseId,proto3" json:"expense_id,omitempty"`
+	DueDate       string                 `protobuf:"bytes,2,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"` // YYYY-MM-DD, after business day adjustment
+	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	ScheduledDate string                 `protobuf:"bytes,5,opt,name=scheduled_date,json=scheduledDate,proto3" json:"scheduled_date,omitempty"` // YYYY-MM-DD, before business day adjustment
+	Status        OccurrenceStatus       `protobuf:"varint,6,opt,name=status,proto3,enum=expense.v1.OccurrenceStatus" json:"status,omitempty"`
+	Payment       *Payment               `protobuf:"bytes,7,opt,name=payment,proto3,oneof" json:"payment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseOccurrence) Reset() {
+	*x = ExpenseOccurrence{}
+	mi := &file_expense_v1_expense_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseOccurrence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseOccurrence) ProtoMessage() {}
+
+func (x *ExpenseOccurrence) ProtoReflect() protoreflect.Message {
+	mi := &file_expense_v1_expense_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseOccurrence.ProtoReflect.Descriptor instead.
+func (*ExpenseOccurrence) Descriptor() ([]byte, []int) {
+	return file_expense_v1_expense_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ExpenseOccurrence) GetExpenseId() int64 {
+	if x != nil {
+		return x.ExpenseId
+	}
+	return 0
+}
+
+func (x *ExpenseOccurrence) GetDueDate() string {
+	if x != nil {
+		return x.DueDate
+	}
+	return ""
+}
+
+func (x *ExpenseOccurrence) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ExpenseOccurrence) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ExpenseOccurrence) GetScheduledDate() string {
+	if x != nil {
+		return x.ScheduledDate
+	}
+	return ""
+}
+
+func (x *ExpenseOccurrence) GetStatus() OccurrenceStatus {
+	if x != nil {
+		return x.Status
+	}
+	return OccurrenceStatus_OCCURRENCE_STATUS_UNSPECIFIED
+}
+
+func (x *ExpenseOccurrence) GetPayment() *Payment {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
+type Payment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ExpenseId     int64                  `protobuf:"varint,2,opt,name=expense_id,json=expenseId,proto3" json:"expense_id,omitempty"`
+	ScheduledDate string                 `protobuf:"bytes,3,opt,name=scheduled_date,json=scheduledDate,proto3" json:"scheduled_date,omitempty"` // YYYY-MM-DD, identifies the occurrence
+	PaidDate      string                 `protobuf:"bytes,4,opt,name=paid_date,json=paidDate,proto3" json:"paid_date,omitempty"`                // YYYY-MM-DD
+	Amount        float64                `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	PaidBy        *int64                 `protobuf:"varint,6,opt,name=paid_by,json=paidBy,proto3,oneof" json:"paid_by,omitempty"` // User ID, unset for automatic payments
+	Note          *string                `protobuf:"bytes,7,opt,name=note,proto3,oneof" json:"note,omitempty"`
+	IsAutomatic   bool                   `protobuf:"varint,8,opt,name=is_automatic,json=isAutomatic,proto3" json:"is_automatic,omitempty"` // Marked paid by autopay on the due date
+	CreatedAt     int64                  `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     int64                  `protobuf:"varint,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	TransactionId *int64                 `protobuf:"varint,11,opt,name=transaction_id,json=transactionId,proto3,oneof" json:"transaction_id,omitempty"` // Bank transaction matched to this payment
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Payment) Reset() {
+	*x = Payment{}
+	mi := &file_expense_v1_expense_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Payment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Payment) ProtoMessage() {}
+
+func (x *Payment) ProtoReflect() protoreflect.Message {
+	mi := &file_expense_v1_expense_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Payment.ProtoReflect.Descriptor instead.
+func (*Payment) Descriptor() ([]byte, []int) {
+	return file_expense_v1_expense_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Payment) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Payment) GetExpenseId() int64 {
+	if x != nil {
+		return x.ExpenseId
+	}
+	return 0
+}
+
+func (x *Payment) GetScheduledDate() string {
+	if x != nil {
+		return x.ScheduledDate
+	}
+	return ""
+}
+
+func (x *Payment) GetPaidDate() string {
+	if x != nil {
+		return x.PaidDate
+	}
+	return ""
+}
+
+func (x *Payment) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Payment) GetPaidBy() int64 {
+	if x != nil && x.PaidBy != nil {
+		return *x.PaidBy
+	}
+	return 0
+}
+
+func (x *Payment) GetNote() string {
+	if x != nil && x.Note != nil {
+		return *x.Note
+	}
+	return ""
+}
+
+func (x *Payment) GetIsAutomatic() bool {
+	if x != nil {
+		return x.IsAutomatic
+	}
+	return false
+}
+
+func (x *Payment) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Payment) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *Payment) GetTransactionId() int64 {
+	if x != nil && x.TransactionId != nil {
+		return *x.TransactionId
+	}
+	return 0
+}
+
+type CreateExpenseRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Name   string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Amount float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Days past the end of a short month fall due on its last day
+	DayOfMonthDue int32  `protobuf:"varint,3,opt,name=day_of_month_due,json=dayOfMonthDue,proto3" json:"day_of_month_due,omitempty"`
+	IsAutopay     bool   `protobuf:"varint,4,opt,name=is_autopay,json=isAutopay,proto3" json:"is_autopay,omitempty"`
+	CategoryId    *int64 `protobuf:"varint,5,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	// Defaults to monthly on day_of_month_due
+	Recurrence            *Recurrence           `protobuf:"bytes,6,opt,name=recurrence,proto3,oneof" json:"recurrence,omitempty"`
+	BusinessDayAdjustment BusinessDayAdjustment `protobuf:"varint,7,opt,name=business_day_adjustment,json=businessDayAdjustment,proto3,enum=expense.v1.BusinessDayAdjustment" json:"business_day_adjustment,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *CreateExpenseRequest) Reset() {
+	*x = CreateExpenseRequest{}
+	mi := &file_expense_v1_expense_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateExpenseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateExpenseRequest) ProtoMessage() {}
+
+func (x *CreateExpenseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_expense_v1_expense_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateExpenseRequest.ProtoReflect.Descriptor instead.
+func (*CreateExpenseRequest) Descriptor() ([]byte, []int) {
+	return file_expense_v1_expense_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateExpenseRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateExpenseRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CreateExpenseRequest) GetDayOfMonthDue() int32 {
+	if x != nil {
+		return x.DayOfMonthDue
+	}
+	return 0
+}
+
+func (x *CreateExpenseRequest) GetIsAutopay() bool {
+	if x != nil {
+		return x.IsAutopay
+	}
+	return false
+}
+
+func (x *CreateExpenseRequest) GetCategoryId() int64 {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return 0
+}
+
+func (x *CreateExpenseRequest) GetRecurrence() *Recurrence {
+	if x != nil {
+		return x.Recurrence
+	}
+	return nil
+}
+
+func (x *CreateExpenseRequest) GetBusinessDayAdjustment() BusinessDayAdjustment {
+	if x != nil {
+		return x.BusinessDayAdjustment
+	}
+	return BusinessDayAdjustment_BUSINESS_DAY_ADJUSTMENT_UNSPECIFIED
+}
+
+type CreateExpenseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Expense       *Expense               `protobuf:"bytes,1,opt,name=expense,proto3" json:"expense,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateExpenseResponse) Reset() {
+	*x = CreateExpenseResponse{}
+	mi := &file_expense_v1_expense_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateExpenseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateExpenseResponse) ProtoMessage() {}
+
+func (x *CreateExpenseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_expense_v1_expense_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateExpenseResponse.ProtoReflect.Descriptor instead.
+func (*CreateExpenseResponse) Descriptor() ([]byte, []int) {
+	return file_expense_v1_expense_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateExpenseResponse) GetExpense() *Expense {
+	if x != nil {
+		return x.Expense
+	}
+	return nil
+}
+
+type GetExpenseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExpenseRequest) Reset() {
+	*x = GetExpenseRequest{}
+	mi := &file_expense_v1_expense_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExpenseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExpenseRequest) ProtoMessage() {}
+
+func (x *GetExpenseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_expense_v1_expense_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExpenseRequest.ProtoReflect.Descriptor instead.
+func (*GetExpenseRequest) Descriptor() ([]byte, []int) {
+	return file_expense_v1_expense_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetExpenseRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetExpenseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Expense       *Expense               `protobuf:"bytes,1,opt,name=expense,proto3" json:"expense,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExpenseResponse) Reset() {
+	*x = GetExpenseResponse{}
+	mi := &file_expense_v1_expense_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExpenseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExpenseResponse) ProtoMessage() {}
+
+func (x *GetExpenseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_expense_v1_expense_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExpenseResponse.ProtoReflect.Descriptor instead.
+func (*GetExpenseResponse) Descriptor() ([]byte, []int) {
+	return file_expense_v1_expense_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetExpenseResponse) GetExpense() *Expense {
+	if x != nil {
+		return x.Expense
+	}
+	return nil
+}
+
+type UpdateExpenseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	DayOfMonthDue int32                  `protobuf:"varint,4,opt,name=day_of_month_due,json=dayOfMonthDue,proto3" json:"day_of_month_due,omitempty"`
+	IsAutopay     bool                   `protobuf:"varint,5,opt,name=is_autopay,json=isAutopay,proto3" json:"is_autopay,omitempty"`
+	// Set to 0 to clear the category.
+	CategoryId            *int64                 `protobuf:"varint,6,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	Recurrence            *Recurrence            `protobuf:"bytes,7,opt,name=recurrence,proto3,oneof" json:"recurrence,omitempty"`
+	BusinessDayAdjustment *BusinessDayAdjustment `protobuf:"varint,8,opt,name=business_day_adjustment,json=businessDayAdjustment,proto3,enum=expense.v1.BusinessDayAdjustment,oneof" json:"business_day_adjustment,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *UpdateExpenseRequest) Reset() {
+	*x = UpdateExpenseRequest{}
+	mi := &file_expense_v1_expense_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateExpenseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateExpenseRequest) ProtoMessage() {}
+
+func (x *UpdateExpenseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_expense_v1_expense_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateExpenseRequest.ProtoReflect.Descriptor instead.
+func (*UpdateExpenseRequest) Descriptor() ([]byte, []int) {
+	return file_expense_v1_expense_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateExpenseRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateExpenseRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateExpenseRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *UpdateExpenseRequest) GetDayOfMonthDue() int32 {
+	if x != nil {
+		return x.DayOfMonthDue
+	}
+	return 0
+}
+
+func (x *UpdateExpenseRequest) GetIsAutopay() bool {
+	if x != nil {
+		return x.IsAutopay
+	}
+	return false
+}
+
+func (x *UpdateExpenseRequest) GetCategoryId() int64 {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return 0
+}
+
+func (x *UpdateExpenseRequest) GetRecurrence() *Recurrence {
+	if x != nil {
+		return x.Recurrence
+	}
+	return nil
+}
+
+func (x *UpdateExpenseRequest) GetBusinessDayAdjustment() BusinessDayAdjustment {
+	if x != nil && x.BusinessDayAdjustment != nil {
+		return *x.BusinessDayAdjustment
+	}
+	return BusinessDayAdjustment_BUSINESS_DAY_ADJUSTMENT_UNSPECIFIED
+}
+
+type UpdateExpenseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Expense       *Expense               `protobuf:"bytes,1,opt,name=expense,proto3" json:"expense,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateExpenseResponse) Reset() {
+	*x = UpdateExpenseResponse{}
+	mi := &file_expense_v1_expense_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateExpenseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateExpenseResponse) ProtoMessage() {}
+
+func (x *UpdateExpenseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_expense_v1_expense_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateExpenseResponse.ProtoReflect.Descriptor instead.
+func (*UpdateExpenseResponse) Descriptor() ([]byte, []int) {
+	return file_expense_v1_expense_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateExpenseResponse) GetExpense() *Expense {
+	if x != nil {
+		return x.Expense
+	}
+	return nil
+}
+
+type DeleteExpenseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteExpenseRequest) Reset() {
+	*x = DeleteExpenseRequest{}
+	mi := &file_expense_v1_expense_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteExpenseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteExpenseRequest) ProtoMessage() {}
+
+func (x *DeleteExpenseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_expense_v1_expense_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteExpenseRequest.ProtoReflect.Descriptor instead.
+func (*DeleteExpenseRequest) Descriptor() ([]byte, []int) {
+	return file_expense_v1_expense_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeleteExpenseRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteExpenseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteExpenseResponse) Reset() {
+	*x = DeleteExpenseResponse{}
+	mi := &file_expense_v1_expense_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteExpenseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteExpenseResponse) ProtoMessage() {}
+
+func (x *DeleteExpenseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_expense_v1_expense_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteExpenseResponse.ProtoReflect.Descriptor instead.
+func (*DeleteExpenseResponse) Descriptor() ([]byte, []int) {
+	return file_expense_v1_expense_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeleteExpenseResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListExpensesRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	PageSize   int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken  string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	CategoryId *int64                 `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	IsAutopay  *bool                  `protobuf:"varint,4,opt,name=is_autopay,json=isAutopay,proto3,oneof" json:"is_autopay,omitempty"`
+	MinDayDue  *int32                 `protobuf:"varint,5,opt,name=min_day_due,json=minDayDue,proto3,oneof" json:"min_day_due,omitempty"`
+	MaxDayDue  *int32                 `protobuf:"varint,6,opt,name=max_day_due,json=maxDayDue,proto3,oneof" json:"max_day_due,omitempty"`
+	// YYYY-MM; when set, expenses are grouped by their actual due dates in the
+	// month and occurrences are returned. The whole month is listed at once, so
+	// page_size and page_token must be left unset.
+	Month         *string `protobuf:"bytes,7,opt,name=month,proto3,oneof" json:"month,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExpensesRequest) Reset() {
+	*x = ListExpensesRequest{}
+	mi := &file_expense_v1_expense_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExpensesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExpensesRequest) ProtoMessage() {}
+
+func (x *ListExpensesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_expense_v1_expense_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExpensesRequest.ProtoReflect.Descriptor instead.
+func (*ListExpensesRequest) Descriptor() ([]byte, []int) {
+	return file_expense_v1_expense_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListExpensesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListExpensesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListExpensesRequest) GetCategoryId() int64 {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return 0
+}
+
+func (x *ListExpensesRequest) GetIsAutopay() bool {
+	if x != nil && x.IsAutopay != nil {
+		return *x.IsAutopay
+	}
+	return false
+}
+
+func (x *ListExpensesRequest) GetMinDayDue() int32 {
+	if x != nil && x.MinDayDue != nil {
+		return *x.MinDayDue
+	}
+	return 0
+}
+
+func (x *ListExpensesRequest) GetMaxDayDue() int32 {
+	if x != nil && x.MaxDayDue != nil {
+		return *x.MaxDayDue
+	}
+	return 0
+}
+
+func (x *ListExpensesRequest) GetMonth() string {
+	if x != nil && x.Month != nil {
+		return *x.Month
+	}
+	return ""
 }
 
-type CreateExpenseRequest struct {
+type ListExpensesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Amount        float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	DayOfMonthDue int32                  `protobuf:"varint,3,opt,name=day_of_month_due,json=dayOfMonthDue,proto3" json:"day_of_month_due,omitempty"`
-	IsAutopay     bool                   `protobuf:"varint,4,opt,name=is_autopay,json=isAutopay,proto3" json:"is_autopay,omitempty"`
+	Expenses      []*SortedExpense       `protobuf:"bytes,1,rep,name=expenses,proto3" json:"expenses,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// Matching expenses, or occurrences when month is set
+	TotalCount    int64                `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Occurrences   []*ExpenseOccurrence `protobuf:"bytes,4,rep,name=occurrences,proto3" json:"occurrences,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateExpenseRequest) Reset() {
-	*x = CreateExpenseRequest{}
-	mi := &file_expense_v1_expense_proto_msgTypes[2]
+func (x *ListExpensesResponse) Reset() {
+	*x = ListExpensesResponse{}
+	mi := &file_expense_v1_expense_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateExpenseRequest) String() string {
+func (x *ListExpensesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateExpenseRequest) ProtoMessage() {}
+func (*ListExpensesResponse) ProtoMessage() {}
 
-func (x *CreateExpenseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_expense_v1_expense_proto_msgTypes[2]
+func (x *ListExpensesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_expense_v1_expense_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -200,61 +1240,63 @@ func (x *CreateExpenseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateExpenseRequest.ProtoReflect.Descriptor instead.
-func (*CreateExpenseRequest) Descriptor() ([]byte, []int) {
-	return file_expense_v1_expense_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use ListExpensesResponse.ProtoReflect.Descriptor instead.
+func (*ListExpensesResponse) Descriptor() ([]byte, []int) {
+	return file_expense_v1_expense_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *CreateExpenseRequest) GetName() string {
+func (x *ListExpensesResponse) GetExpenses() []*SortedExpense {
 	if x != nil {
-		return x.Name
+		return x.Expenses
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateExpenseRequest) GetAmount() float64 {
+func (x *ListExpensesResponse) GetNextPageToken() string {
 	if x != nil {
-		return x.Amount
+		return x.NextPageToken
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateExpenseRequest) GetDayOfMonthDue() int32 {
+func (x *ListExpensesResponse) GetTotalCount() int64 {
 	if x != nil {
-		return x.DayOfMonthDue
+		return x.TotalCount
 	}
 	return 0
 }
 
-func (x *CreateExpenseRequest) GetIsAutopay() bool {
+func (x *ListExpensesResponse) GetOccurrences() []*ExpenseOccurrence {
 	if x != nil {
-		return x.IsAutopay
+		return x.Occurrences
 	}
-	return false
+	return nil
 }
 
-type CreateExpenseResponse struct {
+type GetNextOccurrencesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Expense       *Expense               `protobuf:"bytes,1,opt,name=expense,proto3" json:"expense,omitempty"`
+	ExpenseId     int64                  `protobuf:"varint,1,opt,name=expense_id,json=expenseId,proto3" json:"expense_id,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	FromDate      *string                `protobuf:"bytes,3,opt,name=from_date,json=fromDate,proto3,oneof" json:"from_date,omitempty"` // YYYY-MM-DD, defaults to today
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateExpenseResponse) Reset() {
-	*x = CreateExpenseResponse{}
-	mi := &file_expense_v1_expense_proto_msgTypes[3]
+func (x *GetNextOccurrencesRequest) Reset() {
+	*x = GetNextOccurrencesRequest{}
+	mi := &file_expense_v1_expense_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateExpenseResponse) String() string {
+func (x *GetNextOccurrencesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateExpenseResponse) ProtoMessage() {}
+func (*GetNextOccurrencesRequest) ProtoMessage() {}
 
-func (x *CreateExpenseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_expense_v1_expense_proto_msgTypes[3]
+func (x *GetNextOccurrencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_expense_v1_expense_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -265,84 +1307,54 @@ func (x *CreateExpenseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateExpenseResponse.ProtoReflect.Descriptor instead.
-func (*CreateExpenseResponse) Descriptor() ([]byte, []int) {
-	return file_expense_v1_expense_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use GetNextOccurrencesRequest.ProtoReflect.Descriptor instead.
+func (*GetNextOccurrencesRequest) Descriptor() ([]byte, []int) {
+	return file_expense_v1_expense_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *CreateExpenseResponse) GetExpense() *Expense {
+func (x *GetNextOccurrencesRequest) GetExpenseId() int64 {
 	if x != nil {
-		return x.Expense
+		return x.ExpenseId
 	}
-	return nil
-}
-
-type GetExpenseRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetExpenseRequest) Reset() {
-	*x = GetExpenseRequest{}
-	mi := &file_expense_v1_expense_proto_msgTypes[4]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetExpenseRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return 0
 }
 
-func (*GetExpenseRequest) ProtoMessage() {}
-
-func (x *GetExpenseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_expense_v1_expense_proto_msgTypes[4]
+func (x *GetNextOccurrencesRequest) GetCount() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Count
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetExpenseRequest.ProtoReflect.Descriptor instead.
-func (*GetExpenseRequest) Descriptor() ([]byte, []int) {
-	return file_expense_v1_expense_proto_rawDescGZIP(), []int{4}
+	return 0
 }
 
-func (x *GetExpenseRequest) GetId() int64 {
-	if x != nil {
-		return x.Id
+func (x *GetNextOccurrencesRequest) GetFromDate() string {
+	if x != nil && x.FromDate != nil {
+		return *x.FromDate
 	}
-	return 0
+	return ""
 }
 
-type GetExpenseResponse struct {
+type GetNextOccurrencesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Expense       *Expense               `protobuf:"bytes,1,opt,name=expense,proto3" json:"expense,omitempty"`
+	Occurrences   []*ExpenseOccurrence   `protobuf:"bytes,1,rep,name=occurrences,proto3" json:"occurrences,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetExpenseResponse) Reset() {
-	*x = GetExpenseResponse{}
-	mi := &file_expense_v1_expense_proto_msgTypes[5]
+func (x *GetNextOccurrencesResponse) Reset() {
+	*x = GetNextOccurrencesResponse{}
+	mi := &file_expense_v1_expense_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetExpenseResponse) String() string {
+func (x *GetNextOccurrencesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetExpenseResponse) ProtoMessage() {}
+func (*GetNextOccurrencesResponse) ProtoMessage() {}
 
-func (x *GetExpenseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_expense_v1_expense_proto_msgTypes[5]
+func (x *GetNextOccurrencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_expense_v1_expense_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -353,44 +1365,45 @@ func (x *GetExpenseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetExpenseResponse.ProtoReflect.Descriptor instead.
-func (*GetExpenseResponse) Descriptor() ([]byte, []int) {
-	return file_expense_v1_expense_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use GetNextOccurrencesResponse.ProtoReflect.Descriptor instead.
+func (*GetNextOccurrencesResponse) Descriptor() ([]byte, []int) {
+	return file_expense_v1_expense_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *GetExpenseResponse) GetExpense() *Expense {
+func (x *GetNextOccurrencesResponse) GetOccurrences() []*ExpenseOccurrence {
 	if x != nil {
-		return x.Expense
+		return x.Occurrences
 	}
 	return nil
 }
 
-type UpdateExpenseRequest struct {
+type MarkPaidRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	DayOfMonthDue int32                  `protobuf:"varint,4,opt,name=day_of_month_due,json=dayOfMonthDue,proto3" json:"day_of_month_due,omitempty"`
-	IsAutopay     bool                   `protobuf:"varint,5,opt,name=is_autopay,json=isAutopay,proto3" json:"is_autopay,omitempty"`
+	ExpenseId     int64                  `protobuf:"varint,1,opt,name=expense_id,json=expenseId,proto3" json:"expense_id,omitempty"`
+	ScheduledDate string                 `protobuf:"bytes,2,opt,name=scheduled_date,json=scheduledDate,proto3" json:"scheduled_date,omitempty"` // YYYY-MM-DD, the occurrence's scheduled_date
+	PaidDate      *string                `protobuf:"bytes,3,opt,name=paid_date,json=paidDate,proto3,oneof" json:"paid_date,omitempty"`          // YYYY-MM-DD, defaults to today
+	Amount        *float64               `protobuf:"fixed64,4,opt,name=amount,proto3,oneof" json:"amount,omitempty"`                            // Defaults to the expense amount
+	Note          *string                `protobuf:"bytes,5,opt,name=note,proto3,oneof" json:"note,omitempty"`
+	PaidBy        *int64                 `protobuf:"varint,6,opt,name=paid_by,json=paidBy,proto3,oneof" json:"paid_by,omitempty"` // Member who paid, defaults to the caller
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateExpenseRequest) Reset() {
-	*x = UpdateExpenseRequest{}
-	mi := &file_expense_v1_expense_proto_msgTypes[6]
+func (x *MarkPaidRequest) Reset() {
+	*x = MarkPaidRequest{}
+	mi := &file_expense_v1_expense_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateExpenseRequest) String() string {
+func (x *MarkPaidRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateExpenseRequest) ProtoMessage() {}
+func (*MarkPaidRequest) ProtoMessage() {}
 
-func (x *UpdateExpenseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_expense_v1_expense_proto_msgTypes[6]
+func (x *MarkPaidRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_expense_v1_expense_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -401,68 +1414,75 @@ func (x *UpdateExpenseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateExpenseRequest.ProtoReflect.Descriptor instead.
-func (*UpdateExpenseRequest) Descriptor() ([]byte, []int) {
-	return file_expense_v1_expense_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use MarkPaidRequest.ProtoReflect.Descriptor instead.
+func (*MarkPaidRequest) Descriptor() ([]byte, []int) {
+	return file_expense_v1_expense_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *UpdateExpenseRequest) GetId() int64 {
+func (x *MarkPaidRequest) GetExpenseId() int64 {
 	if x != nil {
-		return x.Id
+		return x.ExpenseId
 	}
 	return 0
 }
 
-func (x *UpdateExpenseRequest) GetName() string {
+func (x *MarkPaidRequest) GetScheduledDate() string {
 	if x != nil {
-		return x.Name
+		return x.ScheduledDate
 	}
 	return ""
 }
 
-func (x *UpdateExpenseRequest) GetAmount() float64 {
-	if x != nil {
-		return x.Amount
+func (x *MarkPaidRequest) GetPaidDate() string {
+	if x != nil && x.PaidDate != nil {
+		return *x.PaidDate
 	}
-	return 0
+	return ""
 }
 
-func (x *UpdateExpenseRequest) GetDayOfMonthDue() int32 {
-	if x != nil {
-		return x.DayOfMonthDue
+func (x *MarkPaidRequest) GetAmount() float64 {
+	if x != nil && x.Amount != nil {
+		return *x.Amount
 	}
 	return 0
 }
 
-func (x *UpdateExpenseRequest) GetIsAutopay() bool {
-	if x != nil {
-		return x.IsAutopay
+func (x *MarkPaidRequest) GetNote() string {
+	if x != nil && x.Note != nil {
+		return *x.Note
 	}
-	return false
+	return ""
 }
 
-type UpdateExpenseResponse struct {
+func (x *MarkPaidRequest) GetPaidBy() int64 {
+	if x != nil && x.PaidBy != nil {
+		return *x.PaidBy
+	}
+	return 0
+}
+
+type MarkPaidResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Expense       *Expense               `protobuf:"bytes,1,opt,name=expense,proto3" json:"expense,omitempty"`
+	Payment       *Payment               `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateExpenseResponse) Reset() {
-	*x = UpdateExpenseResponse{}
-	mi := &file_expense_v1_expense_proto_msgTypes[7]
+func (x *MarkPaidResponse) Reset() {
+	*x = MarkPaidResponse{}
+	mi := &file_expense_v1_expense_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateExpenseResponse) String() string {
+func (x *MarkPaidResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateExpenseResponse) ProtoMessage() {}
+func (*MarkPaidResponse) ProtoMessage() {}
 
-func (x *UpdateExpenseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_expense_v1_expense_proto_msgTypes[7]
+func (x *MarkPaidResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_expense_v1_expense_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -473,40 +1493,43 @@ func (x *UpdateExpenseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateExpenseResponse.ProtoReflect.Descriptor instead.
-func (*UpdateExpenseResponse) Descriptor() ([]byte, []int) {
-	return file_expense_v1_expense_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use MarkPaidResponse.ProtoReflect.Descriptor instead.
+func (*MarkPaidResponse) Descriptor() ([]byte, []int) {
+	return file_expense_v1_expense_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *UpdateExpenseResponse) GetExpense() *Expense {
+func (x *MarkPaidResponse) GetPayment() *Payment {
 	if x != nil {
-		return x.Expense
+		return x.Payment
 	}
 	return nil
 }
 
-type DeleteExpenseRequest struct {
+// Unmarking an autopay occurrence records an override so it is not marked
+// paid automatically again
+type UnmarkPaidRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ExpenseId     int64                  `protobuf:"varint,1,opt,name=expense_id,json=expenseId,proto3" json:"expense_id,omitempty"`
+	ScheduledDate string                 `protobuf:"bytes,2,opt,name=scheduled_date,json=scheduledDate,proto3" json:"scheduled_date,omitempty"` // YYYY-MM-DD
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteExpenseRequest) Reset() {
-	*x = DeleteExpenseRequest{}
-	mi := &file_expense_v1_expense_proto_msgTypes[8]
+func (x *UnmarkPaidRequest) Reset() {
+	*x = UnmarkPaidRequest{}
+	mi := &file_expense_v1_expense_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteExpenseRequest) String() string {
+func (x *UnmarkPaidRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteExpenseRequest) ProtoMessage() {}
+func (*UnmarkPaidRequest) ProtoMessage() {}
 
-func (x *DeleteExpenseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_expense_v1_expense_proto_msgTypes[8]
+func (x *UnmarkPaidRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_expense_v1_expense_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -517,40 +1540,47 @@ func (x *DeleteExpenseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteExpenseRequest.ProtoReflect.Descriptor instead.
-func (*DeleteExpenseRequest) Descriptor() ([]byte, []int) {
-	return file_expense_v1_expense_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use UnmarkPaidRequest.ProtoReflect.Descriptor instead.
+func (*UnmarkPaidRequest) Descriptor() ([]byte, []int) {
+	return file_expense_v1_expense_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *DeleteExpenseRequest) GetId() int64 {
+func (x *UnmarkPaidRequest) GetExpenseId() int64 {
 	if x != nil {
-		return x.Id
+		return x.ExpenseId
 	}
 	return 0
 }
 
-type DeleteExpenseResponse struct {
+func (x *UnmarkPaidRequest) GetScheduledDate() string {
+	if x != nil {
+		return x.ScheduledDate
+	}
+	return ""
+}
+
+type UnmarkPaidResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteExpenseResponse) Reset() {
-	*x = DeleteExpenseResponse{}
-	mi := &file_expense_v1_expense_proto_msgTypes[9]
+func (x *UnmarkPaidResponse) Reset() {
+	*x = UnmarkPaidResponse{}
+	mi := &file_expense_v1_expense_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteExpenseResponse) String() string {
+func (x *UnmarkPaidResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteExpenseResponse) ProtoMessage() {}
+func (*UnmarkPaidResponse) ProtoMessage() {}
 
-func (x *DeleteExpenseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_expense_v1_expense_proto_msgTypes[9]
+func (x *UnmarkPaidResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_expense_v1_expense_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -561,41 +1591,44 @@ func (x *DeleteExpenseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteExpenseResponse.ProtoReflect.Descriptor instead.
-func (*DeleteExpenseResponse) Descriptor() ([]byte, []int) {
-	return file_expense_v1_expense_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use UnmarkPaidResponse.ProtoReflect.Descriptor instead.
+func (*UnmarkPaidResponse) Descriptor() ([]byte, []int) {
+	return file_expense_v1_expense_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *DeleteExpenseResponse) GetSuccess() bool {
+func (x *UnmarkPaidResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-type ListExpensesRequest struct {
+type ListPaymentsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	ExpenseId     *int64                 `protobuf:"varint,3,opt,name=expense_id,json=expenseId,proto3,oneof" json:"expense_id,omitempty"`
+	FromDate      *string                `protobuf:"bytes,4,opt,name=from_date,json=fromDate,proto3,oneof" json:"from_date,omitempty"` // YYYY-MM-DD, inclusive, by scheduled date
+	ToDate        *string                `protobuf:"bytes,5,opt,name=to_date,json=toDate,proto3,oneof" json:"to_date,omitempty"`       // YYYY-MM-DD, inclusive, by scheduled date
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListExpensesRequest) Reset() {
-	*x = ListExpensesRequest{}
-	mi := &file_expense_v1_expense_proto_msgTypes[10]
+func (x *ListPaymentsRequest) Reset() {
+	*x = ListPaymentsRequest{}
+	mi := &file_expense_v1_expense_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListExpensesRequest) String() string {
+func (x *ListPaymentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListExpensesRequest) ProtoMessage() {}
+func (*ListPaymentsRequest) ProtoMessage() {}
 
-func (x *ListExpensesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_expense_v1_expense_proto_msgTypes[10]
+func (x *ListPaymentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_expense_v1_expense_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -606,48 +1639,69 @@ func (x *ListExpensesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListExpensesRequest.ProtoReflect.Descriptor instead.
-func (*ListExpensesRequest) Descriptor() ([]byte, []int) {
-	return file_expense_v1_expense_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ListPaymentsRequest.ProtoReflect.Descriptor instead.
+func (*ListPaymentsRequest) Descriptor() ([]byte, []int) {
+	return file_expense_v1_expense_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *ListExpensesRequest) GetPageSize() int32 {
+func (x *ListPaymentsRequest) GetPageSize() int32 {
 	if x != nil {
 		return x.PageSize
 	}
 	return 0
 }
 
-func (x *ListExpensesRequest) GetPageToken() string {
+func (x *ListPaymentsRequest) GetPageToken() string {
 	if x != nil {
 		return x.PageToken
 	}
 	return ""
 }
 
-type ListExpensesResponse struct {
+func (x *ListPaymentsRequest) GetExpenseId() int64 {
+	if x != nil && x.ExpenseId != nil {
+		return *x.ExpenseId
+	}
+	return 0
+}
+
+func (x *ListPaymentsRequest) GetFromDate() string {
+	if x != nil && x.FromDate != nil {
+		return *x.FromDate
+	}
+	return ""
+}
+
+func (x *ListPaymentsRequest) GetToDate() string {
+	if x != nil && x.ToDate != nil {
+		return *x.ToDate
+	}
+	return ""
+}
+
+type ListPaymentsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Expenses      []*SortedExpense       `protobuf:"bytes,1,rep,name=expenses,proto3" json:"expenses,omitempty"`
+	Payments      []*Payment             `protobuf:"bytes,1,rep,name=payments,proto3" json:"payments,omitempty"`
 	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListExpensesResponse) Reset() {
-	*x = ListExpensesResponse{}
-	mi := &file_expense_v1_expense_proto_msgTypes[11]
+func (x *ListPaymentsResponse) Reset() {
+	*x = ListPaymentsResponse{}
+	mi := &file_expense_v1_expense_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListExpensesResponse) String() string {
+func (x *ListPaymentsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListExpensesResponse) ProtoMessage() {}
+func (*ListPaymentsResponse) ProtoMessage() {}
 
-func (x *ListExpensesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_expense_v1_expense_proto_msgTypes[11]
+func (x *ListPaymentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_expense_v1_expense_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -658,19 +1712,19 @@ func (x *ListExpensesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListExpensesResponse.ProtoReflect.Descriptor instead.
-func (*ListExpensesResponse) Descriptor() ([]byte, []int) {
-	return file_expense_v1_expense_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ListPaymentsResponse.ProtoReflect.Descriptor instead.
+func (*ListPaymentsResponse) Descriptor() ([]byte, []int) {
+	return file_expense_v1_expense_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *ListExpensesResponse) GetExpenses() []*SortedExpense {
+func (x *ListPaymentsResponse) GetPayments() []*Payment {
 	if x != nil {
-		return x.Expenses
+		return x.Payments
 	}
 	return nil
 }
 
-func (x *ListExpensesResponse) GetNextPageToken() string {
+func (x *ListPaymentsResponse) GetNextPageToken() string {
 	if x != nil {
 		return x.NextPageToken
 	}
@@ -682,7 +1736,17 @@ var File_expense_v1_expense_proto protoreflect.FileDescriptor
 const file_expense_v1_expense_proto_rawDesc = "" +
 	"\n" +
 	"\x18expense/v1/expense.proto\x12\n" +
-	"expense.v1\"\xcb\x01\n" +
+	"expense.v1\"\xf0\x01\n" +
+	"\n" +
+	"Recurrence\x123\n" +
+	"\tfrequency\x18\x01 \x01(\x0e2\x15.expense.v1.FrequencyR\tfrequency\x12\x1a\n" +
+	"\binterval\x18\x02 \x01(\x05R\binterval\x12\x1f\n" +
+	"\vanchor_date\x18\x03 \x01(\tR\n" +
+	"anchorDate\x12\x1e\n" +
+	"\bend_date\x18\x04 \x01(\tH\x00R\aendDate\x88\x01\x01\x12.\n" +
+	"\x10occurrence_count\x18\x05 \x01(\x05H\x01R\x0foccurrenceCount\x88\x01\x01B\v\n" +
+	"\t_end_dateB\x13\n" +
+	"\x11_occurrence_count\"\x94\x03\n" +
 	"\aExpense\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
@@ -693,49 +1757,191 @@ const file_expense_v1_expense_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x06 \x01(\x03R\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\a \x01(\x03R\tupdatedAt\"R\n" +
+	"updated_at\x18\a \x01(\x03R\tupdatedAt\x12$\n" +
+	"\vcategory_id\x18\b \x01(\x03H\x00R\n" +
+	"categoryId\x88\x01\x01\x126\n" +
+	"\n" +
+	"recurrence\x18\t \x01(\v2\x16.expense.v1.RecurrenceR\n" +
+	"recurrence\x12Y\n" +
+	"\x17business_day_adjustment\x18\n" +
+	" \x01(\x0e2!.expense.v1.BusinessDayAdjustmentR\x15businessDayAdjustmentB\x0e\n" +
+	"\f_category_id\"R\n" +
 	"\rSortedExpense\x12\x10\n" +
 	"\x03day\x18\x01 \x01(\x05R\x03day\x12/\n" +
-	"\bexpenses\x18\x02 \x03(\v2\x13.expense.v1.ExpenseR\bexpenses\"\x8a\x01\n" +
+	"\bexpenses\x18\x02 \x03(\v2\x13.expense.v1.ExpenseR\bexpenses\"\x96\x02\n" +
+	"\x11ExpenseOccurrence\x12\x1d\n" +
+	"\n" +
+	"expense_id\x18\x01 \x01(\x03R\texpenseId\x12\x19\n" +
+	"\bdue_date\x18\x02 \x01(\tR\adueDate\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12%\n" +
+	"\x0escheduled_date\x18\x05 \x01(\tR\rscheduledDate\x124\n" +
+	"\x06status\x18\x06 \x01(\x0e2\x1c.expense.v1.OccurrenceStatusR\x06status\x122\n" +
+	"\apayment\x18\a \x01(\v2\x13.expense.v1.PaymentH\x00R\apayment\x88\x01\x01B\n" +
+	"\n" +
+	"\b_payment\"\x80\x03\n" +
+	"\aPayment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"expense_id\x18\x02 \x01(\x03R\texpenseId\x12%\n" +
+	"\x0escheduled_date\x18\x03 \x01(\tR\rscheduledDate\x12\x1b\n" +
+	"\tpaid_date\x18\x04 \x01(\tR\bpaidDate\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x01R\x06amount\x12\x1c\n" +
+	"\apaid_by\x18\x06 \x01(\x03H\x00R\x06paidBy\x88\x01\x01\x12\x17\n" +
+	"\x04note\x18\a \x01(\tH\x01R\x04note\x88\x01\x01\x12!\n" +
+	"\fis_automatic\x18\b \x01(\bR\visAutomatic\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\x03R\tupdatedAt\x12*\n" +
+	"\x0etransaction_id\x18\v \x01(\x03H\x02R\rtransactionId\x88\x01\x01B\n" +
+	"\n" +
+	"\b_paid_byB\a\n" +
+	"\x05_noteB\x11\n" +
+	"\x0f_transaction_id\"\xe7\x02\n" +
 	"\x14CreateExpenseRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
 	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12'\n" +
 	"\x10day_of_month_due\x18\x03 \x01(\x05R\rdayOfMonthDue\x12\x1d\n" +
 	"\n" +
-	"is_autopay\x18\x04 \x01(\bR\tisAutopay\"F\n" +
+	"is_autopay\x18\x04 \x01(\bR\tisAutopay\x12$\n" +
+	"\vcategory_id\x18\x05 \x01(\x03H\x00R\n" +
+	"categoryId\x88\x01\x01\x12;\n" +
+	"\n" +
+	"recurrence\x18\x06 \x01(\v2\x16.expense.v1.RecurrenceH\x01R\n" +
+	"recurrence\x88\x01\x01\x12Y\n" +
+	"\x17business_day_adjustment\x18\a \x01(\x0e2!.expense.v1.BusinessDayAdjustmentR\x15businessDayAdjustmentB\x0e\n" +
+	"\f_category_idB\r\n" +
+	"\v_recurrence\"F\n" +
 	"\x15CreateExpenseResponse\x12-\n" +
 	"\aexpense\x18\x01 \x01(\v2\x13.expense.v1.ExpenseR\aexpense\"#\n" +
 	"\x11GetExpenseRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\"C\n" +
 	"\x12GetExpenseResponse\x12-\n" +
-	"\aexpense\x18\x01 \x01(\v2\x13.expense.v1.ExpenseR\aexpense\"\x9a\x01\n" +
+	"\aexpense\x18\x01 \x01(\v2\x13.expense.v1.ExpenseR\aexpense\"\x98\x03\n" +
 	"\x14UpdateExpenseRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
 	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12'\n" +
 	"\x10day_of_month_due\x18\x04 \x01(\x05R\rdayOfMonthDue\x12\x1d\n" +
 	"\n" +
-	"is_autopay\x18\x05 \x01(\bR\tisAutopay\"F\n" +
+	"is_autopay\x18\x05 \x01(\bR\tisAutopay\x12$\n" +
+	"\vcategory_id\x18\x06 \x01(\x03H\x00R\n" +
+	"categoryId\x88\x01\x01\x12;\n" +
+	"\n" +
+	"recurrence\x18\a \x01(\v2\x16.expense.v1.RecurrenceH\x01R\n" +
+	"recurrence\x88\x01\x01\x12^\n" +
+	"\x17business_day_adjustment\x18\b \x01(\x0e2!.expense.v1.BusinessDayAdjustmentH\x02R\x15businessDayAdjustment\x88\x01\x01B\x0e\n" +
+	"\f_category_idB\r\n" +
+	"\v_recurrenceB\x1a\n" +
+	"\x18_business_day_adjustment\"F\n" +
 	"\x15UpdateExpenseResponse\x12-\n" +
 	"\aexpense\x18\x01 \x01(\v2\x13.expense.v1.ExpenseR\aexpense\"&\n" +
 	"\x14DeleteExpenseRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\"1\n" +
 	"\x15DeleteExpenseResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"Q\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xc9\x02\n" +
 	"\x13ListExpensesRequest\x12\x1b\n" +
 	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
-	"page_token\x18\x02 \x01(\tR\tpageToken\"u\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12$\n" +
+	"\vcategory_id\x18\x03 \x01(\x03H\x00R\n" +
+	"categoryId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"is_autopay\x18\x04 \x01(\bH\x01R\tisAutopay\x88\x01\x01\x12#\n" +
+	"\vmin_day_due\x18\x05 \x01(\x05H\x02R\tminDayDue\x88\x01\x01\x12#\n" +
+	"\vmax_day_due\x18\x06 \x01(\x05H\x03R\tmaxDayDue\x88\x01\x01\x12\x19\n" +
+	"\x05month\x18\a \x01(\tH\x04R\x05month\x88\x01\x01B\x0e\n" +
+	"\f_category_idB\r\n" +
+	"\v_is_autopayB\x0e\n" +
+	"\f_min_day_dueB\x0e\n" +
+	"\f_max_day_dueB\b\n" +
+	"\x06_month\"\xd7\x01\n" +
 	"\x14ListExpensesResponse\x125\n" +
 	"\bexpenses\x18\x01 \x03(\v2\x19.expense.v1.SortedExpenseR\bexpenses\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken2\xb2\x03\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x03R\n" +
+	"totalCount\x12?\n" +
+	"\voccurrences\x18\x04 \x03(\v2\x1d.expense.v1.ExpenseOccurrenceR\voccurrences\"\x80\x01\n" +
+	"\x19GetNextOccurrencesRequest\x12\x1d\n" +
+	"\n" +
+	"expense_id\x18\x01 \x01(\x03R\texpenseId\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\x12 \n" +
+	"\tfrom_date\x18\x03 \x01(\tH\x00R\bfromDate\x88\x01\x01B\f\n" +
+	"\n" +
+	"_from_date\"]\n" +
+	"\x1aGetNextOccurrencesResponse\x12?\n" +
+	"\voccurrences\x18\x01 \x03(\v2\x1d.expense.v1.ExpenseOccurrenceR\voccurrences\"\xfb\x01\n" +
+	"\x0fMarkPaidRequest\x12\x1d\n" +
+	"\n" +
+	"expense_id\x18\x01 \x01(\x03R\texpenseId\x12%\n" +
+	"\x0escheduled_date\x18\x02 \x01(\tR\rscheduledDate\x12 \n" +
+	"\tpaid_date\x18\x03 \x01(\tH\x00R\bpaidDate\x88\x01\x01\x12\x1b\n" +
+	"\x06amount\x18\x04 \x01(\x01H\x01R\x06amount\x88\x01\x01\x12\x17\n" +
+	"\x04note\x18\x05 \x01(\tH\x02R\x04note\x88\x01\x01\x12\x1c\n" +
+	"\apaid_by\x18\x06 \x01(\x03H\x03R\x06paidBy\x88\x01\x01B\f\n" +
+	"\n" +
+	"_paid_dateB\t\n" +
+	"\a_amountB\a\n" +
+	"\x05_noteB\n" +
+	"\n" +
+	"\b_paid_by\"A\n" +
+	"\x10MarkPaidResponse\x12-\n" +
+	"\apayment\x18\x01 \x01(\v2\x13.expense.v1.PaymentR\apayment\"Y\n" +
+	"\x11UnmarkPaidRequest\x12\x1d\n" +
+	"\n" +
+	"expense_id\x18\x01 \x01(\x03R\texpenseId\x12%\n" +
+	"\x0escheduled_date\x18\x02 \x01(\tR\rscheduledDate\".\n" +
+	"\x12UnmarkPaidResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xde\x01\n" +
+	"\x13ListPaymentsRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\"\n" +
+	"\n" +
+	"expense_id\x18\x03 \x01(\x03H\x00R\texpenseId\x88\x01\x01\x12 \n" +
+	"\tfrom_date\x18\x04 \x01(\tH\x01R\bfromDate\x88\x01\x01\x12\x1c\n" +
+	"\ato_date\x18\x05 \x01(\tH\x02R\x06toDate\x88\x01\x01B\r\n" +
+	"\v_expense_idB\f\n" +
+	"\n" +
+	"_from_dateB\n" +
+	"\n" +
+	"\b_to_date\"o\n" +
+	"\x14ListPaymentsResponse\x12/\n" +
+	"\bpayments\x18\x01 \x03(\v2\x13.expense.v1.PaymentR\bpayments\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken*\xb4\x01\n" +
+	"\tFrequency\x12\x19\n" +
+	"\x15FREQUENCY_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10FREQUENCY_WEEKLY\x10\x01\x12\x16\n" +
+	"\x12FREQUENCY_BIWEEKLY\x10\x02\x12\x15\n" +
+	"\x11FREQUENCY_MONTHLY\x10\x03\x12\x17\n" +
+	"\x13FREQUENCY_QUARTERLY\x10\x04\x12\x18\n" +
+	"\x14FREQUENCY_SEMIANNUAL\x10\x05\x12\x14\n" +
+	"\x10FREQUENCY_ANNUAL\x10\x06*\xaa\x01\n" +
+	"\x15BusinessDayAdjustment\x12'\n" +
+	"#BUSINESS_DAY_ADJUSTMENT_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cBUSINESS_DAY_ADJUSTMENT_NONE\x10\x01\x12$\n" +
+	" BUSINESS_DAY_ADJUSTMENT_PREVIOUS\x10\x02\x12 \n" +
+	"\x1cBUSINESS_DAY_ADJUSTMENT_NEXT\x10\x03*\xab\x01\n" +
+	"\x10OccurrenceStatus\x12!\n" +
+	"\x1dOCCURRENCE_STATUS_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aOCCURRENCE_STATUS_UPCOMING\x10\x01\x12\x19\n" +
+	"\x15OCCURRENCE_STATUS_DUE\x10\x02\x12\x1d\n" +
+	"\x19OCCURRENCE_STATUS_OVERDUE\x10\x03\x12\x1a\n" +
+	"\x16OCCURRENCE_STATUS_PAID\x10\x042\xfe\x05\n" +
 	"\x0eExpenseService\x12T\n" +
 	"\rCreateExpense\x12 .expense.v1.CreateExpenseRequest\x1a!.expense.v1.CreateExpenseResponse\x12K\n" +
 	"\n" +
 	"GetExpense\x12\x1d.expense.v1.GetExpenseRequest\x1a\x1e.expense.v1.GetExpenseResponse\x12T\n" +
 	"\rUpdateExpense\x12 .expense.v1.UpdateExpenseRequest\x1a!.expense.v1.UpdateExpenseResponse\x12T\n" +
 	"\rDeleteExpense\x12 .expense.v1.DeleteExpenseRequest\x1a!.expense.v1.DeleteExpenseResponse\x12Q\n" +
-	"\fListExpenses\x12\x1f.expense.v1.ListExpensesRequest\x1a .expense.v1.ListExpensesResponseB+Z)expenses-backend/pkg/expense/v1;expensev1b\x06proto3"
+	"\fListExpenses\x12\x1f.expense.v1.ListExpensesRequest\x1a .expense.v1.ListExpensesResponse\x12c\n" +
+	"\x12GetNextOccurrences\x12%.expense.v1.GetNextOccurrencesRequest\x1a&.expense.v1.GetNextOccurrencesResponse\x12E\n" +
+	"\bMarkPaid\x12\x1b.expense.v1.MarkPaidRequest\x1a\x1c.expense.v1.MarkPaidResponse\x12K\n" +
+	"\n" +
+	"UnmarkPaid\x12\x1d.expense.v1.UnmarkPaidRequest\x1a\x1e.expense.v1.UnmarkPaidResponse\x12Q\n" +
+	"\fListPayments\x12\x1f.expense.v1.ListPaymentsRequest\x1a .expense.v1.ListPaymentsResponseB+Z)expenses-backend/pkg/expense/v1;expensev1b\x06proto3"
 
 var (
 	file_expense_v1_expense_proto_rawDescOnce sync.Once
@@ -749,42 +1955,78 @@ func file_expense_v1_expense_proto_rawDescGZIP() []byte {
 	return file_expense_v1_expense_proto_rawDescData
 }
 
-var file_expense_v1_expense_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_expense_v1_expense_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_expense_v1_expense_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
 var file_expense_v1_expense_proto_goTypes = []any{
-	(*Expense)(nil),               // 0: expense.v1.Expense
-	(*SortedExpense)(nil),         // 1: expense.v1.SortedExpense
-	(*CreateExpenseRequest)(nil),  // 2: expense.v1.CreateExpenseRequest
-	(*CreateExpenseResponse)(nil), // 3: expense.v1.CreateExpenseResponse
-	(*GetExpenseRequest)(nil),     // 4: expense.v1.GetExpenseRequest
-	(*GetExpenseResponse)(nil),    // 5: expense.v1.GetExpenseResponse
-	(*UpdateExpenseRequest)(nil),  // 6: expense.v1.UpdateExpenseRequest
-	(*UpdateExpenseResponse)(nil), // 7: expense.v1.UpdateExpenseResponse
-	(*DeleteExpenseRequest)(nil),  // 8: expense.v1.DeleteExpenseRequest
-	(*DeleteExpenseResponse)(nil), // 9: expense.v1.DeleteExpenseResponse
-	(*ListExpensesRequest)(nil),   // 10: expense.v1.ListExpensesRequest
-	(*ListExpensesResponse)(nil),  // 11: expense.v1.ListExpensesResponse
+	(Frequency)(0),                     // 0: expense.v1.Frequency
+	(BusinessDayAdjustment)(0),         // 1: expense.v1.BusinessDayAdjustment
+	(OccurrenceStatus)(0),              // 2: expense.v1.OccurrenceStatus
+	(*Recurrence)(nil),                 // 3: expense.v1.Recurrence
+	(*Expense)(nil),                    // 4: expense.v1.Expense
+	(*SortedExpense)(nil),              // 5: expense.v1.SortedExpense
+	(*ExpenseOccurrence)(nil),          // 6: expense.v1.ExpenseOccurrence
+	(*Payment)(nil),                    // 7: expense.v1.Payment
+	(*CreateExpenseRequest)(nil),       // 8: expense.v1.CreateExpenseRequest
+	(*CreateExpenseResponse)(nil),      // 9: expense.v1.CreateExpenseResponse
+	(*GetExpenseRequest)(nil),          // 10: expense.v1.GetExpenseRequest
+	(*GetExpenseResponse)(nil),         // 11: expense.v1.GetExpenseResponse
+	(*UpdateExpenseRequest)(nil),       // 12: expense.v1.UpdateExpenseRequest
+	(*UpdateExpenseResponse)(nil),      // 13: expense.v1.UpdateExpenseResponse
+	(*DeleteExpenseRequest)(nil),       // 14: expense.v1.DeleteExpenseRequest
+	(*DeleteExpenseResponse)(nil),      // 15: expense.v1.DeleteExpenseResponse
+	(*ListExpensesRequest)(nil),        // 16: expense.v1.ListExpensesRequest
+	(*ListExpensesResponse)(nil),       // 17: expense.v1.ListExpensesResponse
+	(*GetNextOccurrencesRequest)(nil),  // 18: expense.v1.GetNextOccurrencesRequest
+	(*GetNextOccurrencesResponse)(nil), // 19: expense.v1.GetNextOccurrencesResponse
+	(*MarkPaidRequest)(nil),            // 20: expense.v1.MarkPaidRequest
+	(*MarkPaidResponse)(nil),           // 21: expense.v1.MarkPaidResponse
+	(*UnmarkPaidRequest)(nil),          // 22: expense.v1.UnmarkPaidRequest
+	(*UnmarkPaidResponse)(nil),         // 23: expense.v1.UnmarkPaidResponse
+	(*ListPaymentsRequest)(nil),        // 24: expense.v1.ListPaymentsRequest
+	(*ListPaymentsResponse)(nil),       // 25: expense.v1.ListPaymentsResponse
 }
 var file_expense_v1_expense_proto_depIdxs = []int32{
-	0,  // 0: expense.v1.SortedExpense.expenses:type_name -> expense.v1.Expense
-	0,  // 1: expense.v1.CreateExpenseResponse.expense:type_name -> expense.v1.Expense
-	0,  // 2: expense.v1.GetExpenseResponse.expense:type_name -> expense.v1.Expense
-	0,  // 3: expense.v1.UpdateExpenseResponse.expense:type_name -> expense.v1.Expense
-	1,  // 4: expense.v1.ListExpensesResponse.expenses:type_name -> expense.v1.SortedExpense
-	2,  // 5: expense.v1.ExpenseService.CreateExpense:input_type -> expense.v1.CreateExpenseRequest
-	4,  // 6: expense.v1.ExpenseService.GetExpense:input_type -> expense.v1.GetExpenseRequest
-	6,  // 7: expense.v1.ExpenseService.UpdateExpense:input_type -> expense.v1.UpdateExpenseRequest
-	8,  // 8: expense.v1.ExpenseService.DeleteExpense:input_type -> expense.v1.DeleteExpenseRequest
-	10, // 9: expense.v1.ExpenseService.ListExpenses:input_type -> expense.v1.ListExpensesRequest
-	3,  // 10: expense.v1.ExpenseService.CreateExpense:output_type -> expense.v1.CreateExpenseResponse
-	5,  // 11: expense.v1.ExpenseService.GetExpense:output_type -> expense.v1.GetExpenseResponse
-	7,  // 12: expense.v1.ExpenseService.UpdateExpense:output_type -> expense.v1.UpdateExpenseResponse
-	9,  // 13: expense.v1.ExpenseService.DeleteExpense:output_type -> expense.v1.DeleteExpenseResponse
-	11, // 14: expense.v1.ExpenseService.ListExpenses:output_type -> expense.v1.ListExpensesResponse
-	10, // [10:15] is the sub-list for method output_type
-	5,  // [5:10] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	0,  // 0: expense.v1.Recurrence.frequency:type_name -> expense.v1.Frequency
+	3,  // 1: expense.v1.Expense.recurrence:type_name -> expense.v1.Recurrence
+	1,  // 2: expense.v1.Expense.business_day_adjustment:type_name -> expense.v1.BusinessDayAdjustment
+	4,  // 3: expense.v1.SortedExpense.expenses:type_name -> expense.v1.Expense
+	2,  // 4: expense.v1.ExpenseOccurrence.status:type_name -> expense.v1.OccurrenceStatus
+	7,  // 5: expense.v1.ExpenseOccurrence.payment:type_name -> expense.v1.Payment
+	3,  // 6: expense.v1.CreateExpenseRequest.recurrence:type_name -> expense.v1.Recurrence
+	1,  // 7: expense.v1.CreateExpenseRequest.business_day_adjustment:type_name -> expense.v1.BusinessDayAdjustment
+	4,  // 8: expense.v1.CreateExpenseResponse.expense:type_name -> expense.v1.Expense
+	4,  // 9: expense.v1.GetExpenseResponse.expense:type_name -> expense.v1.Expense
+	3,  // 10: expense.v1.UpdateExpenseRequest.recurrence:type_name -> expense.v1.Recurrence
+	1,  // 11: expense.v1.UpdateExpenseRequest.business_day_adjustment:type_name -> expense.v1.BusinessDayAdjustment
+	4,  // 12: expense.v1.UpdateExpenseResponse.expense:type_name -> expense.v1.Expense
+	5,  // 13: expense.v1.ListExpensesResponse.expenses:type_name -> expense.v1.SortedExpense
+	6,  // 14: expense.v1.ListExpensesResponse.occurrences:type_name -> expense.v1.ExpenseOccurrence
+	6,  // 15: expense.v1.GetNextOccurrencesResponse.occurrences:type_name -> expense.v1.ExpenseOccurrence
+	7,  // 16: expense.v1.MarkPaidResponse.payment:type_name -> expense.v1.Payment
+	7,  // 17: expense.v1.ListPaymentsResponse.payments:type_name -> expense.v1.Payment
+	8,  // 18: expense.v1.ExpenseService.CreateExpense:input_type -> expense.v1.CreateExpenseRequest
+	10, // 19: expense.v1.ExpenseService.GetExpense:input_type -> expense.v1.GetExpenseRequest
+	12, // 20: expense.v1.ExpenseService.UpdateExpense:input_type -> expense.v1.UpdateExpenseRequest
+	14, // 21: expense.v1.ExpenseService.DeleteExpense:input_type -> expense.v1.DeleteExpenseRequest
+	16, // 22: expense.v1.ExpenseService.ListExpenses:input_type -> expense.v1.ListExpensesRequest
+	18, // 23: expense.v1.ExpenseService.GetNextOccurrences:input_type -> expense.v1.GetNextOccurrencesRequest
+	20, // 24: expense.v1.ExpenseService.MarkPaid:input_type -> expense.v1.MarkPaidRequest
+	22, // 25: expense.v1.ExpenseService.UnmarkPaid:input_type -> expense.v1.UnmarkPaidRequest
+	24, // 26: expense.v1.ExpenseService.ListPayments:input_type -> expense.v1.ListPaymentsRequest
+	9,  // 27: expense.v1.ExpenseService.CreateExpense:output_type -> expense.v1.CreateExpenseResponse
+	11, // 28: expense.v1.ExpenseService.GetExpense:output_type -> expense.v1.GetExpenseResponse
+	13, // 29: expense.v1.ExpenseService.UpdateExpense:output_type -> expense.v1.UpdateExpenseResponse
+	15, // 30: expense.v1.ExpenseService.DeleteExpense:output_type -> expense.v1.DeleteExpenseResponse
+	17, // 31: expense.v1.ExpenseService.ListExpenses:output_type -> expense.v1.ListExpensesResponse
+	19, // 32: expense.v1.ExpenseService.GetNextOccurrences:output_type -> expense.v1.GetNextOccurrencesResponse
+	21, // 33: expense.v1.ExpenseService.MarkPaid:output_type -> expense.v1.MarkPaidResponse
+	23, // 34: expense.v1.ExpenseService.UnmarkPaid:output_type -> expense.v1.UnmarkPaidResponse
+	25, // 35: expense.v1.ExpenseService.ListPayments:output_type -> expense.v1.ListPaymentsResponse
+	27, // [27:36] is the sub-list for method output_type
+	18, // [18:27] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_expense_v1_expense_proto_init() }
@@ -792,18 +2034,29 @@ func file_expense_v1_expense_proto_init() {
 	if File_expense_v1_expense_proto != nil {
 		return
 	}
+	file_expense_v1_expense_proto_msgTypes[0].OneofWrappers = []any{}
+	file_expense_v1_expense_proto_msgTypes[1].OneofWrappers = []any{}
+	file_expense_v1_expense_proto_msgTypes[3].OneofWrappers = []any{}
+	file_expense_v1_expense_proto_msgTypes[4].OneofWrappers = []any{}
+	file_expense_v1_expense_proto_msgTypes[5].OneofWrappers = []any{}
+	file_expense_v1_expense_proto_msgTypes[9].OneofWrappers = []any{}
+	file_expense_v1_expense_proto_msgTypes[13].OneofWrappers = []any{}
+	file_expense_v1_expense_proto_msgTypes[15].OneofWrappers = []any{}
+	file_expense_v1_expense_proto_msgTypes[17].OneofWrappers = []any{}
+	file_expense_v1_expense_proto_msgTypes[21].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_expense_v1_expense_proto_rawDesc), len(file_expense_v1_expense_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   12,
+			NumEnums:      3,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_expense_v1_expense_proto_goTypes,
 		DependencyIndexes: file_expense_v1_expense_proto_depIdxs,
+		EnumInfos:         file_expense_v1_expense_proto_enumTypes,
 		MessageInfos:      file_expense_v1_expense_proto_msgTypes,
 	}.Build()
 	File_expense_v1_expense_proto = out.File
@@ -48,6 +48,17 @@ const (
 	// ExpenseServiceListExpensesProcedure is the fully-qualified name of the ExpenseService's
 	// ListExpenses RPC.
 	ExpenseServiceListExpensesProcedure = "/expense.v1.ExpenseService/ListExpenses"
+	// ExpenseServiceGetNextOccurrencesProcedure is the fully-qualified name of the ExpenseService's
+	// GetNextOccurrences RPC.
+	ExpenseServiceGetNextOccurrencesProcedure = "/expense.v1.ExpenseService/GetNextOccurrences"
+	// ExpenseServiceMarkPaidProcedure is the fully-qualified name of the ExpenseService's MarkPaid RPC.
+	ExpenseServiceMarkPaidProcedure = "/expense.v1.ExpenseService/MarkPaid"
+	// ExpenseServiceUnmarkPaidProcedure is the fully-qualified name of the ExpenseService's UnmarkPaid
+	// RPC.
+	ExpenseServiceUnmarkPaidProcedure = "/expense.v1.ExpenseService/UnmarkPaid"
+	// ExpenseServiceListPaymentsProcedure is the fully-qualified name of the ExpenseService's
+	// ListPayments RPC.
+	ExpenseServiceListPaymentsProcedure = "/expense.v1.ExpenseService/ListPayments"
 )
 
 // ExpenseServiceClient is a client for the expense.v1.ExpenseService service.
@@ -57,6 +68,11 @@ type ExpenseServiceClient interface {
 	UpdateExpense(context.Context, *connect.Request[v1.UpdateExpenseRequest]) (*connect.Response[v1.UpdateExpenseResponse], error)
 	DeleteExpense(context.Context, *connect.Request[v1.DeleteExpenseRequest]) (*connect.Response[v1.DeleteExpenseResponse], error)
 	ListExpenses(context.Context, *connect.Request[v1.ListExpensesRequest]) (*connect.Response[v1.ListExpensesResponse], error)
+	GetNextOccurrences(context.Context, *connect.Request[v1.GetNextOccurrencesRequest]) (*connect.Response[v1.GetNextOccurrencesResponse], error)
+	// Payment tracking endpoints
+	MarkPaid(context.Context, *connect.Request[v1.MarkPaidRequest]) (*connect.Response[v1.MarkPaidResponse], error)
+	UnmarkPaid(context.Context, *connect.Request[v1.UnmarkPaidRequest]) (*connect.Response[v1.UnmarkPaidResponse], error)
+	ListPayments(context.Context, *connect.Request[v1.ListPaymentsRequest]) (*connect.Response[v1.ListPaymentsResponse], error)
 }
 
 // NewExpenseServiceClient constructs a client for the expense.v1.ExpenseService service. By
@@ -100,16 +116,44 @@ func NewExpenseServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(expenseServiceMethods.ByName("ListExpenses")),
 			connect.WithClientOptions(opts...),
 		),
+		getNextOccurrences: connect.NewClient[v1.GetNextOccurrencesRequest, v1.GetNextOccurrencesResponse](
+			httpClient,
+			baseURL+ExpenseServiceGetNextOccurrencesProcedure,
+			connect.WithSchema(expenseServiceMethods.ByName("GetNextOccurrences")),
+			connect.WithClientOptions(opts...),
+		),
+		markPaid: connect.NewClient[v1.MarkPaidRequest, v1.MarkPaidResponse](
+			httpClient,
+			baseURL+ExpenseServiceMarkPaidProcedure,
+			connect.WithSchema(expenseServiceMethods.ByName("MarkPaid")),
+			connect.WithClientOptions(opts...),
+		),
+		unmarkPaid: connect.NewClient[v1.UnmarkPaidRequest, v1.UnmarkPaidResponse](
+			httpClient,
+			baseURL+ExpenseServiceUnmarkPaidProcedure,
+			connect.WithSchema(expenseServiceMethods.ByName("UnmarkPaid")),
+			connect.WithClientOptions(opts...),
+		),
+		listPayments: connect.NewClient[v1.ListPaymentsRequest, v1.ListPaymentsResponse](
+			httpClient,
+			baseURL+ExpenseServiceListPaymentsProcedure,
+			connect.WithSchema(expenseServiceMethods.ByName("ListPayments")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // expenseServiceClient implements ExpenseServiceClient.
 type expenseServiceClient struct {
-	createExpense *connect.Client[v1.CreateExpenseRequest, v1.CreateExpenseResponse]
-	getExpense    *connect.Client[v1.GetExpenseRequest, v1.GetExpenseResponse]
-	updateExpense *connect.Client[v1.UpdateExpenseRequest, v1.UpdateExpenseResponse]
-	deleteExpense *connect.Client[v1.DeleteExpenseRequest, v1.DeleteExpenseResponse]
-	listExpenses  *connect.Client[v1.ListExpensesRequest, v1.ListExpensesResponse]
+	createExpense      *connect.Client[v1.CreateExpenseRequest, v1.CreateExpenseResponse]
+	getExpense         *connect.Client[v1.GetExpenseRequest, v1.GetExpenseResponse]
+	updateExpense      *connect.Client[v1.UpdateExpenseRequest, v1.UpdateExpenseResponse]
+	deleteExpense      *connect.Client[v1.DeleteExpenseRequest, v1.DeleteExpenseResponse]
+	listExpenses       *connect.Client[v1.ListExpensesRequest, v1.ListExpensesResponse]
+	getNextOccurrences *connect.Client[v1.GetNextOccurrencesRequest, v1.GetNextOccurrencesResponse]
+	markPaid           *connect.Client[v1.MarkPaidRequest, v1.MarkPaidResponse]
+	unmarkPaid         *connect.Client[v1.UnmarkPaidRequest, v1.UnmarkPaidResponse]
+	listPayments       *connect.Client[v1.ListPaymentsRequest, v1.ListPaymentsResponse]
 }
 
 // CreateExpense calls expense.v1.ExpenseService.CreateExpense.
@@ -137,6 +181,26 @@ func (c *expenseServiceClient) ListExpenses(ctx context.Context, req *connect.Re
 	return c.listExpenses.CallUnary(ctx, req)
 }
 
+// GetNextOccurrences calls expense.v1.ExpenseService.GetNextOccurrences.
+func (c *expenseServiceClient) GetNextOccurrences(ctx context.Context, req *connect.Request[v1.GetNextOccurrencesRequest]) (*connect.Response[v1.GetNextOccurrencesResponse], error) {
+	return c.getNextOccurrences.CallUnary(ctx, req)
+}
+
+// MarkPaid calls expense.v1.ExpenseService.MarkPaid.
+func (c *expenseServiceClient) MarkPaid(ctx context.Context, req *connect.Request[v1.MarkPaidRequest]) (*connect.Response[v1.MarkPaidResponse], error) {
+	return c.markPaid.CallUnary(ctx, req)
+}
+
+// UnmarkPaid calls expense.v1.ExpenseService.UnmarkPaid.
+func (c *expenseServiceClient) UnmarkPaid(ctx context.Context, req *connect.Request[v1.UnmarkPaidRequest]) (*connect.Response[v1.UnmarkPaidResponse], error) {
+	return c.unmarkPaid.CallUnary(ctx, req)
+}
+
+// ListPayments calls expense.v1.ExpenseService.ListPayments.
+func (c *expenseServiceClient) ListPayments(ctx context.Context, req *connect.Request[v1.ListPaymentsRequest]) (*connect.Response[v1.ListPaymentsResponse], error) {
+	return c.listPayments.CallUnary(ctx, req)
+}
+
 // ExpenseServiceHandler is an implementation of the expense.v1.ExpenseService service.
 type ExpenseServiceHandler interface {
 	CreateExpense(context.Context, *connect.Request[v1.CreateExpenseRequest]) (*connect.Response[v1.CreateExpenseResponse], error)
@@ -144,6 +208,11 @@ type ExpenseServiceHandler interface {
 	UpdateExpense(context.Context, *connect.Request[v1.UpdateExpenseRequest]) (*connect.Response[v1.UpdateExpenseResponse], error)
 	DeleteExpense(context.Context, *connect.Request[v1.DeleteExpenseRequest]) (*connect.Response[v1.DeleteExpenseResponse], error)
 	ListExpenses(context.Context, *connect.Request[v1.ListExpensesRequest]) (*connect.Response[v1.ListExpensesResponse], error)
+	GetNextOccurrences(context.Context, *connect.Request[v1.GetNextOccurrencesRequest]) (*connect.Response[v1.GetNextOccurrencesResponse], error)
+	// Payment tracking endpoints
+	MarkPaid(context.Context, *connect.Request[v1.MarkPaidRequest]) (*connect.Response[v1.MarkPaidResponse], error)
+	UnmarkPaid(context.Context, *connect.Request[v1.UnmarkPaidRequest]) (*connect.Response[v1.UnmarkPaidResponse], error)
+	ListPayments(context.Context, *connect.Request[v1.ListPaymentsRequest]) (*connect.Response[v1.ListPaymentsResponse], error)
 }
 
 // NewExpenseServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -183,6 +252,30 @@ func NewExpenseServiceHandler(svc ExpenseServiceHandler, opts ...connect.Handler
 		connect.WithSchema(expenseServiceMethods.ByName("ListExpenses")),
 		connect.WithHandlerOptions(opts...),
 	)
+	expenseServiceGetNextOccurrencesHandler := connect.NewUnaryHandler(
+		ExpenseServiceGetNextOccurrencesProcedure,
+		svc.GetNextOccurrences,
+		connect.WithSchema(expenseServiceMethods.ByName("GetNextOccurrences")),
+		connect.WithHandlerOptions(opts...),
+	)
+	expenseServiceMarkPaidHandler := connect.NewUnaryHandler(
+		ExpenseServiceMarkPaidProcedure,
+		svc.MarkPaid,
+		connect.WithSchema(expenseServiceMethods.ByName("MarkPaid")),
+		connect.WithHandlerOptions(opts...),
+	)
+	expenseServiceUnmarkPaidHandler := connect.NewUnaryHandler(
+		ExpenseServiceUnmarkPaidProcedure,
+		svc.UnmarkPaid,
+		connect.WithSchema(expenseServiceMethods.ByName("UnmarkPaid")),
+		connect.WithHandlerOptions(opts...),
+	)
+	expenseServiceListPaymentsHandler := connect.NewUnaryHandler(
+		ExpenseServiceListPaymentsProcedure,
+		svc.ListPayments,
+		connect.WithSchema(expenseServiceMethods.ByName("ListPayments")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/expense.v1.ExpenseService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ExpenseServiceCreateExpenseProcedure:
@@ -195,6 +288,14 @@ func NewExpenseServiceHandler(svc ExpenseServiceHandler, opts ...connect.Handler
 			expenseServiceDeleteExpenseHandler.ServeHTTP(w, r)
 		case ExpenseServiceListExpensesProcedure:
 			expenseServiceListExpensesHandler.ServeHTTP(w, r)
+		case ExpenseServiceGetNextOccurrencesProcedure:
+			expenseServiceGetNextOccurrencesHandler.ServeHTTP(w, r)
+		case ExpenseServiceMarkPaidProcedure:
+			expenseServiceMarkPaidHandler.ServeHTTP(w, r)
+		case ExpenseServiceUnmarkPaidProcedure:
+			expenseServiceUnmarkPaidHandler.ServeHTTP(w, r)
+		case ExpenseServiceListPaymentsProcedure:
+			expenseServiceListPaymentsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -223,3 +324,19 @@ func (UnimplementedExpenseServiceHandler) DeleteExpense(context.Context, *connec
 func (UnimplementedExpenseServiceHandler) ListExpenses(context.Context, *connect.Request[v1.ListExpensesRequest]) (*connect.Response[v1.ListExpensesResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("expense.v1.ExpenseService.ListExpenses is not implemented"))
 }
+
+func (UnimplementedExpenseServiceHandler) GetNextOccurrences(context.Context, *connect.Request[v1.GetNextOccurrencesRequest]) (*connect.Response[v1.GetNextOccurrencesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("expense.v1.ExpenseService.GetNextOccurrences is not implemented"))
+}
+
+func (UnimplementedExpenseServiceHandler) MarkPaid(context.Context, *connect.Request[v1.MarkPaidRequest]) (*connect.Response[v1.MarkPaidResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("expense.v1.ExpenseService.MarkPaid is not implemented"))
+}
+
+func (UnimplementedExpenseServiceHandler) UnmarkPaid(context.Context, *connect.Request[v1.UnmarkPaidRequest]) (*connect.Response[v1.UnmarkPaidResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("expense.v1.ExpenseService.UnmarkPaid is not implemented"))
+}
+
+func (UnimplementedExpenseServiceHandler) ListPayments(context.Context, *connect.Request[v1.ListPaymentsRequest]) (*connect.Response[v1.ListPaymentsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("expense.v1.ExpenseService.ListPayments is not implemented"))
+}
@@ -21,12 +21,167 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// How often an income source pays out
+type PayFrequency int32
+
+const (
+	PayFrequency_PAY_FREQUENCY_UNSPECIFIED PayFrequency = 0 // Monthly for new sources; keeps the current schedule on update
+	PayFrequency_PAY_FREQUENCY_WEEKLY      PayFrequency = 1
+	PayFrequency_PAY_FREQUENCY_BIWEEKLY    PayFrequency = 2
+	PayFrequency_PAY_FREQUENCY_MONTHLY     PayFrequency = 3
+	PayFrequency_PAY_FREQUENCY_SEMIMONTHLY PayFrequency = 7 // The anchor day and half a month later, e.g. the 1st and 16th
+)
+
+// Enum value maps for PayFrequency.
+var (
+	PayFrequency_name = map[int32]string{
+		0: "PAY_FREQUENCY_UNSPECIFIED",
+		1: "PAY_FREQUENCY_WEEKLY",
+		2: "PAY_FREQUENCY_BIWEEKLY",
+		3: "PAY_FREQUENCY_MONTHLY",
+		7: "PAY_FREQUENCY_SEMIMONTHLY",
+	}
+	PayFrequency_value = map[string]int32{
+		"PAY_FREQUENCY_UNSPECIFIED": 0,
+		"PAY_FREQUENCY_WEEKLY":      1,
+		"PAY_FREQUENCY_BIWEEKLY":    2,
+		"PAY_FREQUENCY_MONTHLY":     3,
+		"PAY_FREQUENCY_SEMIMONTHLY": 7,
+	}
+)
+
+func (x PayFrequency) Enum() *PayFrequency {
+	p := new(PayFrequency)
+	*p = x
+	return p
+}
+
+func (x PayFrequency) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PayFrequency) Descriptor() protoreflect.EnumDescriptor {
+	return file_family_v1_family_proto_enumTypes[0].Descriptor()
+}
+
+func (PayFrequency) Type() protoreflect.EnumType {
+	return &file_family_v1_family_proto_enumTypes[0]
+}
+
+func (x PayFrequency) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PayFrequency.Descriptor instead.
+func (PayFrequency) EnumDescriptor() ([]byte, []int) {
+	return file_family_v1_family_proto_rawDescGZIP(), []int{0}
+}
+
+type HolidayPreset int32
+
+const (
+	HolidayPreset_HOLIDAY_PRESET_UNSPECIFIED HolidayPreset = 0
+	HolidayPreset_HOLIDAY_PRESET_US_BANK     HolidayPreset = 1 // Federal Reserve bank holidays
+)
+
+// Enum value maps for HolidayPreset.
+var (
+	HolidayPreset_name = map[int32]string{
+		0: "HOLIDAY_PRESET_UNSPECIFIED",
+		1: "HOLIDAY_PRESET_US_BANK",
+	}
+	HolidayPreset_value = map[string]int32{
+		"HOLIDAY_PRESET_UNSPECIFIED": 0,
+		"HOLIDAY_PRESET_US_BANK":     1,
+	}
+)
+
+func (x HolidayPreset) Enum() *HolidayPreset {
+	p := new(HolidayPreset)
+	*p = x
+	return p
+}
+
+func (x HolidayPreset) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HolidayPreset) Descriptor() protoreflect.EnumDescriptor {
+	return file_family_v1_family_proto_enumTypes[1].Descriptor()
+}
+
+func (HolidayPreset) Type() protoreflect.EnumType {
+	return &file_family_v1_family_proto_enumTypes[1]
+}
+
+func (x HolidayPreset) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HolidayPreset.Descriptor instead.
+func (HolidayPreset) EnumDescriptor() ([]byte, []int) {
+	return file_family_v1_family_proto_rawDescGZIP(), []int{1}
+}
+
+type SplitMethod int32
+
+const (
+	SplitMethod_SPLIT_METHOD_UNSPECIFIED SplitMethod = 0
+	SplitMethod_SPLIT_METHOD_EQUAL       SplitMethod = 1
+	SplitMethod_SPLIT_METHOD_PERCENTAGE  SplitMethod = 2
+	SplitMethod_SPLIT_METHOD_FIXED       SplitMethod = 3
+)
+
+// Enum value maps for SplitMethod.
+var (
+	SplitMethod_name = map[int32]string{
+		0: "SPLIT_METHOD_UNSPECIFIED",
+		1: "SPLIT_METHOD_EQUAL",
+		2: "SPLIT_METHOD_PERCENTAGE",
+		3: "SPLIT_METHOD_FIXED",
+	}
+	SplitMethod_value = map[string]int32{
+		"SPLIT_METHOD_UNSPECIFIED": 0,
+		"SPLIT_METHOD_EQUAL":       1,
+		"SPLIT_METHOD_PERCENTAGE":  2,
+		"SPLIT_METHOD_FIXED":       3,
+	}
+)
+
+func (x SplitMethod) Enum() *SplitMethod {
+	p := new(SplitMethod)
+	*p = x
+	return p
+}
+
+func (x SplitMethod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SplitMethod) Descriptor() protoreflect.EnumDescriptor {
+	return file_family_v1_family_proto_enumTypes[2].Descriptor()
+}
+
+func (SplitMethod) Type() protoreflect.EnumType {
+	return &file_family_v1_family_proto_enumTypes[2]
+}
+
+func (x SplitMethod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SplitMethod.Descriptor instead.
+func (SplitMethod) EnumDescriptor() ([]byte, []int) {
+	return file_family_v1_family_proto_rawDescGZIP(), []int{2}
+}
+
 type FamilySetting struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	SettingKey    string                 `protobuf:"bytes,2,opt,name=setting_key,json=settingKey,proto3" json:"setting_key,omitempty"`
-	SettingValue  *string                `protobuf:"bytes,3,opt,name=setting_value,json=settingValue,proto3,oneof" json:"setting_value,omitempty"`
-	DataType      string                 `protobuf:"bytes,4,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
+	SettingValue  *string                `protobuf:"bytes,3,opt,name=setting_value,json=settingValue,proto3,oneof" json:"setting_value,omitempty"` // Never set for secret settings
+	DataType      string                 `protobuf:"bytes,4,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`                   // "secret" values are encrypted at rest
+	Redacted      bool                   `protobuf:"varint,5,opt,name=redacted,proto3" json:"redacted,omitempty"`                                  // The value is secret and is not returned
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -89,6 +244,13 @@ func (x *FamilySetting) GetDataType() string {
 	return ""
 }
 
+func (x *FamilySetting) GetRedacted() bool {
+	if x != nil {
+		return x.Redacted
+	}
+	return false
+}
+
 type CreateFamilySettingRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SettingKey    string                 `protobuf:"bytes,1,opt,name=setting_key,json=settingKey,proto3" json:"setting_key,omitempty"`
@@ -556,9 +718,15 @@ func (x *DeleteFamilySettingResponse) GetSuccess() bool {
 type IncomeSource struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Amount        float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Amount        float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"` // Net pay per month on average; sets net_amount when that is not given
 	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
 	IsActive      bool                   `protobuf:"varint,4,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	PayFrequency  PayFrequency           `protobuf:"varint,5,opt,name=pay_frequency,json=payFrequency,proto3,enum=family.v1.PayFrequency" json:"pay_frequency,omitempty"`
+	PayAnchorDate *string                `protobuf:"bytes,6,opt,name=pay_anchor_date,json=payAnchorDate,proto3,oneof" json:"pay_anchor_date,omitempty"` // YYYY-MM-DD, the first pay date; defaults to the 1st of the current month
+	Id            int64                  `protobuf:"varint,7,opt,name=id,proto3" json:"id,omitempty"`                                                   // Set by the server
+	GrossAmount   *string                `protobuf:"bytes,8,opt,name=gross_amount,json=grossAmount,proto3,oneof" json:"gross_amount,omitempty"`         // Exact decimal per paycheck before deductions, defaults to net_amount
+	NetAmount     *string                `protobuf:"bytes,9,opt,name=net_amount,json=netAmount,proto3,oneof" json:"net_amount,omitempty"`               // Exact decimal per paycheck as deposited
+	MemberId      *int64                 `protobuf:"varint,10,opt,name=member_id,json=memberId,proto3,oneof" json:"member_id,omitempty"`                // Family member who earns it; 0 clears it
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -621,9 +789,51 @@ func (x *IncomeSource) GetIsActive() bool {
 	return false
 }
 
+func (x *IncomeSource) GetPayFrequency() PayFrequency {
+	if x != nil {
+		return x.PayFrequency
+	}
+	return PayFrequency_PAY_FREQUENCY_UNSPECIFIED
+}
+
+func (x *IncomeSource) GetPayAnchorDate() string {
+	if x != nil && x.PayAnchorDate != nil {
+		return *x.PayAnchorDate
+	}
+	return ""
+}
+
+func (x *IncomeSource) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *IncomeSource) GetGrossAmount() string {
+	if x != nil && x.GrossAmount != nil {
+		return *x.GrossAmount
+	}
+	return ""
+}
+
+func (x *IncomeSource) GetNetAmount() string {
+	if x != nil && x.NetAmount != nil {
+		return *x.NetAmount
+	}
+	return ""
+}
+
+func (x *IncomeSource) GetMemberId() int64 {
+	if x != nil && x.MemberId != nil {
+		return *x.MemberId
+	}
+	return 0
+}
+
 type MonthlyIncome struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TotalAmount   float64                `protobuf:"fixed64,1,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	TotalAmount   float64                `protobuf:"fixed64,1,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"` // Derived from the active sources' net pay; ignored when set
 	Sources       []*IncomeSource        `protobuf:"bytes,2,rep,name=sources,proto3" json:"sources,omitempty"`
 	UpdatedAt     int64                  `protobuf:"varint,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // Unix timestamp
 	unknownFields protoimpl.UnknownFields
@@ -1121,185 +1331,1964 @@ func (x *UpdateIncomeSourceResponse) GetSuccess() bool {
 	return false
 }
 
-var File_family_v1_family_proto protoreflect.FileDescriptor
+type Payday struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Date           string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`                                        // YYYY-MM-DD, when the pay arrives
+	ScheduledDate  string                 `protobuf:"bytes,2,opt,name=scheduled_date,json=scheduledDate,proto3" json:"scheduled_date,omitempty"` // YYYY-MM-DD, before moving off a weekend or holiday
+	IncomeSourceId int64                  `protobuf:"varint,3,opt,name=income_source_id,json=incomeSourceId,proto3" json:"income_source_id,omitempty"`
+	Name           string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	GrossAmount    string                 `protobuf:"bytes,5,opt,name=gross_amount,json=grossAmount,proto3" json:"gross_amount,omitempty"` // Exact decimal
+	NetAmount      string                 `protobuf:"bytes,6,opt,name=net_amount,json=netAmount,proto3" json:"net_amount,omitempty"`       // Exact decimal
+	MemberId       *int64                 `protobuf:"varint,7,opt,name=member_id,json=memberId,proto3,oneof" json:"member_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
 
-const file_family_v1_family_proto_rawDesc = "" +
-	"\n" +
-	"\x16family/v1/family.proto\x12\tfamily.v1\"\x99\x01\n" +
-	"\rFamilySetting\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
-	"\vsetting_key\x18\x02 \x01(\tR\n" +
-	"settingKey\x12(\n" +
-	"\rsetting_value\x18\x03 \x01(\tH\x00R\fsettingValue\x88\x01\x01\x12\x1b\n" +
-	"\tdata_type\x18\x04 \x01(\tR\bdataTypeB\x10\n" +
-	"\x0e_setting_value\"\x96\x01\n" +
-	"\x1aCreateFamilySettingRequest\x12\x1f\n" +
-	"\vsetting_key\x18\x01 \x01(\tR\n" +
-	"settingKey\x12(\n" +
-	"\rsetting_value\x18\x02 \x01(\tH\x00R\fsettingValue\x88\x01\x01\x12\x1b\n" +
-	"\tdata_type\x18\x03 \x01(\tR\bdataTypeB\x10\n" +
-	"\x0e_setting_value\"^\n" +
-	"\x1bCreateFamilySettingResponse\x12?\n" +
-	"\x0efamily_setting\x18\x01 \x01(\v2\x18.family.v1.FamilySettingR\rfamilySetting\"\x1b\n" +
-	"\x19ListFamilySettingsRequest\"_\n" +
-	"\x1aListFamilySettingsResponse\x12A\n" +
-	"\x0ffamily_settings\x18\x01 \x03(\v2\x18.family.v1.FamilySettingR\x0efamilySettings\"0\n" +
-	"\x1cGetFamilySettingByKeyRequest\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\"x\n" +
-	"\x1dGetFamilySettingByKeyResponse\x12D\n" +
-	"\x0efamily_setting\x18\x01 \x01(\v2\x18.family.v1.FamilySettingH\x00R\rfamilySetting\x88\x01\x01B\x11\n" +
-	"\x0f_family_setting\"\x85\x01\n" +
-	"\x1aUpdateFamilySettingRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12(\n" +
-	"\rsetting_value\x18\x02 \x01(\tH\x00R\fsettingValue\x88\x01\x01\x12\x1b\n" +
-	"\tdata_type\x18\x03 \x01(\tR\bdataTypeB\x10\n" +
-	"\x0e_setting_value\"^\n" +
-	"\x1bUpdateFamilySettingResponse\x12?\n" +
-	"\x0efamily_setting\x18\x01 \x01(\v2\x18.family.v1.FamilySettingR\rfamilySetting\",\n" +
-	"\x1aDeleteFamilySettingRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"7\n" +
-	"\x1bDeleteFamilySettingResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"y\n" +
-	"\fIncomeSource\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
-	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1b\n" +
-	"\tis_active\x18\x04 \x01(\bR\bisActive\"\x84\x01\n" +
-	"\rMonthlyIncome\x12!\n" +
-	"\ftotal_amount\x18\x01 \x01(\x01R\vtotalAmount\x121\n" +
-	"\asources\x18\x02 \x03(\v2\x17.family.v1.IncomeSourceR\asources\x12\x1d\n" +
-	"\n" +
-	"updated_at\x18\x03 \x01(\x03R\tupdatedAt\"\x19\n" +
-	"\x17GetMonthlyIncomeRequest\"[\n" +
-	"\x18GetMonthlyIncomeResponse\x12?\n" +
-	"\x0emonthly_income\x18\x01 \x01(\v2\x18.family.v1.MonthlyIncomeR\rmonthlyIncome\"Z\n" +
-	"\x17SetMonthlyIncomeRequest\x12?\n" +
-	"\x0emonthly_income\x18\x01 \x01(\v2\x18.family.v1.MonthlyIncomeR\rmonthlyIncome\"4\n" +
-	"\x18SetMonthlyIncomeResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"V\n" +
-	"\x16AddIncomeSourceRequest\x12<\n" +
-	"\rincome_source\x18\x01 \x01(\v2\x17.family.v1.IncomeSourceR\fincomeSource\"3\n" +
-	"\x17AddIncomeSourceResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"<\n" +
-	"\x19RemoveIncomeSourceRequest\x12\x1f\n" +
-	"\vsource_name\x18\x01 \x01(\tR\n" +
-	"sourceName\"6\n" +
-	"\x1aRemoveIncomeSourceResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"|\n" +
-	"\x19UpdateIncomeSourceRequest\x12\x1f\n" +
-	"\vsource_name\x18\x01 \x01(\tR\n" +
-	"sourceName\x12>\n" +
-	"\x0eupdated_source\x18\x02 \x01(\v2\x17.family.v1.IncomeSourceR\rupdatedSource\"6\n" +
-	"\x1aUpdateIncomeSourceResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess2\xf2\a\n" +
-	"\x15FamilySettingsService\x12d\n" +
-	"\x13CreateFamilySetting\x12%.family.v1.CreateFamilySettingRequest\x1a&.family.v1.CreateFamilySettingResponse\x12a\n" +
-	"\x12ListFamilySettings\x12$.family.v1.ListFamilySettingsRequest\x1a%.family.v1.ListFamilySettingsResponse\x12j\n" +
-	"\x15GetFamilySettingByKey\x12'.family.v1.GetFamilySettingByKeyRequest\x1a(.family.v1.GetFamilySettingByKeyResponse\x12d\n" +
-	"\x13UpdateFamilySetting\x12%.family.v1.UpdateFamilySettingRequest\x1a&.family.v1.UpdateFamilySettingResponse\x12d\n" +
-	"\x13DeleteFamilySetting\x12%.family.v1.DeleteFamilySettingRequest\x1a&.family.v1.DeleteFamilySettingResponse\x12[\n" +
-	"\x10GetMonthlyIncome\x12\".family.v1.GetMonthlyIncomeRequest\x1a#.family.v1.GetMonthlyIncomeResponse\x12[\n" +
-	"\x10SetMonthlyIncome\x12\".family.v1.SetMonthlyIncomeRequest\x1a#.family.v1.SetMonthlyIncomeResponse\x12X\n" +
-	"\x0fAddIncomeSource\x12!.family.v1.AddIncomeSourceRequest\x1a\".family.v1.AddIncomeSourceResponse\x12a\n" +
-	"\x12RemoveIncomeSource\x12$.family.v1.RemoveIncomeSourceRequest\x1a%.family.v1.RemoveIncomeSourceResponse\x12a\n" +
-	"\x12UpdateIncomeSource\x12$.family.v1.UpdateIncomeSourceRequest\x1a%.family.v1.UpdateIncomeSourceResponseB)Z'expenses-backend/pkg/family/v1;familyv1b\x06proto3"
+func (x *Payday) Reset() {
+	*x = Payday{}
+	mi := &file_family_v1_family_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
 
-var (
-	file_family_v1_family_proto_rawDescOnce sync.Once
-	file_family_v1_family_proto_rawDescData []byte
-)
+func (x *Payday) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-func file_family_v1_family_proto_rawDescGZIP() []byte {
-	file_family_v1_family_proto_rawDescOnce.Do(func() {
-		file_family_v1_family_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_family_v1_family_proto_rawDesc), len(file_family_v1_family_proto_rawDesc)))
-	})
-	return file_family_v1_family_proto_rawDescData
+func (*Payday) ProtoMessage() {}
+
+func (x *Payday) ProtoReflect() protoreflect.Message {
+	mi := &file_family_v1_family_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var file_family_v1_family_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
-var file_family_v1_family_proto_goTypes = []any{
-	(*FamilySetting)(nil),                 // 0: family.v1.FamilySetting
-	(*CreateFamilySettingRequest)(nil),    // 1: family.v1.CreateFamilySettingRequest
-	(*CreateFamilySettingResponse)(nil),   // 2: family.v1.CreateFamilySettingResponse
-	(*ListFamilySettingsRequest)(nil),     // 3: family.v1.ListFamilySettingsRequest
-	(*ListFamilySettingsResponse)(nil),    // 4: family.v1.ListFamilySettingsResponse
-	(*GetFamilySettingByKeyRequest)(nil),  // 5: family.v1.GetFamilySettingByKeyRequest
-	(*GetFamilySettingByKeyResponse)(nil), // 6: family.v1.GetFamilySettingByKeyResponse
-	(*UpdateFamilySettingRequest)(nil),    // 7: family.v1.UpdateFamilySettingRequest
-	(*UpdateFamilySettingResponse)(nil),   // 8: family.v1.UpdateFamilySettingResponse
-	(*DeleteFamilySettingRequest)(nil),    // 9: family.v1.DeleteFamilySettingRequest
-	(*DeleteFamilySettingResponse)(nil),   // 10: family.v1.DeleteFamilySettingResponse
-	(*IncomeSource)(nil),                  // 11: family.v1.IncomeSource
-	(*MonthlyIncome)(nil),                 // 12: family.v1.MonthlyIncome
-	(*GetMonthlyIncomeRequest)(nil),       // 13: family.v1.GetMonthlyIncomeRequest
-	(*GetMonthlyIncomeResponse)(nil),      // 14: family.v1.GetMonthlyIncomeResponse
-	(*SetMonthlyIncomeRequest)(nil),       // 15: family.v1.SetMonthlyIncomeRequest
-	(*SetMonthlyIncomeResponse)(nil),      // 16: family.v1.SetMonthlyIncomeResponse
-	(*AddIncomeSourceRequest)(nil),        // 17: family.v1.AddIncomeSourceRequest
-	(*AddIncomeSourceResponse)(nil),       // 18: family.v1.AddIncomeSourceResponse
-	(*RemoveIncomeSourceRequest)(nil),     // 19: family.v1.RemoveIncomeSourceRequest
-	(*RemoveIncomeSourceResponse)(nil),    // 20: family.v1.RemoveIncomeSourceResponse
-	(*UpdateIncomeSourceRequest)(nil),     // 21: family.v1.UpdateIncomeSourceRequest
-	(*UpdateIncomeSourceResponse)(nil),    // 22: family.v1.UpdateIncomeSourceResponse
+// Deprecated: Use Payday.ProtoReflect.Descriptor instead.
+func (*Payday) Descriptor() ([]byte, []int) {
+	return file_family_v1_family_proto_rawDescGZIP(), []int{23}
 }
-var file_family_v1_family_proto_depIdxs = []int32{
-	0,  // 0: family.v1.CreateFamilySettingResponse.family_setting:type_name -> family.v1.FamilySetting
-	0,  // 1: family.v1.ListFamilySettingsResponse.family_settings:type_name -> family.v1.FamilySetting
-	0,  // 2: family.v1.GetFamilySettingByKeyResponse.family_setting:type_name -> family.v1.FamilySetting
-	0,  // 3: family.v1.UpdateFamilySettingResponse.family_setting:type_name -> family.v1.FamilySetting
-	11, // 4: family.v1.MonthlyIncome.sources:type_name -> family.v1.IncomeSource
-	12, // 5: family.v1.GetMonthlyIncomeResponse.monthly_income:type_name -> family.v1.MonthlyIncome
-	12, // 6: family.v1.SetMonthlyIncomeRequest.monthly_income:type_name -> family.v1.MonthlyIncome
-	11, // 7: family.v1.AddIncomeSourceRequest.income_source:type_name -> family.v1.IncomeSource
-	11, // 8: family.v1.UpdateIncomeSourceRequest.updated_source:type_name -> family.v1.IncomeSource
-	1,  // 9: family.v1.FamilySettingsService.CreateFamilySetting:input_type -> family.v1.CreateFamilySettingRequest
-	3,  // 10: family.v1.FamilySettingsService.ListFamilySettings:input_type -> family.v1.ListFamilySettingsRequest
-	5,  // 11: family.v1.FamilySettingsService.GetFamilySettingByKey:input_type -> family.v1.GetFamilySettingByKeyRequest
-	7,  // 12: family.v1.FamilySettingsService.UpdateFamilySetting:input_type -> family.v1.UpdateFamilySettingRequest
-	9,  // 13: family.v1.FamilySettingsService.DeleteFamilySetting:input_type -> family.v1.DeleteFamilySettingRequest
-	13, // 14: family.v1.FamilySettingsService.GetMonthlyIncome:input_type -> family.v1.GetMonthlyIncomeRequest
-	15, // 15: family.v1.FamilySettingsService.SetMonthlyIncome:input_type -> family.v1.SetMonthlyIncomeRequest
-	17, // 16: family.v1.FamilySettingsService.AddIncomeSource:input_type -> family.v1.AddIncomeSourceRequest
-	19, // 17: family.v1.FamilySettingsService.RemoveIncomeSource:input_type -> family.v1.RemoveIncomeSourceRequest
-	21, // 18: family.v1.FamilySettingsService.UpdateIncomeSource:input_type -> family.v1.UpdateIncomeSourceRequest
-	2,  // 19: family.v1.FamilySettingsService.CreateFamilySetting:output_type -> family.v1.CreateFamilySettingResponse
-	4,  // 20: family.v1.FamilySettingsService.ListFamilySettings:output_type -> family.v1.ListFamilySettingsResponse
-	6,  // 21: family.v1.FamilySettingsService.GetFamilySettingByKey:output_type -> family.v1.GetFamilySettingByKeyResponse
-	8,  // 22: family.v1.FamilySettingsService.UpdateFamilySetting:output_type -> family.v1.UpdateFamilySettingResponse
-	10, // 23: family.v1.FamilySettingsService.DeleteFamilySetting:output_type -> family.v1.DeleteFamilySettingResponse
-	14, // 24: family.v1.FamilySettingsService.GetMonthlyIncome:output_type -> family.v1.GetMonthlyIncomeResponse
-	16, // 25: family.v1.FamilySettingsService.SetMonthlyIncome:output_type -> family.v1.SetMonthlyIncomeResponse
-	18, // 26: family.v1.FamilySettingsService.AddIncomeSource:output_type -> family.v1.AddIncomeSourceResponse
-	20, // 27: family.v1.FamilySettingsService.RemoveIncomeSource:output_type -> family.v1.RemoveIncomeSourceResponse
-	22, // 28: family.v1.FamilySettingsService.UpdateIncomeSource:output_type -> family.v1.UpdateIncomeSourceResponse
-	19, // [19:29] is the sub-list for method output_type
-	9,  // [9:19] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+
+func (x *Payday) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
 }
 
-func init() { file_family_v1_family_proto_init() }
-func file_family_v1_family_proto_init() {
-	if File_family_v1_family_proto != nil {
-		return
+func (x *Payday) GetScheduledDate() string {
+	if x != nil {
+		return x.ScheduledDate
 	}
-	file_family_v1_family_proto_msgTypes[0].OneofWrappers = []any{}
-	file_family_v1_family_proto_msgTypes[1].OneofWrappers = []any{}
-	file_family_v1_family_proto_msgTypes[6].OneofWrappers = []any{}
-	file_family_v1_family_proto_msgTypes[7].OneofWrappers = []any{}
+	return ""
+}
+
+func (x *Payday) GetIncomeSourceId() int64 {
+	if x != nil {
+		return x.IncomeSourceId
+	}
+	return 0
+}
+
+func (x *Payday) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Payday) GetGrossAmount() string {
+	if x != nil {
+		return x.GrossAmount
+	}
+	return ""
+}
+
+func (x *Payday) GetNetAmount() string {
+	if x != nil {
+		return x.NetAmount
+	}
+	return ""
+}
+
+func (x *Payday) GetMemberId() int64 {
+	if x != nil && x.MemberId != nil {
+		return *x.MemberId
+	}
+	return 0
+}
+
+type ListPaydaysRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	StartDate       string                 `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // YYYY-MM-DD
+	EndDate         string                 `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`       // YYYY-MM-DD, inclusive and at most a year after start_date
+	IncludeInactive bool                   `protobuf:"varint,3,opt,name=include_inactive,json=includeInactive,proto3" json:"include_inactive,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListPaydaysRequest) Reset() {
+	*x = ListPaydaysRequest{}
+	mi := &file_family_v1_family_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPaydaysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPaydaysRequest) ProtoMessage() {}
+
+func (x *ListPaydaysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_family_v1_family_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPaydaysRequest.ProtoReflect.Descriptor instead.
+func (*ListPaydaysRequest) Descriptor() ([]byte, []int) {
+	return file_family_v1_family_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListPaydaysRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *ListPaydaysRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *ListPaydaysRequest) GetIncludeInactive() bool {
+	if x != nil {
+		return x.IncludeInactive
+	}
+	return false
+}
+
+type ListPaydaysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Paydays       []*Payday              `protobuf:"bytes,1,rep,name=paydays,proto3" json:"paydays,omitempty"` // Ordered by date
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPaydaysResponse) Reset() {
+	*x = ListPaydaysResponse{}
+	mi := &file_family_v1_family_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPaydaysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPaydaysResponse) ProtoMessage() {}
+
+func (x *ListPaydaysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_family_v1_family_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPaydaysResponse.ProtoReflect.Descriptor instead.
+func (*ListPaydaysResponse) Descriptor() ([]byte, []int) {
+	return file_family_v1_family_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListPaydaysResponse) GetPaydays() []*Payday {
+	if x != nil {
+		return x.Paydays
+	}
+	return nil
+}
+
+type Holiday struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"` // YYYY-MM-DD
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Holiday) Reset() {
+	*x = Holiday{}
+	mi := &file_family_v1_family_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Holiday) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Holiday) ProtoMessage() {}
+
+func (x *Holiday) ProtoReflect() protoreflect.Message {
+	mi := &file_family_v1_family_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Holiday.ProtoReflect.Descriptor instead.
+func (*Holiday) Descriptor() ([]byte, []int) {
+	return file_family_v1_family_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *Holiday) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *Holiday) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type HolidayCalendar struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Presets       []HolidayPreset        `protobuf:"varint,1,rep,packed,name=presets,proto3,enum=family.v1.HolidayPreset" json:"presets,omitempty"`
+	Holidays      []*Holiday             `protobuf:"bytes,2,rep,name=holidays,proto3" json:"holidays,omitempty"` // Custom dates in addition to the presets
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HolidayCalendar) Reset() {
+	*x = HolidayCalendar{}
+	mi := &file_family_v1_family_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HolidayCalendar) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HolidayCalendar) ProtoMessage() {}
+
+func (x *HolidayCalendar) ProtoReflect() protoreflect.Message {
+	mi := &file_family_v1_family_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HolidayCalendar.ProtoReflect.Descriptor instead.
+func (*HolidayCalendar) Descriptor() ([]byte, []int) {
+	return file_family_v1_family_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *HolidayCalendar) GetPresets() []HolidayPreset {
+	if x != nil {
+		return x.Presets
+	}
+	return nil
+}
+
+func (x *HolidayCalendar) GetHolidays() []*Holiday {
+	if x != nil {
+		return x.Holidays
+	}
+	return nil
+}
+
+type GetHolidayCalendarRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Year to list observed holidays for, defaults to the current year
+	Year          *int32 `protobuf:"varint,1,opt,name=year,proto3,oneof" json:"year,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHolidayCalendarRequest) Reset() {
+	*x = GetHolidayCalendarRequest{}
+	mi := &file_family_v1_family_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHolidayCalendarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHolidayCalendarRequest) ProtoMessage() {}
+
+func (x *GetHolidayCalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_family_v1_family_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHolidayCalendarRequest.ProtoReflect.Descriptor instead.
+func (*GetHolidayCalendarRequest) Descriptor() ([]byte, []int) {
+	return file_family_v1_family_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetHolidayCalendarRequest) GetYear() int32 {
+	if x != nil && x.Year != nil {
+		return *x.Year
+	}
+	return 0
+}
+
+type GetHolidayCalendarResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Calendar         *HolidayCalendar       `protobuf:"bytes,1,opt,name=calendar,proto3" json:"calendar,omitempty"`
+	ObservedHolidays []*Holiday             `protobuf:"bytes,2,rep,name=observed_holidays,json=observedHolidays,proto3" json:"observed_holidays,omitempty"` // Presets and custom dates in the year
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetHolidayCalendarResponse) Reset() {
+	*x = GetHolidayCalendarResponse{}
+	mi := &file_family_v1_family_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHolidayCalendarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHolidayCalendarResponse) ProtoMessage() {}
+
+func (x *GetHolidayCalendarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_family_v1_family_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHolidayCalendarResponse.ProtoReflect.Descriptor instead.
+func (*GetHolidayCalendarResponse) Descriptor() ([]byte, []int) {
+	return file_family_v1_family_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetHolidayCalendarResponse) GetCalendar() *HolidayCalendar {
+	if x != nil {
+		return x.Calendar
+	}
+	return nil
+}
+
+func (x *GetHolidayCalendarResponse) GetObservedHolidays() []*Holiday {
+	if x != nil {
+		return x.ObservedHolidays
+	}
+	return nil
+}
+
+type SetHolidayCalendarRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Calendar      *HolidayCalendar       `protobuf:"bytes,1,opt,name=calendar,proto3" json:"calendar,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetHolidayCalendarRequest) Reset() {
+	*x = SetHolidayCalendarRequest{}
+	mi := &file_family_v1_family_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetHolidayCalendarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetHolidayCalendarRequest) ProtoMessage() {}
+
+func (x *SetHolidayCalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_family_v1_family_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetHolidayCalendarRequest.ProtoReflect.Descriptor instead.
+func (*SetHolidayCalendarRequest) Descriptor() ([]byte, []int) {
+	return file_family_v1_family_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SetHolidayCalendarRequest) GetCalendar() *HolidayCalendar {
+	if x != nil {
+		return x.Calendar
+	}
+	return nil
+}
+
+type SetHolidayCalendarResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetHolidayCalendarResponse) Reset() {
+	*x = SetHolidayCalendarResponse{}
+	mi := &file_family_v1_family_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetHolidayCalendarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetHolidayCalendarResponse) ProtoMessage() {}
+
+func (x *SetHolidayCalendarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_family_v1_family_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetHolidayCalendarResponse.ProtoReflect.Descriptor instead.
+func (*SetHolidayCalendarResponse) Descriptor() ([]byte, []int) {
+	return file_family_v1_family_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SetHolidayCalendarResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// The paid expense occurrence or bank transaction a shared cost comes from
+type SharedCostSource struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Source:
+	//
+	//	*SharedCostSource_PaymentId
+	//	*SharedCostSource_TransactionId
+	Source        isSharedCostSource_Source `protobuf_oneof:"source"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SharedCostSource) Reset() {
+	*x = SharedCostSource{}
+	mi := &file_family_v1_family_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SharedCostSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SharedCostSource) ProtoMessage() {}
+
+func (x *SharedCostSource) ProtoReflect() protoreflect.Message {
+	mi := &file_family_v1_family_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SharedCostSource.ProtoReflect.Descriptor instead.
+func (*SharedCostSource) Descriptor() ([]byte, []int) {
+	return file_family_v1_family_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SharedCostSource) GetSource() isSharedCostSource_Source {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *SharedCostSource) GetPaymentId() int64 {
+	if x != nil {
+		if x, ok := x.Source.(*SharedCostSource_PaymentId); ok {
+			return x.PaymentId
+		}
+	}
+	return 0
+}
+
+func (x *SharedCostSource) GetTransactionId() int64 {
+	if x != nil {
+		if x, ok := x.Source.(*SharedCostSource_TransactionId); ok {
+			return x.TransactionId
+		}
+	}
+	return 0
+}
+
+type isSharedCostSource_Source interface {
+	isSharedCostSource_Source()
+}
+
+type SharedCostSource_PaymentId struct {
+	PaymentId int64 `protobuf:"varint,1,opt,name=payment_id,json=paymentId,proto3,oneof"` // Expense payment; the occurrence must be paid
+}
+
+type SharedCostSource_TransactionId struct {
+	TransactionId int64 `protobuf:"varint,2,opt,name=transaction_id,json=transactionId,proto3,oneof"` // The cost is the amount leaving the account
+}
+
+func (*SharedCostSource_PaymentId) isSharedCostSource_Source() {}
+
+func (*SharedCostSource_TransactionId) isSharedCostSource_Source() {}
+
+type MemberShare struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	MemberId int64                  `protobuf:"varint,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	// Percent such as "33.33" for percentage splits, exact decimal amount for
+	// fixed splits; ignored for equal splits
+	Value         string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Owed          string `protobuf:"bytes,3,opt,name=owed,proto3" json:"owed,omitempty"` // Output only, this member's part of the cost
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemberShare) Reset() {
+	*x = MemberShare{}
+	mi := &file_family_v1_family_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemberShare) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberShare) ProtoMessage() {}
+
+func (x *MemberShare) ProtoReflect() protoreflect.Message {
+	mi := &file_family_v1_family_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberShare.ProtoReflect.Descriptor instead.
+func (*MemberShare) Descriptor() ([]byte, []int) {
+	return file_family_v1_family_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *MemberShare) GetMemberId() int64 {
+	if x != nil {
+		return x.MemberId
+	}
+	return 0
+}
+
+func (x *MemberShare) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *MemberShare) GetOwed() string {
+	if x != nil {
+		return x.Owed
+	}
+	return ""
+}
+
+// A cost one member paid that is shared with others. Cents that don't divide
+// evenly go to the earlier shares.
+type SharedCost struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Source        *SharedCostSource      `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	PaidBy        int64                  `protobuf:"varint,2,opt,name=paid_by,json=paidBy,proto3" json:"paid_by,omitempty"` // Family member ID
+	Method        SplitMethod            `protobuf:"varint,3,opt,name=method,proto3,enum=family.v1.SplitMethod" json:"method,omitempty"`
+	Shares        []*MemberShare         `protobuf:"bytes,4,rep,name=shares,proto3" json:"shares,omitempty"`
+	Amount        string                 `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"` // Output only, read from the source
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SharedCost) Reset() {
+	*x = SharedCost{}
+	mi := &file_family_v1_family_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SharedCost) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SharedCost) ProtoMessage() {}
+
+func (x *SharedCost) ProtoReflect() protoreflect.Message {
+	mi := &file_family_v1_family_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SharedCost.ProtoReflect.Descriptor instead.
+func (*SharedCost) Descriptor() ([]byte, []int) {
+	return file_family_v1_family_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SharedCost) GetSource() *SharedCostSource {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *SharedCost) GetPaidBy() int64 {
+	if x != nil {
+		return x.PaidBy
+	}
+	return 0
+}
+
+func (x *SharedCost) GetMethod() SplitMethod {
+	if x != nil {
+		return x.Method
+	}
+	return SplitMethod_SPLIT_METHOD_UNSPECIFIED
+}
+
+func (x *SharedCost) GetShares() []*MemberShare {
+	if x != nil {
+		return x.Shares
+	}
+	return nil
+}
+
+func (x *SharedCost) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type SetSharedCostRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Source *SharedCostSource      `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// Defaults to whoever marked the expense paid; required for transactions
+	PaidBy        *int64         `protobuf:"varint,2,opt,name=paid_by,json=paidBy,proto3,oneof" json:"paid_by,omitempty"`
+	Method        SplitMethod    `protobuf:"varint,3,opt,name=method,proto3,enum=family.v1.SplitMethod" json:"method,omitempty"`
+	Shares        []*MemberShare `protobuf:"bytes,4,rep,name=shares,proto3" json:"shares,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSharedCostRequest) Reset() {
+	*x = SetSharedCostRequest{}
+	mi := &file_family_v1_family_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSharedCostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSharedCostRequest) ProtoMessage() {}
+
+func (x *SetSharedCostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_family_v1_family_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSharedCostRequest.ProtoReflect.Descriptor instead.
+func (*SetSharedCostRequest) Descriptor() ([]byte, []int) {
+	return file_family_v1_family_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SetSharedCostRequest) GetSource() *SharedCostSource {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *SetSharedCostRequest) GetPaidBy() int64 {
+	if x != nil && x.PaidBy != nil {
+		return *x.PaidBy
+	}
+	return 0
+}
+
+func (x *SetSharedCostRequest) GetMethod() SplitMethod {
+	if x != nil {
+		return x.Method
+	}
+	return SplitMethod_SPLIT_METHOD_UNSPECIFIED
+}
+
+func (x *SetSharedCostRequest) GetShares() []*MemberShare {
+	if x != nil {
+		return x.Shares
+	}
+	return nil
+}
+
+type SetSharedCostResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SharedCost    *SharedCost            `protobuf:"bytes,1,opt,name=shared_cost,json=sharedCost,proto3" json:"shared_cost,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSharedCostResponse) Reset() {
+	*x = SetSharedCostResponse{}
+	mi := &file_family_v1_family_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSharedCostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSharedCostResponse) ProtoMessage() {}
+
+func (x *SetSharedCostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_family_v1_family_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSharedCostResponse.ProtoReflect.Descriptor instead.
+func (*SetSharedCostResponse) Descriptor() ([]byte, []int) {
+	return file_family_v1_family_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SetSharedCostResponse) GetSharedCost() *SharedCost {
+	if x != nil {
+		return x.SharedCost
+	}
+	return nil
+}
+
+type GetSharedCostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Source        *SharedCostSource      `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSharedCostRequest) Reset() {
+	*x = GetSharedCostRequest{}
+	mi := &file_family_v1_family_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSharedCostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSharedCostRequest) ProtoMessage() {}
+
+func (x *GetSharedCostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_family_v1_family_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSharedCostRequest.ProtoReflect.Descriptor instead.
+func (*GetSharedCostRequest) Descriptor() ([]byte, []int) {
+	return file_family_v1_family_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetSharedCostRequest) GetSource() *SharedCostSource {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+type GetSharedCostResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SharedCost    *SharedCost            `protobuf:"bytes,1,opt,name=shared_cost,json=sharedCost,proto3" json:"shared_cost,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSharedCostResponse) Reset() {
+	*x = GetSharedCostResponse{}
+	mi := &file_family_v1_family_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSharedCostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSharedCostResponse) ProtoMessage() {}
+
+func (x *GetSharedCostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_family_v1_family_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSharedCostResponse.ProtoReflect.Descriptor instead.
+func (*GetSharedCostResponse) Descriptor() ([]byte, []int) {
+	return file_family_v1_family_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetSharedCostResponse) GetSharedCost() *SharedCost {
+	if x != nil {
+		return x.SharedCost
+	}
+	return nil
+}
+
+type DeleteSharedCostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Source        *SharedCostSource      `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSharedCostRequest) Reset() {
+	*x = DeleteSharedCostRequest{}
+	mi := &file_family_v1_family_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSharedCostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSharedCostRequest) ProtoMessage() {}
+
+func (x *DeleteSharedCostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_family_v1_family_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSharedCostRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSharedCostRequest) Descriptor() ([]byte, []int) {
+	return file_family_v1_family_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *DeleteSharedCostRequest) GetSource() *SharedCostSource {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+type DeleteSharedCostResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSharedCostResponse) Reset() {
+	*x = DeleteSharedCostResponse{}
+	mi := &file_family_v1_family_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSharedCostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSharedCostResponse) ProtoMessage() {}
+
+func (x *DeleteSharedCostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_family_v1_family_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSharedCostResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSharedCostResponse) Descriptor() ([]byte, []int) {
+	return file_family_v1_family_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *DeleteSharedCostResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type MemberBalance struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MemberId      int64                  `protobuf:"varint,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Balance       string                 `protobuf:"bytes,3,opt,name=balance,proto3" json:"balance,omitempty"` // Positive when the member is owed money, negative when they owe
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemberBalance) Reset() {
+	*x = MemberBalance{}
+	mi := &file_family_v1_family_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemberBalance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberBalance) ProtoMessage() {}
+
+func (x *MemberBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_family_v1_family_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberBalance.ProtoReflect.Descriptor instead.
+func (*MemberBalance) Descriptor() ([]byte, []int) {
+	return file_family_v1_family_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *MemberBalance) GetMemberId() int64 {
+	if x != nil {
+		return x.MemberId
+	}
+	return 0
+}
+
+func (x *MemberBalance) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MemberBalance) GetBalance() string {
+	if x != nil {
+		return x.Balance
+	}
+	return ""
+}
+
+type Reimbursement struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromMemberId  int64                  `protobuf:"varint,1,opt,name=from_member_id,json=fromMemberId,proto3" json:"from_member_id,omitempty"`
+	ToMemberId    int64                  `protobuf:"varint,2,opt,name=to_member_id,json=toMemberId,proto3" json:"to_member_id,omitempty"`
+	Amount        string                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"` // Exact decimal, positive
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Reimbursement) Reset() {
+	*x = Reimbursement{}
+	mi := &file_family_v1_family_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reimbursement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reimbursement) ProtoMessage() {}
+
+func (x *Reimbursement) ProtoReflect() protoreflect.Message {
+	mi := &file_family_v1_family_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reimbursement.ProtoReflect.Descriptor instead.
+func (*Reimbursement) Descriptor() ([]byte, []int) {
+	return file_family_v1_family_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *Reimbursement) GetFromMemberId() int64 {
+	if x != nil {
+		return x.FromMemberId
+	}
+	return 0
+}
+
+func (x *Reimbursement) GetToMemberId() int64 {
+	if x != nil {
+		return x.ToMemberId
+	}
+	return 0
+}
+
+func (x *Reimbursement) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type GetBalancesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBalancesRequest) Reset() {
+	*x = GetBalancesRequest{}
+	mi := &file_family_v1_family_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBalancesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBalancesRequest) ProtoMessage() {}
+
+func (x *GetBalancesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_family_v1_family_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBalancesRequest.ProtoReflect.Descriptor instead.
+func (*GetBalancesRequest) Descriptor() ([]byte, []int) {
+	return file_family_v1_family_proto_rawDescGZIP(), []int{43}
+}
+
+type GetBalancesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Balances      []*MemberBalance       `protobuf:"bytes,1,rep,name=balances,proto3" json:"balances,omitempty"`                 // Members with a balance, and active members
+	SettleUp      []*Reimbursement       `protobuf:"bytes,2,rep,name=settle_up,json=settleUp,proto3" json:"settle_up,omitempty"` // Fewest payments that settle every balance
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBalancesResponse) Reset() {
+	*x = GetBalancesResponse{}
+	mi := &file_family_v1_family_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBalancesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBalancesResponse) ProtoMessage() {}
+
+func (x *GetBalancesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_family_v1_family_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBalancesResponse.ProtoReflect.Descriptor instead.
+func (*GetBalancesResponse) Descriptor() ([]byte, []int) {
+	return file_family_v1_family_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetBalancesResponse) GetBalances() []*MemberBalance {
+	if x != nil {
+		return x.Balances
+	}
+	return nil
+}
+
+func (x *GetBalancesResponse) GetSettleUp() []*Reimbursement {
+	if x != nil {
+		return x.SettleUp
+	}
+	return nil
+}
+
+// Records reimbursements between members. Without any, records the fewest
+// payments that settle every balance, as GetBalances suggests.
+type SettleUpRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Reimbursements []*Reimbursement       `protobuf:"bytes,1,rep,name=reimbursements,proto3" json:"reimbursements,omitempty"`
+	Note           *string                `protobuf:"bytes,2,opt,name=note,proto3,oneof" json:"note,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SettleUpRequest) Reset() {
+	*x = SettleUpRequest{}
+	mi := &file_family_v1_family_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SettleUpRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SettleUpRequest) ProtoMessage() {}
+
+func (x *SettleUpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_family_v1_family_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SettleUpRequest.ProtoReflect.Descriptor instead.
+func (*SettleUpRequest) Descriptor() ([]byte, []int) {
+	return file_family_v1_family_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SettleUpRequest) GetReimbursements() []*Reimbursement {
+	if x != nil {
+		return x.Reimbursements
+	}
+	return nil
+}
+
+func (x *SettleUpRequest) GetNote() string {
+	if x != nil && x.Note != nil {
+		return *x.Note
+	}
+	return ""
+}
+
+type SettleUpResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Recorded      []*Reimbursement       `protobuf:"bytes,1,rep,name=recorded,proto3" json:"recorded,omitempty"`
+	Balances      []*MemberBalance       `protobuf:"bytes,2,rep,name=balances,proto3" json:"balances,omitempty"` // After the reimbursements
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SettleUpResponse) Reset() {
+	*x = SettleUpResponse{}
+	mi := &file_family_v1_family_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SettleUpResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SettleUpResponse) ProtoMessage() {}
+
+func (x *SettleUpResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_family_v1_family_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SettleUpResponse.ProtoReflect.Descriptor instead.
+func (*SettleUpResponse) Descriptor() ([]byte, []int) {
+	return file_family_v1_family_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *SettleUpResponse) GetRecorded() []*Reimbursement {
+	if x != nil {
+		return x.Recorded
+	}
+	return nil
+}
+
+func (x *SettleUpResponse) GetBalances() []*MemberBalance {
+	if x != nil {
+		return x.Balances
+	}
+	return nil
+}
+
+type ExportFamilyDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportFamilyDataRequest) Reset() {
+	*x = ExportFamilyDataRequest{}
+	mi := &file_family_v1_family_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportFamilyDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportFamilyDataRequest) ProtoMessage() {}
+
+func (x *ExportFamilyDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_family_v1_family_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportFamilyDataRequest.ProtoReflect.Descriptor instead.
+func (*ExportFamilyDataRequest) Descriptor() ([]byte, []int) {
+	return file_family_v1_family_proto_rawDescGZIP(), []int{47}
+}
+
+// The chunks of the stream, joined in order, are a JSON archive of the family's
+// data. Secret settings such as the SimpleFIN token are not included.
+type ExportFamilyDataResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Chunk         []byte                 `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportFamilyDataResponse) Reset() {
+	*x = ExportFamilyDataResponse{}
+	mi := &file_family_v1_family_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportFamilyDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportFamilyDataResponse) ProtoMessage() {}
+
+func (x *ExportFamilyDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_family_v1_family_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportFamilyDataResponse.ProtoReflect.Descriptor instead.
+func (*ExportFamilyDataResponse) Descriptor() ([]byte, []int) {
+	return file_family_v1_family_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ExportFamilyDataResponse) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+// Restores an archive from ExportFamilyData into this family, which must not
+// hold any expenses, income sources, accounts, rules, statement mappings or
+// settlements yet. Categories are matched to existing ones by name.
+type ImportFamilyDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Archive       []byte                 `protobuf:"bytes,1,opt,name=archive,proto3" json:"archive,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportFamilyDataRequest) Reset() {
+	*x = ImportFamilyDataRequest{}
+	mi := &file_family_v1_family_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportFamilyDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportFamilyDataRequest) ProtoMessage() {}
+
+func (x *ImportFamilyDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_family_v1_family_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportFamilyDataRequest.ProtoReflect.Descriptor instead.
+func (*ImportFamilyDataRequest) Descriptor() ([]byte, []int) {
+	return file_family_v1_family_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ImportFamilyDataRequest) GetArchive() []byte {
+	if x != nil {
+		return x.Archive
+	}
+	return nil
+}
+
+type ImportedTable struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Imported      int32                  `protobuf:"varint,2,opt,name=imported,proto3" json:"imported,omitempty"`
+	Skipped       int32                  `protobuf:"varint,3,opt,name=skipped,proto3" json:"skipped,omitempty"` // Rows involving a member who is not in this family, and secret settings
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportedTable) Reset() {
+	*x = ImportedTable{}
+	mi := &file_family_v1_family_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportedTable) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportedTable) ProtoMessage() {}
+
+func (x *ImportedTable) ProtoReflect() protoreflect.Message {
+	mi := &file_family_v1_family_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportedTable.ProtoReflect.Descriptor instead.
+func (*ImportedTable) Descriptor() ([]byte, []int) {
+	return file_family_v1_family_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ImportedTable) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ImportedTable) GetImported() int32 {
+	if x != nil {
+		return x.Imported
+	}
+	return 0
+}
+
+func (x *ImportedTable) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+type ImportFamilyDataResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Tables []*ImportedTable       `protobuf:"bytes,1,rep,name=tables,proto3" json:"tables,omitempty"`
+	// Emails of archived members who are not in this family. Their shared costs
+	// and settlements are skipped, and other rows naming them no longer do.
+	UnmatchedMembers []string `protobuf:"bytes,2,rep,name=unmatched_members,json=unmatchedMembers,proto3" json:"unmatched_members,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ImportFamilyDataResponse) Reset() {
+	*x = ImportFamilyDataResponse{}
+	mi := &file_family_v1_family_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportFamilyDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportFamilyDataResponse) ProtoMessage() {}
+
+func (x *ImportFamilyDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_family_v1_family_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportFamilyDataResponse.ProtoReflect.Descriptor instead.
+func (*ImportFamilyDataResponse) Descriptor() ([]byte, []int) {
+	return file_family_v1_family_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ImportFamilyDataResponse) GetTables() []*ImportedTable {
+	if x != nil {
+		return x.Tables
+	}
+	return nil
+}
+
+func (x *ImportFamilyDataResponse) GetUnmatchedMembers() []string {
+	if x != nil {
+		return x.UnmatchedMembers
+	}
+	return nil
+}
+
+var File_family_v1_family_proto protoreflect.FileDescriptor
+
+const file_family_v1_family_proto_rawDesc = "" +
+	"\n" +
+	"\x16family/v1/family.proto\x12\tfamily.v1\"\xb5\x01\n" +
+	"\rFamilySetting\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
+	"\vsetting_key\x18\x02 \x01(\tR\n" +
+	"settingKey\x12(\n" +
+	"\rsetting_value\x18\x03 \x01(\tH\x00R\fsettingValue\x88\x01\x01\x12\x1b\n" +
+	"\tdata_type\x18\x04 \x01(\tR\bdataType\x12\x1a\n" +
+	"\bredacted\x18\x05 \x01(\bR\bredactedB\x10\n" +
+	"\x0e_setting_value\"\x96\x01\n" +
+	"\x1aCreateFamilySettingRequest\x12\x1f\n" +
+	"\vsetting_key\x18\x01 \x01(\tR\n" +
+	"settingKey\x12(\n" +
+	"\rsetting_value\x18\x02 \x01(\tH\x00R\fsettingValue\x88\x01\x01\x12\x1b\n" +
+	"\tdata_type\x18\x03 \x01(\tR\bdataTypeB\x10\n" +
+	"\x0e_setting_value\"^\n" +
+	"\x1bCreateFamilySettingResponse\x12?\n" +
+	"\x0efamily_setting\x18\x01 \x01(\v2\x18.family.v1.FamilySettingR\rfamilySetting\"\x1b\n" +
+	"\x19ListFamilySettingsRequest\"_\n" +
+	"\x1aListFamilySettingsResponse\x12A\n" +
+	"\x0ffamily_settings\x18\x01 \x03(\v2\x18.family.v1.FamilySettingR\x0efamilySettings\"0\n" +
+	"\x1cGetFamilySettingByKeyRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"x\n" +
+	"\x1dGetFamilySettingByKeyResponse\x12D\n" +
+	"\x0efamily_setting\x18\x01 \x01(\v2\x18.family.v1.FamilySettingH\x00R\rfamilySetting\x88\x01\x01B\x11\n" +
+	"\x0f_family_setting\"\x85\x01\n" +
+	"\x1aUpdateFamilySettingRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12(\n" +
+	"\rsetting_value\x18\x02 \x01(\tH\x00R\fsettingValue\x88\x01\x01\x12\x1b\n" +
+	"\tdata_type\x18\x03 \x01(\tR\bdataTypeB\x10\n" +
+	"\x0e_setting_value\"^\n" +
+	"\x1bUpdateFamilySettingResponse\x12?\n" +
+	"\x0efamily_setting\x18\x01 \x01(\v2\x18.family.v1.FamilySettingR\rfamilySetting\",\n" +
+	"\x1aDeleteFamilySettingRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"7\n" +
+	"\x1bDeleteFamilySettingResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xa4\x03\n" +
+	"\fIncomeSource\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1b\n" +
+	"\tis_active\x18\x04 \x01(\bR\bisActive\x12<\n" +
+	"\rpay_frequency\x18\x05 \x01(\x0e2\x17.family.v1.PayFrequencyR\fpayFrequency\x12+\n" +
+	"\x0fpay_anchor_date\x18\x06 \x01(\tH\x00R\rpayAnchorDate\x88\x01\x01\x12\x0e\n" +
+	"\x02id\x18\a \x01(\x03R\x02id\x12&\n" +
+	"\fgross_amount\x18\b \x01(\tH\x01R\vgrossAmount\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"net_amount\x18\t \x01(\tH\x02R\tnetAmount\x88\x01\x01\x12 \n" +
+	"\tmember_id\x18\n" +
+	" \x01(\x03H\x03R\bmemberId\x88\x01\x01B\x12\n" +
+	"\x10_pay_anchor_dateB\x0f\n" +
+	"\r_gross_amountB\r\n" +
+	"\v_net_amountB\f\n" +
+	"\n" +
+	"_member_id\"\x84\x01\n" +
+	"\rMonthlyIncome\x12!\n" +
+	"\ftotal_amount\x18\x01 \x01(\x01R\vtotalAmount\x121\n" +
+	"\asources\x18\x02 \x03(\v2\x17.family.v1.IncomeSourceR\asources\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\x03R\tupdatedAt\"\x19\n" +
+	"\x17GetMonthlyIncomeRequest\"[\n" +
+	"\x18GetMonthlyIncomeResponse\x12?\n" +
+	"\x0emonthly_income\x18\x01 \x01(\v2\x18.family.v1.MonthlyIncomeR\rmonthlyIncome\"Z\n" +
+	"\x17SetMonthlyIncomeRequest\x12?\n" +
+	"\x0emonthly_income\x18\x01 \x01(\v2\x18.family.v1.MonthlyIncomeR\rmonthlyIncome\"4\n" +
+	"\x18SetMonthlyIncomeResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"V\n" +
+	"\x16AddIncomeSourceRequest\x12<\n" +
+	"\rincome_source\x18\x01 \x01(\v2\x17.family.v1.IncomeSourceR\fincomeSource\"3\n" +
+	"\x17AddIncomeSourceResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"<\n" +
+	"\x19RemoveIncomeSourceRequest\x12\x1f\n" +
+	"\vsource_name\x18\x01 \x01(\tR\n" +
+	"sourceName\"6\n" +
+	"\x1aRemoveIncomeSourceResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"|\n" +
+	"\x19UpdateIncomeSourceRequest\x12\x1f\n" +
+	"\vsource_name\x18\x01 \x01(\tR\n" +
+	"sourceName\x12>\n" +
+	"\x0eupdated_source\x18\x02 \x01(\v2\x17.family.v1.IncomeSourceR\rupdatedSource\"6\n" +
+	"\x1aUpdateIncomeSourceResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xf3\x01\n" +
+	"\x06Payday\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12%\n" +
+	"\x0escheduled_date\x18\x02 \x01(\tR\rscheduledDate\x12(\n" +
+	"\x10income_source_id\x18\x03 \x01(\x03R\x0eincomeSourceId\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12!\n" +
+	"\fgross_amount\x18\x05 \x01(\tR\vgrossAmount\x12\x1d\n" +
+	"\n" +
+	"net_amount\x18\x06 \x01(\tR\tnetAmount\x12 \n" +
+	"\tmember_id\x18\a \x01(\x03H\x00R\bmemberId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_member_id\"y\n" +
+	"\x12ListPaydaysRequest\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x02 \x01(\tR\aendDate\x12)\n" +
+	"\x10include_inactive\x18\x03 \x01(\bR\x0fincludeInactive\"B\n" +
+	"\x13ListPaydaysResponse\x12+\n" +
+	"\apaydays\x18\x01 \x03(\v2\x11.family.v1.PaydayR\apaydays\"1\n" +
+	"\aHoliday\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"u\n" +
+	"\x0fHolidayCalendar\x122\n" +
+	"\apresets\x18\x01 \x03(\x0e2\x18.family.v1.HolidayPresetR\apresets\x12.\n" +
+	"\bholidays\x18\x02 \x03(\v2\x12.family.v1.HolidayR\bholidays\"=\n" +
+	"\x19GetHolidayCalendarRequest\x12\x17\n" +
+	"\x04year\x18\x01 \x01(\x05H\x00R\x04year\x88\x01\x01B\a\n" +
+	"\x05_year\"\x95\x01\n" +
+	"\x1aGetHolidayCalendarResponse\x126\n" +
+	"\bcalendar\x18\x01 \x01(\v2\x1a.family.v1.HolidayCalendarR\bcalendar\x12?\n" +
+	"\x11observed_holidays\x18\x02 \x03(\v2\x12.family.v1.HolidayR\x10observedHolidays\"S\n" +
+	"\x19SetHolidayCalendarRequest\x126\n" +
+	"\bcalendar\x18\x01 \x01(\v2\x1a.family.v1.HolidayCalendarR\bcalendar\"6\n" +
+	"\x1aSetHolidayCalendarResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"f\n" +
+	"\x10SharedCostSource\x12\x1f\n" +
+	"\n" +
+	"payment_id\x18\x01 \x01(\x03H\x00R\tpaymentId\x12'\n" +
+	"\x0etransaction_id\x18\x02 \x01(\x03H\x00R\rtransactionIdB\b\n" +
+	"\x06source\"T\n" +
+	"\vMemberShare\x12\x1b\n" +
+	"\tmember_id\x18\x01 \x01(\x03R\bmemberId\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x12\x12\n" +
+	"\x04owed\x18\x03 \x01(\tR\x04owed\"\xd2\x01\n" +
+	"\n" +
+	"SharedCost\x123\n" +
+	"\x06source\x18\x01 \x01(\v2\x1b.family.v1.SharedCostSourceR\x06source\x12\x17\n" +
+	"\apaid_by\x18\x02 \x01(\x03R\x06paidBy\x12.\n" +
+	"\x06method\x18\x03 \x01(\x0e2\x16.family.v1.SplitMethodR\x06method\x12.\n" +
+	"\x06shares\x18\x04 \x03(\v2\x16.family.v1.MemberShareR\x06shares\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\tR\x06amount\"\xd5\x01\n" +
+	"\x14SetSharedCostRequest\x123\n" +
+	"\x06source\x18\x01 \x01(\v2\x1b.family.v1.SharedCostSourceR\x06source\x12\x1c\n" +
+	"\apaid_by\x18\x02 \x01(\x03H\x00R\x06paidBy\x88\x01\x01\x12.\n" +
+	"\x06method\x18\x03 \x01(\x0e2\x16.family.v1.SplitMethodR\x06method\x12.\n" +
+	"\x06shares\x18\x04 \x03(\v2\x16.family.v1.MemberShareR\x06sharesB\n" +
+	"\n" +
+	"\b_paid_by\"O\n" +
+	"\x15SetSharedCostResponse\x126\n" +
+	"\vshared_cost\x18\x01 \x01(\v2\x15.family.v1.SharedCostR\n" +
+	"sharedCost\"K\n" +
+	"\x14GetSharedCostRequest\x123\n" +
+	"\x06source\x18\x01 \x01(\v2\x1b.family.v1.SharedCostSourceR\x06source\"O\n" +
+	"\x15GetSharedCostResponse\x126\n" +
+	"\vshared_cost\x18\x01 \x01(\v2\x15.family.v1.SharedCostR\n" +
+	"sharedCost\"N\n" +
+	"\x17DeleteSharedCostRequest\x123\n" +
+	"\x06source\x18\x01 \x01(\v2\x1b.family.v1.SharedCostSourceR\x06source\"4\n" +
+	"\x18DeleteSharedCostResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"Z\n" +
+	"\rMemberBalance\x12\x1b\n" +
+	"\tmember_id\x18\x01 \x01(\x03R\bmemberId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\abalance\x18\x03 \x01(\tR\abalance\"o\n" +
+	"\rReimbursement\x12$\n" +
+	"\x0efrom_member_id\x18\x01 \x01(\x03R\ffromMemberId\x12 \n" +
+	"\fto_member_id\x18\x02 \x01(\x03R\n" +
+	"toMemberId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\tR\x06amount\"\x14\n" +
+	"\x12GetBalancesRequest\"\x82\x01\n" +
+	"\x13GetBalancesResponse\x124\n" +
+	"\bbalances\x18\x01 \x03(\v2\x18.family.v1.MemberBalanceR\bbalances\x125\n" +
+	"\tsettle_up\x18\x02 \x03(\v2\x18.family.v1.ReimbursementR\bsettleUp\"u\n" +
+	"\x0fSettleUpRequest\x12@\n" +
+	"\x0ereimbursements\x18\x01 \x03(\v2\x18.family.v1.ReimbursementR\x0ereimbursements\x12\x17\n" +
+	"\x04note\x18\x02 \x01(\tH\x00R\x04note\x88\x01\x01B\a\n" +
+	"\x05_note\"~\n" +
+	"\x10SettleUpResponse\x124\n" +
+	"\brecorded\x18\x01 \x03(\v2\x18.family.v1.ReimbursementR\brecorded\x124\n" +
+	"\bbalances\x18\x02 \x03(\v2\x18.family.v1.MemberBalanceR\bbalances\"\x19\n" +
+	"\x17ExportFamilyDataRequest\"0\n" +
+	"\x18ExportFamilyDataResponse\x12\x14\n" +
+	"\x05chunk\x18\x01 \x01(\fR\x05chunk\"3\n" +
+	"\x17ImportFamilyDataRequest\x12\x18\n" +
+	"\aarchive\x18\x01 \x01(\fR\aarchive\"Y\n" +
+	"\rImportedTable\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\bimported\x18\x02 \x01(\x05R\bimported\x12\x18\n" +
+	"\askipped\x18\x03 \x01(\x05R\askipped\"y\n" +
+	"\x18ImportFamilyDataResponse\x120\n" +
+	"\x06tables\x18\x01 \x03(\v2\x18.family.v1.ImportedTableR\x06tables\x12+\n" +
+	"\x11unmatched_members\x18\x02 \x03(\tR\x10unmatchedMembers*\xaf\x01\n" +
+	"\fPayFrequency\x12\x1d\n" +
+	"\x19PAY_FREQUENCY_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14PAY_FREQUENCY_WEEKLY\x10\x01\x12\x1a\n" +
+	"\x16PAY_FREQUENCY_BIWEEKLY\x10\x02\x12\x19\n" +
+	"\x15PAY_FREQUENCY_MONTHLY\x10\x03\x12\x1d\n" +
+	"\x19PAY_FREQUENCY_SEMIMONTHLY\x10\a\"\x04\b\x04\x10\x04\"\x04\b\x05\x10\x05\"\x04\b\x06\x10\x06*K\n" +
+	"\rHolidayPreset\x12\x1e\n" +
+	"\x1aHOLIDAY_PRESET_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16HOLIDAY_PRESET_US_BANK\x10\x01*x\n" +
+	"\vSplitMethod\x12\x1c\n" +
+	"\x18SPLIT_METHOD_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12SPLIT_METHOD_EQUAL\x10\x01\x12\x1b\n" +
+	"\x17SPLIT_METHOD_PERCENTAGE\x10\x02\x12\x16\n" +
+	"\x12SPLIT_METHOD_FIXED\x10\x032\xda\x0e\n" +
+	"\x15FamilySettingsService\x12d\n" +
+	"\x13CreateFamilySetting\x12%.family.v1.CreateFamilySettingRequest\x1a&.family.v1.CreateFamilySettingResponse\x12a\n" +
+	"\x12ListFamilySettings\x12$.family.v1.ListFamilySettingsRequest\x1a%.family.v1.ListFamilySettingsResponse\x12j\n" +
+	"\x15GetFamilySettingByKey\x12'.family.v1.GetFamilySettingByKeyRequest\x1a(.family.v1.GetFamilySettingByKeyResponse\x12d\n" +
+	"\x13UpdateFamilySetting\x12%.family.v1.UpdateFamilySettingRequest\x1a&.family.v1.UpdateFamilySettingResponse\x12d\n" +
+	"\x13DeleteFamilySetting\x12%.family.v1.DeleteFamilySettingRequest\x1a&.family.v1.DeleteFamilySettingResponse\x12[\n" +
+	"\x10GetMonthlyIncome\x12\".family.v1.GetMonthlyIncomeRequest\x1a#.family.v1.GetMonthlyIncomeResponse\x12[\n" +
+	"\x10SetMonthlyIncome\x12\".family.v1.SetMonthlyIncomeRequest\x1a#.family.v1.SetMonthlyIncomeResponse\x12X\n" +
+	"\x0fAddIncomeSource\x12!.family.v1.AddIncomeSourceRequest\x1a\".family.v1.AddIncomeSourceResponse\x12a\n" +
+	"\x12RemoveIncomeSource\x12$.family.v1.RemoveIncomeSourceRequest\x1a%.family.v1.RemoveIncomeSourceResponse\x12a\n" +
+	"\x12UpdateIncomeSource\x12$.family.v1.UpdateIncomeSourceRequest\x1a%.family.v1.UpdateIncomeSourceResponse\x12L\n" +
+	"\vListPaydays\x12\x1d.family.v1.ListPaydaysRequest\x1a\x1e.family.v1.ListPaydaysResponse\x12a\n" +
+	"\x12GetHolidayCalendar\x12$.family.v1.GetHolidayCalendarRequest\x1a%.family.v1.GetHolidayCalendarResponse\x12a\n" +
+	"\x12SetHolidayCalendar\x12$.family.v1.SetHolidayCalendarRequest\x1a%.family.v1.SetHolidayCalendarResponse\x12R\n" +
+	"\rSetSharedCost\x12\x1f.family.v1.SetSharedCostRequest\x1a .family.v1.SetSharedCostResponse\x12R\n" +
+	"\rGetSharedCost\x12\x1f.family.v1.GetSharedCostRequest\x1a .family.v1.GetSharedCostResponse\x12[\n" +
+	"\x10DeleteSharedCost\x12\".family.v1.DeleteSharedCostRequest\x1a#.family.v1.DeleteSharedCostResponse\x12L\n" +
+	"\vGetBalances\x12\x1d.family.v1.GetBalancesRequest\x1a\x1e.family.v1.GetBalancesResponse\x12C\n" +
+	"\bSettleUp\x12\x1a.family.v1.SettleUpRequest\x1a\x1b.family.v1.SettleUpResponse\x12]\n" +
+	"\x10ExportFamilyData\x12\".family.v1.ExportFamilyDataRequest\x1a#.family.v1.ExportFamilyDataResponse0\x01\x12[\n" +
+	"\x10ImportFamilyData\x12\".family.v1.ImportFamilyDataRequest\x1a#.family.v1.ImportFamilyDataResponseB)Z'expenses-backend/pkg/family/v1;familyv1b\x06proto3"
+
+var (
+	file_family_v1_family_proto_rawDescOnce sync.Once
+	file_family_v1_family_proto_rawDescData []byte
+)
+
+func file_family_v1_family_proto_rawDescGZIP() []byte {
+	file_family_v1_family_proto_rawDescOnce.Do(func() {
+		file_family_v1_family_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_family_v1_family_proto_rawDesc), len(file_family_v1_family_proto_rawDesc)))
+	})
+	return file_family_v1_family_proto_rawDescData
+}
+
+var file_family_v1_family_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_family_v1_family_proto_msgTypes = make([]protoimpl.MessageInfo, 52)
+var file_family_v1_family_proto_goTypes = []any{
+	(PayFrequency)(0),                     // 0: family.v1.PayFrequency
+	(HolidayPreset)(0),                    // 1: family.v1.HolidayPreset
+	(SplitMethod)(0),                      // 2: family.v1.SplitMethod
+	(*FamilySetting)(nil),                 // 3: family.v1.FamilySetting
+	(*CreateFamilySettingRequest)(nil),    // 4: family.v1.CreateFamilySettingRequest
+	(*CreateFamilySettingResponse)(nil),   // 5: family.v1.CreateFamilySettingResponse
+	(*ListFamilySettingsRequest)(nil),     // 6: family.v1.ListFamilySettingsRequest
+	(*ListFamilySettingsResponse)(nil),    // 7: family.v1.ListFamilySettingsResponse
+	(*GetFamilySettingByKeyRequest)(nil),  // 8: family.v1.GetFamilySettingByKeyRequest
+	(*GetFamilySettingByKeyResponse)(nil), // 9: family.v1.GetFamilySettingByKeyResponse
+	(*UpdateFamilySettingRequest)(nil),    // 10: family.v1.UpdateFamilySettingRequest
+	(*UpdateFamilySettingResponse)(nil),   // 11: family.v1.UpdateFamilySettingResponse
+	(*DeleteFamilySettingRequest)(nil),    // 12: family.v1.DeleteFamilySettingRequest
+	(*DeleteFamilySettingResponse)(nil),   // 13: family.v1.DeleteFamilySettingResponse
+	(*IncomeSource)(nil),                  // 14: family.v1.IncomeSource
+	(*MonthlyIncome)(nil),                 // 15: family.v1.MonthlyIncome
+	(*GetMonthlyIncomeRequest)(nil),       // 16: family.v1.GetMonthlyIncomeRequest
+	(*GetMonthlyIncomeResponse)(nil),      // 17: family.v1.GetMonthlyIncomeResponse
+	(*SetMonthlyIncomeRequest)(nil),       // 18: family.v1.SetMonthlyIncomeRequest
+	(*SetMonthlyIncomeResponse)(nil),      // 19: family.v1.SetMonthlyIncomeResponse
+	(*AddIncomeSourceRequest)(nil),        // 20: family.v1.AddIncomeSourceRequest
+	(*AddIncomeSourceResponse)(nil),       // 21: family.v1.AddIncomeSourceResponse
+	(*RemoveIncomeSourceRequest)(nil),     // 22: family.v1.RemoveIncomeSourceRequest
+	(*RemoveIncomeSourceResponse)(nil),    // 23: family.v1.RemoveIncomeSourceResponse
+	(*UpdateIncomeSourceRequest)(nil),     // 24: family.v1.UpdateIncomeSourceRequest
+	(*UpdateIncomeSourceResponse)(nil),    // 25: family.v1.UpdateIncomeSourceResponse
+	(*Payday)(nil),                        // 26: family.v1.Payday
+	(*ListPaydaysRequest)(nil),            // 27: family.v1.ListPaydaysRequest
+	(*ListPaydaysResponse)(nil),           // 28: family.v1.ListPaydaysResponse
+	(*Holiday)(nil),                       // 29: family.v1.Holiday
+	(*HolidayCalendar)(nil),               // 30: family.v1.HolidayCalendar
+	(*GetHolidayCalendarRequest)(nil),     // 31: family.v1.GetHolidayCalendarRequest
+	(*GetHolidayCalendarResponse)(nil),    // 32: family.v1.GetHolidayCalendarResponse
+	(*SetHolidayCalendarRequest)(nil),     // 33: family.v1.SetHolidayCalendarRequest
+	(*SetHolidayCalendarResponse)(nil),    // 34: family.v1.SetHolidayCalendarResponse
+	(*SharedCostSource)(nil),              // 35: family.v1.SharedCostSource
+	(*MemberShare)(nil),                   // 36: family.v1.MemberShare
+	(*SharedCost)(nil),                    // 37: family.v1.SharedCost
+	(*SetSharedCostRequest)(nil),          // 38: family.v1.SetSharedCostRequest
+	(*SetSharedCostResponse)(nil),         // 39: family.v1.SetSharedCostResponse
+	(*GetSharedCostRequest)(nil),          // 40: family.v1.GetSharedCostRequest
+	(*GetSharedCostResponse)(nil),         // 41: family.v1.GetSharedCostResponse
+	(*DeleteSharedCostRequest)(nil),       // 42: family.v1.DeleteSharedCostRequest
+	(*DeleteSharedCostResponse)(nil),      // 43: family.v1.DeleteSharedCostResponse
+	(*MemberBalance)(nil),                 // 44: family.v1.MemberBalance
+	(*Reimbursement)(nil),                 // 45: family.v1.Reimbursement
+	(*GetBalancesRequest)(nil),            // 46: family.v1.GetBalancesRequest
+	(*GetBalancesResponse)(nil),           // 47: family.v1.GetBalancesResponse
+	(*SettleUpRequest)(nil),               // 48: family.v1.SettleUpRequest
+	(*SettleUpResponse)(nil),              // 49: family.v1.SettleUpResponse
+	(*ExportFamilyDataRequest)(nil),       // 50: family.v1.ExportFamilyDataRequest
+	(*ExportFamilyDataResponse)(nil),      // 51: family.v1.ExportFamilyDataResponse
+	(*ImportFamilyDataRequest)(nil),       // 52: family.v1.ImportFamilyDataRequest
+	(*ImportedTable)(nil),                 // 53: family.v1.ImportedTable
+	(*ImportFamilyDataResponse)(nil),      // 54: family.v1.ImportFamilyDataResponse
+}
+var file_family_v1_family_proto_depIdxs = []int32{
+	3,  // 0: family.v1.CreateFamilySettingResponse.family_setting:type_name -> family.v1.FamilySetting
+	3,  // 1: family.v1.ListFamilySettingsResponse.family_settings:type_name -> family.v1.FamilySetting
+	3,  // 2: family.v1.GetFamilySettingByKeyResponse.family_setting:type_name -> family.v1.FamilySetting
+	3,  // 3: family.v1.UpdateFamilySettingResponse.family_setting:type_name -> family.v1.FamilySetting
+	0,  // 4: family.v1.IncomeSource.pay_frequency:type_name -> family.v1.PayFrequency
+	14, // 5: family.v1.MonthlyIncome.sources:type_name -> family.v1.IncomeSource
+	15, // 6: family.v1.GetMonthlyIncomeResponse.monthly_income:type_name -> family.v1.MonthlyIncome
+	15, // 7: family.v1.SetMonthlyIncomeRequest.monthly_income:type_name -> family.v1.MonthlyIncome
+	14, // 8: family.v1.AddIncomeSourceRequest.income_source:type_name -> family.v1.IncomeSource
+	14, // 9: family.v1.UpdateIncomeSourceRequest.updated_source:type_name -> family.v1.IncomeSource
+	26, // 10: family.v1.ListPaydaysResponse.paydays:type_name -> family.v1.Payday
+	1,  // 11: family.v1.HolidayCalendar.presets:type_name -> family.v1.HolidayPreset
+	29, // 12: family.v1.HolidayCalendar.holidays:type_name -> family.v1.Holiday
+	30, // 13: family.v1.GetHolidayCalendarResponse.calendar:type_name -> family.v1.HolidayCalendar
+	29, // 14: family.v1.GetHolidayCalendarResponse.observed_holidays:type_name -> family.v1.Holiday
+	30, // 15: family.v1.SetHolidayCalendarRequest.calendar:type_name -> family.v1.HolidayCalendar
+	35, // 16: family.v1.SharedCost.source:type_name -> family.v1.SharedCostSource
+	2,  // 17: family.v1.SharedCost.method:type_name -> family.v1.SplitMethod
+	36, // 18: family.v1.SharedCost.shares:type_name -> family.v1.MemberShare
+	35, // 19: family.v1.SetSharedCostRequest.source:type_name -> family.v1.SharedCostSource
+	2,  // 20: family.v1.SetSharedCostRequest.method:type_name -> family.v1.SplitMethod
+	36, // 21: family.v1.SetSharedCostRequest.shares:type_name -> family.v1.MemberShare
+	37, // 22: family.v1.SetSharedCostResponse.shared_cost:type_name -> family.v1.SharedCost
+	35, // 23: family.v1.GetSharedCostRequest.source:type_name -> family.v1.SharedCostSource
+	37, // 24: family.v1.GetSharedCostResponse.shared_cost:type_name -> family.v1.SharedCost
+	35, // 25: family.v1.DeleteSharedCostRequest.source:type_name -> family.v1.SharedCostSource
+	44, // 26: family.v1.GetBalancesResponse.balances:type_name -> family.v1.MemberBalance
+	45, // 27: family.v1.GetBalancesResponse.settle_up:type_name -> family.v1.Reimbursement
+	45, // 28: family.v1.SettleUpRequest.reimbursements:type_name -> family.v1.Reimbursement
+	45, // 29: family.v1.SettleUpResponse.recorded:type_name -> family.v1.Reimbursement
+	44, // 30: family.v1.SettleUpResponse.balances:type_name -> family.v1.MemberBalance
+	53, // 31: family.v1.ImportFamilyDataResponse.tables:type_name -> family.v1.ImportedTable
+	4,  // 32: family.v1.FamilySettingsService.CreateFamilySetting:input_type -> family.v1.CreateFamilySettingRequest
+	6,  // 33: family.v1.FamilySettingsService.ListFamilySettings:input_type -> family.v1.ListFamilySettingsRequest
+	8,  // 34: family.v1.FamilySettingsService.GetFamilySettingByKey:input_type -> family.v1.GetFamilySettingByKeyRequest
+	10, // 35: family.v1.FamilySettingsService.UpdateFamilySetting:input_type -> family.v1.UpdateFamilySettingRequest
+	12, // 36: family.v1.FamilySettingsService.DeleteFamilySetting:input_type -> family.v1.DeleteFamilySettingRequest
+	16, // 37: family.v1.FamilySettingsService.GetMonthlyIncome:input_type -> family.v1.GetMonthlyIncomeRequest
+	18, // 38: family.v1.FamilySettingsService.SetMonthlyIncome:input_type -> family.v1.SetMonthlyIncomeRequest
+	20, // 39: family.v1.FamilySettingsService.AddIncomeSource:input_type -> family.v1.AddIncomeSourceRequest
+	22, // 40: family.v1.FamilySettingsService.RemoveIncomeSource:input_type -> family.v1.RemoveIncomeSourceRequest
+	24, // 41: family.v1.FamilySettingsService.UpdateIncomeSource:input_type -> family.v1.UpdateIncomeSourceRequest
+	27, // 42: family.v1.FamilySettingsService.ListPaydays:input_type -> family.v1.ListPaydaysRequest
+	31, // 43: family.v1.FamilySettingsService.GetHolidayCalendar:input_type -> family.v1.GetHolidayCalendarRequest
+	33, // 44: family.v1.FamilySettingsService.SetHolidayCalendar:input_type -> family.v1.SetHolidayCalendarRequest
+	38, // 45: family.v1.FamilySettingsService.SetSharedCost:input_type -> family.v1.SetSharedCostRequest
+	40, // 46: family.v1.FamilySettingsService.GetSharedCost:input_type -> family.v1.GetSharedCostRequest
+	42, // 47: family.v1.FamilySettingsService.DeleteSharedCost:input_type -> family.v1.DeleteSharedCostRequest
+	46, // 48: family.v1.FamilySettingsService.GetBalances:input_type -> family.v1.GetBalancesRequest
+	48, // 49: family.v1.FamilySettingsService.SettleUp:input_type -> family.v1.SettleUpRequest
+	50, // 50: family.v1.FamilySettingsService.ExportFamilyData:input_type -> family.v1.ExportFamilyDataRequest
+	52, // 51: family.v1.FamilySettingsService.ImportFamilyData:input_type -> family.v1.ImportFamilyDataRequest
+	5,  // 52: family.v1.FamilySettingsService.CreateFamilySetting:output_type -> family.v1.CreateFamilySettingResponse
+	7,  // 53: family.v1.FamilySettingsService.ListFamilySettings:output_type -> family.v1.ListFamilySettingsResponse
+	9,  // 54: family.v1.FamilySettingsService.GetFamilySettingByKey:output_type -> family.v1.GetFamilySettingByKeyResponse
+	11, // 55: family.v1.FamilySettingsService.UpdateFamilySetting:output_type -> family.v1.UpdateFamilySettingResponse
+	13, // 56: family.v1.FamilySettingsService.DeleteFamilySetting:output_type -> family.v1.DeleteFamilySettingResponse
+	17, // 57: family.v1.FamilySettingsService.GetMonthlyIncome:output_type -> family.v1.GetMonthlyIncomeResponse
+	19, // 58: family.v1.FamilySettingsService.SetMonthlyIncome:output_type -> family.v1.SetMonthlyIncomeResponse
+	21, // 59: family.v1.FamilySettingsService.AddIncomeSource:output_type -> family.v1.AddIncomeSourceResponse
+	23, // 60: family.v1.FamilySettingsService.RemoveIncomeSource:output_type -> family.v1.RemoveIncomeSourceResponse
+	25, // 61: family.v1.FamilySettingsService.UpdateIncomeSource:output_type -> family.v1.UpdateIncomeSourceResponse
+	28, // 62: family.v1.FamilySettingsService.ListPaydays:output_type -> family.v1.ListPaydaysResponse
+	32, // 63: family.v1.FamilySettingsService.GetHolidayCalendar:output_type -> family.v1.GetHolidayCalendarResponse
+	34, // 64: family.v1.FamilySettingsService.SetHolidayCalendar:output_type -> family.v1.SetHolidayCalendarResponse
+	39, // 65: family.v1.FamilySettingsService.SetSharedCost:output_type -> family.v1.SetSharedCostResponse
+	41, // 66: family.v1.FamilySettingsService.GetSharedCost:output_type -> family.v1.GetSharedCostResponse
+	43, // 67: family.v1.FamilySettingsService.DeleteSharedCost:output_type -> family.v1.DeleteSharedCostResponse
+	47, // 68: family.v1.FamilySettingsService.GetBalances:output_type -> family.v1.GetBalancesResponse
+	49, // 69: family.v1.FamilySettingsService.SettleUp:output_type -> family.v1.SettleUpResponse
+	51, // 70: family.v1.FamilySettingsService.ExportFamilyData:output_type -> family.v1.ExportFamilyDataResponse
+	54, // 71: family.v1.FamilySettingsService.ImportFamilyData:output_type -> family.v1.ImportFamilyDataResponse
+	52, // [52:72] is the sub-list for method output_type
+	32, // [32:52] is the sub-list for method input_type
+	32, // [32:32] is the sub-list for extension type_name
+	32, // [32:32] is the sub-list for extension extendee
+	0,  // [0:32] is the sub-list for field type_name
+}
+
+func init() { file_family_v1_family_proto_init() }
+func file_family_v1_family_proto_init() {
+	if File_family_v1_family_proto != nil {
+		return
+	}
+	file_family_v1_family_proto_msgTypes[0].OneofWrappers = []any{}
+	file_family_v1_family_proto_msgTypes[1].OneofWrappers = []any{}
+	file_family_v1_family_proto_msgTypes[6].OneofWrappers = []any{}
+	file_family_v1_family_proto_msgTypes[7].OneofWrappers = []any{}
+	file_family_v1_family_proto_msgTypes[11].OneofWrappers = []any{}
+	file_family_v1_family_proto_msgTypes[23].OneofWrappers = []any{}
+	file_family_v1_family_proto_msgTypes[28].OneofWrappers = []any{}
+	file_family_v1_family_proto_msgTypes[32].OneofWrappers = []any{
+		(*SharedCostSource_PaymentId)(nil),
+		(*SharedCostSource_TransactionId)(nil),
+	}
+	file_family_v1_family_proto_msgTypes[35].OneofWrappers = []any{}
+	file_family_v1_family_proto_msgTypes[45].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_family_v1_family_proto_rawDesc), len(file_family_v1_family_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   23,
+			NumEnums:      3,
+			NumMessages:   52,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_family_v1_family_proto_goTypes,
 		DependencyIndexes: file_family_v1_family_proto_depIdxs,
+		EnumInfos:         file_family_v1_family_proto_enumTypes,
 		MessageInfos:      file_family_v1_family_proto_msgTypes,
 	}.Build()
 	File_family_v1_family_proto = out.File
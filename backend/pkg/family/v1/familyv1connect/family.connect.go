@@ -63,6 +63,36 @@ const (
 	// FamilySettingsServiceUpdateIncomeSourceProcedure is the fully-qualified name of the
 	// FamilySettingsService's UpdateIncomeSource RPC.
 	FamilySettingsServiceUpdateIncomeSourceProcedure = "/family.v1.FamilySettingsService/UpdateIncomeSource"
+	// FamilySettingsServiceListPaydaysProcedure is the fully-qualified name of the
+	// FamilySettingsService's ListPaydays RPC.
+	FamilySettingsServiceListPaydaysProcedure = "/family.v1.FamilySettingsService/ListPaydays"
+	// FamilySettingsServiceGetHolidayCalendarProcedure is the fully-qualified name of the
+	// FamilySettingsService's GetHolidayCalendar RPC.
+	FamilySettingsServiceGetHolidayCalendarProcedure = "/family.v1.FamilySettingsService/GetHolidayCalendar"
+	// FamilySettingsServiceSetHolidayCalendarProcedure is the fully-qualified name of the
+	// FamilySettingsService's SetHolidayCalendar RPC.
+	FamilySettingsServiceSetHolidayCalendarProcedure = "/family.v1.FamilySettingsService/SetHolidayCalendar"
+	// FamilySettingsServiceSetSharedCostProcedure is the fully-qualified name of the
+	// FamilySettingsService's SetSharedCost RPC.
+	FamilySettingsServiceSetSharedCostProcedure = "/family.v1.FamilySettingsService/SetSharedCost"
+	// FamilySettingsServiceGetSharedCostProcedure is the fully-qualified name of the
+	// FamilySettingsService's GetSharedCost RPC.
+	FamilySettingsServiceGetSharedCostProcedure = "/family.v1.FamilySettingsService/GetSharedCost"
+	// FamilySettingsServiceDeleteSharedCostProcedure is the fully-qualified name of the
+	// FamilySettingsService's DeleteSharedCost RPC.
+	FamilySettingsServiceDeleteSharedCostProcedure = "/family.v1.FamilySettingsService/DeleteSharedCost"
+	// FamilySettingsServiceGetBalancesProcedure is the fully-qualified name of the
+	// FamilySettingsService's GetBalances RPC.
+	FamilySettingsServiceGetBalancesProcedure = "/family.v1.FamilySettingsService/GetBalances"
+	// FamilySettingsServiceSettleUpProcedure is the fully-qualified name of the FamilySettingsService's
+	// SettleUp RPC.
+	FamilySettingsServiceSettleUpProcedure = "/family.v1.FamilySettingsService/SettleUp"
+	// FamilySettingsServiceExportFamilyDataProcedure is the fully-qualified name of the
+	// FamilySettingsService's ExportFamilyData RPC.
+	FamilySettingsServiceExportFamilyDataProcedure = "/family.v1.FamilySettingsService/ExportFamilyData"
+	// FamilySettingsServiceImportFamilyDataProcedure is the fully-qualified name of the
+	// FamilySettingsService's ImportFamilyData RPC.
+	FamilySettingsServiceImportFamilyDataProcedure = "/family.v1.FamilySettingsService/ImportFamilyData"
 )
 
 // FamilySettingsServiceClient is a client for the family.v1.FamilySettingsService service.
@@ -78,6 +108,19 @@ type FamilySettingsServiceClient interface {
 	AddIncomeSource(context.Context, *connect.Request[v1.AddIncomeSourceRequest]) (*connect.Response[v1.AddIncomeSourceResponse], error)
 	RemoveIncomeSource(context.Context, *connect.Request[v1.RemoveIncomeSourceRequest]) (*connect.Response[v1.RemoveIncomeSourceResponse], error)
 	UpdateIncomeSource(context.Context, *connect.Request[v1.UpdateIncomeSourceRequest]) (*connect.Response[v1.UpdateIncomeSourceResponse], error)
+	ListPaydays(context.Context, *connect.Request[v1.ListPaydaysRequest]) (*connect.Response[v1.ListPaydaysResponse], error)
+	// Holiday calendar endpoints
+	GetHolidayCalendar(context.Context, *connect.Request[v1.GetHolidayCalendarRequest]) (*connect.Response[v1.GetHolidayCalendarResponse], error)
+	SetHolidayCalendar(context.Context, *connect.Request[v1.SetHolidayCalendarRequest]) (*connect.Response[v1.SetHolidayCalendarResponse], error)
+	// Settle-up endpoints
+	SetSharedCost(context.Context, *connect.Request[v1.SetSharedCostRequest]) (*connect.Response[v1.SetSharedCostResponse], error)
+	GetSharedCost(context.Context, *connect.Request[v1.GetSharedCostRequest]) (*connect.Response[v1.GetSharedCostResponse], error)
+	DeleteSharedCost(context.Context, *connect.Request[v1.DeleteSharedCostRequest]) (*connect.Response[v1.DeleteSharedCostResponse], error)
+	GetBalances(context.Context, *connect.Request[v1.GetBalancesRequest]) (*connect.Response[v1.GetBalancesResponse], error)
+	SettleUp(context.Context, *connect.Request[v1.SettleUpRequest]) (*connect.Response[v1.SettleUpResponse], error)
+	// Data export endpoints, for family managers
+	ExportFamilyData(context.Context, *connect.Request[v1.ExportFamilyDataRequest]) (*connect.ServerStreamForClient[v1.ExportFamilyDataResponse], error)
+	ImportFamilyData(context.Context, *connect.Request[v1.ImportFamilyDataRequest]) (*connect.Response[v1.ImportFamilyDataResponse], error)
 }
 
 // NewFamilySettingsServiceClient constructs a client for the family.v1.FamilySettingsService
@@ -151,6 +194,66 @@ func NewFamilySettingsServiceClient(httpClient connect.HTTPClient, baseURL strin
 			connect.WithSchema(familySettingsServiceMethods.ByName("UpdateIncomeSource")),
 			connect.WithClientOptions(opts...),
 		),
+		listPaydays: connect.NewClient[v1.ListPaydaysRequest, v1.ListPaydaysResponse](
+			httpClient,
+			baseURL+FamilySettingsServiceListPaydaysProcedure,
+			connect.WithSchema(familySettingsServiceMethods.ByName("ListPaydays")),
+			connect.WithClientOptions(opts...),
+		),
+		getHolidayCalendar: connect.NewClient[v1.GetHolidayCalendarRequest, v1.GetHolidayCalendarResponse](
+			httpClient,
+			baseURL+FamilySettingsServiceGetHolidayCalendarProcedure,
+			connect.WithSchema(familySettingsServiceMethods.ByName("GetHolidayCalendar")),
+			connect.WithClientOptions(opts...),
+		),
+		setHolidayCalendar: connect.NewClient[v1.SetHolidayCalendarRequest, v1.SetHolidayCalendarResponse](
+			httpClient,
+			baseURL+FamilySettingsServiceSetHolidayCalendarProcedure,
+			connect.WithSchema(familySettingsServiceMethods.ByName("SetHolidayCalendar")),
+			connect.WithClientOptions(opts...),
+		),
+		setSharedCost: connect.NewClient[v1.SetSharedCostRequest, v1.SetSharedCostResponse](
+			httpClient,
+			baseURL+FamilySettingsServiceSetSharedCostProcedure,
+			connect.WithSchema(familySettingsServiceMethods.ByName("SetSharedCost")),
+			connect.WithClientOptions(opts...),
+		),
+		getSharedCost: connect.NewClient[v1.GetSharedCostRequest, v1.GetSharedCostResponse](
+			httpClient,
+			baseURL+FamilySettingsServiceGetSharedCostProcedure,
+			connect.WithSchema(familySettingsServiceMethods.ByName("GetSharedCost")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteSharedCost: connect.NewClient[v1.DeleteSharedCostRequest, v1.DeleteSharedCostResponse](
+			httpClient,
+			baseURL+FamilySettingsServiceDeleteSharedCostProcedure,
+			connect.WithSchema(familySettingsServiceMethods.ByName("DeleteSharedCost")),
+			connect.WithClientOptions(opts...),
+		),
+		getBalances: connect.NewClient[v1.GetBalancesRequest, v1.GetBalancesResponse](
+			httpClient,
+			baseURL+FamilySettingsServiceGetBalancesProcedure,
+			connect.WithSchema(familySettingsServiceMethods.ByName("GetBalances")),
+			connect.WithClientOptions(opts...),
+		),
+		settleUp: connect.NewClient[v1.SettleUpRequest, v1.SettleUpResponse](
+			httpClient,
+			baseURL+FamilySettingsServiceSettleUpProcedure,
+			connect.WithSchema(familySettingsServiceMethods.ByName("SettleUp")),
+			connect.WithClientOptions(opts...),
+		),
+		exportFamilyData: connect.NewClient[v1.ExportFamilyDataRequest, v1.ExportFamilyDataResponse](
+			httpClient,
+			baseURL+FamilySettingsServiceExportFamilyDataProcedure,
+			connect.WithSchema(familySettingsServiceMethods.ByName("ExportFamilyData")),
+			connect.WithClientOptions(opts...),
+		),
+		importFamilyData: connect.NewClient[v1.ImportFamilyDataRequest, v1.ImportFamilyDataResponse](
+			httpClient,
+			baseURL+FamilySettingsServiceImportFamilyDataProcedure,
+			connect.WithSchema(familySettingsServiceMethods.ByName("ImportFamilyData")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -166,6 +269,16 @@ type familySettingsServiceClient struct {
 	addIncomeSource       *connect.Client[v1.AddIncomeSourceRequest, v1.AddIncomeSourceResponse]
 	removeIncomeSource    *connect.Client[v1.RemoveIncomeSourceRequest, v1.RemoveIncomeSourceResponse]
 	updateIncomeSource    *connect.Client[v1.UpdateIncomeSourceRequest, v1.UpdateIncomeSourceResponse]
+	listPaydays           *connect.Client[v1.ListPaydaysRequest, v1.ListPaydaysResponse]
+	getHolidayCalendar    *connect.Client[v1.GetHolidayCalendarRequest, v1.GetHolidayCalendarResponse]
+	setHolidayCalendar    *connect.Client[v1.SetHolidayCalendarRequest, v1.SetHolidayCalendarResponse]
+	setSharedCost         *connect.Client[v1.SetSharedCostRequest, v1.SetSharedCostResponse]
+	getSharedCost         *connect.Client[v1.GetSharedCostRequest, v1.GetSharedCostResponse]
+	deleteSharedCost      *connect.Client[v1.DeleteSharedCostRequest, v1.DeleteSharedCostResponse]
+	getBalances           *connect.Client[v1.GetBalancesRequest, v1.GetBalancesResponse]
+	settleUp              *connect.Client[v1.SettleUpRequest, v1.SettleUpResponse]
+	exportFamilyData      *connect.Client[v1.ExportFamilyDataRequest, v1.ExportFamilyDataResponse]
+	importFamilyData      *connect.Client[v1.ImportFamilyDataRequest, v1.ImportFamilyDataResponse]
 }
 
 // CreateFamilySetting calls family.v1.FamilySettingsService.CreateFamilySetting.
@@ -218,6 +331,56 @@ func (c *familySettingsServiceClient) UpdateIncomeSource(ctx context.Context, re
 	return c.updateIncomeSource.CallUnary(ctx, req)
 }
 
+// ListPaydays calls family.v1.FamilySettingsService.ListPaydays.
+func (c *familySettingsServiceClient) ListPaydays(ctx context.Context, req *connect.Request[v1.ListPaydaysRequest]) (*connect.Response[v1.ListPaydaysResponse], error) {
+	return c.listPaydays.CallUnary(ctx, req)
+}
+
+// GetHolidayCalendar calls family.v1.FamilySettingsService.GetHolidayCalendar.
+func (c *familySettingsServiceClient) GetHolidayCalendar(ctx context.Context, req *connect.Request[v1.GetHolidayCalendarRequest]) (*connect.Response[v1.GetHolidayCalendarResponse], error) {
+	return c.getHolidayCalendar.CallUnary(ctx, req)
+}
+
+// SetHolidayCalendar calls family.v1.FamilySettingsService.SetHolidayCalendar.
+func (c *familySettingsServiceClient) SetHolidayCalendar(ctx context.Context, req *connect.Request[v1.SetHolidayCalendarRequest]) (*connect.Response[v1.SetHolidayCalendarResponse], error) {
+	return c.setHolidayCalendar.CallUnary(ctx, req)
+}
+
+// SetSharedCost calls family.v1.FamilySettingsService.SetSharedCost.
+func (c *familySettingsServiceClient) SetSharedCost(ctx context.Context, req *connect.Request[v1.SetSharedCostRequest]) (*connect.Response[v1.SetSharedCostResponse], error) {
+	return c.setSharedCost.CallUnary(ctx, req)
+}
+
+// GetSharedCost calls family.v1.FamilySettingsService.GetSharedCost.
+func (c *familySettingsServiceClient) GetSharedCost(ctx context.Context, req *connect.Request[v1.GetSharedCostRequest]) (*connect.Response[v1.GetSharedCostResponse], error) {
+	return c.getSharedCost.CallUnary(ctx, req)
+}
+
+// DeleteSharedCost calls family.v1.FamilySettingsService.DeleteSharedCost.
+func (c *familySettingsServiceClient) DeleteSharedCost(ctx context.Context, req *connect.Request[v1.DeleteSharedCostRequest]) (*connect.Response[v1.DeleteSharedCostResponse], error) {
+	return c.deleteSharedCost.CallUnary(ctx, req)
+}
+
+// GetBalances calls family.v1.FamilySettingsService.GetBalances.
+func (c *familySettingsServiceClient) GetBalances(ctx context.Context, req *connect.Request[v1.GetBalancesRequest]) (*connect.Response[v1.GetBalancesResponse], error) {
+	return c.getBalances.CallUnary(ctx, req)
+}
+
+// SettleUp calls family.v1.FamilySettingsService.SettleUp.
+func (c *familySettingsServiceClient) SettleUp(ctx context.Context, req *connect.Request[v1.SettleUpRequest]) (*connect.Response[v1.SettleUpResponse], error) {
+	return c.settleUp.CallUnary(ctx, req)
+}
+
+// ExportFamilyData calls family.v1.FamilySettingsService.ExportFamilyData.
+func (c *familySettingsServiceClient) ExportFamilyData(ctx context.Context, req *connect.Request[v1.ExportFamilyDataRequest]) (*connect.ServerStreamForClient[v1.ExportFamilyDataResponse], error) {
+	return c.exportFamilyData.CallServerStream(ctx, req)
+}
+
+// ImportFamilyData calls family.v1.FamilySettingsService.ImportFamilyData.
+func (c *familySettingsServiceClient) ImportFamilyData(ctx context.Context, req *connect.Request[v1.ImportFamilyDataRequest]) (*connect.Response[v1.ImportFamilyDataResponse], error) {
+	return c.importFamilyData.CallUnary(ctx, req)
+}
+
 // FamilySettingsServiceHandler is an implementation of the family.v1.FamilySettingsService service.
 type FamilySettingsServiceHandler interface {
 	CreateFamilySetting(context.Context, *connect.Request[v1.CreateFamilySettingRequest]) (*connect.Response[v1.CreateFamilySettingResponse], error)
@@ -231,6 +394,19 @@ type FamilySettingsServiceHandler interface {
 	AddIncomeSource(context.Context, *connect.Request[v1.AddIncomeSourceRequest]) (*connect.Response[v1.AddIncomeSourceResponse], error)
 	RemoveIncomeSource(context.Context, *connect.Request[v1.RemoveIncomeSourceRequest]) (*connect.Response[v1.RemoveIncomeSourceResponse], error)
 	UpdateIncomeSource(context.Context, *connect.Request[v1.UpdateIncomeSourceRequest]) (*connect.Response[v1.UpdateIncomeSourceResponse], error)
+	ListPaydays(context.Context, *connect.Request[v1.ListPaydaysRequest]) (*connect.Response[v1.ListPaydaysResponse], error)
+	// Holiday calendar endpoints
+	GetHolidayCalendar(context.Context, *connect.Request[v1.GetHolidayCalendarRequest]) (*connect.Response[v1.GetHolidayCalendarResponse], error)
+	SetHolidayCalendar(context.Context, *connect.Request[v1.SetHolidayCalendarRequest]) (*connect.Response[v1.SetHolidayCalendarResponse], error)
+	// Settle-up endpoints
+	SetSharedCost(context.Context, *connect.Request[v1.SetSharedCostRequest]) (*connect.Response[v1.SetSharedCostResponse], error)
+	GetSharedCost(context.Context, *connect.Request[v1.GetSharedCostRequest]) (*connect.Response[v1.GetSharedCostResponse], error)
+	DeleteSharedCost(context.Context, *connect.Request[v1.DeleteSharedCostRequest]) (*connect.Response[v1.DeleteSharedCostResponse], error)
+	GetBalances(context.Context, *connect.Request[v1.GetBalancesRequest]) (*connect.Response[v1.GetBalancesResponse], error)
+	SettleUp(context.Context, *connect.Request[v1.SettleUpRequest]) (*connect.Response[v1.SettleUpResponse], error)
+	// Data export endpoints, for family managers
+	ExportFamilyData(context.Context, *connect.Request[v1.ExportFamilyDataRequest], *connect.ServerStream[v1.ExportFamilyDataResponse]) error
+	ImportFamilyData(context.Context, *connect.Request[v1.ImportFamilyDataRequest]) (*connect.Response[v1.ImportFamilyDataResponse], error)
 }
 
 // NewFamilySettingsServiceHandler builds an HTTP handler from the service implementation. It
@@ -300,6 +476,66 @@ func NewFamilySettingsServiceHandler(svc FamilySettingsServiceHandler, opts ...c
 		connect.WithSchema(familySettingsServiceMethods.ByName("UpdateIncomeSource")),
 		connect.WithHandlerOptions(opts...),
 	)
+	familySettingsServiceListPaydaysHandler := connect.NewUnaryHandler(
+		FamilySettingsServiceListPaydaysProcedure,
+		svc.ListPaydays,
+		connect.WithSchema(familySettingsServiceMethods.ByName("ListPaydays")),
+		connect.WithHandlerOptions(opts...),
+	)
+	familySettingsServiceGetHolidayCalendarHandler := connect.NewUnaryHandler(
+		FamilySettingsServiceGetHolidayCalendarProcedure,
+		svc.GetHolidayCalendar,
+		connect.WithSchema(familySettingsServiceMethods.ByName("GetHolidayCalendar")),
+		connect.WithHandlerOptions(opts...),
+	)
+	familySettingsServiceSetHolidayCalendarHandler := connect.NewUnaryHandler(
+		FamilySettingsServiceSetHolidayCalendarProcedure,
+		svc.SetHolidayCalendar,
+		connect.WithSchema(familySettingsServiceMethods.ByName("SetHolidayCalendar")),
+		connect.WithHandlerOptions(opts...),
+	)
+	familySettingsServiceSetSharedCostHandler := connect.NewUnaryHandler(
+		FamilySettingsServiceSetSharedCostProcedure,
+		svc.SetSharedCost,
+		connect.WithSchema(familySettingsServiceMethods.ByName("SetSharedCost")),
+		connect.WithHandlerOptions(opts...),
+	)
+	familySettingsServiceGetSharedCostHandler := connect.NewUnaryHandler(
+		FamilySettingsServiceGetSharedCostProcedure,
+		svc.GetSharedCost,
+		connect.WithSchema(familySettingsServiceMethods.ByName("GetSharedCost")),
+		connect.WithHandlerOptions(opts...),
+	)
+	familySettingsServiceDeleteSharedCostHandler := connect.NewUnaryHandler(
+		FamilySettingsServiceDeleteSharedCostProcedure,
+		svc.DeleteSharedCost,
+		connect.WithSchema(familySettingsServiceMethods.ByName("DeleteSharedCost")),
+		connect.WithHandlerOptions(opts...),
+	)
+	familySettingsServiceGetBalancesHandler := connect.NewUnaryHandler(
+		FamilySettingsServiceGetBalancesProcedure,
+		svc.GetBalances,
+		connect.WithSchema(familySettingsServiceMethods.ByName("GetBalances")),
+		connect.WithHandlerOptions(opts...),
+	)
+	familySettingsServiceSettleUpHandler := connect.NewUnaryHandler(
+		FamilySettingsServiceSettleUpProcedure,
+		svc.SettleUp,
+		connect.WithSchema(familySettingsServiceMethods.ByName("SettleUp")),
+		connect.WithHandlerOptions(opts...),
+	)
+	familySettingsServiceExportFamilyDataHandler := connect.NewServerStreamHandler(
+		FamilySettingsServiceExportFamilyDataProcedure,
+		svc.ExportFamilyData,
+		connect.WithSchema(familySettingsServiceMethods.ByName("ExportFamilyData")),
+		connect.WithHandlerOptions(opts...),
+	)
+	familySettingsServiceImportFamilyDataHandler := connect.NewUnaryHandler(
+		FamilySettingsServiceImportFamilyDataProcedure,
+		svc.ImportFamilyData,
+		connect.WithSchema(familySettingsServiceMethods.ByName("ImportFamilyData")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/family.v1.FamilySettingsService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case FamilySettingsServiceCreateFamilySettingProcedure:
@@ -322,6 +558,26 @@ func NewFamilySettingsServiceHandler(svc FamilySettingsServiceHandler, opts ...c
 			familySettingsServiceRemoveIncomeSourceHandler.ServeHTTP(w, r)
 		case FamilySettingsServiceUpdateIncomeSourceProcedure:
 			familySettingsServiceUpdateIncomeSourceHandler.ServeHTTP(w, r)
+		case FamilySettingsServiceListPaydaysProcedure:
+			familySettingsServiceListPaydaysHandler.ServeHTTP(w, r)
+		case FamilySettingsServiceGetHolidayCalendarProcedure:
+			familySettingsServiceGetHolidayCalendarHandler.ServeHTTP(w, r)
+		case FamilySettingsServiceSetHolidayCalendarProcedure:
+			familySettingsServiceSetHolidayCalendarHandler.ServeHTTP(w, r)
+		case FamilySettingsServiceSetSharedCostProcedure:
+			familySettingsServiceSetSharedCostHandler.ServeHTTP(w, r)
+		case FamilySettingsServiceGetSharedCostProcedure:
+			familySettingsServiceGetSharedCostHandler.ServeHTTP(w, r)
+		case FamilySettingsServiceDeleteSharedCostProcedure:
+			familySettingsServiceDeleteSharedCostHandler.ServeHTTP(w, r)
+		case FamilySettingsServiceGetBalancesProcedure:
+			familySettingsServiceGetBalancesHandler.ServeHTTP(w, r)
+		case FamilySettingsServiceSettleUpProcedure:
+			familySettingsServiceSettleUpHandler.ServeHTTP(w, r)
+		case FamilySettingsServiceExportFamilyDataProcedure:
+			familySettingsServiceExportFamilyDataHandler.ServeHTTP(w, r)
+		case FamilySettingsServiceImportFamilyDataProcedure:
+			familySettingsServiceImportFamilyDataHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -370,3 +626,43 @@ func (UnimplementedFamilySettingsServiceHandler) RemoveIncomeSource(context.Cont
 func (UnimplementedFamilySettingsServiceHandler) UpdateIncomeSource(context.Context, *connect.Request[v1.UpdateIncomeSourceRequest]) (*connect.Response[v1.UpdateIncomeSourceResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("family.v1.FamilySettingsService.UpdateIncomeSource is not implemented"))
 }
+
+func (UnimplementedFamilySettingsServiceHandler) ListPaydays(context.Context, *connect.Request[v1.ListPaydaysRequest]) (*connect.Response[v1.ListPaydaysResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("family.v1.FamilySettingsService.ListPaydays is not implemented"))
+}
+
+func (UnimplementedFamilySettingsServiceHandler) GetHolidayCalendar(context.Context, *connect.Request[v1.GetHolidayCalendarRequest]) (*connect.Response[v1.GetHolidayCalendarResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("family.v1.FamilySettingsService.GetHolidayCalendar is not implemented"))
+}
+
+func (UnimplementedFamilySettingsServiceHandler) SetHolidayCalendar(context.Context, *connect.Request[v1.SetHolidayCalendarRequest]) (*connect.Response[v1.SetHolidayCalendarResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("family.v1.FamilySettingsService.SetHolidayCalendar is not implemented"))
+}
+
+func (UnimplementedFamilySettingsServiceHandler) SetSharedCost(context.Context, *connect.Request[v1.SetSharedCostRequest]) (*connect.Response[v1.SetSharedCostResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("family.v1.FamilySettingsService.SetSharedCost is not implemented"))
+}
+
+func (UnimplementedFamilySettingsServiceHandler) GetSharedCost(context.Context, *connect.Request[v1.GetSharedCostRequest]) (*connect.Response[v1.GetSharedCostResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("family.v1.FamilySettingsService.GetSharedCost is not implemented"))
+}
+
+func (UnimplementedFamilySettingsServiceHandler) DeleteSharedCost(context.Context, *connect.Request[v1.DeleteSharedCostRequest]) (*connect.Response[v1.DeleteSharedCostResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("family.v1.FamilySettingsService.DeleteSharedCost is not implemented"))
+}
+
+func (UnimplementedFamilySettingsServiceHandler) GetBalances(context.Context, *connect.Request[v1.GetBalancesRequest]) (*connect.Response[v1.GetBalancesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("family.v1.FamilySettingsService.GetBalances is not implemented"))
+}
+
+func (UnimplementedFamilySettingsServiceHandler) SettleUp(context.Context, *connect.Request[v1.SettleUpRequest]) (*connect.Response[v1.SettleUpResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("family.v1.FamilySettingsService.SettleUp is not implemented"))
+}
+
+func (UnimplementedFamilySettingsServiceHandler) ExportFamilyData(context.Context, *connect.Request[v1.ExportFamilyDataRequest], *connect.ServerStream[v1.ExportFamilyDataResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("family.v1.FamilySettingsService.ExportFamilyData is not implemented"))
+}
+
+func (UnimplementedFamilySettingsServiceHandler) ImportFamilyData(context.Context, *connect.Request[v1.ImportFamilyDataRequest]) (*connect.Response[v1.ImportFamilyDataResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("family.v1.FamilySettingsService.ImportFamilyData is not implemented"))
+}
@@ -0,0 +1,1036 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: forecast/v1/forecast.proto
+
+package forecastv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ForecastEventKind int32
+
+const (
+	ForecastEventKind_FORECAST_EVENT_KIND_UNSPECIFIED ForecastEventKind = 0
+	ForecastEventKind_FORECAST_EVENT_KIND_INCOME      ForecastEventKind = 1
+	ForecastEventKind_FORECAST_EVENT_KIND_EXPENSE     ForecastEventKind = 2
+)
+
+// Enum value maps for ForecastEventKind.
+var (
+	ForecastEventKind_name = map[int32]string{
+		0: "FORECAST_EVENT_KIND_UNSPECIFIED",
+		1: "FORECAST_EVENT_KIND_INCOME",
+		2: "FORECAST_EVENT_KIND_EXPENSE",
+	}
+	ForecastEventKind_value = map[string]int32{
+		"FORECAST_EVENT_KIND_UNSPECIFIED": 0,
+		"FORECAST_EVENT_KIND_INCOME":      1,
+		"FORECAST_EVENT_KIND_EXPENSE":     2,
+	}
+)
+
+func (x ForecastEventKind) Enum() *ForecastEventKind {
+	p := new(ForecastEventKind)
+	*p = x
+	return p
+}
+
+func (x ForecastEventKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ForecastEventKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_forecast_v1_forecast_proto_enumTypes[0].Descriptor()
+}
+
+func (ForecastEventKind) Type() protoreflect.EnumType {
+	return &file_forecast_v1_forecast_proto_enumTypes[0]
+}
+
+func (x ForecastEventKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ForecastEventKind.Descriptor instead.
+func (ForecastEventKind) EnumDescriptor() ([]byte, []int) {
+	return file_forecast_v1_forecast_proto_rawDescGZIP(), []int{0}
+}
+
+type ForecastEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          ForecastEventKind      `protobuf:"varint,1,opt,name=kind,proto3,enum=forecast.v1.ForecastEventKind" json:"kind,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`     // Income source or expense name
+	Amount        string                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"` // Exact decimal, always positive
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForecastEvent) Reset() {
+	*x = ForecastEvent{}
+	mi := &file_forecast_v1_forecast_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForecastEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForecastEvent) ProtoMessage() {}
+
+func (x *ForecastEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_forecast_v1_forecast_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForecastEvent.ProtoReflect.Descriptor instead.
+func (*ForecastEvent) Descriptor() ([]byte, []int) {
+	return file_forecast_v1_forecast_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ForecastEvent) GetKind() ForecastEventKind {
+	if x != nil {
+		return x.Kind
+	}
+	return ForecastEventKind_FORECAST_EVENT_KIND_UNSPECIFIED
+}
+
+func (x *ForecastEvent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ForecastEvent) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type ForecastDay struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`         // YYYY-MM-DD
+	Income        string                 `protobuf:"bytes,2,opt,name=income,proto3" json:"income,omitempty"`     // Exact decimal
+	Expenses      string                 `protobuf:"bytes,3,opt,name=expenses,proto3" json:"expenses,omitempty"` // Exact decimal, as a positive amount
+	Balance       string                 `protobuf:"bytes,4,opt,name=balance,proto3" json:"balance,omitempty"`   // Projected balance at the end of the day
+	Events        []*ForecastEvent       `protobuf:"bytes,5,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForecastDay) Reset() {
+	*x = ForecastDay{}
+	mi := &file_forecast_v1_forecast_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForecastDay) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForecastDay) ProtoMessage() {}
+
+func (x *ForecastDay) ProtoReflect() protoreflect.Message {
+	mi := &file_forecast_v1_forecast_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForecastDay.ProtoReflect.Descriptor instead.
+func (*ForecastDay) Descriptor() ([]byte, []int) {
+	return file_forecast_v1_forecast_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ForecastDay) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *ForecastDay) GetIncome() string {
+	if x != nil {
+		return x.Income
+	}
+	return ""
+}
+
+func (x *ForecastDay) GetExpenses() string {
+	if x != nil {
+		return x.Expenses
+	}
+	return ""
+}
+
+func (x *ForecastDay) GetBalance() string {
+	if x != nil {
+		return x.Balance
+	}
+	return ""
+}
+
+func (x *ForecastDay) GetEvents() []*ForecastEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type ForecastWarning struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`           // YYYY-MM-DD
+	Balance       string                 `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance,omitempty"`     // Projected balance at the end of the day
+	Shortfall     string                 `protobuf:"bytes,3,opt,name=shortfall,proto3" json:"shortfall,omitempty"` // How far below the threshold the balance is
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForecastWarning) Reset() {
+	*x = ForecastWarning{}
+	mi := &file_forecast_v1_forecast_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForecastWarning) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForecastWarning) ProtoMessage() {}
+
+func (x *ForecastWarning) ProtoReflect() protoreflect.Message {
+	mi := &file_forecast_v1_forecast_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForecastWarning.ProtoReflect.Descriptor instead.
+func (*ForecastWarning) Descriptor() ([]byte, []int) {
+	return file_forecast_v1_forecast_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ForecastWarning) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *ForecastWarning) GetBalance() string {
+	if x != nil {
+		return x.Balance
+	}
+	return ""
+}
+
+func (x *ForecastWarning) GetShortfall() string {
+	if x != nil {
+		return x.Shortfall
+	}
+	return ""
+}
+
+type GetCashFlowForecastRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Days          int32                  `protobuf:"varint,1,opt,name=days,proto3" json:"days,omitempty"`                                      // Days to project starting today, defaults to 30, at most 366
+	Threshold     *string                `protobuf:"bytes,2,opt,name=threshold,proto3,oneof" json:"threshold,omitempty"`                       // Exact decimal; days ending below it are flagged, defaults to 0
+	AccountIds    []int64                `protobuf:"varint,3,rep,packed,name=account_ids,json=accountIds,proto3" json:"account_ids,omitempty"` // Checking accounts to start from; all linked checking accounts when empty
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCashFlowForecastRequest) Reset() {
+	*x = GetCashFlowForecastRequest{}
+	mi := &file_forecast_v1_forecast_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCashFlowForecastRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCashFlowForecastRequest) ProtoMessage() {}
+
+func (x *GetCashFlowForecastRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_forecast_v1_forecast_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCashFlowForecastRequest.ProtoReflect.Descriptor instead.
+func (*GetCashFlowForecastRequest) Descriptor() ([]byte, []int) {
+	return file_forecast_v1_forecast_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetCashFlowForecastRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *GetCashFlowForecastRequest) GetThreshold() string {
+	if x != nil && x.Threshold != nil {
+		return *x.Threshold
+	}
+	return ""
+}
+
+func (x *GetCashFlowForecastRequest) GetAccountIds() []int64 {
+	if x != nil {
+		return x.AccountIds
+	}
+	return nil
+}
+
+type GetCashFlowForecastResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	StartingBalance string                 `protobuf:"bytes,1,opt,name=starting_balance,json=startingBalance,proto3" json:"starting_balance,omitempty"` // Latest balance of the accounts
+	Threshold       string                 `protobuf:"bytes,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Days            []*ForecastDay         `protobuf:"bytes,3,rep,name=days,proto3" json:"days,omitempty"`
+	Warnings        []*ForecastWarning     `protobuf:"bytes,4,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetCashFlowForecastResponse) Reset() {
+	*x = GetCashFlowForecastResponse{}
+	mi := &file_forecast_v1_forecast_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCashFlowForecastResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCashFlowForecastResponse) ProtoMessage() {}
+
+func (x *GetCashFlowForecastResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_forecast_v1_forecast_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCashFlowForecastResponse.ProtoReflect.Descriptor instead.
+func (*GetCashFlowForecastResponse) Descriptor() ([]byte, []int) {
+	return file_forecast_v1_forecast_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetCashFlowForecastResponse) GetStartingBalance() string {
+	if x != nil {
+		return x.StartingBalance
+	}
+	return ""
+}
+
+func (x *GetCashFlowForecastResponse) GetThreshold() string {
+	if x != nil {
+		return x.Threshold
+	}
+	return ""
+}
+
+func (x *GetCashFlowForecastResponse) GetDays() []*ForecastDay {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *GetCashFlowForecastResponse) GetWarnings() []*ForecastWarning {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type AllocatedBill struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExpenseId     int64                  `protobuf:"varint,1,opt,name=expense_id,json=expenseId,proto3" json:"expense_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ScheduledDate string                 `protobuf:"bytes,3,opt,name=scheduled_date,json=scheduledDate,proto3" json:"scheduled_date,omitempty"` // YYYY-MM-DD, identifies the occurrence
+	DueDate       string                 `protobuf:"bytes,4,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`                   // YYYY-MM-DD
+	Amount        string                 `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`                                    // Exact decimal
+	Pinned        bool                   `protobuf:"varint,6,opt,name=pinned,proto3" json:"pinned,omitempty"`                                   // Assigned to its paycheck by hand
+	Paid          bool                   `protobuf:"varint,7,opt,name=paid,proto3" json:"paid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AllocatedBill) Reset() {
+	*x = AllocatedBill{}
+	mi := &file_forecast_v1_forecast_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AllocatedBill) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllocatedBill) ProtoMessage() {}
+
+func (x *AllocatedBill) ProtoReflect() protoreflect.Message {
+	mi := &file_forecast_v1_forecast_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllocatedBill.ProtoReflect.Descriptor instead.
+func (*AllocatedBill) Descriptor() ([]byte, []int) {
+	return file_forecast_v1_forecast_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AllocatedBill) GetExpenseId() int64 {
+	if x != nil {
+		return x.ExpenseId
+	}
+	return 0
+}
+
+func (x *AllocatedBill) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AllocatedBill) GetScheduledDate() string {
+	if x != nil {
+		return x.ScheduledDate
+	}
+	return ""
+}
+
+func (x *AllocatedBill) GetDueDate() string {
+	if x != nil {
+		return x.DueDate
+	}
+	return ""
+}
+
+func (x *AllocatedBill) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *AllocatedBill) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+func (x *AllocatedBill) GetPaid() bool {
+	if x != nil {
+		return x.Paid
+	}
+	return false
+}
+
+type PaycheckAllocation struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	IncomeSourceId int64                  `protobuf:"varint,1,opt,name=income_source_id,json=incomeSourceId,proto3" json:"income_source_id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	PayDate        string                 `protobuf:"bytes,3,opt,name=pay_date,json=payDate,proto3" json:"pay_date,omitempty"` // YYYY-MM-DD, the scheduled pay date; identifies the paycheck
+	Date           string                 `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`                      // YYYY-MM-DD, when the pay arrives
+	Amount         string                 `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`                  // Net pay, exact decimal
+	Bills          []*AllocatedBill       `protobuf:"bytes,6,rep,name=bills,proto3" json:"bills,omitempty"`                    // Ordered by due date
+	Total          string                 `protobuf:"bytes,7,opt,name=total,proto3" json:"total,omitempty"`                    // Sum of the bills
+	Leftover       string                 `protobuf:"bytes,8,opt,name=leftover,proto3" json:"leftover,omitempty"`              // amount - total; negative when the paycheck falls short
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PaycheckAllocation) Reset() {
+	*x = PaycheckAllocation{}
+	mi := &file_forecast_v1_forecast_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaycheckAllocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaycheckAllocation) ProtoMessage() {}
+
+func (x *PaycheckAllocation) ProtoReflect() protoreflect.Message {
+	mi := &file_forecast_v1_forecast_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaycheckAllocation.ProtoReflect.Descriptor instead.
+func (*PaycheckAllocation) Descriptor() ([]byte, []int) {
+	return file_forecast_v1_forecast_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PaycheckAllocation) GetIncomeSourceId() int64 {
+	if x != nil {
+		return x.IncomeSourceId
+	}
+	return 0
+}
+
+func (x *PaycheckAllocation) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PaycheckAllocation) GetPayDate() string {
+	if x != nil {
+		return x.PayDate
+	}
+	return ""
+}
+
+func (x *PaycheckAllocation) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *PaycheckAllocation) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *PaycheckAllocation) GetBills() []*AllocatedBill {
+	if x != nil {
+		return x.Bills
+	}
+	return nil
+}
+
+func (x *PaycheckAllocation) GetTotal() string {
+	if x != nil {
+		return x.Total
+	}
+	return ""
+}
+
+func (x *PaycheckAllocation) GetLeftover() string {
+	if x != nil {
+		return x.Leftover
+	}
+	return ""
+}
+
+type AllocatePaychecksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Month         string                 `protobuf:"bytes,1,opt,name=month,proto3" json:"month,omitempty"` // YYYY-MM
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AllocatePaychecksRequest) Reset() {
+	*x = AllocatePaychecksRequest{}
+	mi := &file_forecast_v1_forecast_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AllocatePaychecksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllocatePaychecksRequest) ProtoMessage() {}
+
+func (x *AllocatePaychecksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_forecast_v1_forecast_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllocatePaychecksRequest.ProtoReflect.Descriptor instead.
+func (*AllocatePaychecksRequest) Descriptor() ([]byte, []int) {
+	return file_forecast_v1_forecast_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AllocatePaychecksRequest) GetMonth() string {
+	if x != nil {
+		return x.Month
+	}
+	return ""
+}
+
+// Bills due in the month, assigned to the latest paycheck that arrives before
+// each is due, or to the paycheck it is pinned to. The list starts with the
+// last paycheck of the previous month, which pays bills due before the
+// month's first payday.
+type AllocatePaychecksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Paychecks     []*PaycheckAllocation  `protobuf:"bytes,1,rep,name=paychecks,proto3" json:"paychecks,omitempty"`   // Ordered by arrival date
+	Unassigned    []*AllocatedBill       `protobuf:"bytes,2,rep,name=unassigned,proto3" json:"unassigned,omitempty"` // Due before any paycheck arrives
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AllocatePaychecksResponse) Reset() {
+	*x = AllocatePaychecksResponse{}
+	mi := &file_forecast_v1_forecast_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AllocatePaychecksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllocatePaychecksResponse) ProtoMessage() {}
+
+func (x *AllocatePaychecksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_forecast_v1_forecast_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllocatePaychecksResponse.ProtoReflect.Descriptor instead.
+func (*AllocatePaychecksResponse) Descriptor() ([]byte, []int) {
+	return file_forecast_v1_forecast_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AllocatePaychecksResponse) GetPaychecks() []*PaycheckAllocation {
+	if x != nil {
+		return x.Paychecks
+	}
+	return nil
+}
+
+func (x *AllocatePaychecksResponse) GetUnassigned() []*AllocatedBill {
+	if x != nil {
+		return x.Unassigned
+	}
+	return nil
+}
+
+type PinBillRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ExpenseId      int64                  `protobuf:"varint,1,opt,name=expense_id,json=expenseId,proto3" json:"expense_id,omitempty"`
+	ScheduledDate  string                 `protobuf:"bytes,2,opt,name=scheduled_date,json=scheduledDate,proto3" json:"scheduled_date,omitempty"` // YYYY-MM-DD, an occurrence of the expense
+	IncomeSourceId int64                  `protobuf:"varint,3,opt,name=income_source_id,json=incomeSourceId,proto3" json:"income_source_id,omitempty"`
+	PayDate        string                 `protobuf:"bytes,4,opt,name=pay_date,json=payDate,proto3" json:"pay_date,omitempty"` // YYYY-MM-DD, a scheduled pay date of the source
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PinBillRequest) Reset() {
+	*x = PinBillRequest{}
+	mi := &file_forecast_v1_forecast_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PinBillRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinBillRequest) ProtoMessage() {}
+
+func (x *PinBillRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_forecast_v1_forecast_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinBillRequest.ProtoReflect.Descriptor instead.
+func (*PinBillRequest) Descriptor() ([]byte, []int) {
+	return file_forecast_v1_forecast_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PinBillRequest) GetExpenseId() int64 {
+	if x != nil {
+		return x.ExpenseId
+	}
+	return 0
+}
+
+func (x *PinBillRequest) GetScheduledDate() string {
+	if x != nil {
+		return x.ScheduledDate
+	}
+	return ""
+}
+
+func (x *PinBillRequest) GetIncomeSourceId() int64 {
+	if x != nil {
+		return x.IncomeSourceId
+	}
+	return 0
+}
+
+func (x *PinBillRequest) GetPayDate() string {
+	if x != nil {
+		return x.PayDate
+	}
+	return ""
+}
+
+type PinBillResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PinBillResponse) Reset() {
+	*x = PinBillResponse{}
+	mi := &file_forecast_v1_forecast_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PinBillResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinBillResponse) ProtoMessage() {}
+
+func (x *PinBillResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_forecast_v1_forecast_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinBillResponse.ProtoReflect.Descriptor instead.
+func (*PinBillResponse) Descriptor() ([]byte, []int) {
+	return file_forecast_v1_forecast_proto_rawDescGZIP(), []int{10}
+}
+
+type UnpinBillRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExpenseId     int64                  `protobuf:"varint,1,opt,name=expense_id,json=expenseId,proto3" json:"expense_id,omitempty"`
+	ScheduledDate string                 `protobuf:"bytes,2,opt,name=scheduled_date,json=scheduledDate,proto3" json:"scheduled_date,omitempty"` // YYYY-MM-DD
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnpinBillRequest) Reset() {
+	*x = UnpinBillRequest{}
+	mi := &file_forecast_v1_forecast_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnpinBillRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpinBillRequest) ProtoMessage() {}
+
+func (x *UnpinBillRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_forecast_v1_forecast_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpinBillRequest.ProtoReflect.Descriptor instead.
+func (*UnpinBillRequest) Descriptor() ([]byte, []int) {
+	return file_forecast_v1_forecast_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UnpinBillRequest) GetExpenseId() int64 {
+	if x != nil {
+		return x.ExpenseId
+	}
+	return 0
+}
+
+func (x *UnpinBillRequest) GetScheduledDate() string {
+	if x != nil {
+		return x.ScheduledDate
+	}
+	return ""
+}
+
+type UnpinBillResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnpinBillResponse) Reset() {
+	*x = UnpinBillResponse{}
+	mi := &file_forecast_v1_forecast_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnpinBillResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpinBillResponse) ProtoMessage() {}
+
+func (x *UnpinBillResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_forecast_v1_forecast_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpinBillResponse.ProtoReflect.Descriptor instead.
+func (*UnpinBillResponse) Descriptor() ([]byte, []int) {
+	return file_forecast_v1_forecast_proto_rawDescGZIP(), []int{12}
+}
+
+var File_forecast_v1_forecast_proto protoreflect.FileDescriptor
+
+const file_forecast_v1_forecast_proto_rawDesc = "" +
+	"\n" +
+	"\x1aforecast/v1/forecast.proto\x12\vforecast.v1\"o\n" +
+	"\rForecastEvent\x122\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x1e.forecast.v1.ForecastEventKindR\x04kind\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\tR\x06amount\"\xa3\x01\n" +
+	"\vForecastDay\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x16\n" +
+	"\x06income\x18\x02 \x01(\tR\x06income\x12\x1a\n" +
+	"\bexpenses\x18\x03 \x01(\tR\bexpenses\x12\x18\n" +
+	"\abalance\x18\x04 \x01(\tR\abalance\x122\n" +
+	"\x06events\x18\x05 \x03(\v2\x1a.forecast.v1.ForecastEventR\x06events\"]\n" +
+	"\x0fForecastWarning\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x18\n" +
+	"\abalance\x18\x02 \x01(\tR\abalance\x12\x1c\n" +
+	"\tshortfall\x18\x03 \x01(\tR\tshortfall\"\x82\x01\n" +
+	"\x1aGetCashFlowForecastRequest\x12\x12\n" +
+	"\x04days\x18\x01 \x01(\x05R\x04days\x12!\n" +
+	"\tthreshold\x18\x02 \x01(\tH\x00R\tthreshold\x88\x01\x01\x12\x1f\n" +
+	"\vaccount_ids\x18\x03 \x03(\x03R\n" +
+	"accountIdsB\f\n" +
+	"\n" +
+	"_threshold\"\xce\x01\n" +
+	"\x1bGetCashFlowForecastResponse\x12)\n" +
+	"\x10starting_balance\x18\x01 \x01(\tR\x0fstartingBalance\x12\x1c\n" +
+	"\tthreshold\x18\x02 \x01(\tR\tthreshold\x12,\n" +
+	"\x04days\x18\x03 \x03(\v2\x18.forecast.v1.ForecastDayR\x04days\x128\n" +
+	"\bwarnings\x18\x04 \x03(\v2\x1c.forecast.v1.ForecastWarningR\bwarnings\"\xc8\x01\n" +
+	"\rAllocatedBill\x12\x1d\n" +
+	"\n" +
+	"expense_id\x18\x01 \x01(\x03R\texpenseId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12%\n" +
+	"\x0escheduled_date\x18\x03 \x01(\tR\rscheduledDate\x12\x19\n" +
+	"\bdue_date\x18\x04 \x01(\tR\adueDate\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\tR\x06amount\x12\x16\n" +
+	"\x06pinned\x18\x06 \x01(\bR\x06pinned\x12\x12\n" +
+	"\x04paid\x18\a \x01(\bR\x04paid\"\xfd\x01\n" +
+	"\x12PaycheckAllocation\x12(\n" +
+	"\x10income_source_id\x18\x01 \x01(\x03R\x0eincomeSourceId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x19\n" +
+	"\bpay_date\x18\x03 \x01(\tR\apayDate\x12\x12\n" +
+	"\x04date\x18\x04 \x01(\tR\x04date\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\tR\x06amount\x120\n" +
+	"\x05bills\x18\x06 \x03(\v2\x1a.forecast.v1.AllocatedBillR\x05bills\x12\x14\n" +
+	"\x05total\x18\a \x01(\tR\x05total\x12\x1a\n" +
+	"\bleftover\x18\b \x01(\tR\bleftover\"0\n" +
+	"\x18AllocatePaychecksRequest\x12\x14\n" +
+	"\x05month\x18\x01 \x01(\tR\x05month\"\x96\x01\n" +
+	"\x19AllocatePaychecksResponse\x12=\n" +
+	"\tpaychecks\x18\x01 \x03(\v2\x1f.forecast.v1.PaycheckAllocationR\tpaychecks\x12:\n" +
+	"\n" +
+	"unassigned\x18\x02 \x03(\v2\x1a.forecast.v1.AllocatedBillR\n" +
+	"unassigned\"\x9b\x01\n" +
+	"\x0ePinBillRequest\x12\x1d\n" +
+	"\n" +
+	"expense_id\x18\x01 \x01(\x03R\texpenseId\x12%\n" +
+	"\x0escheduled_date\x18\x02 \x01(\tR\rscheduledDate\x12(\n" +
+	"\x10income_source_id\x18\x03 \x01(\x03R\x0eincomeSourceId\x12\x19\n" +
+	"\bpay_date\x18\x04 \x01(\tR\apayDate\"\x11\n" +
+	"\x0fPinBillResponse\"X\n" +
+	"\x10UnpinBillRequest\x12\x1d\n" +
+	"\n" +
+	"expense_id\x18\x01 \x01(\x03R\texpenseId\x12%\n" +
+	"\x0escheduled_date\x18\x02 \x01(\tR\rscheduledDate\"\x13\n" +
+	"\x11UnpinBillResponse*y\n" +
+	"\x11ForecastEventKind\x12#\n" +
+	"\x1fFORECAST_EVENT_KIND_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aFORECAST_EVENT_KIND_INCOME\x10\x01\x12\x1f\n" +
+	"\x1bFORECAST_EVENT_KIND_EXPENSE\x10\x022\xf1\x02\n" +
+	"\x0fForecastService\x12h\n" +
+	"\x13GetCashFlowForecast\x12'.forecast.v1.GetCashFlowForecastRequest\x1a(.forecast.v1.GetCashFlowForecastResponse\x12b\n" +
+	"\x11AllocatePaychecks\x12%.forecast.v1.AllocatePaychecksRequest\x1a&.forecast.v1.AllocatePaychecksResponse\x12D\n" +
+	"\aPinBill\x12\x1b.forecast.v1.PinBillRequest\x1a\x1c.forecast.v1.PinBillResponse\x12J\n" +
+	"\tUnpinBill\x12\x1d.forecast.v1.UnpinBillRequest\x1a\x1e.forecast.v1.UnpinBillResponseB-Z+expenses-backend/pkg/forecast/v1;forecastv1b\x06proto3"
+
+var (
+	file_forecast_v1_forecast_proto_rawDescOnce sync.Once
+	file_forecast_v1_forecast_proto_rawDescData []byte
+)
+
+func file_forecast_v1_forecast_proto_rawDescGZIP() []byte {
+	file_forecast_v1_forecast_proto_rawDescOnce.Do(func() {
+		file_forecast_v1_forecast_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_forecast_v1_forecast_proto_rawDesc), len(file_forecast_v1_forecast_proto_rawDesc)))
+	})
+	return file_forecast_v1_forecast_proto_rawDescData
+}
+
+var file_forecast_v1_forecast_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_forecast_v1_forecast_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_forecast_v1_forecast_proto_goTypes = []any{
+	(ForecastEventKind)(0),              // 0: forecast.v1.ForecastEventKind
+	(*ForecastEvent)(nil),               // 1: forecast.v1.ForecastEvent
+	(*ForecastDay)(nil),                 // 2: forecast.v1.ForecastDay
+	(*ForecastWarning)(nil),             // 3: forecast.v1.ForecastWarning
+	(*GetCashFlowForecastRequest)(nil),  // 4: forecast.v1.GetCashFlowForecastRequest
+	(*GetCashFlowForecastResponse)(nil), // 5: forecast.v1.GetCashFlowForecastResponse
+	(*AllocatedBill)(nil),               // 6: forecast.v1.AllocatedBill
+	(*PaycheckAllocation)(nil),          // 7: forecast.v1.PaycheckAllocation
+	(*AllocatePaychecksRequest)(nil),    // 8: forecast.v1.AllocatePaychecksRequest
+	(*AllocatePaychecksResponse)(nil),   // 9: forecast.v1.AllocatePaychecksResponse
+	(*PinBillRequest)(nil),              // 10: forecast.v1.PinBillRequest
+	(*PinBillResponse)(nil),             // 11: forecast.v1.PinBillResponse
+	(*UnpinBillRequest)(nil),            // 12: forecast.v1.UnpinBillRequest
+	(*UnpinBillResponse)(nil),           // 13: forecast.v1.UnpinBillResponse
+}
+var file_forecast_v1_forecast_proto_depIdxs = []int32{
+	0,  // 0: forecast.v1.ForecastEvent.kind:type_name -> forecast.v1.ForecastEventKind
+	1,  // 1: forecast.v1.ForecastDay.events:type_name -> forecast.v1.ForecastEvent
+	2,  // 2: forecast.v1.GetCashFlowForecastResponse.days:type_name -> forecast.v1.ForecastDay
+	3,  // 3: forecast.v1.GetCashFlowForecastResponse.warnings:type_name -> forecast.v1.ForecastWarning
+	6,  // 4: forecast.v1.PaycheckAllocation.bills:type_name -> forecast.v1.AllocatedBill
+	7,  // 5: forecast.v1.AllocatePaychecksResponse.paychecks:type_name -> forecast.v1.PaycheckAllocation
+	6,  // 6: forecast.v1.AllocatePaychecksResponse.unassigned:type_name -> forecast.v1.AllocatedBill
+	4,  // 7: forecast.v1.ForecastService.GetCashFlowForecast:input_type -> forecast.v1.GetCashFlowForecastRequest
+	8,  // 8: forecast.v1.ForecastService.AllocatePaychecks:input_type -> forecast.v1.AllocatePaychecksRequest
+	10, // 9: forecast.v1.ForecastService.PinBill:input_type -> forecast.v1.PinBillRequest
+	12, // 10: forecast.v1.ForecastService.UnpinBill:input_type -> forecast.v1.UnpinBillRequest
+	5,  // 11: forecast.v1.ForecastService.GetCashFlowForecast:output_type -> forecast.v1.GetCashFlowForecastResponse
+	9,  // 12: forecast.v1.ForecastService.AllocatePaychecks:output_type -> forecast.v1.AllocatePaychecksResponse
+	11, // 13: forecast.v1.ForecastService.PinBill:output_type -> forecast.v1.PinBillResponse
+	13, // 14: forecast.v1.ForecastService.UnpinBill:output_type -> forecast.v1.UnpinBillResponse
+	11, // [11:15] is the sub-list for method output_type
+	7,  // [7:11] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_forecast_v1_forecast_proto_init() }
+func file_forecast_v1_forecast_proto_init() {
+	if File_forecast_v1_forecast_proto != nil {
+		return
+	}
+	file_forecast_v1_forecast_proto_msgTypes[3].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_forecast_v1_forecast_proto_rawDesc), len(file_forecast_v1_forecast_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_forecast_v1_forecast_proto_goTypes,
+		DependencyIndexes: file_forecast_v1_forecast_proto_depIdxs,
+		EnumInfos:         file_forecast_v1_forecast_proto_enumTypes,
+		MessageInfos:      file_forecast_v1_forecast_proto_msgTypes,
+	}.Build()
+	File_forecast_v1_forecast_proto = out.File
+	file_forecast_v1_forecast_proto_goTypes = nil
+	file_forecast_v1_forecast_proto_depIdxs = nil
+}
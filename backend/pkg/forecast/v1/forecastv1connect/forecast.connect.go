@@ -0,0 +1,197 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: forecast/v1/forecast.proto
+
+package forecastv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "expenses-backend/pkg/forecast/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ForecastServiceName is the fully-qualified name of the ForecastService service.
+	ForecastServiceName = "forecast.v1.ForecastService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ForecastServiceGetCashFlowForecastProcedure is the fully-qualified name of the ForecastService's
+	// GetCashFlowForecast RPC.
+	ForecastServiceGetCashFlowForecastProcedure = "/forecast.v1.ForecastService/GetCashFlowForecast"
+	// ForecastServiceAllocatePaychecksProcedure is the fully-qualified name of the ForecastService's
+	// AllocatePaychecks RPC.
+	ForecastServiceAllocatePaychecksProcedure = "/forecast.v1.ForecastService/AllocatePaychecks"
+	// ForecastServicePinBillProcedure is the fully-qualified name of the ForecastService's PinBill RPC.
+	ForecastServicePinBillProcedure = "/forecast.v1.ForecastService/PinBill"
+	// ForecastServiceUnpinBillProcedure is the fully-qualified name of the ForecastService's UnpinBill
+	// RPC.
+	ForecastServiceUnpinBillProcedure = "/forecast.v1.ForecastService/UnpinBill"
+)
+
+// ForecastServiceClient is a client for the forecast.v1.ForecastService service.
+type ForecastServiceClient interface {
+	GetCashFlowForecast(context.Context, *connect.Request[v1.GetCashFlowForecastRequest]) (*connect.Response[v1.GetCashFlowForecastResponse], error)
+	// Paycheck allocation endpoints
+	AllocatePaychecks(context.Context, *connect.Request[v1.AllocatePaychecksRequest]) (*connect.Response[v1.AllocatePaychecksResponse], error)
+	PinBill(context.Context, *connect.Request[v1.PinBillRequest]) (*connect.Response[v1.PinBillResponse], error)
+	UnpinBill(context.Context, *connect.Request[v1.UnpinBillRequest]) (*connect.Response[v1.UnpinBillResponse], error)
+}
+
+// NewForecastServiceClient constructs a client for the forecast.v1.ForecastService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewForecastServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ForecastServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	forecastServiceMethods := v1.File_forecast_v1_forecast_proto.Services().ByName("ForecastService").Methods()
+	return &forecastServiceClient{
+		getCashFlowForecast: connect.NewClient[v1.GetCashFlowForecastRequest, v1.GetCashFlowForecastResponse](
+			httpClient,
+			baseURL+ForecastServiceGetCashFlowForecastProcedure,
+			connect.WithSchema(forecastServiceMethods.ByName("GetCashFlowForecast")),
+			connect.WithClientOptions(opts...),
+		),
+		allocatePaychecks: connect.NewClient[v1.AllocatePaychecksRequest, v1.AllocatePaychecksResponse](
+			httpClient,
+			baseURL+ForecastServiceAllocatePaychecksProcedure,
+			connect.WithSchema(forecastServiceMethods.ByName("AllocatePaychecks")),
+			connect.WithClientOptions(opts...),
+		),
+		pinBill: connect.NewClient[v1.PinBillRequest, v1.PinBillResponse](
+			httpClient,
+			baseURL+ForecastServicePinBillProcedure,
+			connect.WithSchema(forecastServiceMethods.ByName("PinBill")),
+			connect.WithClientOptions(opts...),
+		),
+		unpinBill: connect.NewClient[v1.UnpinBillRequest, v1.UnpinBillResponse](
+			httpClient,
+			baseURL+ForecastServiceUnpinBillProcedure,
+			connect.WithSchema(forecastServiceMethods.ByName("UnpinBill")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// forecastServiceClient implements ForecastServiceClient.
+type forecastServiceClient struct {
+	getCashFlowForecast *connect.Client[v1.GetCashFlowForecastRequest, v1.GetCashFlowForecastResponse]
+	allocatePaychecks   *connect.Client[v1.AllocatePaychecksRequest, v1.AllocatePaychecksResponse]
+	pinBill             *connect.Client[v1.PinBillRequest, v1.PinBillResponse]
+	unpinBill           *connect.Client[v1.UnpinBillRequest, v1.UnpinBillResponse]
+}
+
+// GetCashFlowForecast calls forecast.v1.ForecastService.GetCashFlowForecast.
+func (c *forecastServiceClient) GetCashFlowForecast(ctx context.Context, req *connect.Request[v1.GetCashFlowForecastRequest]) (*connect.Response[v1.GetCashFlowForecastResponse], error) {
+	return c.getCashFlowForecast.CallUnary(ctx, req)
+}
+
+// AllocatePaychecks calls forecast.v1.ForecastService.AllocatePaychecks.
+func (c *forecastServiceClient) AllocatePaychecks(ctx context.Context, req *connect.Request[v1.AllocatePaychecksRequest]) (*connect.Response[v1.AllocatePaychecksResponse], error) {
+	return c.allocatePaychecks.CallUnary(ctx, req)
+}
+
+// PinBill calls forecast.v1.ForecastService.PinBill.
+func (c *forecastServiceClient) PinBill(ctx context.Context, req *connect.Request[v1.PinBillRequest]) (*connect.Response[v1.PinBillResponse], error) {
+	return c.pinBill.CallUnary(ctx, req)
+}
+
+// UnpinBill calls forecast.v1.ForecastService.UnpinBill.
+func (c *forecastServiceClient) UnpinBill(ctx context.Context, req *connect.Request[v1.UnpinBillRequest]) (*connect.Response[v1.UnpinBillResponse], error) {
+	return c.unpinBill.CallUnary(ctx, req)
+}
+
+// ForecastServiceHandler is an implementation of the forecast.v1.ForecastService service.
+type ForecastServiceHandler interface {
+	GetCashFlowForecast(context.Context, *connect.Request[v1.GetCashFlowForecastRequest]) (*connect.Response[v1.GetCashFlowForecastResponse], error)
+	// Paycheck allocation endpoints
+	AllocatePaychecks(context.Context, *connect.Request[v1.AllocatePaychecksRequest]) (*connect.Response[v1.AllocatePaychecksResponse], error)
+	PinBill(context.Context, *connect.Request[v1.PinBillRequest]) (*connect.Response[v1.PinBillResponse], error)
+	UnpinBill(context.Context, *connect.Request[v1.UnpinBillRequest]) (*connect.Response[v1.UnpinBillResponse], error)
+}
+
+// NewForecastServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewForecastServiceHandler(svc ForecastServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	forecastServiceMethods := v1.File_forecast_v1_forecast_proto.Services().ByName("ForecastService").Methods()
+	forecastServiceGetCashFlowForecastHandler := connect.NewUnaryHandler(
+		ForecastServiceGetCashFlowForecastProcedure,
+		svc.GetCashFlowForecast,
+		connect.WithSchema(forecastServiceMethods.ByName("GetCashFlowForecast")),
+		connect.WithHandlerOptions(opts...),
+	)
+	forecastServiceAllocatePaychecksHandler := connect.NewUnaryHandler(
+		ForecastServiceAllocatePaychecksProcedure,
+		svc.AllocatePaychecks,
+		connect.WithSchema(forecastServiceMethods.ByName("AllocatePaychecks")),
+		connect.WithHandlerOptions(opts...),
+	)
+	forecastServicePinBillHandler := connect.NewUnaryHandler(
+		ForecastServicePinBillProcedure,
+		svc.PinBill,
+		connect.WithSchema(forecastServiceMethods.ByName("PinBill")),
+		connect.WithHandlerOptions(opts...),
+	)
+	forecastServiceUnpinBillHandler := connect.NewUnaryHandler(
+		ForecastServiceUnpinBillProcedure,
+		svc.UnpinBill,
+		connect.WithSchema(forecastServiceMethods.ByName("UnpinBill")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/forecast.v1.ForecastService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ForecastServiceGetCashFlowForecastProcedure:
+			forecastServiceGetCashFlowForecastHandler.ServeHTTP(w, r)
+		case ForecastServiceAllocatePaychecksProcedure:
+			forecastServiceAllocatePaychecksHandler.ServeHTTP(w, r)
+		case ForecastServicePinBillProcedure:
+			forecastServicePinBillHandler.ServeHTTP(w, r)
+		case ForecastServiceUnpinBillProcedure:
+			forecastServiceUnpinBillHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedForecastServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedForecastServiceHandler struct{}
+
+func (UnimplementedForecastServiceHandler) GetCashFlowForecast(context.Context, *connect.Request[v1.GetCashFlowForecastRequest]) (*connect.Response[v1.GetCashFlowForecastResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("forecast.v1.ForecastService.GetCashFlowForecast is not implemented"))
+}
+
+func (UnimplementedForecastServiceHandler) AllocatePaychecks(context.Context, *connect.Request[v1.AllocatePaychecksRequest]) (*connect.Response[v1.AllocatePaychecksResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("forecast.v1.ForecastService.AllocatePaychecks is not implemented"))
+}
+
+func (UnimplementedForecastServiceHandler) PinBill(context.Context, *connect.Request[v1.PinBillRequest]) (*connect.Response[v1.PinBillResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("forecast.v1.ForecastService.PinBill is not implemented"))
+}
+
+func (UnimplementedForecastServiceHandler) UnpinBill(context.Context, *connect.Request[v1.UnpinBillRequest]) (*connect.Response[v1.UnpinBillResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("forecast.v1.ForecastService.UnpinBill is not implemented"))
+}
@@ -22,6 +22,537 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type AccountType int32
+
+const (
+	AccountType_ACCOUNT_TYPE_UNSPECIFIED AccountType = 0
+	AccountType_ACCOUNT_TYPE_CHECKING    AccountType = 1
+	AccountType_ACCOUNT_TYPE_SAVINGS     AccountType = 2
+	AccountType_ACCOUNT_TYPE_CREDIT_CARD AccountType = 3
+	AccountType_ACCOUNT_TYPE_LOAN        AccountType = 4
+	AccountType_ACCOUNT_TYPE_INVESTMENT  AccountType = 5
+	AccountType_ACCOUNT_TYPE_CASH        AccountType = 6
+	AccountType_ACCOUNT_TYPE_OTHER       AccountType = 7
+)
+
+// Enum value maps for AccountType.
+var (
+	AccountType_name = map[int32]string{
+		0: "ACCOUNT_TYPE_UNSPECIFIED",
+		1: "ACCOUNT_TYPE_CHECKING",
+		2: "ACCOUNT_TYPE_SAVINGS",
+		3: "ACCOUNT_TYPE_CREDIT_CARD",
+		4: "ACCOUNT_TYPE_LOAN",
+		5: "ACCOUNT_TYPE_INVESTMENT",
+		6: "ACCOUNT_TYPE_CASH",
+		7: "ACCOUNT_TYPE_OTHER",
+	}
+	AccountType_value = map[string]int32{
+		"ACCOUNT_TYPE_UNSPECIFIED": 0,
+		"ACCOUNT_TYPE_CHECKING":    1,
+		"ACCOUNT_TYPE_SAVINGS":     2,
+		"ACCOUNT_TYPE_CREDIT_CARD": 3,
+		"ACCOUNT_TYPE_LOAN":        4,
+		"ACCOUNT_TYPE_INVESTMENT":  5,
+		"ACCOUNT_TYPE_CASH":        6,
+		"ACCOUNT_TYPE_OTHER":       7,
+	}
+)
+
+func (x AccountType) Enum() *AccountType {
+	p := new(AccountType)
+	*p = x
+	return p
+}
+
+func (x AccountType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AccountType) Descriptor() protoreflect.EnumDescriptor {
+	return file_transaction_v1_transaction_proto_enumTypes[0].Descriptor()
+}
+
+func (AccountType) Type() protoreflect.EnumType {
+	return &file_transaction_v1_transaction_proto_enumTypes[0]
+}
+
+func (x AccountType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AccountType.Descriptor instead.
+func (AccountType) EnumDescriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{0}
+}
+
+// Whether an account holds money or money owed; derived from its type
+type AccountClass int32
+
+const (
+	AccountClass_ACCOUNT_CLASS_UNSPECIFIED AccountClass = 0
+	AccountClass_ACCOUNT_CLASS_ASSET       AccountClass = 1
+	AccountClass_ACCOUNT_CLASS_LIABILITY   AccountClass = 2 // Credit cards and loans
+)
+
+// Enum value maps for AccountClass.
+var (
+	AccountClass_name = map[int32]string{
+		0: "ACCOUNT_CLASS_UNSPECIFIED",
+		1: "ACCOUNT_CLASS_ASSET",
+		2: "ACCOUNT_CLASS_LIABILITY",
+	}
+	AccountClass_value = map[string]int32{
+		"ACCOUNT_CLASS_UNSPECIFIED": 0,
+		"ACCOUNT_CLASS_ASSET":       1,
+		"ACCOUNT_CLASS_LIABILITY":   2,
+	}
+)
+
+func (x AccountClass) Enum() *AccountClass {
+	p := new(AccountClass)
+	*p = x
+	return p
+}
+
+func (x AccountClass) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AccountClass) Descriptor() protoreflect.EnumDescriptor {
+	return file_transaction_v1_transaction_proto_enumTypes[1].Descriptor()
+}
+
+func (AccountClass) Type() protoreflect.EnumType {
+	return &file_transaction_v1_transaction_proto_enumTypes[1]
+}
+
+func (x AccountClass) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AccountClass.Descriptor instead.
+func (AccountClass) EnumDescriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{1}
+}
+
+// Where an account's transactions come from
+type AccountSource int32
+
+const (
+	AccountSource_ACCOUNT_SOURCE_UNSPECIFIED AccountSource = 0
+	AccountSource_ACCOUNT_SOURCE_SIMPLEFIN   AccountSource = 1 // Synced from SimpleFIN
+	AccountSource_ACCOUNT_SOURCE_IMPORT      AccountSource = 2 // Imported from statement files
+)
+
+// Enum value maps for AccountSource.
+var (
+	AccountSource_name = map[int32]string{
+		0: "ACCOUNT_SOURCE_UNSPECIFIED",
+		1: "ACCOUNT_SOURCE_SIMPLEFIN",
+		2: "ACCOUNT_SOURCE_IMPORT",
+	}
+	AccountSource_value = map[string]int32{
+		"ACCOUNT_SOURCE_UNSPECIFIED": 0,
+		"ACCOUNT_SOURCE_SIMPLEFIN":   1,
+		"ACCOUNT_SOURCE_IMPORT":      2,
+	}
+)
+
+func (x AccountSource) Enum() *AccountSource {
+	p := new(AccountSource)
+	*p = x
+	return p
+}
+
+func (x AccountSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AccountSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_transaction_v1_transaction_proto_enumTypes[2].Descriptor()
+}
+
+func (AccountSource) Type() protoreflect.EnumType {
+	return &file_transaction_v1_transaction_proto_enumTypes[2]
+}
+
+func (x AccountSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AccountSource.Descriptor instead.
+func (AccountSource) EnumDescriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{2}
+}
+
+type SimplefinHealthState int32
+
+const (
+	SimplefinHealthState_SIMPLEFIN_HEALTH_STATE_UNSPECIFIED   SimplefinHealthState = 0
+	SimplefinHealthState_SIMPLEFIN_HEALTH_STATE_NOT_CONNECTED SimplefinHealthState = 1
+	SimplefinHealthState_SIMPLEFIN_HEALTH_STATE_UNCHECKED     SimplefinHealthState = 2 // Connected but not used since the server started
+	SimplefinHealthState_SIMPLEFIN_HEALTH_STATE_HEALTHY       SimplefinHealthState = 3 // The last request succeeded
+	SimplefinHealthState_SIMPLEFIN_HEALTH_STATE_FAILING       SimplefinHealthState = 4 // The last request failed
+	SimplefinHealthState_SIMPLEFIN_HEALTH_STATE_AUTH_FAILED   SimplefinHealthState = 5 // SimpleFIN keeps rejecting the token; requests are paused until retry_at
+)
+
+// Enum value maps for SimplefinHealthState.
+var (
+	SimplefinHealthState_name = map[int32]string{
+		0: "SIMPLEFIN_HEALTH_STATE_UNSPECIFIED",
+		1: "SIMPLEFIN_HEALTH_STATE_NOT_CONNECTED",
+		2: "SIMPLEFIN_HEALTH_STATE_UNCHECKED",
+		3: "SIMPLEFIN_HEALTH_STATE_HEALTHY",
+		4: "SIMPLEFIN_HEALTH_STATE_FAILING",
+		5: "SIMPLEFIN_HEALTH_STATE_AUTH_FAILED",
+	}
+	SimplefinHealthState_value = map[string]int32{
+		"SIMPLEFIN_HEALTH_STATE_UNSPECIFIED":   0,
+		"SIMPLEFIN_HEALTH_STATE_NOT_CONNECTED": 1,
+		"SIMPLEFIN_HEALTH_STATE_UNCHECKED":     2,
+		"SIMPLEFIN_HEALTH_STATE_HEALTHY":       3,
+		"SIMPLEFIN_HEALTH_STATE_FAILING":       4,
+		"SIMPLEFIN_HEALTH_STATE_AUTH_FAILED":   5,
+	}
+)
+
+func (x SimplefinHealthState) Enum() *SimplefinHealthState {
+	p := new(SimplefinHealthState)
+	*p = x
+	return p
+}
+
+func (x SimplefinHealthState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SimplefinHealthState) Descriptor() protoreflect.EnumDescriptor {
+	return file_transaction_v1_transaction_proto_enumTypes[3].Descriptor()
+}
+
+func (SimplefinHealthState) Type() protoreflect.EnumType {
+	return &file_transaction_v1_transaction_proto_enumTypes[3]
+}
+
+func (x SimplefinHealthState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SimplefinHealthState.Descriptor instead.
+func (SimplefinHealthState) EnumDescriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{3}
+}
+
+type Granularity int32
+
+const (
+	Granularity_GRANULARITY_UNSPECIFIED Granularity = 0 // Same as DAY
+	Granularity_GRANULARITY_DAY         Granularity = 1
+	Granularity_GRANULARITY_WEEK        Granularity = 2 // Weeks end on Sunday
+	Granularity_GRANULARITY_MONTH       Granularity = 3
+)
+
+// Enum value maps for Granularity.
+var (
+	Granularity_name = map[int32]string{
+		0: "GRANULARITY_UNSPECIFIED",
+		1: "GRANULARITY_DAY",
+		2: "GRANULARITY_WEEK",
+		3: "GRANULARITY_MONTH",
+	}
+	Granularity_value = map[string]int32{
+		"GRANULARITY_UNSPECIFIED": 0,
+		"GRANULARITY_DAY":         1,
+		"GRANULARITY_WEEK":        2,
+		"GRANULARITY_MONTH":       3,
+	}
+)
+
+func (x Granularity) Enum() *Granularity {
+	p := new(Granularity)
+	*p = x
+	return p
+}
+
+func (x Granularity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Granularity) Descriptor() protoreflect.EnumDescriptor {
+	return file_transaction_v1_transaction_proto_enumTypes[4].Descriptor()
+}
+
+func (Granularity) Type() protoreflect.EnumType {
+	return &file_transaction_v1_transaction_proto_enumTypes[4]
+}
+
+func (x Granularity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Granularity.Descriptor instead.
+func (Granularity) EnumDescriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{4}
+}
+
+type RuleMatchField int32
+
+const (
+	RuleMatchField_RULE_MATCH_FIELD_UNSPECIFIED RuleMatchField = 0 // Same as ANY
+	RuleMatchField_RULE_MATCH_FIELD_PAYEE       RuleMatchField = 1 // The bank's payee
+	RuleMatchField_RULE_MATCH_FIELD_DESCRIPTION RuleMatchField = 2
+	RuleMatchField_RULE_MATCH_FIELD_ANY         RuleMatchField = 3 // Payee or description
+)
+
+// Enum value maps for RuleMatchField.
+var (
+	RuleMatchField_name = map[int32]string{
+		0: "RULE_MATCH_FIELD_UNSPECIFIED",
+		1: "RULE_MATCH_FIELD_PAYEE",
+		2: "RULE_MATCH_FIELD_DESCRIPTION",
+		3: "RULE_MATCH_FIELD_ANY",
+	}
+	RuleMatchField_value = map[string]int32{
+		"RULE_MATCH_FIELD_UNSPECIFIED": 0,
+		"RULE_MATCH_FIELD_PAYEE":       1,
+		"RULE_MATCH_FIELD_DESCRIPTION": 2,
+		"RULE_MATCH_FIELD_ANY":         3,
+	}
+)
+
+func (x RuleMatchField) Enum() *RuleMatchField {
+	p := new(RuleMatchField)
+	*p = x
+	return p
+}
+
+func (x RuleMatchField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RuleMatchField) Descriptor() protoreflect.EnumDescriptor {
+	return file_transaction_v1_transaction_proto_enumTypes[5].Descriptor()
+}
+
+func (RuleMatchField) Type() protoreflect.EnumType {
+	return &file_transaction_v1_transaction_proto_enumTypes[5]
+}
+
+func (x RuleMatchField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RuleMatchField.Descriptor instead.
+func (RuleMatchField) EnumDescriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{5}
+}
+
+type RuleMatchType int32
+
+const (
+	RuleMatchType_RULE_MATCH_TYPE_UNSPECIFIED RuleMatchType = 0 // Same as CONTAINS
+	RuleMatchType_RULE_MATCH_TYPE_CONTAINS    RuleMatchType = 1 // Case-insensitive substring
+	RuleMatchType_RULE_MATCH_TYPE_REGEX       RuleMatchType = 2 // RE2 syntax; case-sensitive unless the pattern starts with (?i)
+)
+
+// Enum value maps for RuleMatchType.
+var (
+	RuleMatchType_name = map[int32]string{
+		0: "RULE_MATCH_TYPE_UNSPECIFIED",
+		1: "RULE_MATCH_TYPE_CONTAINS",
+		2: "RULE_MATCH_TYPE_REGEX",
+	}
+	RuleMatchType_value = map[string]int32{
+		"RULE_MATCH_TYPE_UNSPECIFIED": 0,
+		"RULE_MATCH_TYPE_CONTAINS":    1,
+		"RULE_MATCH_TYPE_REGEX":       2,
+	}
+)
+
+func (x RuleMatchType) Enum() *RuleMatchType {
+	p := new(RuleMatchType)
+	*p = x
+	return p
+}
+
+func (x RuleMatchType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RuleMatchType) Descriptor() protoreflect.EnumDescriptor {
+	return file_transaction_v1_transaction_proto_enumTypes[6].Descriptor()
+}
+
+func (RuleMatchType) Type() protoreflect.EnumType {
+	return &file_transaction_v1_transaction_proto_enumTypes[6]
+}
+
+func (x RuleMatchType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RuleMatchType.Descriptor instead.
+func (RuleMatchType) EnumDescriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{6}
+}
+
+type TransferPairStatus int32
+
+const (
+	TransferPairStatus_TRANSFER_PAIR_STATUS_UNSPECIFIED TransferPairStatus = 0
+	TransferPairStatus_TRANSFER_PAIR_STATUS_DETECTED    TransferPairStatus = 1 // Found by the detector, not yet reviewed
+	TransferPairStatus_TRANSFER_PAIR_STATUS_CONFIRMED   TransferPairStatus = 2
+	TransferPairStatus_TRANSFER_PAIR_STATUS_SPLIT       TransferPairStatus = 3 // Not a transfer; the detector won't pair these again
+)
+
+// Enum value maps for TransferPairStatus.
+var (
+	TransferPairStatus_name = map[int32]string{
+		0: "TRANSFER_PAIR_STATUS_UNSPECIFIED",
+		1: "TRANSFER_PAIR_STATUS_DETECTED",
+		2: "TRANSFER_PAIR_STATUS_CONFIRMED",
+		3: "TRANSFER_PAIR_STATUS_SPLIT",
+	}
+	TransferPairStatus_value = map[string]int32{
+		"TRANSFER_PAIR_STATUS_UNSPECIFIED": 0,
+		"TRANSFER_PAIR_STATUS_DETECTED":    1,
+		"TRANSFER_PAIR_STATUS_CONFIRMED":   2,
+		"TRANSFER_PAIR_STATUS_SPLIT":       3,
+	}
+)
+
+func (x TransferPairStatus) Enum() *TransferPairStatus {
+	p := new(TransferPairStatus)
+	*p = x
+	return p
+}
+
+func (x TransferPairStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TransferPairStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_transaction_v1_transaction_proto_enumTypes[7].Descriptor()
+}
+
+func (TransferPairStatus) Type() protoreflect.EnumType {
+	return &file_transaction_v1_transaction_proto_enumTypes[7]
+}
+
+func (x TransferPairStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TransferPairStatus.Descriptor instead.
+func (TransferPairStatus) EnumDescriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{7}
+}
+
+type StatementFormat int32
+
+const (
+	StatementFormat_STATEMENT_FORMAT_UNSPECIFIED StatementFormat = 0 // Told from the file name or content
+	StatementFormat_STATEMENT_FORMAT_CSV         StatementFormat = 1
+	StatementFormat_STATEMENT_FORMAT_OFX         StatementFormat = 2 // OFX 1.x SGML or 2.x XML
+	StatementFormat_STATEMENT_FORMAT_QFX         StatementFormat = 3 // Quicken's flavor of OFX
+)
+
+// Enum value maps for StatementFormat.
+var (
+	StatementFormat_name = map[int32]string{
+		0: "STATEMENT_FORMAT_UNSPECIFIED",
+		1: "STATEMENT_FORMAT_CSV",
+		2: "STATEMENT_FORMAT_OFX",
+		3: "STATEMENT_FORMAT_QFX",
+	}
+	StatementFormat_value = map[string]int32{
+		"STATEMENT_FORMAT_UNSPECIFIED": 0,
+		"STATEMENT_FORMAT_CSV":         1,
+		"STATEMENT_FORMAT_OFX":         2,
+		"STATEMENT_FORMAT_QFX":         3,
+	}
+)
+
+func (x StatementFormat) Enum() *StatementFormat {
+	p := new(StatementFormat)
+	*p = x
+	return p
+}
+
+func (x StatementFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StatementFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_transaction_v1_transaction_proto_enumTypes[8].Descriptor()
+}
+
+func (StatementFormat) Type() protoreflect.EnumType {
+	return &file_transaction_v1_transaction_proto_enumTypes[8]
+}
+
+func (x StatementFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StatementFormat.Descriptor instead.
+func (StatementFormat) EnumDescriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{8}
+}
+
+type StatementRowStatus int32
+
+const (
+	StatementRowStatus_STATEMENT_ROW_STATUS_UNSPECIFIED StatementRowStatus = 0
+	StatementRowStatus_STATEMENT_ROW_STATUS_NEW         StatementRowStatus = 1
+	StatementRowStatus_STATEMENT_ROW_STATUS_DUPLICATE   StatementRowStatus = 2 // Already stored, or a transaction ID repeated in the file
+	StatementRowStatus_STATEMENT_ROW_STATUS_SKIPPED     StatementRowStatus = 3 // Left out by skip_rows
+)
+
+// Enum value maps for StatementRowStatus.
+var (
+	StatementRowStatus_name = map[int32]string{
+		0: "STATEMENT_ROW_STATUS_UNSPECIFIED",
+		1: "STATEMENT_ROW_STATUS_NEW",
+		2: "STATEMENT_ROW_STATUS_DUPLICATE",
+		3: "STATEMENT_ROW_STATUS_SKIPPED",
+	}
+	StatementRowStatus_value = map[string]int32{
+		"STATEMENT_ROW_STATUS_UNSPECIFIED": 0,
+		"STATEMENT_ROW_STATUS_NEW":         1,
+		"STATEMENT_ROW_STATUS_DUPLICATE":   2,
+		"STATEMENT_ROW_STATUS_SKIPPED":     3,
+	}
+)
+
+func (x StatementRowStatus) Enum() *StatementRowStatus {
+	p := new(StatementRowStatus)
+	*p = x
+	return p
+}
+
+func (x StatementRowStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StatementRowStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_transaction_v1_transaction_proto_enumTypes[9].Descriptor()
+}
+
+func (StatementRowStatus) Type() protoreflect.EnumType {
+	return &file_transaction_v1_transaction_proto_enumTypes[9]
+}
+
+func (x StatementRowStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StatementRowStatus.Descriptor instead.
+func (StatementRowStatus) EnumDescriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{9}
+}
+
 type Organization struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
@@ -159,12 +690,19 @@ func (x *Transaction) GetPending() bool {
 }
 
 type Account struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	AccountId     string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AccountId       string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"` // SimpleFIN account ID; empty for import accounts
+	Name            string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                            // Display name
+	Type            AccountType            `protobuf:"varint,4,opt,name=type,proto3,enum=transaction.v1.AccountType" json:"type,omitempty"`
+	Hidden          bool                   `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	IncludeInBudget bool                   `protobuf:"varint,6,opt,name=include_in_budget,json=includeInBudget,proto3" json:"include_in_budget,omitempty"`
+	UnlinkedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=unlinked_at,json=unlinkedAt,proto3,oneof" json:"unlinked_at,omitempty"` // Set when removed with its transactions kept; no longer synced
+	RelinkedAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=relinked_at,json=relinkedAt,proto3,oneof" json:"relinked_at,omitempty"` // When the SimpleFIN account ID was last replaced
+	Class           AccountClass           `protobuf:"varint,9,opt,name=class,proto3,enum=transaction.v1.AccountClass" json:"class,omitempty"`
+	Source          AccountSource          `protobuf:"varint,10,opt,name=source,proto3,enum=transaction.v1.AccountSource" json:"source,omitempty"` // Relinking an import account to SimpleFIN makes it a SimpleFIN account
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *Account) Reset() {
@@ -218,35 +756,84 @@ func (x *Account) GetName() string {
 	return ""
 }
 
-type SimplefinAccount struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Org              *Organization          `protobuf:"bytes,2,opt,name=org,proto3" json:"org,omitempty"`
-	Name             string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Currency         string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
-	Balance          string                 `protobuf:"bytes,5,opt,name=balance,proto3" json:"balance,omitempty"`
-	AvailableBalance *string                `protobuf:"bytes,6,opt,name=available_balance,json=availableBalance,proto3,oneof" json:"available_balance,omitempty"`
-	BalanceDate      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=balance_date,json=balanceDate,proto3" json:"balance_date,omitempty"`
-	Transactions     []*Transaction         `protobuf:"bytes,8,rep,name=transactions,proto3" json:"transactions,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *Account) GetType() AccountType {
+	if x != nil {
+		return x.Type
+	}
+	return AccountType_ACCOUNT_TYPE_UNSPECIFIED
 }
 
-func (x *SimplefinAccount) Reset() {
-	*x = SimplefinAccount{}
-	mi := &file_transaction_v1_transaction_proto_msgTypes[3]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Account) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
 }
 
-func (x *SimplefinAccount) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Account) GetIncludeInBudget() bool {
+	if x != nil {
+		return x.IncludeInBudget
+	}
+	return false
 }
 
-func (*SimplefinAccount) ProtoMessage() {}
-
-func (x *SimplefinAccount) ProtoReflect() protoreflect.Message {
-	mi := &file_transaction_v1_transaction_proto_msgTypes[3]
+func (x *Account) GetUnlinkedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UnlinkedAt
+	}
+	return nil
+}
+
+func (x *Account) GetRelinkedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RelinkedAt
+	}
+	return nil
+}
+
+func (x *Account) GetClass() AccountClass {
+	if x != nil {
+		return x.Class
+	}
+	return AccountClass_ACCOUNT_CLASS_UNSPECIFIED
+}
+
+func (x *Account) GetSource() AccountSource {
+	if x != nil {
+		return x.Source
+	}
+	return AccountSource_ACCOUNT_SOURCE_UNSPECIFIED
+}
+
+type SimplefinAccount struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Org              *Organization          `protobuf:"bytes,2,opt,name=org,proto3" json:"org,omitempty"`
+	Name             string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Currency         string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	Balance          string                 `protobuf:"bytes,5,opt,name=balance,proto3" json:"balance,omitempty"`
+	AvailableBalance *string                `protobuf:"bytes,6,opt,name=available_balance,json=availableBalance,proto3,oneof" json:"available_balance,omitempty"`
+	BalanceDate      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=balance_date,json=balanceDate,proto3" json:"balance_date,omitempty"`
+	Transactions     []*Transaction         `protobuf:"bytes,8,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SimplefinAccount) Reset() {
+	*x = SimplefinAccount{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimplefinAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimplefinAccount) ProtoMessage() {}
+
+func (x *SimplefinAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -301,44 +888,4843 @@ func (x *SimplefinAccount) GetAvailableBalance() string {
 	if x != nil && x.AvailableBalance != nil {
 		return *x.AvailableBalance
 	}
-	return ""
+	return ""
+}
+
+func (x *SimplefinAccount) GetBalanceDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BalanceDate
+	}
+	return nil
+}
+
+func (x *SimplefinAccount) GetTransactions() []*Transaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+type GetSimplefinAccountsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSimplefinAccountsRequest) Reset() {
+	*x = GetSimplefinAccountsRequest{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSimplefinAccountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSimplefinAccountsRequest) ProtoMessage() {}
+
+func (x *GetSimplefinAccountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSimplefinAccountsRequest.ProtoReflect.Descriptor instead.
+func (*GetSimplefinAccountsRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{4}
+}
+
+type GetSimplefinAccountsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Accounts      []*SimplefinAccount    `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	Health        *SimplefinHealth       `protobuf:"bytes,2,opt,name=health,proto3" json:"health,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSimplefinAccountsResponse) Reset() {
+	*x = GetSimplefinAccountsResponse{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSimplefinAccountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSimplefinAccountsResponse) ProtoMessage() {}
+
+func (x *GetSimplefinAccountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSimplefinAccountsResponse.ProtoReflect.Descriptor instead.
+func (*GetSimplefinAccountsResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetSimplefinAccountsResponse) GetAccounts() []*SimplefinAccount {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+func (x *GetSimplefinAccountsResponse) GetHealth() *SimplefinHealth {
+	if x != nil {
+		return x.Health
+	}
+	return nil
+}
+
+type GetAccountsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IncludeHidden bool                   `protobuf:"varint,1,opt,name=include_hidden,json=includeHidden,proto3" json:"include_hidden,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountsRequest) Reset() {
+	*x = GetAccountsRequest{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountsRequest) ProtoMessage() {}
+
+func (x *GetAccountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountsRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountsRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetAccountsRequest) GetIncludeHidden() bool {
+	if x != nil {
+		return x.IncludeHidden
+	}
+	return false
+}
+
+type GetAccountsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Accounts      []*Account             `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	Health        *SimplefinHealth       `protobuf:"bytes,2,opt,name=health,proto3" json:"health,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountsResponse) Reset() {
+	*x = GetAccountsResponse{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountsResponse) ProtoMessage() {}
+
+func (x *GetAccountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountsResponse.ProtoReflect.Descriptor instead.
+func (*GetAccountsResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetAccountsResponse) GetAccounts() []*Account {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+func (x *GetAccountsResponse) GetHealth() *SimplefinHealth {
+	if x != nil {
+		return x.Health
+	}
+	return nil
+}
+
+type AddAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                            // Required for import accounts
+	AccountId     string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"` // Must be an account of the family's SimpleFIN connection; empty for import accounts
+	Type          AccountType            `protobuf:"varint,3,opt,name=type,proto3,enum=transaction.v1.AccountType" json:"type,omitempty"`
+	Source        AccountSource          `protobuf:"varint,4,opt,name=source,proto3,enum=transaction.v1.AccountSource" json:"source,omitempty"` // Defaults to SIMPLEFIN
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddAccountRequest) Reset() {
+	*x = AddAccountRequest{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddAccountRequest) ProtoMessage() {}
+
+func (x *AddAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddAccountRequest.ProtoReflect.Descriptor instead.
+func (*AddAccountRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AddAccountRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AddAccountRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *AddAccountRequest) GetType() AccountType {
+	if x != nil {
+		return x.Type
+	}
+	return AccountType_ACCOUNT_TYPE_UNSPECIFIED
+}
+
+func (x *AddAccountRequest) GetSource() AccountSource {
+	if x != nil {
+		return x.Source
+	}
+	return AccountSource_ACCOUNT_SOURCE_UNSPECIFIED
+}
+
+type AddAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddAccountResponse) Reset() {
+	*x = AddAccountResponse{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddAccountResponse) ProtoMessage() {}
+
+func (x *AddAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddAccountResponse.ProtoReflect.Descriptor instead.
+func (*AddAccountResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AddAccountResponse) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+// Only the fields that are set are changed
+type UpdateAccountRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name            *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Type            *AccountType           `protobuf:"varint,3,opt,name=type,proto3,enum=transaction.v1.AccountType,oneof" json:"type,omitempty"`
+	Hidden          *bool                  `protobuf:"varint,4,opt,name=hidden,proto3,oneof" json:"hidden,omitempty"`
+	IncludeInBudget *bool                  `protobuf:"varint,5,opt,name=include_in_budget,json=includeInBudget,proto3,oneof" json:"include_in_budget,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateAccountRequest) Reset() {
+	*x = UpdateAccountRequest{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAccountRequest) ProtoMessage() {}
+
+func (x *UpdateAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAccountRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAccountRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateAccountRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateAccountRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateAccountRequest) GetType() AccountType {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return AccountType_ACCOUNT_TYPE_UNSPECIFIED
+}
+
+func (x *UpdateAccountRequest) GetHidden() bool {
+	if x != nil && x.Hidden != nil {
+		return *x.Hidden
+	}
+	return false
+}
+
+func (x *UpdateAccountRequest) GetIncludeInBudget() bool {
+	if x != nil && x.IncludeInBudget != nil {
+		return *x.IncludeInBudget
+	}
+	return false
+}
+
+type UpdateAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAccountResponse) Reset() {
+	*x = UpdateAccountResponse{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAccountResponse) ProtoMessage() {}
+
+func (x *UpdateAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAccountResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAccountResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UpdateAccountResponse) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+type RemoveAccountRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Delete the account with its transactions. Otherwise the account is only
+	// unlinked: it stops syncing and its transactions are kept.
+	PurgeTransactions bool `protobuf:"varint,2,opt,name=purge_transactions,json=purgeTransactions,proto3" json:"purge_transactions,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *RemoveAccountRequest) Reset() {
+	*x = RemoveAccountRequest{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveAccountRequest) ProtoMessage() {}
+
+func (x *RemoveAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveAccountRequest.ProtoReflect.Descriptor instead.
+func (*RemoveAccountRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RemoveAccountRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RemoveAccountRequest) GetPurgeTransactions() bool {
+	if x != nil {
+		return x.PurgeTransactions
+	}
+	return false
+}
+
+type RemoveAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveAccountResponse) Reset() {
+	*x = RemoveAccountResponse{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveAccountResponse) ProtoMessage() {}
+
+func (x *RemoveAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveAccountResponse.ProtoReflect.Descriptor instead.
+func (*RemoveAccountResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RemoveAccountResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Points an account at a new SimpleFIN account ID, e.g. after the bank
+// reissued it, keeping the account's transactions and settings
+type RelinkAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AccountId     string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RelinkAccountRequest) Reset() {
+	*x = RelinkAccountRequest{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RelinkAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelinkAccountRequest) ProtoMessage() {}
+
+func (x *RelinkAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelinkAccountRequest.ProtoReflect.Descriptor instead.
+func (*RelinkAccountRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RelinkAccountRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RelinkAccountRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+type RelinkAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RelinkAccountResponse) Reset() {
+	*x = RelinkAccountResponse{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RelinkAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelinkAccountResponse) ProtoMessage() {}
+
+func (x *RelinkAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelinkAccountResponse.ProtoReflect.Descriptor instead.
+func (*RelinkAccountResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RelinkAccountResponse) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+// How well requests to the family's SimpleFIN bridge are going
+type SimplefinHealth struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	State         SimplefinHealthState   `protobuf:"varint,1,opt,name=state,proto3,enum=transaction.v1.SimplefinHealthState" json:"state,omitempty"`
+	LastSuccessAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=last_success_at,json=lastSuccessAt,proto3,oneof" json:"last_success_at,omitempty"`
+	LastErrorAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_error_at,json=lastErrorAt,proto3,oneof" json:"last_error_at,omitempty"`
+	LastError     *string                `protobuf:"bytes,4,opt,name=last_error,json=lastError,proto3,oneof" json:"last_error,omitempty"`
+	AuthFailures  int32                  `protobuf:"varint,5,opt,name=auth_failures,json=authFailures,proto3" json:"auth_failures,omitempty"` // Consecutive requests rejected for the token
+	RetryAt       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=retry_at,json=retryAt,proto3,oneof" json:"retry_at,omitempty"`           // When requests resume after auth failures
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimplefinHealth) Reset() {
+	*x = SimplefinHealth{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimplefinHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimplefinHealth) ProtoMessage() {}
+
+func (x *SimplefinHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimplefinHealth.ProtoReflect.Descriptor instead.
+func (*SimplefinHealth) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SimplefinHealth) GetState() SimplefinHealthState {
+	if x != nil {
+		return x.State
+	}
+	return SimplefinHealthState_SIMPLEFIN_HEALTH_STATE_UNSPECIFIED
+}
+
+func (x *SimplefinHealth) GetLastSuccessAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSuccessAt
+	}
+	return nil
+}
+
+func (x *SimplefinHealth) GetLastErrorAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastErrorAt
+	}
+	return nil
+}
+
+func (x *SimplefinHealth) GetLastError() string {
+	if x != nil && x.LastError != nil {
+		return *x.LastError
+	}
+	return ""
+}
+
+func (x *SimplefinHealth) GetAuthFailures() int32 {
+	if x != nil {
+		return x.AuthFailures
+	}
+	return 0
+}
+
+func (x *SimplefinHealth) GetRetryAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RetryAt
+	}
+	return nil
+}
+
+// The family's link to a SimpleFIN bridge
+type SimplefinConnection struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Connected         bool                   `protobuf:"varint,1,opt,name=connected,proto3" json:"connected,omitempty"`
+	Server            string                 `protobuf:"bytes,2,opt,name=server,proto3" json:"server,omitempty"` // Host of the bridge, without credentials
+	LinkedAccounts    int32                  `protobuf:"varint,3,opt,name=linked_accounts,json=linkedAccounts,proto3" json:"linked_accounts,omitempty"`
+	AvailableAccounts *int32                 `protobuf:"varint,4,opt,name=available_accounts,json=availableAccounts,proto3,oneof" json:"available_accounts,omitempty"` // Accounts SimpleFIN returned, set when the connection was checked
+	Errors            []string               `protobuf:"bytes,5,rep,name=errors,proto3" json:"errors,omitempty"`                                                       // Reported by SimpleFIN when the connection was checked
+	Health            *SimplefinHealth       `protobuf:"bytes,6,opt,name=health,proto3" json:"health,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SimplefinConnection) Reset() {
+	*x = SimplefinConnection{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimplefinConnection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimplefinConnection) ProtoMessage() {}
+
+func (x *SimplefinConnection) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimplefinConnection.ProtoReflect.Descriptor instead.
+func (*SimplefinConnection) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SimplefinConnection) GetConnected() bool {
+	if x != nil {
+		return x.Connected
+	}
+	return false
+}
+
+func (x *SimplefinConnection) GetServer() string {
+	if x != nil {
+		return x.Server
+	}
+	return ""
+}
+
+func (x *SimplefinConnection) GetLinkedAccounts() int32 {
+	if x != nil {
+		return x.LinkedAccounts
+	}
+	return 0
+}
+
+func (x *SimplefinConnection) GetAvailableAccounts() int32 {
+	if x != nil && x.AvailableAccounts != nil {
+		return *x.AvailableAccounts
+	}
+	return 0
+}
+
+func (x *SimplefinConnection) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *SimplefinConnection) GetHealth() *SimplefinHealth {
+	if x != nil {
+		return x.Health
+	}
+	return nil
+}
+
+type ConnectSimplefinRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SetupToken    string                 `protobuf:"bytes,1,opt,name=setup_token,json=setupToken,proto3" json:"setup_token,omitempty"` // From the SimpleFIN bridge; can only be claimed once
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConnectSimplefinRequest) Reset() {
+	*x = ConnectSimplefinRequest{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConnectSimplefinRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectSimplefinRequest) ProtoMessage() {}
+
+func (x *ConnectSimplefinRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectSimplefinRequest.ProtoReflect.Descriptor instead.
+func (*ConnectSimplefinRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ConnectSimplefinRequest) GetSetupToken() string {
+	if x != nil {
+		return x.SetupToken
+	}
+	return ""
+}
+
+type ConnectSimplefinResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The claimed token is kept even when SimpleFIN can't list accounts yet;
+	// the connection's errors and health then say what went wrong
+	Connection    *SimplefinConnection `protobuf:"bytes,1,opt,name=connection,proto3" json:"connection,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConnectSimplefinResponse) Reset() {
+	*x = ConnectSimplefinResponse{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConnectSimplefinResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectSimplefinResponse) ProtoMessage() {}
+
+func (x *ConnectSimplefinResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectSimplefinResponse.ProtoReflect.Descriptor instead.
+func (*ConnectSimplefinResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ConnectSimplefinResponse) GetConnection() *SimplefinConnection {
+	if x != nil {
+		return x.Connection
+	}
+	return nil
+}
+
+type DisconnectSimplefinRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisconnectSimplefinRequest) Reset() {
+	*x = DisconnectSimplefinRequest{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisconnectSimplefinRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisconnectSimplefinRequest) ProtoMessage() {}
+
+func (x *DisconnectSimplefinRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisconnectSimplefinRequest.ProtoReflect.Descriptor instead.
+func (*DisconnectSimplefinRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{20}
+}
+
+type DisconnectSimplefinResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisconnectSimplefinResponse) Reset() {
+	*x = DisconnectSimplefinResponse{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisconnectSimplefinResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisconnectSimplefinResponse) ProtoMessage() {}
+
+func (x *DisconnectSimplefinResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisconnectSimplefinResponse.ProtoReflect.Descriptor instead.
+func (*DisconnectSimplefinResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DisconnectSimplefinResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetSimplefinConnectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Check         bool                   `protobuf:"varint,1,opt,name=check,proto3" json:"check,omitempty"` // Call SimpleFIN to verify the connection
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSimplefinConnectionRequest) Reset() {
+	*x = GetSimplefinConnectionRequest{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSimplefinConnectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSimplefinConnectionRequest) ProtoMessage() {}
+
+func (x *GetSimplefinConnectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSimplefinConnectionRequest.ProtoReflect.Descriptor instead.
+func (*GetSimplefinConnectionRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetSimplefinConnectionRequest) GetCheck() bool {
+	if x != nil {
+		return x.Check
+	}
+	return false
+}
+
+type GetSimplefinConnectionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Connection    *SimplefinConnection   `protobuf:"bytes,1,opt,name=connection,proto3" json:"connection,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSimplefinConnectionResponse) Reset() {
+	*x = GetSimplefinConnectionResponse{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSimplefinConnectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSimplefinConnectionResponse) ProtoMessage() {}
+
+func (x *GetSimplefinConnectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSimplefinConnectionResponse.ProtoReflect.Descriptor instead.
+func (*GetSimplefinConnectionResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetSimplefinConnectionResponse) GetConnection() *SimplefinConnection {
+	if x != nil {
+		return x.Connection
+	}
+	return nil
+}
+
+// A transaction stored for a linked account
+type AccountTransaction struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AccountId            int64                  `protobuf:"varint,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	PostedDate           string                 `protobuf:"bytes,3,opt,name=posted_date,json=postedDate,proto3" json:"posted_date,omitempty"` // YYYY-MM-DD
+	Description          string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Payee                string                 `protobuf:"bytes,5,opt,name=payee,proto3" json:"payee,omitempty"`
+	Amount               string                 `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"` // Exact decimal, negative for money leaving the account
+	MatchedExpenseId     *int64                 `protobuf:"varint,7,opt,name=matched_expense_id,json=matchedExpenseId,proto3,oneof" json:"matched_expense_id,omitempty"`
+	MatchedScheduledDate *string                `protobuf:"bytes,8,opt,name=matched_scheduled_date,json=matchedScheduledDate,proto3,oneof" json:"matched_scheduled_date,omitempty"` // YYYY-MM-DD
+	Pending              bool                   `protobuf:"varint,9,opt,name=pending,proto3" json:"pending,omitempty"`                                                              // Not yet posted by the bank
+	CategoryId           *int64                 `protobuf:"varint,10,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	Note                 *string                `protobuf:"bytes,11,opt,name=note,proto3,oneof" json:"note,omitempty"`
+	ExternalId           *string                `protobuf:"bytes,12,opt,name=external_id,json=externalId,proto3,oneof" json:"external_id,omitempty"`       // SimpleFIN transaction ID
+	TransactedAt         *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=transacted_at,json=transactedAt,proto3,oneof" json:"transacted_at,omitempty"` // When the purchase was made, if the bank reports it
+	DisplayPayee         *string                `protobuf:"bytes,14,opt,name=display_payee,json=displayPayee,proto3,oneof" json:"display_payee,omitempty"` // Shown instead of payee; set by a rule or by hand
+	IsTransfer           bool                   `protobuf:"varint,15,opt,name=is_transfer,json=isTransfer,proto3" json:"is_transfer,omitempty"`            // Money moved between the family's own accounts
+	// Category the family's classifier suggests; equals category_id when the
+	// suggestion was confident enough to be applied
+	SuggestedCategoryId  *int64   `protobuf:"varint,16,opt,name=suggested_category_id,json=suggestedCategoryId,proto3,oneof" json:"suggested_category_id,omitempty"`
+	SuggestionConfidence *float64 `protobuf:"fixed64,17,opt,name=suggestion_confidence,json=suggestionConfidence,proto3,oneof" json:"suggestion_confidence,omitempty"` // 0 to 1
+	// Allocations of a split transaction, which budgets and category filters use
+	// instead of the transaction. Empty unless the transaction is split.
+	Splits        []*TransactionSplit `protobuf:"bytes,18,rep,name=splits,proto3" json:"splits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccountTransaction) Reset() {
+	*x = AccountTransaction{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountTransaction) ProtoMessage() {}
+
+func (x *AccountTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountTransaction.ProtoReflect.Descriptor instead.
+func (*AccountTransaction) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *AccountTransaction) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AccountTransaction) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *AccountTransaction) GetPostedDate() string {
+	if x != nil {
+		return x.PostedDate
+	}
+	return ""
+}
+
+func (x *AccountTransaction) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *AccountTransaction) GetPayee() string {
+	if x != nil {
+		return x.Payee
+	}
+	return ""
+}
+
+func (x *AccountTransaction) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *AccountTransaction) GetMatchedExpenseId() int64 {
+	if x != nil && x.MatchedExpenseId != nil {
+		return *x.MatchedExpenseId
+	}
+	return 0
+}
+
+func (x *AccountTransaction) GetMatchedScheduledDate() string {
+	if x != nil && x.MatchedScheduledDate != nil {
+		return *x.MatchedScheduledDate
+	}
+	return ""
+}
+
+func (x *AccountTransaction) GetPending() bool {
+	if x != nil {
+		return x.Pending
+	}
+	return false
+}
+
+func (x *AccountTransaction) GetCategoryId() int64 {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return 0
+}
+
+func (x *AccountTransaction) GetNote() string {
+	if x != nil && x.Note != nil {
+		return *x.Note
+	}
+	return ""
+}
+
+func (x *AccountTransaction) GetExternalId() string {
+	if x != nil && x.ExternalId != nil {
+		return *x.ExternalId
+	}
+	return ""
+}
+
+func (x *AccountTransaction) GetTransactedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TransactedAt
+	}
+	return nil
+}
+
+func (x *AccountTransaction) GetDisplayPayee() string {
+	if x != nil && x.DisplayPayee != nil {
+		return *x.DisplayPayee
+	}
+	return ""
+}
+
+func (x *AccountTransaction) GetIsTransfer() bool {
+	if x != nil {
+		return x.IsTransfer
+	}
+	return false
+}
+
+func (x *AccountTransaction) GetSuggestedCategoryId() int64 {
+	if x != nil && x.SuggestedCategoryId != nil {
+		return *x.SuggestedCategoryId
+	}
+	return 0
+}
+
+func (x *AccountTransaction) GetSuggestionConfidence() float64 {
+	if x != nil && x.SuggestionConfidence != nil {
+		return *x.SuggestionConfidence
+	}
+	return 0
+}
+
+func (x *AccountTransaction) GetSplits() []*TransactionSplit {
+	if x != nil {
+		return x.Splits
+	}
+	return nil
+}
+
+// One allocation of a split transaction
+type TransactionSplit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Amount        string                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"` // Exact decimal, signed like the transaction
+	CategoryId    *int64                 `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	Note          *string                `protobuf:"bytes,4,opt,name=note,proto3,oneof" json:"note,omitempty"`
+	MemberId      *int64                 `protobuf:"varint,5,opt,name=member_id,json=memberId,proto3,oneof" json:"member_id,omitempty"` // Family member the allocation is for
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransactionSplit) Reset() {
+	*x = TransactionSplit{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionSplit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionSplit) ProtoMessage() {}
+
+func (x *TransactionSplit) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionSplit.ProtoReflect.Descriptor instead.
+func (*TransactionSplit) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *TransactionSplit) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TransactionSplit) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *TransactionSplit) GetCategoryId() int64 {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return 0
+}
+
+func (x *TransactionSplit) GetNote() string {
+	if x != nil && x.Note != nil {
+		return *x.Note
+	}
+	return ""
+}
+
+func (x *TransactionSplit) GetMemberId() int64 {
+	if x != nil && x.MemberId != nil {
+		return *x.MemberId
+	}
+	return 0
+}
+
+type ListTransactionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     *int64                 `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3,oneof" json:"account_id,omitempty"`
+	StartDate     *string                `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3,oneof" json:"start_date,omitempty"` // YYYY-MM-DD, inclusive
+	EndDate       *string                `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3,oneof" json:"end_date,omitempty"`       // YYYY-MM-DD, inclusive
+	MinAmount     *string                `protobuf:"bytes,4,opt,name=min_amount,json=minAmount,proto3,oneof" json:"min_amount,omitempty"` // Exact decimal, inclusive
+	MaxAmount     *string                `protobuf:"bytes,5,opt,name=max_amount,json=maxAmount,proto3,oneof" json:"max_amount,omitempty"` // Exact decimal, inclusive
+	Pending       *bool                  `protobuf:"varint,6,opt,name=pending,proto3,oneof" json:"pending,omitempty"`
+	CategoryId    *int64                 `protobuf:"varint,7,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	Query         string                 `protobuf:"bytes,8,opt,name=query,proto3" json:"query,omitempty"` // Case-insensitive match on description, payee or note
+	PageSize      int32                  `protobuf:"varint,9,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,10,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTransactionsRequest) Reset() {
+	*x = ListTransactionsRequest{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransactionsRequest) ProtoMessage() {}
+
+func (x *ListTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*ListTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListTransactionsRequest) GetAccountId() int64 {
+	if x != nil && x.AccountId != nil {
+		return *x.AccountId
+	}
+	return 0
+}
+
+func (x *ListTransactionsRequest) GetStartDate() string {
+	if x != nil && x.StartDate != nil {
+		return *x.StartDate
+	}
+	return ""
+}
+
+func (x *ListTransactionsRequest) GetEndDate() string {
+	if x != nil && x.EndDate != nil {
+		return *x.EndDate
+	}
+	return ""
+}
+
+func (x *ListTransactionsRequest) GetMinAmount() string {
+	if x != nil && x.MinAmount != nil {
+		return *x.MinAmount
+	}
+	return ""
+}
+
+func (x *ListTransactionsRequest) GetMaxAmount() string {
+	if x != nil && x.MaxAmount != nil {
+		return *x.MaxAmount
+	}
+	return ""
+}
+
+func (x *ListTransactionsRequest) GetPending() bool {
+	if x != nil && x.Pending != nil {
+		return *x.Pending
+	}
+	return false
+}
+
+func (x *ListTransactionsRequest) GetCategoryId() int64 {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return 0
+}
+
+func (x *ListTransactionsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *ListTransactionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTransactionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListTransactionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transactions  []*AccountTransaction  `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"` // Newest first
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    int64                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTransactionsResponse) Reset() {
+	*x = ListTransactionsResponse{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransactionsResponse) ProtoMessage() {}
+
+func (x *ListTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*ListTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListTransactionsResponse) GetTransactions() []*AccountTransaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+func (x *ListTransactionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListTransactionsResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type GetTransactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransactionRequest) Reset() {
+	*x = GetTransactionRequest{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionRequest) ProtoMessage() {}
+
+func (x *GetTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionRequest.ProtoReflect.Descriptor instead.
+func (*GetTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetTransactionRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetTransactionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transaction   *AccountTransaction    `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransactionResponse) Reset() {
+	*x = GetTransactionResponse{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionResponse) ProtoMessage() {}
+
+func (x *GetTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionResponse.ProtoReflect.Descriptor instead.
+func (*GetTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetTransactionResponse) GetTransaction() *AccountTransaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+type UpdateTransactionRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CategoryId           *int64                 `protobuf:"varint,2,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`                                // 0 clears the category
+	Note                 *string                `protobuf:"bytes,3,opt,name=note,proto3,oneof" json:"note,omitempty"`                                                               // Empty clears the note
+	MatchedExpenseId     *int64                 `protobuf:"varint,4,opt,name=matched_expense_id,json=matchedExpenseId,proto3,oneof" json:"matched_expense_id,omitempty"`            // 0 unlinks the matched expense
+	MatchedScheduledDate *string                `protobuf:"bytes,5,opt,name=matched_scheduled_date,json=matchedScheduledDate,proto3,oneof" json:"matched_scheduled_date,omitempty"` // YYYY-MM-DD, the occurrence to link; required with matched_expense_id
+	DisplayPayee         *string                `protobuf:"bytes,6,opt,name=display_payee,json=displayPayee,proto3,oneof" json:"display_payee,omitempty"`                           // Empty shows the bank's payee again
+	IsTransfer           *bool                  `protobuf:"varint,7,opt,name=is_transfer,json=isTransfer,proto3,oneof" json:"is_transfer,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *UpdateTransactionRequest) Reset() {
+	*x = UpdateTransactionRequest{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTransactionRequest) ProtoMessage() {}
+
+func (x *UpdateTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTransactionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *UpdateTransactionRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateTransactionRequest) GetCategoryId() int64 {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return 0
+}
+
+func (x *UpdateTransactionRequest) GetNote() string {
+	if x != nil && x.Note != nil {
+		return *x.Note
+	}
+	return ""
+}
+
+func (x *UpdateTransactionRequest) GetMatchedExpenseId() int64 {
+	if x != nil && x.MatchedExpenseId != nil {
+		return *x.MatchedExpenseId
+	}
+	return 0
+}
+
+func (x *UpdateTransactionRequest) GetMatchedScheduledDate() string {
+	if x != nil && x.MatchedScheduledDate != nil {
+		return *x.MatchedScheduledDate
+	}
+	return ""
+}
+
+func (x *UpdateTransactionRequest) GetDisplayPayee() string {
+	if x != nil && x.DisplayPayee != nil {
+		return *x.DisplayPayee
+	}
+	return ""
+}
+
+func (x *UpdateTransactionRequest) GetIsTransfer() bool {
+	if x != nil && x.IsTransfer != nil {
+		return *x.IsTransfer
+	}
+	return false
+}
+
+type UpdateTransactionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transaction   *AccountTransaction    `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTransactionResponse) Reset() {
+	*x = UpdateTransactionResponse{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTransactionResponse) ProtoMessage() {}
+
+func (x *UpdateTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTransactionResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *UpdateTransactionResponse) GetTransaction() *AccountTransaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+// Replaces the allocations of a transaction. There must be at least two, and
+// their amounts must add up to the transaction amount. Synced changes to the
+// amount remove the allocations.
+type SetTransactionSplitsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId int64                  `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Splits        []*TransactionSplit    `protobuf:"bytes,2,rep,name=splits,proto3" json:"splits,omitempty"` // ids are ignored; empty removes the split
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTransactionSplitsRequest) Reset() {
+	*x = SetTransactionSplitsRequest{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTransactionSplitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTransactionSplitsRequest) ProtoMessage() {}
+
+func (x *SetTransactionSplitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTransactionSplitsRequest.ProtoReflect.Descriptor instead.
+func (*SetTransactionSplitsRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SetTransactionSplitsRequest) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *SetTransactionSplitsRequest) GetSplits() []*TransactionSplit {
+	if x != nil {
+		return x.Splits
+	}
+	return nil
+}
+
+type SetTransactionSplitsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transaction   *AccountTransaction    `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTransactionSplitsResponse) Reset() {
+	*x = SetTransactionSplitsResponse{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTransactionSplitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTransactionSplitsResponse) ProtoMessage() {}
+
+func (x *SetTransactionSplitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTransactionSplitsResponse.ProtoReflect.Descriptor instead.
+func (*SetTransactionSplitsResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SetTransactionSplitsResponse) GetTransaction() *AccountTransaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+// A low-confidence match waiting for someone to accept or reject it
+type MatchReview struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Transaction   *AccountTransaction    `protobuf:"bytes,2,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	ExpenseId     int64                  `protobuf:"varint,3,opt,name=expense_id,json=expenseId,proto3" json:"expense_id,omitempty"`
+	ExpenseName   string                 `protobuf:"bytes,4,opt,name=expense_name,json=expenseName,proto3" json:"expense_name,omitempty"`
+	ExpenseAmount float64                `protobuf:"fixed64,5,opt,name=expense_amount,json=expenseAmount,proto3" json:"expense_amount,omitempty"`
+	ScheduledDate string                 `protobuf:"bytes,6,opt,name=scheduled_date,json=scheduledDate,proto3" json:"scheduled_date,omitempty"` // YYYY-MM-DD, the expense occurrence
+	Score         float64                `protobuf:"fixed64,7,opt,name=score,proto3" json:"score,omitempty"`                                    // 0 to 1
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MatchReview) Reset() {
+	*x = MatchReview{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchReview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchReview) ProtoMessage() {}
+
+func (x *MatchReview) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchReview.ProtoReflect.Descriptor instead.
+func (*MatchReview) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *MatchReview) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MatchReview) GetTransaction() *AccountTransaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+func (x *MatchReview) GetExpenseId() int64 {
+	if x != nil {
+		return x.ExpenseId
+	}
+	return 0
+}
+
+func (x *MatchReview) GetExpenseName() string {
+	if x != nil {
+		return x.ExpenseName
+	}
+	return ""
+}
+
+func (x *MatchReview) GetExpenseAmount() float64 {
+	if x != nil {
+		return x.ExpenseAmount
+	}
+	return 0
+}
+
+func (x *MatchReview) GetScheduledDate() string {
+	if x != nil {
+		return x.ScheduledDate
+	}
+	return ""
+}
+
+func (x *MatchReview) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type MatchTransactionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// YYYY-MM-DD, only transactions posted on or after this date are matched;
+	// defaults to 60 days ago
+	SinceDate     *string `protobuf:"bytes,1,opt,name=since_date,json=sinceDate,proto3,oneof" json:"since_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MatchTransactionsRequest) Reset() {
+	*x = MatchTransactionsRequest{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchTransactionsRequest) ProtoMessage() {}
+
+func (x *MatchTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*MatchTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *MatchTransactionsRequest) GetSinceDate() string {
+	if x != nil && x.SinceDate != nil {
+		return *x.SinceDate
+	}
+	return ""
+}
+
+type MatchTransactionsResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Matched         int32                  `protobuf:"varint,1,opt,name=matched,proto3" json:"matched,omitempty"` // Confident matches marked paid
+	QueuedForReview int32                  `protobuf:"varint,2,opt,name=queued_for_review,json=queuedForReview,proto3" json:"queued_for_review,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MatchTransactionsResponse) Reset() {
+	*x = MatchTransactionsResponse{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchTransactionsResponse) ProtoMessage() {}
+
+func (x *MatchTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*MatchTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *MatchTransactionsResponse) GetMatched() int32 {
+	if x != nil {
+		return x.Matched
+	}
+	return 0
+}
+
+func (x *MatchTransactionsResponse) GetQueuedForReview() int32 {
+	if x != nil {
+		return x.QueuedForReview
+	}
+	return 0
+}
+
+type ListMatchReviewsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMatchReviewsRequest) Reset() {
+	*x = ListMatchReviewsRequest{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMatchReviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMatchReviewsRequest) ProtoMessage() {}
+
+func (x *ListMatchReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMatchReviewsRequest.ProtoReflect.Descriptor instead.
+func (*ListMatchReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListMatchReviewsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMatchReviewsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListMatchReviewsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reviews       []*MatchReview         `protobuf:"bytes,1,rep,name=reviews,proto3" json:"reviews,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    int64                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMatchReviewsResponse) Reset() {
+	*x = ListMatchReviewsResponse{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMatchReviewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMatchReviewsResponse) ProtoMessage() {}
+
+func (x *ListMatchReviewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMatchReviewsResponse.ProtoReflect.Descriptor instead.
+func (*ListMatchReviewsResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListMatchReviewsResponse) GetReviews() []*MatchReview {
+	if x != nil {
+		return x.Reviews
+	}
+	return nil
+}
+
+func (x *ListMatchReviewsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListMatchReviewsResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type ResolveMatchReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Accept        bool                   `protobuf:"varint,2,opt,name=accept,proto3" json:"accept,omitempty"` // Accept marks the occurrence paid and links the transaction
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveMatchReviewRequest) Reset() {
+	*x = ResolveMatchReviewRequest{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveMatchReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveMatchReviewRequest) ProtoMessage() {}
+
+func (x *ResolveMatchReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveMatchReviewRequest.ProtoReflect.Descriptor instead.
+func (*ResolveMatchReviewRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ResolveMatchReviewRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ResolveMatchReviewRequest) GetAccept() bool {
+	if x != nil {
+		return x.Accept
+	}
+	return false
+}
+
+type ResolveMatchReviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveMatchReviewResponse) Reset() {
+	*x = ResolveMatchReviewResponse{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveMatchReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveMatchReviewResponse) ProtoMessage() {}
+
+func (x *ResolveMatchReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveMatchReviewResponse.ProtoReflect.Descriptor instead.
+func (*ResolveMatchReviewResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ResolveMatchReviewResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Sync progress of a single linked account
+type AccountSyncStatus struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	AccountId         int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	AccountName       string                 `protobuf:"bytes,2,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	Cursor            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"` // Transactions up to this time have been fetched
+	LastAttemptAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_attempt_at,json=lastAttemptAt,proto3,oneof" json:"last_attempt_at,omitempty"`
+	LastSuccessAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_success_at,json=lastSuccessAt,proto3,oneof" json:"last_success_at,omitempty"`
+	LastError         *string                `protobuf:"bytes,6,opt,name=last_error,json=lastError,proto3,oneof" json:"last_error,omitempty"`
+	TransactionsAdded int32                  `protobuf:"varint,7,opt,name=transactions_added,json=transactionsAdded,proto3" json:"transactions_added,omitempty"` // New transactions in the last successful sync
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *AccountSyncStatus) Reset() {
+	*x = AccountSyncStatus{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountSyncStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountSyncStatus) ProtoMessage() {}
+
+func (x *AccountSyncStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountSyncStatus.ProtoReflect.Descriptor instead.
+func (*AccountSyncStatus) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *AccountSyncStatus) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *AccountSyncStatus) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *AccountSyncStatus) GetCursor() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Cursor
+	}
+	return nil
+}
+
+func (x *AccountSyncStatus) GetLastAttemptAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastAttemptAt
+	}
+	return nil
+}
+
+func (x *AccountSyncStatus) GetLastSuccessAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSuccessAt
+	}
+	return nil
+}
+
+func (x *AccountSyncStatus) GetLastError() string {
+	if x != nil && x.LastError != nil {
+		return *x.LastError
+	}
+	return ""
+}
+
+func (x *AccountSyncStatus) GetTransactionsAdded() int32 {
+	if x != nil {
+		return x.TransactionsAdded
+	}
+	return 0
+}
+
+// An error reported by SimpleFIN or raised while syncing
+type SyncError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AccountId     *int64                 `protobuf:"varint,2,opt,name=account_id,json=accountId,proto3,oneof" json:"account_id,omitempty"` // Unset for connection-wide errors
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncError) Reset() {
+	*x = SyncError{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncError) ProtoMessage() {}
+
+func (x *SyncError) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncError.ProtoReflect.Descriptor instead.
+func (*SyncError) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SyncError) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SyncError) GetAccountId() int64 {
+	if x != nil && x.AccountId != nil {
+		return *x.AccountId
+	}
+	return 0
+}
+
+func (x *SyncError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SyncError) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type SyncStatus struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Running        bool                   `protobuf:"varint,1,opt,name=running,proto3" json:"running,omitempty"`
+	LastStartedAt  *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=last_started_at,json=lastStartedAt,proto3,oneof" json:"last_started_at,omitempty"` // Since the server started
+	LastFinishedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_finished_at,json=lastFinishedAt,proto3,oneof" json:"last_finished_at,omitempty"`
+	Accounts       []*AccountSyncStatus   `protobuf:"bytes,4,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	RecentErrors   []*SyncError           `protobuf:"bytes,5,rep,name=recent_errors,json=recentErrors,proto3" json:"recent_errors,omitempty"` // Newest first
+	Health         *SimplefinHealth       `protobuf:"bytes,6,opt,name=health,proto3" json:"health,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SyncStatus) Reset() {
+	*x = SyncStatus{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncStatus) ProtoMessage() {}
+
+func (x *SyncStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncStatus.ProtoReflect.Descriptor instead.
+func (*SyncStatus) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *SyncStatus) GetRunning() bool {
+	if x != nil {
+		return x.Running
+	}
+	return false
+}
+
+func (x *SyncStatus) GetLastStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastStartedAt
+	}
+	return nil
+}
+
+func (x *SyncStatus) GetLastFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastFinishedAt
+	}
+	return nil
+}
+
+func (x *SyncStatus) GetAccounts() []*AccountSyncStatus {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+func (x *SyncStatus) GetRecentErrors() []*SyncError {
+	if x != nil {
+		return x.RecentErrors
+	}
+	return nil
+}
+
+func (x *SyncStatus) GetHealth() *SimplefinHealth {
+	if x != nil {
+		return x.Health
+	}
+	return nil
+}
+
+type SyncNowRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncNowRequest) Reset() {
+	*x = SyncNowRequest{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncNowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncNowRequest) ProtoMessage() {}
+
+func (x *SyncNowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncNowRequest.ProtoReflect.Descriptor instead.
+func (*SyncNowRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{44}
+}
+
+type SyncNowResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Started       bool                   `protobuf:"varint,1,opt,name=started,proto3" json:"started,omitempty"` // False when a sync of the family was already running
+	Status        *SyncStatus            `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncNowResponse) Reset() {
+	*x = SyncNowResponse{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncNowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncNowResponse) ProtoMessage() {}
+
+func (x *SyncNowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncNowResponse.ProtoReflect.Descriptor instead.
+func (*SyncNowResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SyncNowResponse) GetStarted() bool {
+	if x != nil {
+		return x.Started
+	}
+	return false
+}
+
+func (x *SyncNowResponse) GetStatus() *SyncStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type GetSyncStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSyncStatusRequest) Reset() {
+	*x = GetSyncStatusRequest{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSyncStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSyncStatusRequest) ProtoMessage() {}
+
+func (x *GetSyncStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSyncStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetSyncStatusRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{46}
+}
+
+type GetSyncStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *SyncStatus            `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSyncStatusResponse) Reset() {
+	*x = GetSyncStatusResponse{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSyncStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSyncStatusResponse) ProtoMessage() {}
+
+func (x *GetSyncStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSyncStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetSyncStatusResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetSyncStatusResponse) GetStatus() *SyncStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// An account's balance at the end of a period. Balances carry forward over
+// days the account was not synced.
+type BalancePoint struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Date             string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`             // YYYY-MM-DD, the last day of the period or the end date
+	Balance          *string                `protobuf:"bytes,2,opt,name=balance,proto3,oneof" json:"balance,omitempty"` // Exact decimal as reported by the bank; unset before the first snapshot
+	AvailableBalance *string                `protobuf:"bytes,3,opt,name=available_balance,json=availableBalance,proto3,oneof" json:"available_balance,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *BalancePoint) Reset() {
+	*x = BalancePoint{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BalancePoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BalancePoint) ProtoMessage() {}
+
+func (x *BalancePoint) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BalancePoint.ProtoReflect.Descriptor instead.
+func (*BalancePoint) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *BalancePoint) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *BalancePoint) GetBalance() string {
+	if x != nil && x.Balance != nil {
+		return *x.Balance
+	}
+	return ""
+}
+
+func (x *BalancePoint) GetAvailableBalance() string {
+	if x != nil && x.AvailableBalance != nil {
+		return *x.AvailableBalance
+	}
+	return ""
+}
+
+type AccountBalanceSeries struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Points        []*BalancePoint        `protobuf:"bytes,2,rep,name=points,proto3" json:"points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccountBalanceSeries) Reset() {
+	*x = AccountBalanceSeries{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountBalanceSeries) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountBalanceSeries) ProtoMessage() {}
+
+func (x *AccountBalanceSeries) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountBalanceSeries.ProtoReflect.Descriptor instead.
+func (*AccountBalanceSeries) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *AccountBalanceSeries) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *AccountBalanceSeries) GetPoints() []*BalancePoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+type GetAccountBalancesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountIds    []int64                `protobuf:"varint,1,rep,packed,name=account_ids,json=accountIds,proto3" json:"account_ids,omitempty"` // All accounts when empty
+	StartDate     *string                `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3,oneof" json:"start_date,omitempty"`      // YYYY-MM-DD, defaults to a year before end_date
+	EndDate       *string                `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3,oneof" json:"end_date,omitempty"`            // YYYY-MM-DD, defaults to today
+	Granularity   Granularity            `protobuf:"varint,4,opt,name=granularity,proto3,enum=transaction.v1.Granularity" json:"granularity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountBalancesRequest) Reset() {
+	*x = GetAccountBalancesRequest{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountBalancesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountBalancesRequest) ProtoMessage() {}
+
+func (x *GetAccountBalancesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountBalancesRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountBalancesRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetAccountBalancesRequest) GetAccountIds() []int64 {
+	if x != nil {
+		return x.AccountIds
+	}
+	return nil
+}
+
+func (x *GetAccountBalancesRequest) GetStartDate() string {
+	if x != nil && x.StartDate != nil {
+		return *x.StartDate
+	}
+	return ""
+}
+
+func (x *GetAccountBalancesRequest) GetEndDate() string {
+	if x != nil && x.EndDate != nil {
+		return *x.EndDate
+	}
+	return ""
+}
+
+func (x *GetAccountBalancesRequest) GetGranularity() Granularity {
+	if x != nil {
+		return x.Granularity
+	}
+	return Granularity_GRANULARITY_UNSPECIFIED
+}
+
+type GetAccountBalancesResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Accounts      []*AccountBalanceSeries `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountBalancesResponse) Reset() {
+	*x = GetAccountBalancesResponse{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountBalancesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountBalancesResponse) ProtoMessage() {}
+
+func (x *GetAccountBalancesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountBalancesResponse.ProtoReflect.Descriptor instead.
+func (*GetAccountBalancesResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetAccountBalancesResponse) GetAccounts() []*AccountBalanceSeries {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+type NetWorthPoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`                         // YYYY-MM-DD, the last day of the period or the end date
+	Assets        string                 `protobuf:"bytes,2,opt,name=assets,proto3" json:"assets,omitempty"`                     // Exact decimal
+	Liabilities   string                 `protobuf:"bytes,3,opt,name=liabilities,proto3" json:"liabilities,omitempty"`           // Amount owed, as a positive decimal
+	NetWorth      string                 `protobuf:"bytes,4,opt,name=net_worth,json=netWorth,proto3" json:"net_worth,omitempty"` // assets - liabilities
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NetWorthPoint) Reset() {
+	*x = NetWorthPoint{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetWorthPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetWorthPoint) ProtoMessage() {}
+
+func (x *NetWorthPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetWorthPoint.ProtoReflect.Descriptor instead.
+func (*NetWorthPoint) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *NetWorthPoint) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *NetWorthPoint) GetAssets() string {
+	if x != nil {
+		return x.Assets
+	}
+	return ""
+}
+
+func (x *NetWorthPoint) GetLiabilities() string {
+	if x != nil {
+		return x.Liabilities
+	}
+	return ""
+}
+
+func (x *NetWorthPoint) GetNetWorth() string {
+	if x != nil {
+		return x.NetWorth
+	}
+	return ""
+}
+
+type GetNetWorthHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartDate     *string                `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3,oneof" json:"start_date,omitempty"` // YYYY-MM-DD, defaults to a year before end_date
+	EndDate       *string                `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3,oneof" json:"end_date,omitempty"`       // YYYY-MM-DD, defaults to today
+	Granularity   Granularity            `protobuf:"varint,3,opt,name=granularity,proto3,enum=transaction.v1.Granularity" json:"granularity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNetWorthHistoryRequest) Reset() {
+	*x = GetNetWorthHistoryRequest{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNetWorthHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNetWorthHistoryRequest) ProtoMessage() {}
+
+func (x *GetNetWorthHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNetWorthHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetNetWorthHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetNetWorthHistoryRequest) GetStartDate() string {
+	if x != nil && x.StartDate != nil {
+		return *x.StartDate
+	}
+	return ""
+}
+
+func (x *GetNetWorthHistoryRequest) GetEndDate() string {
+	if x != nil && x.EndDate != nil {
+		return *x.EndDate
+	}
+	return ""
+}
+
+func (x *GetNetWorthHistoryRequest) GetGranularity() Granularity {
+	if x != nil {
+		return x.Granularity
+	}
+	return Granularity_GRANULARITY_UNSPECIFIED
+}
+
+type GetNetWorthHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Points        []*NetWorthPoint       `protobuf:"bytes,1,rep,name=points,proto3" json:"points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNetWorthHistoryResponse) Reset() {
+	*x = GetNetWorthHistoryResponse{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNetWorthHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNetWorthHistoryResponse) ProtoMessage() {}
+
+func (x *GetNetWorthHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNetWorthHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetNetWorthHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetNetWorthHistoryResponse) GetPoints() []*NetWorthPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+// A rule that acts on the transactions it matches. Every condition that is
+// set must hold, and a rule needs at least one condition and one action.
+// Rules run in ascending position; each action comes from the first matching
+// rule that sets it.
+type TransactionRule struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name     string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Position int32                  `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
+	IsActive bool                   `protobuf:"varint,4,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	// Conditions
+	MatchField RuleMatchField `protobuf:"varint,5,opt,name=match_field,json=matchField,proto3,enum=transaction.v1.RuleMatchField" json:"match_field,omitempty"`
+	MatchType  RuleMatchType  `protobuf:"varint,6,opt,name=match_type,json=matchType,proto3,enum=transaction.v1.RuleMatchType" json:"match_type,omitempty"`
+	Pattern    string         `protobuf:"bytes,7,opt,name=pattern,proto3" json:"pattern,omitempty"`                            // Empty matches any text
+	MinAmount  *string        `protobuf:"bytes,8,opt,name=min_amount,json=minAmount,proto3,oneof" json:"min_amount,omitempty"` // Exact decimal, inclusive; negative for money leaving the account
+	MaxAmount  *string        `protobuf:"bytes,9,opt,name=max_amount,json=maxAmount,proto3,oneof" json:"max_amount,omitempty"`
+	AccountId  *int64         `protobuf:"varint,10,opt,name=account_id,json=accountId,proto3,oneof" json:"account_id,omitempty"`
+	// Actions
+	SetCategoryId *int64                 `protobuf:"varint,11,opt,name=set_category_id,json=setCategoryId,proto3,oneof" json:"set_category_id,omitempty"`
+	SetPayee      *string                `protobuf:"bytes,12,opt,name=set_payee,json=setPayee,proto3,oneof" json:"set_payee,omitempty"`                   // Becomes the transaction's display_payee
+	LinkExpenseId *int64                 `protobuf:"varint,13,opt,name=link_expense_id,json=linkExpenseId,proto3,oneof" json:"link_expense_id,omitempty"` // Links the occurrence of the expense nearest the posted date
+	MarkTransfer  bool                   `protobuf:"varint,14,opt,name=mark_transfer,json=markTransfer,proto3" json:"mark_transfer,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransactionRule) Reset() {
+	*x = TransactionRule{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionRule) ProtoMessage() {}
+
+func (x *TransactionRule) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionRule.ProtoReflect.Descriptor instead.
+func (*TransactionRule) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *TransactionRule) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TransactionRule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TransactionRule) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *TransactionRule) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *TransactionRule) GetMatchField() RuleMatchField {
+	if x != nil {
+		return x.MatchField
+	}
+	return RuleMatchField_RULE_MATCH_FIELD_UNSPECIFIED
+}
+
+func (x *TransactionRule) GetMatchType() RuleMatchType {
+	if x != nil {
+		return x.MatchType
+	}
+	return RuleMatchType_RULE_MATCH_TYPE_UNSPECIFIED
+}
+
+func (x *TransactionRule) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *TransactionRule) GetMinAmount() string {
+	if x != nil && x.MinAmount != nil {
+		return *x.MinAmount
+	}
+	return ""
+}
+
+func (x *TransactionRule) GetMaxAmount() string {
+	if x != nil && x.MaxAmount != nil {
+		return *x.MaxAmount
+	}
+	return ""
+}
+
+func (x *TransactionRule) GetAccountId() int64 {
+	if x != nil && x.AccountId != nil {
+		return *x.AccountId
+	}
+	return 0
+}
+
+func (x *TransactionRule) GetSetCategoryId() int64 {
+	if x != nil && x.SetCategoryId != nil {
+		return *x.SetCategoryId
+	}
+	return 0
+}
+
+func (x *TransactionRule) GetSetPayee() string {
+	if x != nil && x.SetPayee != nil {
+		return *x.SetPayee
+	}
+	return ""
+}
+
+func (x *TransactionRule) GetLinkExpenseId() int64 {
+	if x != nil && x.LinkExpenseId != nil {
+		return *x.LinkExpenseId
+	}
+	return 0
+}
+
+func (x *TransactionRule) GetMarkTransfer() bool {
+	if x != nil {
+		return x.MarkTransfer
+	}
+	return false
+}
+
+func (x *TransactionRule) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TransactionRule) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *TransactionRule       `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"` // id and timestamps are ignored
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRuleRequest) Reset() {
+	*x = CreateRuleRequest{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRuleRequest) ProtoMessage() {}
+
+func (x *CreateRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreateRuleRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *CreateRuleRequest) GetRule() *TransactionRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type CreateRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *TransactionRule       `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRuleResponse) Reset() {
+	*x = CreateRuleResponse{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRuleResponse) ProtoMessage() {}
+
+func (x *CreateRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRuleResponse.ProtoReflect.Descriptor instead.
+func (*CreateRuleResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *CreateRuleResponse) GetRule() *TransactionRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+// Replaces every field of the rule
+type UpdateRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *TransactionRule       `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRuleRequest) Reset() {
+	*x = UpdateRuleRequest{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRuleRequest) ProtoMessage() {}
+
+func (x *UpdateRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRuleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRuleRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *UpdateRuleRequest) GetRule() *TransactionRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type UpdateRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *TransactionRule       `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRuleResponse) Reset() {
+	*x = UpdateRuleResponse{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRuleResponse) ProtoMessage() {}
+
+func (x *UpdateRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRuleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateRuleResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *UpdateRuleResponse) GetRule() *TransactionRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type DeleteRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRuleRequest) Reset() {
+	*x = DeleteRuleRequest{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRuleRequest) ProtoMessage() {}
+
+func (x *DeleteRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRuleRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *DeleteRuleRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRuleResponse) Reset() {
+	*x = DeleteRuleResponse{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRuleResponse) ProtoMessage() {}
+
+func (x *DeleteRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRuleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRuleResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *DeleteRuleResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRulesRequest) Reset() {
+	*x = ListRulesRequest{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRulesRequest) ProtoMessage() {}
+
+func (x *ListRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListRulesRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{62}
+}
+
+type ListRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*TransactionRule     `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"` // In the order they run
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRulesResponse) Reset() {
+	*x = ListRulesResponse{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRulesResponse) ProtoMessage() {}
+
+func (x *ListRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListRulesResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ListRulesResponse) GetRules() []*TransactionRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+// Runs the active rules over stored transactions. Rules only fill in a
+// category or display payee that is not set yet unless overwrite is set, and
+// never replace an existing expense link.
+type ApplyRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartDate     *string                `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3,oneof" json:"start_date,omitempty"` // YYYY-MM-DD, inclusive
+	EndDate       *string                `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3,oneof" json:"end_date,omitempty"`       // YYYY-MM-DD, inclusive
+	Overwrite     bool                   `protobuf:"varint,3,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyRulesRequest) Reset() {
+	*x = ApplyRulesRequest{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyRulesRequest) ProtoMessage() {}
+
+func (x *ApplyRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyRulesRequest.ProtoReflect.Descriptor instead.
+func (*ApplyRulesRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ApplyRulesRequest) GetStartDate() string {
+	if x != nil && x.StartDate != nil {
+		return *x.StartDate
+	}
+	return ""
+}
+
+func (x *ApplyRulesRequest) GetEndDate() string {
+	if x != nil && x.EndDate != nil {
+		return *x.EndDate
+	}
+	return ""
+}
+
+func (x *ApplyRulesRequest) GetOverwrite() bool {
+	if x != nil {
+		return x.Overwrite
+	}
+	return false
+}
+
+type ApplyRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Matched       int32                  `protobuf:"varint,1,opt,name=matched,proto3" json:"matched,omitempty"` // Transactions at least one rule matched
+	Updated       int32                  `protobuf:"varint,2,opt,name=updated,proto3" json:"updated,omitempty"` // Transactions the rules changed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyRulesResponse) Reset() {
+	*x = ApplyRulesResponse{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyRulesResponse) ProtoMessage() {}
+
+func (x *ApplyRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyRulesResponse.ProtoReflect.Descriptor instead.
+func (*ApplyRulesResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ApplyRulesResponse) GetMatched() int32 {
+	if x != nil {
+		return x.Matched
+	}
+	return 0
+}
+
+func (x *ApplyRulesResponse) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+// Shows what a rule would change without saving anything. The rule does not
+// need to be saved, and runs alone rather than with the family's other rules.
+type PreviewRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *TransactionRule       `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	StartDate     *string                `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3,oneof" json:"start_date,omitempty"` // YYYY-MM-DD, inclusive
+	EndDate       *string                `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3,oneof" json:"end_date,omitempty"`       // YYYY-MM-DD, inclusive
+	Overwrite     bool                   `protobuf:"varint,4,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
+	Limit         int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"` // Changes to return; defaults to 50, at most 500
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewRuleRequest) Reset() {
+	*x = PreviewRuleRequest{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewRuleRequest) ProtoMessage() {}
+
+func (x *PreviewRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewRuleRequest.ProtoReflect.Descriptor instead.
+func (*PreviewRuleRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *PreviewRuleRequest) GetRule() *TransactionRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+func (x *PreviewRuleRequest) GetStartDate() string {
+	if x != nil && x.StartDate != nil {
+		return *x.StartDate
+	}
+	return ""
+}
+
+func (x *PreviewRuleRequest) GetEndDate() string {
+	if x != nil && x.EndDate != nil {
+		return *x.EndDate
+	}
+	return ""
+}
+
+func (x *PreviewRuleRequest) GetOverwrite() bool {
+	if x != nil {
+		return x.Overwrite
+	}
+	return false
+}
+
+func (x *PreviewRuleRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type RuleChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transaction   *AccountTransaction    `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"` // As stored
+	Updated       *AccountTransaction    `protobuf:"bytes,2,opt,name=updated,proto3" json:"updated,omitempty"`         // With the rule applied
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RuleChange) Reset() {
+	*x = RuleChange{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RuleChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuleChange) ProtoMessage() {}
+
+func (x *RuleChange) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuleChange.ProtoReflect.Descriptor instead.
+func (*RuleChange) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *RuleChange) GetTransaction() *AccountTransaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+func (x *RuleChange) GetUpdated() *AccountTransaction {
+	if x != nil {
+		return x.Updated
+	}
+	return nil
+}
+
+type PreviewRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Changes       []*RuleChange          `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`  // Newest first
+	Matched       int32                  `protobuf:"varint,2,opt,name=matched,proto3" json:"matched,omitempty"` // Transactions the rule matches
+	Changed       int32                  `protobuf:"varint,3,opt,name=changed,proto3" json:"changed,omitempty"` // Matched transactions the rule would change
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewRuleResponse) Reset() {
+	*x = PreviewRuleResponse{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewRuleResponse) ProtoMessage() {}
+
+func (x *PreviewRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewRuleResponse.ProtoReflect.Descriptor instead.
+func (*PreviewRuleResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *PreviewRuleResponse) GetChanges() []*RuleChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *PreviewRuleResponse) GetMatched() int32 {
+	if x != nil {
+		return x.Matched
+	}
+	return 0
+}
+
+func (x *PreviewRuleResponse) GetChanged() int32 {
+	if x != nil {
+		return x.Changed
+	}
+	return 0
+}
+
+// Suggests categories for uncategorized transactions from the family's
+// categorized ones. Suggestions with a confidence at or above the family
+// setting category_auto_apply_threshold (default 0.9) are applied; the others
+// are stored on the transaction for someone to accept. Synced transactions
+// get suggestions automatically, after rules run.
+type SuggestCategoriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartDate     *string                `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3,oneof" json:"start_date,omitempty"` // YYYY-MM-DD, inclusive
+	EndDate       *string                `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3,oneof" json:"end_date,omitempty"`       // YYYY-MM-DD, inclusive
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestCategoriesRequest) Reset() {
+	*x = SuggestCategoriesRequest{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestCategoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestCategoriesRequest) ProtoMessage() {}
+
+func (x *SuggestCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*SuggestCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *SuggestCategoriesRequest) GetStartDate() string {
+	if x != nil && x.StartDate != nil {
+		return *x.StartDate
+	}
+	return ""
+}
+
+func (x *SuggestCategoriesRequest) GetEndDate() string {
+	if x != nil && x.EndDate != nil {
+		return *x.EndDate
+	}
+	return ""
+}
+
+type SuggestCategoriesResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Suggested        int32                  `protobuf:"varint,1,opt,name=suggested,proto3" json:"suggested,omitempty"` // Transactions given a suggestion, including applied ones
+	Applied          int32                  `protobuf:"varint,2,opt,name=applied,proto3" json:"applied,omitempty"`
+	TrainingExamples int32                  `protobuf:"varint,3,opt,name=training_examples,json=trainingExamples,proto3" json:"training_examples,omitempty"` // Categorized transactions the suggestions learned from
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SuggestCategoriesResponse) Reset() {
+	*x = SuggestCategoriesResponse{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestCategoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestCategoriesResponse) ProtoMessage() {}
+
+func (x *SuggestCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestCategoriesResponse.ProtoReflect.Descriptor instead.
+func (*SuggestCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *SuggestCategoriesResponse) GetSuggested() int32 {
+	if x != nil {
+		return x.Suggested
+	}
+	return 0
+}
+
+func (x *SuggestCategoriesResponse) GetApplied() int32 {
+	if x != nil {
+		return x.Applied
+	}
+	return 0
+}
+
+func (x *SuggestCategoriesResponse) GetTrainingExamples() int32 {
+	if x != nil {
+		return x.TrainingExamples
+	}
+	return 0
+}
+
+// Money moved between two of the family's accounts. While a pair is detected
+// or confirmed both transactions are marked is_transfer and left out of
+// budgets and spending.
+type TransferPair struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Outflow       *AccountTransaction    `protobuf:"bytes,2,opt,name=outflow,proto3" json:"outflow,omitempty"`
+	Inflow        *AccountTransaction    `protobuf:"bytes,3,opt,name=inflow,proto3" json:"inflow,omitempty"`
+	Status        TransferPairStatus     `protobuf:"varint,4,opt,name=status,proto3,enum=transaction.v1.TransferPairStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferPair) Reset() {
+	*x = TransferPair{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferPair) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferPair) ProtoMessage() {}
+
+func (x *TransferPair) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferPair.ProtoReflect.Descriptor instead.
+func (*TransferPair) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *TransferPair) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TransferPair) GetOutflow() *AccountTransaction {
+	if x != nil {
+		return x.Outflow
+	}
+	return nil
+}
+
+func (x *TransferPair) GetInflow() *AccountTransaction {
+	if x != nil {
+		return x.Inflow
+	}
+	return nil
+}
+
+func (x *TransferPair) GetStatus() TransferPairStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TransferPairStatus_TRANSFER_PAIR_STATUS_UNSPECIFIED
+}
+
+// Pairs opposite transactions of the same amount on different linked accounts
+// posted at most 4 days apart. Synced transactions are checked automatically.
+type DetectTransfersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// YYYY-MM-DD, only transactions posted on or after this date are paired;
+	// defaults to 60 days ago
+	SinceDate     *string `protobuf:"bytes,1,opt,name=since_date,json=sinceDate,proto3,oneof" json:"since_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetectTransfersRequest) Reset() {
+	*x = DetectTransfersRequest{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetectTransfersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetectTransfersRequest) ProtoMessage() {}
+
+func (x *DetectTransfersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetectTransfersRequest.ProtoReflect.Descriptor instead.
+func (*DetectTransfersRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *DetectTransfersRequest) GetSinceDate() string {
+	if x != nil && x.SinceDate != nil {
+		return *x.SinceDate
+	}
+	return ""
+}
+
+type DetectTransfersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Paired        int32                  `protobuf:"varint,1,opt,name=paired,proto3" json:"paired,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetectTransfersResponse) Reset() {
+	*x = DetectTransfersResponse{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetectTransfersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetectTransfersResponse) ProtoMessage() {}
+
+func (x *DetectTransfersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetectTransfersResponse.ProtoReflect.Descriptor instead.
+func (*DetectTransfersResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *DetectTransfersResponse) GetPaired() int32 {
+	if x != nil {
+		return x.Paired
+	}
+	return 0
+}
+
+type ListTransferPairsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        TransferPairStatus     `protobuf:"varint,1,opt,name=status,proto3,enum=transaction.v1.TransferPairStatus" json:"status,omitempty"` // Unspecified lists every status
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTransferPairsRequest) Reset() {
+	*x = ListTransferPairsRequest{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTransferPairsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransferPairsRequest) ProtoMessage() {}
+
+func (x *ListTransferPairsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTransferPairsRequest.ProtoReflect.Descriptor instead.
+func (*ListTransferPairsRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ListTransferPairsRequest) GetStatus() TransferPairStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TransferPairStatus_TRANSFER_PAIR_STATUS_UNSPECIFIED
+}
+
+func (x *ListTransferPairsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTransferPairsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListTransferPairsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pairs         []*TransferPair        `protobuf:"bytes,1,rep,name=pairs,proto3" json:"pairs,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    int64                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTransferPairsResponse) Reset() {
+	*x = ListTransferPairsResponse{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTransferPairsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransferPairsResponse) ProtoMessage() {}
+
+func (x *ListTransferPairsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTransferPairsResponse.ProtoReflect.Descriptor instead.
+func (*ListTransferPairsResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ListTransferPairsResponse) GetPairs() []*TransferPair {
+	if x != nil {
+		return x.Pairs
+	}
+	return nil
+}
+
+func (x *ListTransferPairsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListTransferPairsResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type ConfirmTransferPairRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmTransferPairRequest) Reset() {
+	*x = ConfirmTransferPairRequest{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmTransferPairRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmTransferPairRequest) ProtoMessage() {}
+
+func (x *ConfirmTransferPairRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmTransferPairRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmTransferPairRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ConfirmTransferPairRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ConfirmTransferPairResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pair          *TransferPair          `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmTransferPairResponse) Reset() {
+	*x = ConfirmTransferPairResponse{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmTransferPairResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmTransferPairResponse) ProtoMessage() {}
+
+func (x *ConfirmTransferPairResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmTransferPairResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmTransferPairResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ConfirmTransferPairResponse) GetPair() *TransferPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+// Splitting a pair says its transactions are not a transfer, so both count as
+// spending or income again
+type SplitTransferPairRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SplitTransferPairRequest) Reset() {
+	*x = SplitTransferPairRequest{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SplitTransferPairRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SplitTransferPairRequest) ProtoMessage() {}
+
+func (x *SplitTransferPairRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SplitTransferPairRequest.ProtoReflect.Descriptor instead.
+func (*SplitTransferPairRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *SplitTransferPairRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type SplitTransferPairResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pair          *TransferPair          `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SplitTransferPairResponse) Reset() {
+	*x = SplitTransferPairResponse{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SplitTransferPairResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SplitTransferPairResponse) ProtoMessage() {}
+
+func (x *SplitTransferPairResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SplitTransferPairResponse.ProtoReflect.Descriptor instead.
+func (*SplitTransferPairResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *SplitTransferPairResponse) GetPair() *TransferPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+// Where a bank's CSV export keeps each field. Columns are header names,
+// matched ignoring case, or column numbers counting from 1.
+type StatementMapping struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`           // Usually the bank's name; unique within the family
+	Delimiter         string                 `protobuf:"bytes,3,opt,name=delimiter,proto3" json:"delimiter,omitempty"` // A single character; defaults to a comma
+	HasHeader         bool                   `protobuf:"varint,4,opt,name=has_header,json=hasHeader,proto3" json:"has_header,omitempty"`
+	SkipRows          int32                  `protobuf:"varint,5,opt,name=skip_rows,json=skipRows,proto3" json:"skip_rows,omitempty"` // Lines before the header or first row, such as an account summary
+	DateColumn        string                 `protobuf:"bytes,6,opt,name=date_column,json=dateColumn,proto3" json:"date_column,omitempty"`
+	DateFormat        string                 `protobuf:"bytes,7,opt,name=date_format,json=dateFormat,proto3" json:"date_format,omitempty"` // Such as MM/DD/YYYY or DD.MM.YY; empty tries ISO dates, then US ones
+	DescriptionColumn string                 `protobuf:"bytes,8,opt,name=description_column,json=descriptionColumn,proto3" json:"description_column,omitempty"`
+	PayeeColumn       *string                `protobuf:"bytes,9,opt,name=payee_column,json=payeeColumn,proto3,oneof" json:"payee_column,omitempty"`     // The description is used when unset
+	AmountColumn      *string                `protobuf:"bytes,10,opt,name=amount_column,json=amountColumn,proto3,oneof" json:"amount_column,omitempty"` // Signed amount; leave unset to use debit and credit columns
+	DebitColumn       *string                `protobuf:"bytes,11,opt,name=debit_column,json=debitColumn,proto3,oneof" json:"debit_column,omitempty"`    // Money out
+	CreditColumn      *string                `protobuf:"bytes,12,opt,name=credit_column,json=creditColumn,proto3,oneof" json:"credit_column,omitempty"` // Money in
+	IdColumn          *string                `protobuf:"bytes,13,opt,name=id_column,json=idColumn,proto3,oneof" json:"id_column,omitempty"`             // The bank's transaction ID, which makes deduplication exact
+	NegateAmounts     bool                   `protobuf:"varint,14,opt,name=negate_amounts,json=negateAmounts,proto3" json:"negate_amounts,omitempty"`   // For exports that show purchases as positive, as card statements often do
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *StatementMapping) Reset() {
+	*x = StatementMapping{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatementMapping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatementMapping) ProtoMessage() {}
+
+func (x *StatementMapping) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatementMapping.ProtoReflect.Descriptor instead.
+func (*StatementMapping) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *StatementMapping) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StatementMapping) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StatementMapping) GetDelimiter() string {
+	if x != nil {
+		return x.Delimiter
+	}
+	return ""
+}
+
+func (x *StatementMapping) GetHasHeader() bool {
+	if x != nil {
+		return x.HasHeader
+	}
+	return false
+}
+
+func (x *StatementMapping) GetSkipRows() int32 {
+	if x != nil {
+		return x.SkipRows
+	}
+	return 0
+}
+
+func (x *StatementMapping) GetDateColumn() string {
+	if x != nil {
+		return x.DateColumn
+	}
+	return ""
+}
+
+func (x *StatementMapping) GetDateFormat() string {
+	if x != nil {
+		return x.DateFormat
+	}
+	return ""
+}
+
+func (x *StatementMapping) GetDescriptionColumn() string {
+	if x != nil {
+		return x.DescriptionColumn
+	}
+	return ""
+}
+
+func (x *StatementMapping) GetPayeeColumn() string {
+	if x != nil && x.PayeeColumn != nil {
+		return *x.PayeeColumn
+	}
+	return ""
+}
+
+func (x *StatementMapping) GetAmountColumn() string {
+	if x != nil && x.AmountColumn != nil {
+		return *x.AmountColumn
+	}
+	return ""
+}
+
+func (x *StatementMapping) GetDebitColumn() string {
+	if x != nil && x.DebitColumn != nil {
+		return *x.DebitColumn
+	}
+	return ""
+}
+
+func (x *StatementMapping) GetCreditColumn() string {
+	if x != nil && x.CreditColumn != nil {
+		return *x.CreditColumn
+	}
+	return ""
+}
+
+func (x *StatementMapping) GetIdColumn() string {
+	if x != nil && x.IdColumn != nil {
+		return *x.IdColumn
+	}
+	return ""
+}
+
+func (x *StatementMapping) GetNegateAmounts() bool {
+	if x != nil {
+		return x.NegateAmounts
+	}
+	return false
+}
+
+func (x *StatementMapping) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *StatementMapping) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateStatementMappingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mapping       *StatementMapping      `protobuf:"bytes,1,opt,name=mapping,proto3" json:"mapping,omitempty"` // id and timestamps are ignored
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateStatementMappingRequest) Reset() {
+	*x = CreateStatementMappingRequest{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateStatementMappingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateStatementMappingRequest) ProtoMessage() {}
+
+func (x *CreateStatementMappingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateStatementMappingRequest.ProtoReflect.Descriptor instead.
+func (*CreateStatementMappingRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *CreateStatementMappingRequest) GetMapping() *StatementMapping {
+	if x != nil {
+		return x.Mapping
+	}
+	return nil
+}
+
+type CreateStatementMappingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mapping       *StatementMapping      `protobuf:"bytes,1,opt,name=mapping,proto3" json:"mapping,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateStatementMappingResponse) Reset() {
+	*x = CreateStatementMappingResponse{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateStatementMappingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateStatementMappingResponse) ProtoMessage() {}
+
+func (x *CreateStatementMappingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateStatementMappingResponse.ProtoReflect.Descriptor instead.
+func (*CreateStatementMappingResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *CreateStatementMappingResponse) GetMapping() *StatementMapping {
+	if x != nil {
+		return x.Mapping
+	}
+	return nil
+}
+
+// Replaces every field of the mapping
+type UpdateStatementMappingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mapping       *StatementMapping      `protobuf:"bytes,1,opt,name=mapping,proto3" json:"mapping,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateStatementMappingRequest) Reset() {
+	*x = UpdateStatementMappingRequest{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateStatementMappingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateStatementMappingRequest) ProtoMessage() {}
+
+func (x *UpdateStatementMappingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateStatementMappingRequest.ProtoReflect.Descriptor instead.
+func (*UpdateStatementMappingRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *UpdateStatementMappingRequest) GetMapping() *StatementMapping {
+	if x != nil {
+		return x.Mapping
+	}
+	return nil
+}
+
+type UpdateStatementMappingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mapping       *StatementMapping      `protobuf:"bytes,1,opt,name=mapping,proto3" json:"mapping,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateStatementMappingResponse) Reset() {
+	*x = UpdateStatementMappingResponse{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateStatementMappingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateStatementMappingResponse) ProtoMessage() {}
+
+func (x *UpdateStatementMappingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateStatementMappingResponse.ProtoReflect.Descriptor instead.
+func (*UpdateStatementMappingResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *UpdateStatementMappingResponse) GetMapping() *StatementMapping {
+	if x != nil {
+		return x.Mapping
+	}
+	return nil
+}
+
+type DeleteStatementMappingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteStatementMappingRequest) Reset() {
+	*x = DeleteStatementMappingRequest{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteStatementMappingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteStatementMappingRequest) ProtoMessage() {}
+
+func (x *DeleteStatementMappingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *SimplefinAccount) GetBalanceDate() *timestamppb.Timestamp {
-	if x != nil {
-		return x.BalanceDate
-	}
-	return nil
+// Deprecated: Use DeleteStatementMappingRequest.ProtoReflect.Descriptor instead.
+func (*DeleteStatementMappingRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *SimplefinAccount) GetTransactions() []*Transaction {
+func (x *DeleteStatementMappingRequest) GetId() int64 {
 	if x != nil {
-		return x.Transactions
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-type GetSimplefinAccountsRequest struct {
+type DeleteStatementMappingResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSimplefinAccountsRequest) Reset() {
-	*x = GetSimplefinAccountsRequest{}
-	mi := &file_transaction_v1_transaction_proto_msgTypes[4]
+func (x *DeleteStatementMappingResponse) Reset() {
+	*x = DeleteStatementMappingResponse{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSimplefinAccountsRequest) String() string {
+func (x *DeleteStatementMappingResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSimplefinAccountsRequest) ProtoMessage() {}
+func (*DeleteStatementMappingResponse) ProtoMessage() {}
 
-func (x *GetSimplefinAccountsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_transaction_v1_transaction_proto_msgTypes[4]
+func (x *DeleteStatementMappingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -349,33 +5735,39 @@ func (x *GetSimplefinAccountsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSimplefinAccountsRequest.ProtoReflect.Descriptor instead.
-func (*GetSimplefinAccountsRequest) Descriptor() ([]byte, []int) {
-	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use DeleteStatementMappingResponse.ProtoReflect.Descriptor instead.
+func (*DeleteStatementMappingResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{86}
 }
 
-type GetSimplefinAccountsResponse struct {
+func (x *DeleteStatementMappingResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListStatementMappingsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Accounts      []*SimplefinAccount    `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSimplefinAccountsResponse) Reset() {
-	*x = GetSimplefinAccountsResponse{}
-	mi := &file_transaction_v1_transaction_proto_msgTypes[5]
+func (x *ListStatementMappingsRequest) Reset() {
+	*x = ListStatementMappingsRequest{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSimplefinAccountsResponse) String() string {
+func (x *ListStatementMappingsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSimplefinAccountsResponse) ProtoMessage() {}
+func (*ListStatementMappingsRequest) ProtoMessage() {}
 
-func (x *GetSimplefinAccountsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_transaction_v1_transaction_proto_msgTypes[5]
+func (x *ListStatementMappingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -386,39 +5778,33 @@ func (x *GetSimplefinAccountsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSimplefinAccountsResponse.ProtoReflect.Descriptor instead.
-func (*GetSimplefinAccountsResponse) Descriptor() ([]byte, []int) {
-	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{5}
-}
-
-func (x *GetSimplefinAccountsResponse) GetAccounts() []*SimplefinAccount {
-	if x != nil {
-		return x.Accounts
-	}
-	return nil
+// Deprecated: Use ListStatementMappingsRequest.ProtoReflect.Descriptor instead.
+func (*ListStatementMappingsRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{87}
 }
 
-type GetAccountsRequest struct {
+type ListStatementMappingsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mappings      []*StatementMapping    `protobuf:"bytes,1,rep,name=mappings,proto3" json:"mappings,omitempty"` // By name
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAccountsRequest) Reset() {
-	*x = GetAccountsRequest{}
-	mi := &file_transaction_v1_transaction_proto_msgTypes[6]
+func (x *ListStatementMappingsResponse) Reset() {
+	*x = ListStatementMappingsResponse{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAccountsRequest) String() string {
+func (x *ListStatementMappingsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAccountsRequest) ProtoMessage() {}
+func (*ListStatementMappingsResponse) ProtoMessage() {}
 
-func (x *GetAccountsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_transaction_v1_transaction_proto_msgTypes[6]
+func (x *ListStatementMappingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -429,33 +5815,52 @@ func (x *GetAccountsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAccountsRequest.ProtoReflect.Descriptor instead.
-func (*GetAccountsRequest) Descriptor() ([]byte, []int) {
-	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ListStatementMappingsResponse.ProtoReflect.Descriptor instead.
+func (*ListStatementMappingsResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{88}
 }
 
-type GetAccountsResponse struct {
+func (x *ListStatementMappingsResponse) GetMappings() []*StatementMapping {
+	if x != nil {
+		return x.Mappings
+	}
+	return nil
+}
+
+// Reads a CSV, OFX or QFX statement for an import account. Rows already
+// stored are found by the bank's transaction ID, or else by posted date and
+// amount. Without confirm nothing is saved and the response previews the
+// file; sending the file again with confirm stores the new rows and the
+// statement's balance, then pairs transfers, applies rules, suggests
+// categories and matches expenses as syncing does.
+type ImportStatementRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Accounts      []*Account             `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	AccountId     int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"` // An account added with source IMPORT
+	Content       []byte                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`                       // At most 10 MiB
+	FileName      string                 `protobuf:"bytes,3,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`     // Used to tell the format when it is unspecified
+	Format        StatementFormat        `protobuf:"varint,4,opt,name=format,proto3,enum=transaction.v1.StatementFormat" json:"format,omitempty"`
+	MappingId     *int64                 `protobuf:"varint,5,opt,name=mapping_id,json=mappingId,proto3,oneof" json:"mapping_id,omitempty"` // Required for CSV files
+	Confirm       bool                   `protobuf:"varint,6,opt,name=confirm,proto3" json:"confirm,omitempty"`
+	SkipRows      []int32                `protobuf:"varint,7,rep,packed,name=skip_rows,json=skipRows,proto3" json:"skip_rows,omitempty"` // Preview rows not to import
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAccountsResponse) Reset() {
-	*x = GetAccountsResponse{}
-	mi := &file_transaction_v1_transaction_proto_msgTypes[7]
+func (x *ImportStatementRequest) Reset() {
+	*x = ImportStatementRequest{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAccountsResponse) String() string {
+func (x *ImportStatementRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAccountsResponse) ProtoMessage() {}
+func (*ImportStatementRequest) ProtoMessage() {}
 
-func (x *GetAccountsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_transaction_v1_transaction_proto_msgTypes[7]
+func (x *ImportStatementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -466,41 +5871,91 @@ func (x *GetAccountsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAccountsResponse.ProtoReflect.Descriptor instead.
-func (*GetAccountsResponse) Descriptor() ([]byte, []int) {
-	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ImportStatementRequest.ProtoReflect.Descriptor instead.
+func (*ImportStatementRequest) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *GetAccountsResponse) GetAccounts() []*Account {
+func (x *ImportStatementRequest) GetAccountId() int64 {
 	if x != nil {
-		return x.Accounts
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *ImportStatementRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
 	}
 	return nil
 }
 
-type AddAccountRequest struct {
+func (x *ImportStatementRequest) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *ImportStatementRequest) GetFormat() StatementFormat {
+	if x != nil {
+		return x.Format
+	}
+	return StatementFormat_STATEMENT_FORMAT_UNSPECIFIED
+}
+
+func (x *ImportStatementRequest) GetMappingId() int64 {
+	if x != nil && x.MappingId != nil {
+		return *x.MappingId
+	}
+	return 0
+}
+
+func (x *ImportStatementRequest) GetConfirm() bool {
+	if x != nil {
+		return x.Confirm
+	}
+	return false
+}
+
+func (x *ImportStatementRequest) GetSkipRows() []int32 {
+	if x != nil {
+		return x.SkipRows
+	}
+	return nil
+}
+
+// A transaction read from a statement
+type StatementRow struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	AccountId     string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Row           int32                  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`                                // Counting from 1 in file order
+	Line          int32                  `protobuf:"varint,2,opt,name=line,proto3" json:"line,omitempty"`                              // Line of a CSV file, or position of an OFX transaction
+	PostedDate    string                 `protobuf:"bytes,3,opt,name=posted_date,json=postedDate,proto3" json:"posted_date,omitempty"` // YYYY-MM-DD
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Payee         string                 `protobuf:"bytes,5,opt,name=payee,proto3" json:"payee,omitempty"`
+	Amount        string                 `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"`                                 // Negative for money leaving the account
+	ExternalId    *string                `protobuf:"bytes,7,opt,name=external_id,json=externalId,proto3,oneof" json:"external_id,omitempty"` // The bank's transaction ID
+	Status        StatementRowStatus     `protobuf:"varint,8,opt,name=status,proto3,enum=transaction.v1.StatementRowStatus" json:"status,omitempty"`
+	TransactionId *int64                 `protobuf:"varint,9,opt,name=transaction_id,json=transactionId,proto3,oneof" json:"transaction_id,omitempty"` // The stored transaction a duplicate matches, or the one a confirmed import created
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddAccountRequest) Reset() {
-	*x = AddAccountRequest{}
-	mi := &file_transaction_v1_transaction_proto_msgTypes[8]
+func (x *StatementRow) Reset() {
+	*x = StatementRow{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddAccountRequest) String() string {
+func (x *StatementRow) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddAccountRequest) ProtoMessage() {}
+func (*StatementRow) ProtoMessage() {}
 
-func (x *AddAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_transaction_v1_transaction_proto_msgTypes[8]
+func (x *StatementRow) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -511,47 +5966,103 @@ func (x *AddAccountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddAccountRequest.ProtoReflect.Descriptor instead.
-func (*AddAccountRequest) Descriptor() ([]byte, []int) {
-	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use StatementRow.ProtoReflect.Descriptor instead.
+func (*StatementRow) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *AddAccountRequest) GetName() string {
+func (x *StatementRow) GetRow() int32 {
 	if x != nil {
-		return x.Name
+		return x.Row
+	}
+	return 0
+}
+
+func (x *StatementRow) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *StatementRow) GetPostedDate() string {
+	if x != nil {
+		return x.PostedDate
 	}
 	return ""
 }
 
-func (x *AddAccountRequest) GetAccountId() string {
+func (x *StatementRow) GetDescription() string {
 	if x != nil {
-		return x.AccountId
+		return x.Description
 	}
 	return ""
 }
 
-type AddAccountResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Account       *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *StatementRow) GetPayee() string {
+	if x != nil {
+		return x.Payee
+	}
+	return ""
 }
 
-func (x *AddAccountResponse) Reset() {
-	*x = AddAccountResponse{}
-	mi := &file_transaction_v1_transaction_proto_msgTypes[9]
+func (x *StatementRow) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *StatementRow) GetExternalId() string {
+	if x != nil && x.ExternalId != nil {
+		return *x.ExternalId
+	}
+	return ""
+}
+
+func (x *StatementRow) GetStatus() StatementRowStatus {
+	if x != nil {
+		return x.Status
+	}
+	return StatementRowStatus_STATEMENT_ROW_STATUS_UNSPECIFIED
+}
+
+func (x *StatementRow) GetTransactionId() int64 {
+	if x != nil && x.TransactionId != nil {
+		return *x.TransactionId
+	}
+	return 0
+}
+
+type ImportStatementResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Rows               []*StatementRow        `protobuf:"bytes,1,rep,name=rows,proto3" json:"rows,omitempty"`
+	NewCount           int32                  `protobuf:"varint,2,opt,name=new_count,json=newCount,proto3" json:"new_count,omitempty"`
+	DuplicateCount     int32                  `protobuf:"varint,3,opt,name=duplicate_count,json=duplicateCount,proto3" json:"duplicate_count,omitempty"`
+	Imported           int32                  `protobuf:"varint,4,opt,name=imported,proto3" json:"imported,omitempty"` // Transactions stored; 0 for a preview
+	Confirmed          bool                   `protobuf:"varint,5,opt,name=confirmed,proto3" json:"confirmed,omitempty"`
+	StatementAccountId *string                `protobuf:"bytes,6,opt,name=statement_account_id,json=statementAccountId,proto3,oneof" json:"statement_account_id,omitempty"` // The account number an OFX file names, to check against the account
+	Balance            *string                `protobuf:"bytes,7,opt,name=balance,proto3,oneof" json:"balance,omitempty"`                                                   // The statement's closing balance, stored as a balance snapshot on confirm
+	BalanceDate        *string                `protobuf:"bytes,8,opt,name=balance_date,json=balanceDate,proto3,oneof" json:"balance_date,omitempty"`                        // YYYY-MM-DD
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ImportStatementResponse) Reset() {
+	*x = ImportStatementResponse{}
+	mi := &file_transaction_v1_transaction_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddAccountResponse) String() string {
+func (x *ImportStatementResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddAccountResponse) ProtoMessage() {}
+func (*ImportStatementResponse) ProtoMessage() {}
 
-func (x *AddAccountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_transaction_v1_transaction_proto_msgTypes[9]
+func (x *ImportStatementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transaction_v1_transaction_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -562,18 +6073,67 @@ func (x *AddAccountResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddAccountResponse.ProtoReflect.Descriptor instead.
-func (*AddAccountResponse) Descriptor() ([]byte, []int) {
-	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ImportStatementResponse.ProtoReflect.Descriptor instead.
+func (*ImportStatementResponse) Descriptor() ([]byte, []int) {
+	return file_transaction_v1_transaction_proto_rawDescGZIP(), []int{91}
 }
 
-func (x *AddAccountResponse) GetAccount() *Account {
+func (x *ImportStatementResponse) GetRows() []*StatementRow {
 	if x != nil {
-		return x.Account
+		return x.Rows
 	}
 	return nil
 }
 
+func (x *ImportStatementResponse) GetNewCount() int32 {
+	if x != nil {
+		return x.NewCount
+	}
+	return 0
+}
+
+func (x *ImportStatementResponse) GetDuplicateCount() int32 {
+	if x != nil {
+		return x.DuplicateCount
+	}
+	return 0
+}
+
+func (x *ImportStatementResponse) GetImported() int32 {
+	if x != nil {
+		return x.Imported
+	}
+	return 0
+}
+
+func (x *ImportStatementResponse) GetConfirmed() bool {
+	if x != nil {
+		return x.Confirmed
+	}
+	return false
+}
+
+func (x *ImportStatementResponse) GetStatementAccountId() string {
+	if x != nil && x.StatementAccountId != nil {
+		return *x.StatementAccountId
+	}
+	return ""
+}
+
+func (x *ImportStatementResponse) GetBalance() string {
+	if x != nil && x.Balance != nil {
+		return *x.Balance
+	}
+	return ""
+}
+
+func (x *ImportStatementResponse) GetBalanceDate() string {
+	if x != nil && x.BalanceDate != nil {
+		return *x.BalanceDate
+	}
+	return ""
+}
+
 var File_transaction_v1_transaction_proto protoreflect.FileDescriptor
 
 const file_transaction_v1_transaction_proto_rawDesc = "" +
@@ -591,12 +6151,24 @@ const file_transaction_v1_transaction_proto_rawDesc = "" +
 	"\apending\x18\x06 \x01(\bH\x01R\apending\x88\x01\x01B\x10\n" +
 	"\x0e_transacted_atB\n" +
 	"\n" +
-	"\b_pending\"L\n" +
+	"\b_pending\"\xd0\x03\n" +
 	"\aAccount\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
 	"\n" +
 	"account_id\x18\x02 \x01(\tR\taccountId\x12\x12\n" +
-	"\x04name\x18\x03 \x01(\tR\x04name\"\xe4\x02\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12/\n" +
+	"\x04type\x18\x04 \x01(\x0e2\x1b.transaction.v1.AccountTypeR\x04type\x12\x16\n" +
+	"\x06hidden\x18\x05 \x01(\bR\x06hidden\x12*\n" +
+	"\x11include_in_budget\x18\x06 \x01(\bR\x0fincludeInBudget\x12@\n" +
+	"\vunlinked_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x00R\n" +
+	"unlinkedAt\x88\x01\x01\x12@\n" +
+	"\vrelinked_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampH\x01R\n" +
+	"relinkedAt\x88\x01\x01\x122\n" +
+	"\x05class\x18\t \x01(\x0e2\x1c.transaction.v1.AccountClassR\x05class\x125\n" +
+	"\x06source\x18\n" +
+	" \x01(\x0e2\x1d.transaction.v1.AccountSourceR\x06sourceB\x0e\n" +
+	"\f_unlinked_atB\x0e\n" +
+	"\f_relinked_at\"\xe4\x02\n" +
 	"\x10SimplefinAccount\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12.\n" +
 	"\x03org\x18\x02 \x01(\v2\x1c.transaction.v1.OrganizationR\x03org\x12\x12\n" +
@@ -607,23 +6179,579 @@ const file_transaction_v1_transaction_proto_rawDesc = "" +
 	"\fbalance_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vbalanceDate\x12?\n" +
 	"\ftransactions\x18\b \x03(\v2\x1b.transaction.v1.TransactionR\ftransactionsB\x14\n" +
 	"\x12_available_balance\"\x1d\n" +
-	"\x1bGetSimplefinAccountsRequest\"\\\n" +
+	"\x1bGetSimplefinAccountsRequest\"\x95\x01\n" +
 	"\x1cGetSimplefinAccountsResponse\x12<\n" +
-	"\baccounts\x18\x01 \x03(\v2 .transaction.v1.SimplefinAccountR\baccounts\"\x14\n" +
-	"\x12GetAccountsRequest\"J\n" +
+	"\baccounts\x18\x01 \x03(\v2 .transaction.v1.SimplefinAccountR\baccounts\x127\n" +
+	"\x06health\x18\x02 \x01(\v2\x1f.transaction.v1.SimplefinHealthR\x06health\";\n" +
+	"\x12GetAccountsRequest\x12%\n" +
+	"\x0einclude_hidden\x18\x01 \x01(\bR\rincludeHidden\"\x83\x01\n" +
 	"\x13GetAccountsResponse\x123\n" +
-	"\baccounts\x18\x01 \x03(\v2\x17.transaction.v1.AccountR\baccounts\"F\n" +
+	"\baccounts\x18\x01 \x03(\v2\x17.transaction.v1.AccountR\baccounts\x127\n" +
+	"\x06health\x18\x02 \x01(\v2\x1f.transaction.v1.SimplefinHealthR\x06health\"\xae\x01\n" +
 	"\x11AddAccountRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1d\n" +
 	"\n" +
-	"account_id\x18\x02 \x01(\tR\taccountId\"G\n" +
+	"account_id\x18\x02 \x01(\tR\taccountId\x12/\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x1b.transaction.v1.AccountTypeR\x04type\x125\n" +
+	"\x06source\x18\x04 \x01(\x0e2\x1d.transaction.v1.AccountSourceR\x06source\"G\n" +
 	"\x12AddAccountResponse\x121\n" +
-	"\aaccount\x18\x01 \x01(\v2\x17.transaction.v1.AccountR\aaccount2\xb4\x02\n" +
+	"\aaccount\x18\x01 \x01(\v2\x17.transaction.v1.AccountR\aaccount\"\xf6\x01\n" +
+	"\x14UpdateAccountRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x124\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x1b.transaction.v1.AccountTypeH\x01R\x04type\x88\x01\x01\x12\x1b\n" +
+	"\x06hidden\x18\x04 \x01(\bH\x02R\x06hidden\x88\x01\x01\x12/\n" +
+	"\x11include_in_budget\x18\x05 \x01(\bH\x03R\x0fincludeInBudget\x88\x01\x01B\a\n" +
+	"\x05_nameB\a\n" +
+	"\x05_typeB\t\n" +
+	"\a_hiddenB\x14\n" +
+	"\x12_include_in_budget\"J\n" +
+	"\x15UpdateAccountResponse\x121\n" +
+	"\aaccount\x18\x01 \x01(\v2\x17.transaction.v1.AccountR\aaccount\"U\n" +
+	"\x14RemoveAccountRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12-\n" +
+	"\x12purge_transactions\x18\x02 \x01(\bR\x11purgeTransactions\"1\n" +
+	"\x15RemoveAccountResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"E\n" +
+	"\x14RelinkAccountRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\tR\taccountId\"J\n" +
+	"\x15RelinkAccountResponse\x121\n" +
+	"\aaccount\x18\x01 \x01(\v2\x17.transaction.v1.AccountR\aaccount\"\xa2\x03\n" +
+	"\x0fSimplefinHealth\x12:\n" +
+	"\x05state\x18\x01 \x01(\x0e2$.transaction.v1.SimplefinHealthStateR\x05state\x12G\n" +
+	"\x0flast_success_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\rlastSuccessAt\x88\x01\x01\x12C\n" +
+	"\rlast_error_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\vlastErrorAt\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"last_error\x18\x04 \x01(\tH\x02R\tlastError\x88\x01\x01\x12#\n" +
+	"\rauth_failures\x18\x05 \x01(\x05R\fauthFailures\x12:\n" +
+	"\bretry_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x03R\aretryAt\x88\x01\x01B\x12\n" +
+	"\x10_last_success_atB\x10\n" +
+	"\x0e_last_error_atB\r\n" +
+	"\v_last_errorB\v\n" +
+	"\t_retry_at\"\x90\x02\n" +
+	"\x13SimplefinConnection\x12\x1c\n" +
+	"\tconnected\x18\x01 \x01(\bR\tconnected\x12\x16\n" +
+	"\x06server\x18\x02 \x01(\tR\x06server\x12'\n" +
+	"\x0flinked_accounts\x18\x03 \x01(\x05R\x0elinkedAccounts\x122\n" +
+	"\x12available_accounts\x18\x04 \x01(\x05H\x00R\x11availableAccounts\x88\x01\x01\x12\x16\n" +
+	"\x06errors\x18\x05 \x03(\tR\x06errors\x127\n" +
+	"\x06health\x18\x06 \x01(\v2\x1f.transaction.v1.SimplefinHealthR\x06healthB\x15\n" +
+	"\x13_available_accounts\":\n" +
+	"\x17ConnectSimplefinRequest\x12\x1f\n" +
+	"\vsetup_token\x18\x01 \x01(\tR\n" +
+	"setupToken\"_\n" +
+	"\x18ConnectSimplefinResponse\x12C\n" +
+	"\n" +
+	"connection\x18\x01 \x01(\v2#.transaction.v1.SimplefinConnectionR\n" +
+	"connection\"\x1c\n" +
+	"\x1aDisconnectSimplefinRequest\"7\n" +
+	"\x1bDisconnectSimplefinResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"5\n" +
+	"\x1dGetSimplefinConnectionRequest\x12\x14\n" +
+	"\x05check\x18\x01 \x01(\bR\x05check\"e\n" +
+	"\x1eGetSimplefinConnectionResponse\x12C\n" +
+	"\n" +
+	"connection\x18\x01 \x01(\v2#.transaction.v1.SimplefinConnectionR\n" +
+	"connection\"\x92\a\n" +
+	"\x12AccountTransaction\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\x03R\taccountId\x12\x1f\n" +
+	"\vposted_date\x18\x03 \x01(\tR\n" +
+	"postedDate\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x14\n" +
+	"\x05payee\x18\x05 \x01(\tR\x05payee\x12\x16\n" +
+	"\x06amount\x18\x06 \x01(\tR\x06amount\x121\n" +
+	"\x12matched_expense_id\x18\a \x01(\x03H\x00R\x10matchedExpenseId\x88\x01\x01\x129\n" +
+	"\x16matched_scheduled_date\x18\b \x01(\tH\x01R\x14matchedScheduledDate\x88\x01\x01\x12\x18\n" +
+	"\apending\x18\t \x01(\bR\apending\x12$\n" +
+	"\vcategory_id\x18\n" +
+	" \x01(\x03H\x02R\n" +
+	"categoryId\x88\x01\x01\x12\x17\n" +
+	"\x04note\x18\v \x01(\tH\x03R\x04note\x88\x01\x01\x12$\n" +
+	"\vexternal_id\x18\f \x01(\tH\x04R\n" +
+	"externalId\x88\x01\x01\x12D\n" +
+	"\rtransacted_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampH\x05R\ftransactedAt\x88\x01\x01\x12(\n" +
+	"\rdisplay_payee\x18\x0e \x01(\tH\x06R\fdisplayPayee\x88\x01\x01\x12\x1f\n" +
+	"\vis_transfer\x18\x0f \x01(\bR\n" +
+	"isTransfer\x127\n" +
+	"\x15suggested_category_id\x18\x10 \x01(\x03H\aR\x13suggestedCategoryId\x88\x01\x01\x128\n" +
+	"\x15suggestion_confidence\x18\x11 \x01(\x01H\bR\x14suggestionConfidence\x88\x01\x01\x128\n" +
+	"\x06splits\x18\x12 \x03(\v2 .transaction.v1.TransactionSplitR\x06splitsB\x15\n" +
+	"\x13_matched_expense_idB\x19\n" +
+	"\x17_matched_scheduled_dateB\x0e\n" +
+	"\f_category_idB\a\n" +
+	"\x05_noteB\x0e\n" +
+	"\f_external_idB\x10\n" +
+	"\x0e_transacted_atB\x10\n" +
+	"\x0e_display_payeeB\x18\n" +
+	"\x16_suggested_category_idB\x18\n" +
+	"\x16_suggestion_confidence\"\xc2\x01\n" +
+	"\x10TransactionSplit\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\tR\x06amount\x12$\n" +
+	"\vcategory_id\x18\x03 \x01(\x03H\x00R\n" +
+	"categoryId\x88\x01\x01\x12\x17\n" +
+	"\x04note\x18\x04 \x01(\tH\x01R\x04note\x88\x01\x01\x12 \n" +
+	"\tmember_id\x18\x05 \x01(\x03H\x02R\bmemberId\x88\x01\x01B\x0e\n" +
+	"\f_category_idB\a\n" +
+	"\x05_noteB\f\n" +
+	"\n" +
+	"_member_id\"\xc5\x03\n" +
+	"\x17ListTransactionsRequest\x12\"\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03H\x00R\taccountId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\tH\x01R\tstartDate\x88\x01\x01\x12\x1e\n" +
+	"\bend_date\x18\x03 \x01(\tH\x02R\aendDate\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"min_amount\x18\x04 \x01(\tH\x03R\tminAmount\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"max_amount\x18\x05 \x01(\tH\x04R\tmaxAmount\x88\x01\x01\x12\x1d\n" +
+	"\apending\x18\x06 \x01(\bH\x05R\apending\x88\x01\x01\x12$\n" +
+	"\vcategory_id\x18\a \x01(\x03H\x06R\n" +
+	"categoryId\x88\x01\x01\x12\x14\n" +
+	"\x05query\x18\b \x01(\tR\x05query\x12\x1b\n" +
+	"\tpage_size\x18\t \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\n" +
+	" \x01(\tR\tpageTokenB\r\n" +
+	"\v_account_idB\r\n" +
+	"\v_start_dateB\v\n" +
+	"\t_end_dateB\r\n" +
+	"\v_min_amountB\r\n" +
+	"\v_max_amountB\n" +
+	"\n" +
+	"\b_pendingB\x0e\n" +
+	"\f_category_id\"\xab\x01\n" +
+	"\x18ListTransactionsResponse\x12F\n" +
+	"\ftransactions\x18\x01 \x03(\v2\".transaction.v1.AccountTransactionR\ftransactions\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x03R\n" +
+	"totalCount\"'\n" +
+	"\x15GetTransactionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"^\n" +
+	"\x16GetTransactionResponse\x12D\n" +
+	"\vtransaction\x18\x01 \x01(\v2\".transaction.v1.AccountTransactionR\vtransaction\"\x94\x03\n" +
+	"\x18UpdateTransactionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12$\n" +
+	"\vcategory_id\x18\x02 \x01(\x03H\x00R\n" +
+	"categoryId\x88\x01\x01\x12\x17\n" +
+	"\x04note\x18\x03 \x01(\tH\x01R\x04note\x88\x01\x01\x121\n" +
+	"\x12matched_expense_id\x18\x04 \x01(\x03H\x02R\x10matchedExpenseId\x88\x01\x01\x129\n" +
+	"\x16matched_scheduled_date\x18\x05 \x01(\tH\x03R\x14matchedScheduledDate\x88\x01\x01\x12(\n" +
+	"\rdisplay_payee\x18\x06 \x01(\tH\x04R\fdisplayPayee\x88\x01\x01\x12$\n" +
+	"\vis_transfer\x18\a \x01(\bH\x05R\n" +
+	"isTransfer\x88\x01\x01B\x0e\n" +
+	"\f_category_idB\a\n" +
+	"\x05_noteB\x15\n" +
+	"\x13_matched_expense_idB\x19\n" +
+	"\x17_matched_scheduled_dateB\x10\n" +
+	"\x0e_display_payeeB\x0e\n" +
+	"\f_is_transfer\"a\n" +
+	"\x19UpdateTransactionResponse\x12D\n" +
+	"\vtransaction\x18\x01 \x01(\v2\".transaction.v1.AccountTransactionR\vtransaction\"~\n" +
+	"\x1bSetTransactionSplitsRequest\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\x03R\rtransactionId\x128\n" +
+	"\x06splits\x18\x02 \x03(\v2 .transaction.v1.TransactionSplitR\x06splits\"d\n" +
+	"\x1cSetTransactionSplitsResponse\x12D\n" +
+	"\vtransaction\x18\x01 \x01(\v2\".transaction.v1.AccountTransactionR\vtransaction\"\x89\x02\n" +
+	"\vMatchReview\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12D\n" +
+	"\vtransaction\x18\x02 \x01(\v2\".transaction.v1.AccountTransactionR\vtransaction\x12\x1d\n" +
+	"\n" +
+	"expense_id\x18\x03 \x01(\x03R\texpenseId\x12!\n" +
+	"\fexpense_name\x18\x04 \x01(\tR\vexpenseName\x12%\n" +
+	"\x0eexpense_amount\x18\x05 \x01(\x01R\rexpenseAmount\x12%\n" +
+	"\x0escheduled_date\x18\x06 \x01(\tR\rscheduledDate\x12\x14\n" +
+	"\x05score\x18\a \x01(\x01R\x05score\"M\n" +
+	"\x18MatchTransactionsRequest\x12\"\n" +
+	"\n" +
+	"since_date\x18\x01 \x01(\tH\x00R\tsinceDate\x88\x01\x01B\r\n" +
+	"\v_since_date\"a\n" +
+	"\x19MatchTransactionsResponse\x12\x18\n" +
+	"\amatched\x18\x01 \x01(\x05R\amatched\x12*\n" +
+	"\x11queued_for_review\x18\x02 \x01(\x05R\x0fqueuedForReview\"U\n" +
+	"\x17ListMatchReviewsRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\"\x9a\x01\n" +
+	"\x18ListMatchReviewsResponse\x125\n" +
+	"\areviews\x18\x01 \x03(\v2\x1b.transaction.v1.MatchReviewR\areviews\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x03R\n" +
+	"totalCount\"C\n" +
+	"\x19ResolveMatchReviewRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06accept\x18\x02 \x01(\bR\x06accept\"6\n" +
+	"\x1aResolveMatchReviewResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xb5\x03\n" +
+	"\x11AccountSyncStatus\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03R\taccountId\x12!\n" +
+	"\faccount_name\x18\x02 \x01(\tR\vaccountName\x127\n" +
+	"\x06cursor\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\x06cursor\x88\x01\x01\x12G\n" +
+	"\x0flast_attempt_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\rlastAttemptAt\x88\x01\x01\x12G\n" +
+	"\x0flast_success_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x02R\rlastSuccessAt\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"last_error\x18\x06 \x01(\tH\x03R\tlastError\x88\x01\x01\x12-\n" +
+	"\x12transactions_added\x18\a \x01(\x05R\x11transactionsAddedB\t\n" +
+	"\a_cursorB\x12\n" +
+	"\x10_last_attempt_atB\x12\n" +
+	"\x10_last_success_atB\r\n" +
+	"\v_last_error\"\xa3\x01\n" +
+	"\tSyncError\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\"\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\x03H\x00R\taccountId\x88\x01\x01\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\r\n" +
+	"\v_account_id\"\x9b\x03\n" +
+	"\n" +
+	"SyncStatus\x12\x18\n" +
+	"\arunning\x18\x01 \x01(\bR\arunning\x12G\n" +
+	"\x0flast_started_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\rlastStartedAt\x88\x01\x01\x12I\n" +
+	"\x10last_finished_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\x0elastFinishedAt\x88\x01\x01\x12=\n" +
+	"\baccounts\x18\x04 \x03(\v2!.transaction.v1.AccountSyncStatusR\baccounts\x12>\n" +
+	"\rrecent_errors\x18\x05 \x03(\v2\x19.transaction.v1.SyncErrorR\frecentErrors\x127\n" +
+	"\x06health\x18\x06 \x01(\v2\x1f.transaction.v1.SimplefinHealthR\x06healthB\x12\n" +
+	"\x10_last_started_atB\x13\n" +
+	"\x11_last_finished_at\"\x10\n" +
+	"\x0eSyncNowRequest\"_\n" +
+	"\x0fSyncNowResponse\x12\x18\n" +
+	"\astarted\x18\x01 \x01(\bR\astarted\x122\n" +
+	"\x06status\x18\x02 \x01(\v2\x1a.transaction.v1.SyncStatusR\x06status\"\x16\n" +
+	"\x14GetSyncStatusRequest\"K\n" +
+	"\x15GetSyncStatusResponse\x122\n" +
+	"\x06status\x18\x01 \x01(\v2\x1a.transaction.v1.SyncStatusR\x06status\"\x95\x01\n" +
+	"\fBalancePoint\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x1d\n" +
+	"\abalance\x18\x02 \x01(\tH\x00R\abalance\x88\x01\x01\x120\n" +
+	"\x11available_balance\x18\x03 \x01(\tH\x01R\x10availableBalance\x88\x01\x01B\n" +
+	"\n" +
+	"\b_balanceB\x14\n" +
+	"\x12_available_balance\"\x7f\n" +
+	"\x14AccountBalanceSeries\x121\n" +
+	"\aaccount\x18\x01 \x01(\v2\x17.transaction.v1.AccountR\aaccount\x124\n" +
+	"\x06points\x18\x02 \x03(\v2\x1c.transaction.v1.BalancePointR\x06points\"\xdb\x01\n" +
+	"\x19GetAccountBalancesRequest\x12\x1f\n" +
+	"\vaccount_ids\x18\x01 \x03(\x03R\n" +
+	"accountIds\x12\"\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\tH\x00R\tstartDate\x88\x01\x01\x12\x1e\n" +
+	"\bend_date\x18\x03 \x01(\tH\x01R\aendDate\x88\x01\x01\x12=\n" +
+	"\vgranularity\x18\x04 \x01(\x0e2\x1b.transaction.v1.GranularityR\vgranularityB\r\n" +
+	"\v_start_dateB\v\n" +
+	"\t_end_date\"^\n" +
+	"\x1aGetAccountBalancesResponse\x12@\n" +
+	"\baccounts\x18\x01 \x03(\v2$.transaction.v1.AccountBalanceSeriesR\baccounts\"z\n" +
+	"\rNetWorthPoint\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x16\n" +
+	"\x06assets\x18\x02 \x01(\tR\x06assets\x12 \n" +
+	"\vliabilities\x18\x03 \x01(\tR\vliabilities\x12\x1b\n" +
+	"\tnet_worth\x18\x04 \x01(\tR\bnetWorth\"\xba\x01\n" +
+	"\x19GetNetWorthHistoryRequest\x12\"\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\tH\x00R\tstartDate\x88\x01\x01\x12\x1e\n" +
+	"\bend_date\x18\x02 \x01(\tH\x01R\aendDate\x88\x01\x01\x12=\n" +
+	"\vgranularity\x18\x03 \x01(\x0e2\x1b.transaction.v1.GranularityR\vgranularityB\r\n" +
+	"\v_start_dateB\v\n" +
+	"\t_end_date\"S\n" +
+	"\x1aGetNetWorthHistoryResponse\x125\n" +
+	"\x06points\x18\x01 \x03(\v2\x1d.transaction.v1.NetWorthPointR\x06points\"\xed\x05\n" +
+	"\x0fTransactionRule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bposition\x18\x03 \x01(\x05R\bposition\x12\x1b\n" +
+	"\tis_active\x18\x04 \x01(\bR\bisActive\x12?\n" +
+	"\vmatch_field\x18\x05 \x01(\x0e2\x1e.transaction.v1.RuleMatchFieldR\n" +
+	"matchField\x12<\n" +
+	"\n" +
+	"match_type\x18\x06 \x01(\x0e2\x1d.transaction.v1.RuleMatchTypeR\tmatchType\x12\x18\n" +
+	"\apattern\x18\a \x01(\tR\apattern\x12\"\n" +
+	"\n" +
+	"min_amount\x18\b \x01(\tH\x00R\tminAmount\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"max_amount\x18\t \x01(\tH\x01R\tmaxAmount\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"account_id\x18\n" +
+	" \x01(\x03H\x02R\taccountId\x88\x01\x01\x12+\n" +
+	"\x0fset_category_id\x18\v \x01(\x03H\x03R\rsetCategoryId\x88\x01\x01\x12 \n" +
+	"\tset_payee\x18\f \x01(\tH\x04R\bsetPayee\x88\x01\x01\x12+\n" +
+	"\x0flink_expense_id\x18\r \x01(\x03H\x05R\rlinkExpenseId\x88\x01\x01\x12#\n" +
+	"\rmark_transfer\x18\x0e \x01(\bR\fmarkTransfer\x129\n" +
+	"\n" +
+	"created_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\r\n" +
+	"\v_min_amountB\r\n" +
+	"\v_max_amountB\r\n" +
+	"\v_account_idB\x12\n" +
+	"\x10_set_category_idB\f\n" +
+	"\n" +
+	"_set_payeeB\x12\n" +
+	"\x10_link_expense_id\"H\n" +
+	"\x11CreateRuleRequest\x123\n" +
+	"\x04rule\x18\x01 \x01(\v2\x1f.transaction.v1.TransactionRuleR\x04rule\"I\n" +
+	"\x12CreateRuleResponse\x123\n" +
+	"\x04rule\x18\x01 \x01(\v2\x1f.transaction.v1.TransactionRuleR\x04rule\"H\n" +
+	"\x11UpdateRuleRequest\x123\n" +
+	"\x04rule\x18\x01 \x01(\v2\x1f.transaction.v1.TransactionRuleR\x04rule\"I\n" +
+	"\x12UpdateRuleResponse\x123\n" +
+	"\x04rule\x18\x01 \x01(\v2\x1f.transaction.v1.TransactionRuleR\x04rule\"#\n" +
+	"\x11DeleteRuleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\".\n" +
+	"\x12DeleteRuleResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x12\n" +
+	"\x10ListRulesRequest\"J\n" +
+	"\x11ListRulesResponse\x125\n" +
+	"\x05rules\x18\x01 \x03(\v2\x1f.transaction.v1.TransactionRuleR\x05rules\"\x91\x01\n" +
+	"\x11ApplyRulesRequest\x12\"\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\tH\x00R\tstartDate\x88\x01\x01\x12\x1e\n" +
+	"\bend_date\x18\x02 \x01(\tH\x01R\aendDate\x88\x01\x01\x12\x1c\n" +
+	"\toverwrite\x18\x03 \x01(\bR\toverwriteB\r\n" +
+	"\v_start_dateB\v\n" +
+	"\t_end_date\"H\n" +
+	"\x12ApplyRulesResponse\x12\x18\n" +
+	"\amatched\x18\x01 \x01(\x05R\amatched\x12\x18\n" +
+	"\aupdated\x18\x02 \x01(\x05R\aupdated\"\xdd\x01\n" +
+	"\x12PreviewRuleRequest\x123\n" +
+	"\x04rule\x18\x01 \x01(\v2\x1f.transaction.v1.TransactionRuleR\x04rule\x12\"\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\tH\x00R\tstartDate\x88\x01\x01\x12\x1e\n" +
+	"\bend_date\x18\x03 \x01(\tH\x01R\aendDate\x88\x01\x01\x12\x1c\n" +
+	"\toverwrite\x18\x04 \x01(\bR\toverwrite\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limitB\r\n" +
+	"\v_start_dateB\v\n" +
+	"\t_end_date\"\x90\x01\n" +
+	"\n" +
+	"RuleChange\x12D\n" +
+	"\vtransaction\x18\x01 \x01(\v2\".transaction.v1.AccountTransactionR\vtransaction\x12<\n" +
+	"\aupdated\x18\x02 \x01(\v2\".transaction.v1.AccountTransactionR\aupdated\"\x7f\n" +
+	"\x13PreviewRuleResponse\x124\n" +
+	"\achanges\x18\x01 \x03(\v2\x1a.transaction.v1.RuleChangeR\achanges\x12\x18\n" +
+	"\amatched\x18\x02 \x01(\x05R\amatched\x12\x18\n" +
+	"\achanged\x18\x03 \x01(\x05R\achanged\"z\n" +
+	"\x18SuggestCategoriesRequest\x12\"\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\tH\x00R\tstartDate\x88\x01\x01\x12\x1e\n" +
+	"\bend_date\x18\x02 \x01(\tH\x01R\aendDate\x88\x01\x01B\r\n" +
+	"\v_start_dateB\v\n" +
+	"\t_end_date\"\x80\x01\n" +
+	"\x19SuggestCategoriesResponse\x12\x1c\n" +
+	"\tsuggested\x18\x01 \x01(\x05R\tsuggested\x12\x18\n" +
+	"\aapplied\x18\x02 \x01(\x05R\aapplied\x12+\n" +
+	"\x11training_examples\x18\x03 \x01(\x05R\x10trainingExamples\"\xd4\x01\n" +
+	"\fTransferPair\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12<\n" +
+	"\aoutflow\x18\x02 \x01(\v2\".transaction.v1.AccountTransactionR\aoutflow\x12:\n" +
+	"\x06inflow\x18\x03 \x01(\v2\".transaction.v1.AccountTransactionR\x06inflow\x12:\n" +
+	"\x06status\x18\x04 \x01(\x0e2\".transaction.v1.TransferPairStatusR\x06status\"K\n" +
+	"\x16DetectTransfersRequest\x12\"\n" +
+	"\n" +
+	"since_date\x18\x01 \x01(\tH\x00R\tsinceDate\x88\x01\x01B\r\n" +
+	"\v_since_date\"1\n" +
+	"\x17DetectTransfersResponse\x12\x16\n" +
+	"\x06paired\x18\x01 \x01(\x05R\x06paired\"\x92\x01\n" +
+	"\x18ListTransferPairsRequest\x12:\n" +
+	"\x06status\x18\x01 \x01(\x0e2\".transaction.v1.TransferPairStatusR\x06status\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x98\x01\n" +
+	"\x19ListTransferPairsResponse\x122\n" +
+	"\x05pairs\x18\x01 \x03(\v2\x1c.transaction.v1.TransferPairR\x05pairs\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x03R\n" +
+	"totalCount\",\n" +
+	"\x1aConfirmTransferPairRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"O\n" +
+	"\x1bConfirmTransferPairResponse\x120\n" +
+	"\x04pair\x18\x01 \x01(\v2\x1c.transaction.v1.TransferPairR\x04pair\"*\n" +
+	"\x18SplitTransferPairRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"M\n" +
+	"\x19SplitTransferPairResponse\x120\n" +
+	"\x04pair\x18\x01 \x01(\v2\x1c.transaction.v1.TransferPairR\x04pair\"\xb8\x05\n" +
+	"\x10StatementMapping\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1c\n" +
+	"\tdelimiter\x18\x03 \x01(\tR\tdelimiter\x12\x1d\n" +
+	"\n" +
+	"has_header\x18\x04 \x01(\bR\thasHeader\x12\x1b\n" +
+	"\tskip_rows\x18\x05 \x01(\x05R\bskipRows\x12\x1f\n" +
+	"\vdate_column\x18\x06 \x01(\tR\n" +
+	"dateColumn\x12\x1f\n" +
+	"\vdate_format\x18\a \x01(\tR\n" +
+	"dateFormat\x12-\n" +
+	"\x12description_column\x18\b \x01(\tR\x11descriptionColumn\x12&\n" +
+	"\fpayee_column\x18\t \x01(\tH\x00R\vpayeeColumn\x88\x01\x01\x12(\n" +
+	"\ramount_column\x18\n" +
+	" \x01(\tH\x01R\famountColumn\x88\x01\x01\x12&\n" +
+	"\fdebit_column\x18\v \x01(\tH\x02R\vdebitColumn\x88\x01\x01\x12(\n" +
+	"\rcredit_column\x18\f \x01(\tH\x03R\fcreditColumn\x88\x01\x01\x12 \n" +
+	"\tid_column\x18\r \x01(\tH\x04R\bidColumn\x88\x01\x01\x12%\n" +
+	"\x0enegate_amounts\x18\x0e \x01(\bR\rnegateAmounts\x129\n" +
+	"\n" +
+	"created_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x0f\n" +
+	"\r_payee_columnB\x10\n" +
+	"\x0e_amount_columnB\x0f\n" +
+	"\r_debit_columnB\x10\n" +
+	"\x0e_credit_columnB\f\n" +
+	"\n" +
+	"_id_column\"[\n" +
+	"\x1dCreateStatementMappingRequest\x12:\n" +
+	"\amapping\x18\x01 \x01(\v2 .transaction.v1.StatementMappingR\amapping\"\\\n" +
+	"\x1eCreateStatementMappingResponse\x12:\n" +
+	"\amapping\x18\x01 \x01(\v2 .transaction.v1.StatementMappingR\amapping\"[\n" +
+	"\x1dUpdateStatementMappingRequest\x12:\n" +
+	"\amapping\x18\x01 \x01(\v2 .transaction.v1.StatementMappingR\amapping\"\\\n" +
+	"\x1eUpdateStatementMappingResponse\x12:\n" +
+	"\amapping\x18\x01 \x01(\v2 .transaction.v1.StatementMappingR\amapping\"/\n" +
+	"\x1dDeleteStatementMappingRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\":\n" +
+	"\x1eDeleteStatementMappingResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x1e\n" +
+	"\x1cListStatementMappingsRequest\"]\n" +
+	"\x1dListStatementMappingsResponse\x12<\n" +
+	"\bmappings\x18\x01 \x03(\v2 .transaction.v1.StatementMappingR\bmappings\"\x91\x02\n" +
+	"\x16ImportStatementRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03R\taccountId\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\fR\acontent\x12\x1b\n" +
+	"\tfile_name\x18\x03 \x01(\tR\bfileName\x127\n" +
+	"\x06format\x18\x04 \x01(\x0e2\x1f.transaction.v1.StatementFormatR\x06format\x12\"\n" +
+	"\n" +
+	"mapping_id\x18\x05 \x01(\x03H\x00R\tmappingId\x88\x01\x01\x12\x18\n" +
+	"\aconfirm\x18\x06 \x01(\bR\aconfirm\x12\x1b\n" +
+	"\tskip_rows\x18\a \x03(\x05R\bskipRowsB\r\n" +
+	"\v_mapping_id\"\xd6\x02\n" +
+	"\fStatementRow\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x05R\x03row\x12\x12\n" +
+	"\x04line\x18\x02 \x01(\x05R\x04line\x12\x1f\n" +
+	"\vposted_date\x18\x03 \x01(\tR\n" +
+	"postedDate\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x14\n" +
+	"\x05payee\x18\x05 \x01(\tR\x05payee\x12\x16\n" +
+	"\x06amount\x18\x06 \x01(\tR\x06amount\x12$\n" +
+	"\vexternal_id\x18\a \x01(\tH\x00R\n" +
+	"externalId\x88\x01\x01\x12:\n" +
+	"\x06status\x18\b \x01(\x0e2\".transaction.v1.StatementRowStatusR\x06status\x12*\n" +
+	"\x0etransaction_id\x18\t \x01(\x03H\x01R\rtransactionId\x88\x01\x01B\x0e\n" +
+	"\f_external_idB\x11\n" +
+	"\x0f_transaction_id\"\xff\x02\n" +
+	"\x17ImportStatementResponse\x120\n" +
+	"\x04rows\x18\x01 \x03(\v2\x1c.transaction.v1.StatementRowR\x04rows\x12\x1b\n" +
+	"\tnew_count\x18\x02 \x01(\x05R\bnewCount\x12'\n" +
+	"\x0fduplicate_count\x18\x03 \x01(\x05R\x0eduplicateCount\x12\x1a\n" +
+	"\bimported\x18\x04 \x01(\x05R\bimported\x12\x1c\n" +
+	"\tconfirmed\x18\x05 \x01(\bR\tconfirmed\x125\n" +
+	"\x14statement_account_id\x18\x06 \x01(\tH\x00R\x12statementAccountId\x88\x01\x01\x12\x1d\n" +
+	"\abalance\x18\a \x01(\tH\x01R\abalance\x88\x01\x01\x12&\n" +
+	"\fbalance_date\x18\b \x01(\tH\x02R\vbalanceDate\x88\x01\x01B\x17\n" +
+	"\x15_statement_account_idB\n" +
+	"\n" +
+	"\b_balanceB\x0f\n" +
+	"\r_balance_date*\xe1\x01\n" +
+	"\vAccountType\x12\x1c\n" +
+	"\x18ACCOUNT_TYPE_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15ACCOUNT_TYPE_CHECKING\x10\x01\x12\x18\n" +
+	"\x14ACCOUNT_TYPE_SAVINGS\x10\x02\x12\x1c\n" +
+	"\x18ACCOUNT_TYPE_CREDIT_CARD\x10\x03\x12\x15\n" +
+	"\x11ACCOUNT_TYPE_LOAN\x10\x04\x12\x1b\n" +
+	"\x17ACCOUNT_TYPE_INVESTMENT\x10\x05\x12\x15\n" +
+	"\x11ACCOUNT_TYPE_CASH\x10\x06\x12\x16\n" +
+	"\x12ACCOUNT_TYPE_OTHER\x10\a*c\n" +
+	"\fAccountClass\x12\x1d\n" +
+	"\x19ACCOUNT_CLASS_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13ACCOUNT_CLASS_ASSET\x10\x01\x12\x1b\n" +
+	"\x17ACCOUNT_CLASS_LIABILITY\x10\x02*h\n" +
+	"\rAccountSource\x12\x1e\n" +
+	"\x1aACCOUNT_SOURCE_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18ACCOUNT_SOURCE_SIMPLEFIN\x10\x01\x12\x19\n" +
+	"\x15ACCOUNT_SOURCE_IMPORT\x10\x02*\xfe\x01\n" +
+	"\x14SimplefinHealthState\x12&\n" +
+	"\"SIMPLEFIN_HEALTH_STATE_UNSPECIFIED\x10\x00\x12(\n" +
+	"$SIMPLEFIN_HEALTH_STATE_NOT_CONNECTED\x10\x01\x12$\n" +
+	" SIMPLEFIN_HEALTH_STATE_UNCHECKED\x10\x02\x12\"\n" +
+	"\x1eSIMPLEFIN_HEALTH_STATE_HEALTHY\x10\x03\x12\"\n" +
+	"\x1eSIMPLEFIN_HEALTH_STATE_FAILING\x10\x04\x12&\n" +
+	"\"SIMPLEFIN_HEALTH_STATE_AUTH_FAILED\x10\x05*l\n" +
+	"\vGranularity\x12\x1b\n" +
+	"\x17GRANULARITY_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fGRANULARITY_DAY\x10\x01\x12\x14\n" +
+	"\x10GRANULARITY_WEEK\x10\x02\x12\x15\n" +
+	"\x11GRANULARITY_MONTH\x10\x03*\x8a\x01\n" +
+	"\x0eRuleMatchField\x12 \n" +
+	"\x1cRULE_MATCH_FIELD_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16RULE_MATCH_FIELD_PAYEE\x10\x01\x12 \n" +
+	"\x1cRULE_MATCH_FIELD_DESCRIPTION\x10\x02\x12\x18\n" +
+	"\x14RULE_MATCH_FIELD_ANY\x10\x03*i\n" +
+	"\rRuleMatchType\x12\x1f\n" +
+	"\x1bRULE_MATCH_TYPE_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18RULE_MATCH_TYPE_CONTAINS\x10\x01\x12\x19\n" +
+	"\x15RULE_MATCH_TYPE_REGEX\x10\x02*\xa1\x01\n" +
+	"\x12TransferPairStatus\x12$\n" +
+	" TRANSFER_PAIR_STATUS_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dTRANSFER_PAIR_STATUS_DETECTED\x10\x01\x12\"\n" +
+	"\x1eTRANSFER_PAIR_STATUS_CONFIRMED\x10\x02\x12\x1e\n" +
+	"\x1aTRANSFER_PAIR_STATUS_SPLIT\x10\x03*\x81\x01\n" +
+	"\x0fStatementFormat\x12 \n" +
+	"\x1cSTATEMENT_FORMAT_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14STATEMENT_FORMAT_CSV\x10\x01\x12\x18\n" +
+	"\x14STATEMENT_FORMAT_OFX\x10\x02\x12\x18\n" +
+	"\x14STATEMENT_FORMAT_QFX\x10\x03*\x9e\x01\n" +
+	"\x12StatementRowStatus\x12$\n" +
+	" STATEMENT_ROW_STATUS_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18STATEMENT_ROW_STATUS_NEW\x10\x01\x12\"\n" +
+	"\x1eSTATEMENT_ROW_STATUS_DUPLICATE\x10\x02\x12 \n" +
+	"\x1cSTATEMENT_ROW_STATUS_SKIPPED\x10\x032\xda\x1c\n" +
 	"\x12TransactionService\x12V\n" +
 	"\vGetAccounts\x12\".transaction.v1.GetAccountsRequest\x1a#.transaction.v1.GetAccountsResponse\x12q\n" +
 	"\x14GetSimplefinAccounts\x12+.transaction.v1.GetSimplefinAccountsRequest\x1a,.transaction.v1.GetSimplefinAccountsResponse\x12S\n" +
 	"\n" +
-	"AddAccount\x12!.transaction.v1.AddAccountRequest\x1a\".transaction.v1.AddAccountResponseB3Z1expenses-backend/pkg/transaction/v1;transactionv1b\x06proto3"
+	"AddAccount\x12!.transaction.v1.AddAccountRequest\x1a\".transaction.v1.AddAccountResponse\x12\\\n" +
+	"\rUpdateAccount\x12$.transaction.v1.UpdateAccountRequest\x1a%.transaction.v1.UpdateAccountResponse\x12\\\n" +
+	"\rRemoveAccount\x12$.transaction.v1.RemoveAccountRequest\x1a%.transaction.v1.RemoveAccountResponse\x12\\\n" +
+	"\rRelinkAccount\x12$.transaction.v1.RelinkAccountRequest\x1a%.transaction.v1.RelinkAccountResponse\x12e\n" +
+	"\x10ConnectSimplefin\x12'.transaction.v1.ConnectSimplefinRequest\x1a(.transaction.v1.ConnectSimplefinResponse\x12n\n" +
+	"\x13DisconnectSimplefin\x12*.transaction.v1.DisconnectSimplefinRequest\x1a+.transaction.v1.DisconnectSimplefinResponse\x12w\n" +
+	"\x16GetSimplefinConnection\x12-.transaction.v1.GetSimplefinConnectionRequest\x1a..transaction.v1.GetSimplefinConnectionResponse\x12e\n" +
+	"\x10ListTransactions\x12'.transaction.v1.ListTransactionsRequest\x1a(.transaction.v1.ListTransactionsResponse\x12_\n" +
+	"\x0eGetTransaction\x12%.transaction.v1.GetTransactionRequest\x1a&.transaction.v1.GetTransactionResponse\x12h\n" +
+	"\x11UpdateTransaction\x12(.transaction.v1.UpdateTransactionRequest\x1a).transaction.v1.UpdateTransactionResponse\x12q\n" +
+	"\x14SetTransactionSplits\x12+.transaction.v1.SetTransactionSplitsRequest\x1a,.transaction.v1.SetTransactionSplitsResponse\x12h\n" +
+	"\x11MatchTransactions\x12(.transaction.v1.MatchTransactionsRequest\x1a).transaction.v1.MatchTransactionsResponse\x12e\n" +
+	"\x10ListMatchReviews\x12'.transaction.v1.ListMatchReviewsRequest\x1a(.transaction.v1.ListMatchReviewsResponse\x12k\n" +
+	"\x12ResolveMatchReview\x12).transaction.v1.ResolveMatchReviewRequest\x1a*.transaction.v1.ResolveMatchReviewResponse\x12J\n" +
+	"\aSyncNow\x12\x1e.transaction.v1.SyncNowRequest\x1a\x1f.transaction.v1.SyncNowResponse\x12\\\n" +
+	"\rGetSyncStatus\x12$.transaction.v1.GetSyncStatusRequest\x1a%.transaction.v1.GetSyncStatusResponse\x12k\n" +
+	"\x12GetAccountBalances\x12).transaction.v1.GetAccountBalancesRequest\x1a*.transaction.v1.GetAccountBalancesResponse\x12k\n" +
+	"\x12GetNetWorthHistory\x12).transaction.v1.GetNetWorthHistoryRequest\x1a*.transaction.v1.GetNetWorthHistoryResponse\x12S\n" +
+	"\n" +
+	"CreateRule\x12!.transaction.v1.CreateRuleRequest\x1a\".transaction.v1.CreateRuleResponse\x12S\n" +
+	"\n" +
+	"UpdateRule\x12!.transaction.v1.UpdateRuleRequest\x1a\".transaction.v1.UpdateRuleResponse\x12S\n" +
+	"\n" +
+	"DeleteRule\x12!.transaction.v1.DeleteRuleRequest\x1a\".transaction.v1.DeleteRuleResponse\x12P\n" +
+	"\tListRules\x12 .transaction.v1.ListRulesRequest\x1a!.transaction.v1.ListRulesResponse\x12S\n" +
+	"\n" +
+	"ApplyRules\x12!.transaction.v1.ApplyRulesRequest\x1a\".transaction.v1.ApplyRulesResponse\x12V\n" +
+	"\vPreviewRule\x12\".transaction.v1.PreviewRuleRequest\x1a#.transaction.v1.PreviewRuleResponse\x12h\n" +
+	"\x11SuggestCategories\x12(.transaction.v1.SuggestCategoriesRequest\x1a).transaction.v1.SuggestCategoriesResponse\x12b\n" +
+	"\x0fDetectTransfers\x12&.transaction.v1.DetectTransfersRequest\x1a'.transaction.v1.DetectTransfersResponse\x12h\n" +
+	"\x11ListTransferPairs\x12(.transaction.v1.ListTransferPairsRequest\x1a).transaction.v1.ListTransferPairsResponse\x12n\n" +
+	"\x13ConfirmTransferPair\x12*.transaction.v1.ConfirmTransferPairRequest\x1a+.transaction.v1.ConfirmTransferPairResponse\x12h\n" +
+	"\x11SplitTransferPair\x12(.transaction.v1.SplitTransferPairRequest\x1a).transaction.v1.SplitTransferPairResponse\x12b\n" +
+	"\x0fImportStatement\x12&.transaction.v1.ImportStatementRequest\x1a'.transaction.v1.ImportStatementResponse\x12w\n" +
+	"\x16CreateStatementMapping\x12-.transaction.v1.CreateStatementMappingRequest\x1a..transaction.v1.CreateStatementMappingResponse\x12w\n" +
+	"\x16UpdateStatementMapping\x12-.transaction.v1.UpdateStatementMappingRequest\x1a..transaction.v1.UpdateStatementMappingResponse\x12w\n" +
+	"\x16DeleteStatementMapping\x12-.transaction.v1.DeleteStatementMappingRequest\x1a..transaction.v1.DeleteStatementMappingResponse\x12t\n" +
+	"\x15ListStatementMappings\x12,.transaction.v1.ListStatementMappingsRequest\x1a-.transaction.v1.ListStatementMappingsResponseB3Z1expenses-backend/pkg/transaction/v1;transactionv1b\x06proto3"
 
 var (
 	file_transaction_v1_transaction_proto_rawDescOnce sync.Once
@@ -637,40 +6765,274 @@ func file_transaction_v1_transaction_proto_rawDescGZIP() []byte {
 	return file_transaction_v1_transaction_proto_rawDescData
 }
 
-var file_transaction_v1_transaction_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_transaction_v1_transaction_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
+var file_transaction_v1_transaction_proto_msgTypes = make([]protoimpl.MessageInfo, 92)
 var file_transaction_v1_transaction_proto_goTypes = []any{
-	(*Organization)(nil),                 // 0: transaction.v1.Organization
-	(*Transaction)(nil),                  // 1: transaction.v1.Transaction
-	(*Account)(nil),                      // 2: transaction.v1.Account
-	(*SimplefinAccount)(nil),             // 3: transaction.v1.SimplefinAccount
-	(*GetSimplefinAccountsRequest)(nil),  // 4: transaction.v1.GetSimplefinAccountsRequest
-	(*GetSimplefinAccountsResponse)(nil), // 5: transaction.v1.GetSimplefinAccountsResponse
-	(*GetAccountsRequest)(nil),           // 6: transaction.v1.GetAccountsRequest
-	(*GetAccountsResponse)(nil),          // 7: transaction.v1.GetAccountsResponse
-	(*AddAccountRequest)(nil),            // 8: transaction.v1.AddAccountRequest
-	(*AddAccountResponse)(nil),           // 9: transaction.v1.AddAccountResponse
-	(*timestamppb.Timestamp)(nil),        // 10: google.protobuf.Timestamp
+	(AccountType)(0),                       // 0: transaction.v1.AccountType
+	(AccountClass)(0),                      // 1: transaction.v1.AccountClass
+	(AccountSource)(0),                     // 2: transaction.v1.AccountSource
+	(SimplefinHealthState)(0),              // 3: transaction.v1.SimplefinHealthState
+	(Granularity)(0),                       // 4: transaction.v1.Granularity
+	(RuleMatchField)(0),                    // 5: transaction.v1.RuleMatchField
+	(RuleMatchType)(0),                     // 6: transaction.v1.RuleMatchType
+	(TransferPairStatus)(0),                // 7: transaction.v1.TransferPairStatus
+	(StatementFormat)(0),                   // 8: transaction.v1.StatementFormat
+	(StatementRowStatus)(0),                // 9: transaction.v1.StatementRowStatus
+	(*Organization)(nil),                   // 10: transaction.v1.Organization
+	(*Transaction)(nil),                    // 11: transaction.v1.Transaction
+	(*Account)(nil),                        // 12: transaction.v1.Account
+	(*SimplefinAccount)(nil),               // 13: transaction.v1.SimplefinAccount
+	(*GetSimplefinAccountsRequest)(nil),    // 14: transaction.v1.GetSimplefinAccountsRequest
+	(*GetSimplefinAccountsResponse)(nil),   // 15: transaction.v1.GetSimplefinAccountsResponse
+	(*GetAccountsRequest)(nil),             // 16: transaction.v1.GetAccountsRequest
+	(*GetAccountsResponse)(nil),            // 17: transaction.v1.GetAccountsResponse
+	(*AddAccountRequest)(nil),              // 18: transaction.v1.AddAccountRequest
+	(*AddAccountResponse)(nil),             // 19: transaction.v1.AddAccountResponse
+	(*UpdateAccountRequest)(nil),           // 20: transaction.v1.UpdateAccountRequest
+	(*UpdateAccountResponse)(nil),          // 21: transaction.v1.UpdateAccountResponse
+	(*RemoveAccountRequest)(nil),           // 22: transaction.v1.RemoveAccountRequest
+	(*RemoveAccountResponse)(nil),          // 23: transaction.v1.RemoveAccountResponse
+	(*RelinkAccountRequest)(nil),           // 24: transaction.v1.RelinkAccountRequest
+	(*RelinkAccountResponse)(nil),          // 25: transaction.v1.RelinkAccountResponse
+	(*SimplefinHealth)(nil),                // 26: transaction.v1.SimplefinHealth
+	(*SimplefinConnection)(nil),            // 27: transaction.v1.SimplefinConnection
+	(*ConnectSimplefinRequest)(nil),        // 28: transaction.v1.ConnectSimplefinRequest
+	(*ConnectSimplefinResponse)(nil),       // 29: transaction.v1.ConnectSimplefinResponse
+	(*DisconnectSimplefinRequest)(nil),     // 30: transaction.v1.DisconnectSimplefinRequest
+	(*DisconnectSimplefinResponse)(nil),    // 31: transaction.v1.DisconnectSimplefinResponse
+	(*GetSimplefinConnectionRequest)(nil),  // 32: transaction.v1.GetSimplefinConnectionRequest
+	(*GetSimplefinConnectionResponse)(nil), // 33: transaction.v1.GetSimplefinConnectionResponse
+	(*AccountTransaction)(nil),             // 34: transaction.v1.AccountTransaction
+	(*TransactionSplit)(nil),               // 35: transaction.v1.TransactionSplit
+	(*ListTransactionsRequest)(nil),        // 36: transaction.v1.ListTransactionsRequest
+	(*ListTransactionsResponse)(nil),       // 37: transaction.v1.ListTransactionsResponse
+	(*GetTransactionRequest)(nil),          // 38: transaction.v1.GetTransactionRequest
+	(*GetTransactionResponse)(nil),         // 39: transaction.v1.GetTransactionResponse
+	(*UpdateTransactionRequest)(nil),       // 40: transaction.v1.UpdateTransactionRequest
+	(*UpdateTransactionResponse)(nil),      // 41: transaction.v1.UpdateTransactionResponse
+	(*SetTransactionSplitsRequest)(nil),    // 42: transaction.v1.SetTransactionSplitsRequest
+	(*SetTransactionSplitsResponse)(nil),   // 43: transaction.v1.SetTransactionSplitsResponse
+	(*MatchReview)(nil),                    // 44: transaction.v1.MatchReview
+	(*MatchTransactionsRequest)(nil),       // 45: transaction.v1.MatchTransactionsRequest
+	(*MatchTransactionsResponse)(nil),      // 46: transaction.v1.MatchTransactionsResponse
+	(*ListMatchReviewsRequest)(nil),        // 47: transaction.v1.ListMatchReviewsRequest
+	(*ListMatchReviewsResponse)(nil),       // 48: transaction.v1.ListMatchReviewsResponse
+	(*ResolveMatchReviewRequest)(nil),      // 49: transaction.v1.ResolveMatchReviewRequest
+	(*ResolveMatchReviewResponse)(nil),     // 50: transaction.v1.ResolveMatchReviewResponse
+	(*AccountSyncStatus)(nil),              // 51: transaction.v1.AccountSyncStatus
+	(*SyncError)(nil),                      // 52: transaction.v1.SyncError
+	(*SyncStatus)(nil),                     // 53: transaction.v1.SyncStatus
+	(*SyncNowRequest)(nil),                 // 54: transaction.v1.SyncNowRequest
+	(*SyncNowResponse)(nil),                // 55: transaction.v1.SyncNowResponse
+	(*GetSyncStatusRequest)(nil),           // 56: transaction.v1.GetSyncStatusRequest
+	(*GetSyncStatusResponse)(nil),          // 57: transaction.v1.GetSyncStatusResponse
+	(*BalancePoint)(nil),                   // 58: transaction.v1.BalancePoint
+	(*AccountBalanceSeries)(nil),           // 59: transaction.v1.AccountBalanceSeries
+	(*GetAccountBalancesRequest)(nil),      // 60: transaction.v1.GetAccountBalancesRequest
+	(*GetAccountBalancesResponse)(nil),     // 61: transaction.v1.GetAccountBalancesResponse
+	(*NetWorthPoint)(nil),                  // 62: transaction.v1.NetWorthPoint
+	(*GetNetWorthHistoryRequest)(nil),      // 63: transaction.v1.GetNetWorthHistoryRequest
+	(*GetNetWorthHistoryResponse)(nil),     // 64: transaction.v1.GetNetWorthHistoryResponse
+	(*TransactionRule)(nil),                // 65: transaction.v1.TransactionRule
+	(*CreateRuleRequest)(nil),              // 66: transaction.v1.CreateRuleRequest
+	(*CreateRuleResponse)(nil),             // 67: transaction.v1.CreateRuleResponse
+	(*UpdateRuleRequest)(nil),              // 68: transaction.v1.UpdateRuleRequest
+	(*UpdateRuleResponse)(nil),             // 69: transaction.v1.UpdateRuleResponse
+	(*DeleteRuleRequest)(nil),              // 70: transaction.v1.DeleteRuleRequest
+	(*DeleteRuleResponse)(nil),             // 71: transaction.v1.DeleteRuleResponse
+	(*ListRulesRequest)(nil),               // 72: transaction.v1.ListRulesRequest
+	(*ListRulesResponse)(nil),              // 73: transaction.v1.ListRulesResponse
+	(*ApplyRulesRequest)(nil),              // 74: transaction.v1.ApplyRulesRequest
+	(*ApplyRulesResponse)(nil),             // 75: transaction.v1.ApplyRulesResponse
+	(*PreviewRuleRequest)(nil),             // 76: transaction.v1.PreviewRuleRequest
+	(*RuleChange)(nil),                     // 77: transaction.v1.RuleChange
+	(*PreviewRuleResponse)(nil),            // 78: transaction.v1.PreviewRuleResponse
+	(*SuggestCategoriesRequest)(nil),       // 79: transaction.v1.SuggestCategoriesRequest
+	(*SuggestCategoriesResponse)(nil),      // 80: transaction.v1.SuggestCategoriesResponse
+	(*TransferPair)(nil),                   // 81: transaction.v1.TransferPair
+	(*DetectTransfersRequest)(nil),         // 82: transaction.v1.DetectTransfersRequest
+	(*DetectTransfersResponse)(nil),        // 83: transaction.v1.DetectTransfersResponse
+	(*ListTransferPairsRequest)(nil),       // 84: transaction.v1.ListTransferPairsRequest
+	(*ListTransferPairsResponse)(nil),      // 85: transaction.v1.ListTransferPairsResponse
+	(*ConfirmTransferPairRequest)(nil),     // 86: transaction.v1.ConfirmTransferPairRequest
+	(*ConfirmTransferPairResponse)(nil),    // 87: transaction.v1.ConfirmTransferPairResponse
+	(*SplitTransferPairRequest)(nil),       // 88: transaction.v1.SplitTransferPairRequest
+	(*SplitTransferPairResponse)(nil),      // 89: transaction.v1.SplitTransferPairResponse
+	(*StatementMapping)(nil),               // 90: transaction.v1.StatementMapping
+	(*CreateStatementMappingRequest)(nil),  // 91: transaction.v1.CreateStatementMappingRequest
+	(*CreateStatementMappingResponse)(nil), // 92: transaction.v1.CreateStatementMappingResponse
+	(*UpdateStatementMappingRequest)(nil),  // 93: transaction.v1.UpdateStatementMappingRequest
+	(*UpdateStatementMappingResponse)(nil), // 94: transaction.v1.UpdateStatementMappingResponse
+	(*DeleteStatementMappingRequest)(nil),  // 95: transaction.v1.DeleteStatementMappingRequest
+	(*DeleteStatementMappingResponse)(nil), // 96: transaction.v1.DeleteStatementMappingResponse
+	(*ListStatementMappingsRequest)(nil),   // 97: transaction.v1.ListStatementMappingsRequest
+	(*ListStatementMappingsResponse)(nil),  // 98: transaction.v1.ListStatementMappingsResponse
+	(*ImportStatementRequest)(nil),         // 99: transaction.v1.ImportStatementRequest
+	(*StatementRow)(nil),                   // 100: transaction.v1.StatementRow
+	(*ImportStatementResponse)(nil),        // 101: transaction.v1.ImportStatementResponse
+	(*timestamppb.Timestamp)(nil),          // 102: google.protobuf.Timestamp
 }
 var file_transaction_v1_transaction_proto_depIdxs = []int32{
-	10, // 0: transaction.v1.Transaction.posted:type_name -> google.protobuf.Timestamp
-	10, // 1: transaction.v1.Transaction.transacted_at:type_name -> google.protobuf.Timestamp
-	0,  // 2: transaction.v1.SimplefinAccount.org:type_name -> transaction.v1.Organization
-	10, // 3: transaction.v1.SimplefinAccount.balance_date:type_name -> google.protobuf.Timestamp
-	1,  // 4: transaction.v1.SimplefinAccount.transactions:type_name -> transaction.v1.Transaction
-	3,  // 5: transaction.v1.GetSimplefinAccountsResponse.accounts:type_name -> transaction.v1.SimplefinAccount
-	2,  // 6: transaction.v1.GetAccountsResponse.accounts:type_name -> transaction.v1.Account
-	2,  // 7: transaction.v1.AddAccountResponse.account:type_name -> transaction.v1.Account
-	6,  // 8: transaction.v1.TransactionService.GetAccounts:input_type -> transaction.v1.GetAccountsRequest
-	4,  // 9: transaction.v1.TransactionService.GetSimplefinAccounts:input_type -> transaction.v1.GetSimplefinAccountsRequest
-	8,  // 10: transaction.v1.TransactionService.AddAccount:input_type -> transaction.v1.AddAccountRequest
-	7,  // 11: transaction.v1.TransactionService.GetAccounts:output_type -> transaction.v1.GetAccountsResponse
-	5,  // 12: transaction.v1.TransactionService.GetSimplefinAccounts:output_type -> transaction.v1.GetSimplefinAccountsResponse
-	9,  // 13: transaction.v1.TransactionService.AddAccount:output_type -> transaction.v1.AddAccountResponse
-	11, // [11:14] is the sub-list for method output_type
-	8,  // [8:11] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	102, // 0: transaction.v1.Transaction.posted:type_name -> google.protobuf.Timestamp
+	102, // 1: transaction.v1.Transaction.transacted_at:type_name -> google.protobuf.Timestamp
+	0,   // 2: transaction.v1.Account.type:type_name -> transaction.v1.AccountType
+	102, // 3: transaction.v1.Account.unlinked_at:type_name -> google.protobuf.Timestamp
+	102, // 4: transaction.v1.Account.relinked_at:type_name -> google.protobuf.Timestamp
+	1,   // 5: transaction.v1.Account.class:type_name -> transaction.v1.AccountClass
+	2,   // 6: transaction.v1.Account.source:type_name -> transaction.v1.AccountSource
+	10,  // 7: transaction.v1.SimplefinAccount.org:type_name -> transaction.v1.Organization
+	102, // 8: transaction.v1.SimplefinAccount.balance_date:type_name -> google.protobuf.Timestamp
+	11,  // 9: transaction.v1.SimplefinAccount.transactions:type_name -> transaction.v1.Transaction
+	13,  // 10: transaction.v1.GetSimplefinAccountsResponse.accounts:type_name -> transaction.v1.SimplefinAccount
+	26,  // 11: transaction.v1.GetSimplefinAccountsResponse.health:type_name -> transaction.v1.SimplefinHealth
+	12,  // 12: transaction.v1.GetAccountsResponse.accounts:type_name -> transaction.v1.Account
+	26,  // 13: transaction.v1.GetAccountsResponse.health:type_name -> transaction.v1.SimplefinHealth
+	0,   // 14: transaction.v1.AddAccountRequest.type:type_name -> transaction.v1.AccountType
+	2,   // 15: transaction.v1.AddAccountRequest.source:type_name -> transaction.v1.AccountSource
+	12,  // 16: transaction.v1.AddAccountResponse.account:type_name -> transaction.v1.Account
+	0,   // 17: transaction.v1.UpdateAccountRequest.type:type_name -> transaction.v1.AccountType
+	12,  // 18: transaction.v1.UpdateAccountResponse.account:type_name -> transaction.v1.Account
+	12,  // 19: transaction.v1.RelinkAccountResponse.account:type_name -> transaction.v1.Account
+	3,   // 20: transaction.v1.SimplefinHealth.state:type_name -> transaction.v1.SimplefinHealthState
+	102, // 21: transaction.v1.SimplefinHealth.last_success_at:type_name -> google.protobuf.Timestamp
+	102, // 22: transaction.v1.SimplefinHealth.last_error_at:type_name -> google.protobuf.Timestamp
+	102, // 23: transaction.v1.SimplefinHealth.retry_at:type_name -> google.protobuf.Timestamp
+	26,  // 24: transaction.v1.SimplefinConnection.health:type_name -> transaction.v1.SimplefinHealth
+	27,  // 25: transaction.v1.ConnectSimplefinResponse.connection:type_name -> transaction.v1.SimplefinConnection
+	27,  // 26: transaction.v1.GetSimplefinConnectionResponse.connection:type_name -> transaction.v1.SimplefinConnection
+	102, // 27: transaction.v1.AccountTransaction.transacted_at:type_name -> google.protobuf.Timestamp
+	35,  // 28: transaction.v1.AccountTransaction.splits:type_name -> transaction.v1.TransactionSplit
+	34,  // 29: transaction.v1.ListTransactionsResponse.transactions:type_name -> transaction.v1.AccountTransaction
+	34,  // 30: transaction.v1.GetTransactionResponse.transaction:type_name -> transaction.v1.AccountTransaction
+	34,  // 31: transaction.v1.UpdateTransactionResponse.transaction:type_name -> transaction.v1.AccountTransaction
+	35,  // 32: transaction.v1.SetTransactionSplitsRequest.splits:type_name -> transaction.v1.TransactionSplit
+	34,  // 33: transaction.v1.SetTransactionSplitsResponse.transaction:type_name -> transaction.v1.AccountTransaction
+	34,  // 34: transaction.v1.MatchReview.transaction:type_name -> transaction.v1.AccountTransaction
+	44,  // 35: transaction.v1.ListMatchReviewsResponse.reviews:type_name -> transaction.v1.MatchReview
+	102, // 36: transaction.v1.AccountSyncStatus.cursor:type_name -> google.protobuf.Timestamp
+	102, // 37: transaction.v1.AccountSyncStatus.last_attempt_at:type_name -> google.protobuf.Timestamp
+	102, // 38: transaction.v1.AccountSyncStatus.last_success_at:type_name -> google.protobuf.Timestamp
+	102, // 39: transaction.v1.SyncError.created_at:type_name -> google.protobuf.Timestamp
+	102, // 40: transaction.v1.SyncStatus.last_started_at:type_name -> google.protobuf.Timestamp
+	102, // 41: transaction.v1.SyncStatus.last_finished_at:type_name -> google.protobuf.Timestamp
+	51,  // 42: transaction.v1.SyncStatus.accounts:type_name -> transaction.v1.AccountSyncStatus
+	52,  // 43: transaction.v1.SyncStatus.recent_errors:type_name -> transaction.v1.SyncError
+	26,  // 44: transaction.v1.SyncStatus.health:type_name -> transaction.v1.SimplefinHealth
+	53,  // 45: transaction.v1.SyncNowResponse.status:type_name -> transaction.v1.SyncStatus
+	53,  // 46: transaction.v1.GetSyncStatusResponse.status:type_name -> transaction.v1.SyncStatus
+	12,  // 47: transaction.v1.AccountBalanceSeries.account:type_name -> transaction.v1.Account
+	58,  // 48: transaction.v1.AccountBalanceSeries.points:type_name -> transaction.v1.BalancePoint
+	4,   // 49: transaction.v1.GetAccountBalancesRequest.granularity:type_name -> transaction.v1.Granularity
+	59,  // 50: transaction.v1.GetAccountBalancesResponse.accounts:type_name -> transaction.v1.AccountBalanceSeries
+	4,   // 51: transaction.v1.GetNetWorthHistoryRequest.granularity:type_name -> transaction.v1.Granularity
+	62,  // 52: transaction.v1.GetNetWorthHistoryResponse.points:type_name -> transaction.v1.NetWorthPoint
+	5,   // 53: transaction.v1.TransactionRule.match_field:type_name -> transaction.v1.RuleMatchField
+	6,   // 54: transaction.v1.TransactionRule.match_type:type_name -> transaction.v1.RuleMatchType
+	102, // 55: transaction.v1.TransactionRule.created_at:type_name -> google.protobuf.Timestamp
+	102, // 56: transaction.v1.TransactionRule.updated_at:type_name -> google.protobuf.Timestamp
+	65,  // 57: transaction.v1.CreateRuleRequest.rule:type_name -> transaction.v1.TransactionRule
+	65,  // 58: transaction.v1.CreateRuleResponse.rule:type_name -> transaction.v1.TransactionRule
+	65,  // 59: transaction.v1.UpdateRuleRequest.rule:type_name -> transaction.v1.TransactionRule
+	65,  // 60: transaction.v1.UpdateRuleResponse.rule:type_name -> transaction.v1.TransactionRule
+	65,  // 61: transaction.v1.ListRulesResponse.rules:type_name -> transaction.v1.TransactionRule
+	65,  // 62: transaction.v1.PreviewRuleRequest.rule:type_name -> transaction.v1.TransactionRule
+	34,  // 63: transaction.v1.RuleChange.transaction:type_name -> transaction.v1.AccountTransaction
+	34,  // 64: transaction.v1.RuleChange.updated:type_name -> transaction.v1.AccountTransaction
+	77,  // 65: transaction.v1.PreviewRuleResponse.changes:type_name -> transaction.v1.RuleChange
+	34,  // 66: transaction.v1.TransferPair.outflow:type_name -> transaction.v1.AccountTransaction
+	34,  // 67: transaction.v1.TransferPair.inflow:type_name -> transaction.v1.AccountTransaction
+	7,   // 68: transaction.v1.TransferPair.status:type_name -> transaction.v1.TransferPairStatus
+	7,   // 69: transaction.v1.ListTransferPairsRequest.status:type_name -> transaction.v1.TransferPairStatus
+	81,  // 70: transaction.v1.ListTransferPairsResponse.pairs:type_name -> transaction.v1.TransferPair
+	81,  // 71: transaction.v1.ConfirmTransferPairResponse.pair:type_name -> transaction.v1.TransferPair
+	81,  // 72: transaction.v1.SplitTransferPairResponse.pair:type_name -> transaction.v1.TransferPair
+	102, // 73: transaction.v1.StatementMapping.created_at:type_name -> google.protobuf.Timestamp
+	102, // 74: transaction.v1.StatementMapping.updated_at:type_name -> google.protobuf.Timestamp
+	90,  // 75: transaction.v1.CreateStatementMappingRequest.mapping:type_name -> transaction.v1.StatementMapping
+	90,  // 76: transaction.v1.CreateStatementMappingResponse.mapping:type_name -> transaction.v1.StatementMapping
+	90,  // 77: transaction.v1.UpdateStatementMappingRequest.mapping:type_name -> transaction.v1.StatementMapping
+	90,  // 78: transaction.v1.UpdateStatementMappingResponse.mapping:type_name -> transaction.v1.StatementMapping
+	90,  // 79: transaction.v1.ListStatementMappingsResponse.mappings:type_name -> transaction.v1.StatementMapping
+	8,   // 80: transaction.v1.ImportStatementRequest.format:type_name -> transaction.v1.StatementFormat
+	9,   // 81: transaction.v1.StatementRow.status:type_name -> transaction.v1.StatementRowStatus
+	100, // 82: transaction.v1.ImportStatementResponse.rows:type_name -> transaction.v1.StatementRow
+	16,  // 83: transaction.v1.TransactionService.GetAccounts:input_type -> transaction.v1.GetAccountsRequest
+	14,  // 84: transaction.v1.TransactionService.GetSimplefinAccounts:input_type -> transaction.v1.GetSimplefinAccountsRequest
+	18,  // 85: transaction.v1.TransactionService.AddAccount:input_type -> transaction.v1.AddAccountRequest
+	20,  // 86: transaction.v1.TransactionService.UpdateAccount:input_type -> transaction.v1.UpdateAccountRequest
+	22,  // 87: transaction.v1.TransactionService.RemoveAccount:input_type -> transaction.v1.RemoveAccountRequest
+	24,  // 88: transaction.v1.TransactionService.RelinkAccount:input_type -> transaction.v1.RelinkAccountRequest
+	28,  // 89: transaction.v1.TransactionService.ConnectSimplefin:input_type -> transaction.v1.ConnectSimplefinRequest
+	30,  // 90: transaction.v1.TransactionService.DisconnectSimplefin:input_type -> transaction.v1.DisconnectSimplefinRequest
+	32,  // 91: transaction.v1.TransactionService.GetSimplefinConnection:input_type -> transaction.v1.GetSimplefinConnectionRequest
+	36,  // 92: transaction.v1.TransactionService.ListTransactions:input_type -> transaction.v1.ListTransactionsRequest
+	38,  // 93: transaction.v1.TransactionService.GetTransaction:input_type -> transaction.v1.GetTransactionRequest
+	40,  // 94: transaction.v1.TransactionService.UpdateTransaction:input_type -> transaction.v1.UpdateTransactionRequest
+	42,  // 95: transaction.v1.TransactionService.SetTransactionSplits:input_type -> transaction.v1.SetTransactionSplitsRequest
+	45,  // 96: transaction.v1.TransactionService.MatchTransactions:input_type -> transaction.v1.MatchTransactionsRequest
+	47,  // 97: transaction.v1.TransactionService.ListMatchReviews:input_type -> transaction.v1.ListMatchReviewsRequest
+	49,  // 98: transaction.v1.TransactionService.ResolveMatchReview:input_type -> transaction.v1.ResolveMatchReviewRequest
+	54,  // 99: transaction.v1.TransactionService.SyncNow:input_type -> transaction.v1.SyncNowRequest
+	56,  // 100: transaction.v1.TransactionService.GetSyncStatus:input_type -> transaction.v1.GetSyncStatusRequest
+	60,  // 101: transaction.v1.TransactionService.GetAccountBalances:input_type -> transaction.v1.GetAccountBalancesRequest
+	63,  // 102: transaction.v1.TransactionService.GetNetWorthHistory:input_type -> transaction.v1.GetNetWorthHistoryRequest
+	66,  // 103: transaction.v1.TransactionService.CreateRule:input_type -> transaction.v1.CreateRuleRequest
+	68,  // 104: transaction.v1.TransactionService.UpdateRule:input_type -> transaction.v1.UpdateRuleRequest
+	70,  // 105: transaction.v1.TransactionService.DeleteRule:input_type -> transaction.v1.DeleteRuleRequest
+	72,  // 106: transaction.v1.TransactionService.ListRules:input_type -> transaction.v1.ListRulesRequest
+	74,  // 107: transaction.v1.TransactionService.ApplyRules:input_type -> transaction.v1.ApplyRulesRequest
+	76,  // 108: transaction.v1.TransactionService.PreviewRule:input_type -> transaction.v1.PreviewRuleRequest
+	79,  // 109: transaction.v1.TransactionService.SuggestCategories:input_type -> transaction.v1.SuggestCategoriesRequest
+	82,  // 110: transaction.v1.TransactionService.DetectTransfers:input_type -> transaction.v1.DetectTransfersRequest
+	84,  // 111: transaction.v1.TransactionService.ListTransferPairs:input_type -> transaction.v1.ListTransferPairsRequest
+	86,  // 112: transaction.v1.TransactionService.ConfirmTransferPair:input_type -> transaction.v1.ConfirmTransferPairRequest
+	88,  // 113: transaction.v1.TransactionService.SplitTransferPair:input_type -> transaction.v1.SplitTransferPairRequest
+	99,  // 114: transaction.v1.TransactionService.ImportStatement:input_type -> transaction.v1.ImportStatementRequest
+	91,  // 115: transaction.v1.TransactionService.CreateStatementMapping:input_type -> transaction.v1.CreateStatementMappingRequest
+	93,  // 116: transaction.v1.TransactionService.UpdateStatementMapping:input_type -> transaction.v1.UpdateStatementMappingRequest
+	95,  // 117: transaction.v1.TransactionService.DeleteStatementMapping:input_type -> transaction.v1.DeleteStatementMappingRequest
+	97,  // 118: transaction.v1.TransactionService.ListStatementMappings:input_type -> transaction.v1.ListStatementMappingsRequest
+	17,  // 119: transaction.v1.TransactionService.GetAccounts:output_type -> transaction.v1.GetAccountsResponse
+	15,  // 120: transaction.v1.TransactionService.GetSimplefinAccounts:output_type -> transaction.v1.GetSimplefinAccountsResponse
+	19,  // 121: transaction.v1.TransactionService.AddAccount:output_type -> transaction.v1.AddAccountResponse
+	21,  // 122: transaction.v1.TransactionService.UpdateAccount:output_type -> transaction.v1.UpdateAccountResponse
+	23,  // 123: transaction.v1.TransactionService.RemoveAccount:output_type -> transaction.v1.RemoveAccountResponse
+	25,  // 124: transaction.v1.TransactionService.RelinkAccount:output_type -> transaction.v1.RelinkAccountResponse
+	29,  // 125: transaction.v1.TransactionService.ConnectSimplefin:output_type -> transaction.v1.ConnectSimplefinResponse
+	31,  // 126: transaction.v1.TransactionService.DisconnectSimplefin:output_type -> transaction.v1.DisconnectSimplefinResponse
+	33,  // 127: transaction.v1.TransactionService.GetSimplefinConnection:output_type -> transaction.v1.GetSimplefinConnectionResponse
+	37,  // 128: transaction.v1.TransactionService.ListTransactions:output_type -> transaction.v1.ListTransactionsResponse
+	39,  // 129: transaction.v1.TransactionService.GetTransaction:output_type -> transaction.v1.GetTransactionResponse
+	41,  // 130: transaction.v1.TransactionService.UpdateTransaction:output_type -> transaction.v1.UpdateTransactionResponse
+	43,  // 131: transaction.v1.TransactionService.SetTransactionSplits:output_type -> transaction.v1.SetTransactionSplitsResponse
+	46,  // 132: transaction.v1.TransactionService.MatchTransactions:output_type -> transaction.v1.MatchTransactionsResponse
+	48,  // 133: transaction.v1.TransactionService.ListMatchReviews:output_type -> transaction.v1.ListMatchReviewsResponse
+	50,  // 134: transaction.v1.TransactionService.ResolveMatchReview:output_type -> transaction.v1.ResolveMatchReviewResponse
+	55,  // 135: transaction.v1.TransactionService.SyncNow:output_type -> transaction.v1.SyncNowResponse
+	57,  // 136: transaction.v1.TransactionService.GetSyncStatus:output_type -> transaction.v1.GetSyncStatusResponse
+	61,  // 137: transaction.v1.TransactionService.GetAccountBalances:output_type -> transaction.v1.GetAccountBalancesResponse
+	64,  // 138: transaction.v1.TransactionService.GetNetWorthHistory:output_type -> transaction.v1.GetNetWorthHistoryResponse
+	67,  // 139: transaction.v1.TransactionService.CreateRule:output_type -> transaction.v1.CreateRuleResponse
+	69,  // 140: transaction.v1.TransactionService.UpdateRule:output_type -> transaction.v1.UpdateRuleResponse
+	71,  // 141: transaction.v1.TransactionService.DeleteRule:output_type -> transaction.v1.DeleteRuleResponse
+	73,  // 142: transaction.v1.TransactionService.ListRules:output_type -> transaction.v1.ListRulesResponse
+	75,  // 143: transaction.v1.TransactionService.ApplyRules:output_type -> transaction.v1.ApplyRulesResponse
+	78,  // 144: transaction.v1.TransactionService.PreviewRule:output_type -> transaction.v1.PreviewRuleResponse
+	80,  // 145: transaction.v1.TransactionService.SuggestCategories:output_type -> transaction.v1.SuggestCategoriesResponse
+	83,  // 146: transaction.v1.TransactionService.DetectTransfers:output_type -> transaction.v1.DetectTransfersResponse
+	85,  // 147: transaction.v1.TransactionService.ListTransferPairs:output_type -> transaction.v1.ListTransferPairsResponse
+	87,  // 148: transaction.v1.TransactionService.ConfirmTransferPair:output_type -> transaction.v1.ConfirmTransferPairResponse
+	89,  // 149: transaction.v1.TransactionService.SplitTransferPair:output_type -> transaction.v1.SplitTransferPairResponse
+	101, // 150: transaction.v1.TransactionService.ImportStatement:output_type -> transaction.v1.ImportStatementResponse
+	92,  // 151: transaction.v1.TransactionService.CreateStatementMapping:output_type -> transaction.v1.CreateStatementMappingResponse
+	94,  // 152: transaction.v1.TransactionService.UpdateStatementMapping:output_type -> transaction.v1.UpdateStatementMappingResponse
+	96,  // 153: transaction.v1.TransactionService.DeleteStatementMapping:output_type -> transaction.v1.DeleteStatementMappingResponse
+	98,  // 154: transaction.v1.TransactionService.ListStatementMappings:output_type -> transaction.v1.ListStatementMappingsResponse
+	119, // [119:155] is the sub-list for method output_type
+	83,  // [83:119] is the sub-list for method input_type
+	83,  // [83:83] is the sub-list for extension type_name
+	83,  // [83:83] is the sub-list for extension extendee
+	0,   // [0:83] is the sub-list for field type_name
 }
 
 func init() { file_transaction_v1_transaction_proto_init() }
@@ -679,19 +7041,44 @@ func file_transaction_v1_transaction_proto_init() {
 		return
 	}
 	file_transaction_v1_transaction_proto_msgTypes[1].OneofWrappers = []any{}
+	file_transaction_v1_transaction_proto_msgTypes[2].OneofWrappers = []any{}
 	file_transaction_v1_transaction_proto_msgTypes[3].OneofWrappers = []any{}
+	file_transaction_v1_transaction_proto_msgTypes[10].OneofWrappers = []any{}
+	file_transaction_v1_transaction_proto_msgTypes[16].OneofWrappers = []any{}
+	file_transaction_v1_transaction_proto_msgTypes[17].OneofWrappers = []any{}
+	file_transaction_v1_transaction_proto_msgTypes[24].OneofWrappers = []any{}
+	file_transaction_v1_transaction_proto_msgTypes[25].OneofWrappers = []any{}
+	file_transaction_v1_transaction_proto_msgTypes[26].OneofWrappers = []any{}
+	file_transaction_v1_transaction_proto_msgTypes[30].OneofWrappers = []any{}
+	file_transaction_v1_transaction_proto_msgTypes[35].OneofWrappers = []any{}
+	file_transaction_v1_transaction_proto_msgTypes[41].OneofWrappers = []any{}
+	file_transaction_v1_transaction_proto_msgTypes[42].OneofWrappers = []any{}
+	file_transaction_v1_transaction_proto_msgTypes[43].OneofWrappers = []any{}
+	file_transaction_v1_transaction_proto_msgTypes[48].OneofWrappers = []any{}
+	file_transaction_v1_transaction_proto_msgTypes[50].OneofWrappers = []any{}
+	file_transaction_v1_transaction_proto_msgTypes[53].OneofWrappers = []any{}
+	file_transaction_v1_transaction_proto_msgTypes[55].OneofWrappers = []any{}
+	file_transaction_v1_transaction_proto_msgTypes[64].OneofWrappers = []any{}
+	file_transaction_v1_transaction_proto_msgTypes[66].OneofWrappers = []any{}
+	file_transaction_v1_transaction_proto_msgTypes[69].OneofWrappers = []any{}
+	file_transaction_v1_transaction_proto_msgTypes[72].OneofWrappers = []any{}
+	file_transaction_v1_transaction_proto_msgTypes[80].OneofWrappers = []any{}
+	file_transaction_v1_transaction_proto_msgTypes[89].OneofWrappers = []any{}
+	file_transaction_v1_transaction_proto_msgTypes[90].OneofWrappers = []any{}
+	file_transaction_v1_transaction_proto_msgTypes[91].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_transaction_v1_transaction_proto_rawDesc), len(file_transaction_v1_transaction_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   10,
+			NumEnums:      10,
+			NumMessages:   92,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_transaction_v1_transaction_proto_goTypes,
 		DependencyIndexes: file_transaction_v1_transaction_proto_depIdxs,
+		EnumInfos:         file_transaction_v1_transaction_proto_enumTypes,
 		MessageInfos:      file_transaction_v1_transaction_proto_msgTypes,
 	}.Build()
 	File_transaction_v1_transaction_proto = out.File
@@ -42,6 +42,105 @@ const (
 	// TransactionServiceAddAccountProcedure is the fully-qualified name of the TransactionService's
 	// AddAccount RPC.
 	TransactionServiceAddAccountProcedure = "/transaction.v1.TransactionService/AddAccount"
+	// TransactionServiceUpdateAccountProcedure is the fully-qualified name of the TransactionService's
+	// UpdateAccount RPC.
+	TransactionServiceUpdateAccountProcedure = "/transaction.v1.TransactionService/UpdateAccount"
+	// TransactionServiceRemoveAccountProcedure is the fully-qualified name of the TransactionService's
+	// RemoveAccount RPC.
+	TransactionServiceRemoveAccountProcedure = "/transaction.v1.TransactionService/RemoveAccount"
+	// TransactionServiceRelinkAccountProcedure is the fully-qualified name of the TransactionService's
+	// RelinkAccount RPC.
+	TransactionServiceRelinkAccountProcedure = "/transaction.v1.TransactionService/RelinkAccount"
+	// TransactionServiceConnectSimplefinProcedure is the fully-qualified name of the
+	// TransactionService's ConnectSimplefin RPC.
+	TransactionServiceConnectSimplefinProcedure = "/transaction.v1.TransactionService/ConnectSimplefin"
+	// TransactionServiceDisconnectSimplefinProcedure is the fully-qualified name of the
+	// TransactionService's DisconnectSimplefin RPC.
+	TransactionServiceDisconnectSimplefinProcedure = "/transaction.v1.TransactionService/DisconnectSimplefin"
+	// TransactionServiceGetSimplefinConnectionProcedure is the fully-qualified name of the
+	// TransactionService's GetSimplefinConnection RPC.
+	TransactionServiceGetSimplefinConnectionProcedure = "/transaction.v1.TransactionService/GetSimplefinConnection"
+	// TransactionServiceListTransactionsProcedure is the fully-qualified name of the
+	// TransactionService's ListTransactions RPC.
+	TransactionServiceListTransactionsProcedure = "/transaction.v1.TransactionService/ListTransactions"
+	// TransactionServiceGetTransactionProcedure is the fully-qualified name of the TransactionService's
+	// GetTransaction RPC.
+	TransactionServiceGetTransactionProcedure = "/transaction.v1.TransactionService/GetTransaction"
+	// TransactionServiceUpdateTransactionProcedure is the fully-qualified name of the
+	// TransactionService's UpdateTransaction RPC.
+	TransactionServiceUpdateTransactionProcedure = "/transaction.v1.TransactionService/UpdateTransaction"
+	// TransactionServiceSetTransactionSplitsProcedure is the fully-qualified name of the
+	// TransactionService's SetTransactionSplits RPC.
+	TransactionServiceSetTransactionSplitsProcedure = "/transaction.v1.TransactionService/SetTransactionSplits"
+	// TransactionServiceMatchTransactionsProcedure is the fully-qualified name of the
+	// TransactionService's MatchTransactions RPC.
+	TransactionServiceMatchTransactionsProcedure = "/transaction.v1.TransactionService/MatchTransactions"
+	// TransactionServiceListMatchReviewsProcedure is the fully-qualified name of the
+	// TransactionService's ListMatchReviews RPC.
+	TransactionServiceListMatchReviewsProcedure = "/transaction.v1.TransactionService/ListMatchReviews"
+	// TransactionServiceResolveMatchReviewProcedure is the fully-qualified name of the
+	// TransactionService's ResolveMatchReview RPC.
+	TransactionServiceResolveMatchReviewProcedure = "/transaction.v1.TransactionService/ResolveMatchReview"
+	// TransactionServiceSyncNowProcedure is the fully-qualified name of the TransactionService's
+	// SyncNow RPC.
+	TransactionServiceSyncNowProcedure = "/transaction.v1.TransactionService/SyncNow"
+	// TransactionServiceGetSyncStatusProcedure is the fully-qualified name of the TransactionService's
+	// GetSyncStatus RPC.
+	TransactionServiceGetSyncStatusProcedure = "/transaction.v1.TransactionService/GetSyncStatus"
+	// TransactionServiceGetAccountBalancesProcedure is the fully-qualified name of the
+	// TransactionService's GetAccountBalances RPC.
+	TransactionServiceGetAccountBalancesProcedure = "/transaction.v1.TransactionService/GetAccountBalances"
+	// TransactionServiceGetNetWorthHistoryProcedure is the fully-qualified name of the
+	// TransactionService's GetNetWorthHistory RPC.
+	TransactionServiceGetNetWorthHistoryProcedure = "/transaction.v1.TransactionService/GetNetWorthHistory"
+	// TransactionServiceCreateRuleProcedure is the fully-qualified name of the TransactionService's
+	// CreateRule RPC.
+	TransactionServiceCreateRuleProcedure = "/transaction.v1.TransactionService/CreateRule"
+	// TransactionServiceUpdateRuleProcedure is the fully-qualified name of the TransactionService's
+	// UpdateRule RPC.
+	TransactionServiceUpdateRuleProcedure = "/transaction.v1.TransactionService/UpdateRule"
+	// TransactionServiceDeleteRuleProcedure is the fully-qualified name of the TransactionService's
+	// DeleteRule RPC.
+	TransactionServiceDeleteRuleProcedure = "/transaction.v1.TransactionService/DeleteRule"
+	// TransactionServiceListRulesProcedure is the fully-qualified name of the TransactionService's
+	// ListRules RPC.
+	TransactionServiceListRulesProcedure = "/transaction.v1.TransactionService/ListRules"
+	// TransactionServiceApplyRulesProcedure is the fully-qualified name of the TransactionService's
+	// ApplyRules RPC.
+	TransactionServiceApplyRulesProcedure = "/transaction.v1.TransactionService/ApplyRules"
+	// TransactionServicePreviewRuleProcedure is the fully-qualified name of the TransactionService's
+	// PreviewRule RPC.
+	TransactionServicePreviewRuleProcedure = "/transaction.v1.TransactionService/PreviewRule"
+	// TransactionServiceSuggestCategoriesProcedure is the fully-qualified name of the
+	// TransactionService's SuggestCategories RPC.
+	TransactionServiceSuggestCategoriesProcedure = "/transaction.v1.TransactionService/SuggestCategories"
+	// TransactionServiceDetectTransfersProcedure is the fully-qualified name of the
+	// TransactionService's DetectTransfers RPC.
+	TransactionServiceDetectTransfersProcedure = "/transaction.v1.TransactionService/DetectTransfers"
+	// TransactionServiceListTransferPairsProcedure is the fully-qualified name of the
+	// TransactionService's ListTransferPairs RPC.
+	TransactionServiceListTransferPairsProcedure = "/transaction.v1.TransactionService/ListTransferPairs"
+	// TransactionServiceConfirmTransferPairProcedure is the fully-qualified name of the
+	// TransactionService's ConfirmTransferPair RPC.
+	TransactionServiceConfirmTransferPairProcedure = "/transaction.v1.TransactionService/ConfirmTransferPair"
+	// TransactionServiceSplitTransferPairProcedure is the fully-qualified name of the
+	// TransactionService's SplitTransferPair RPC.
+	TransactionServiceSplitTransferPairProcedure = "/transaction.v1.TransactionService/SplitTransferPair"
+	// TransactionServiceImportStatementProcedure is the fully-qualified name of the
+	// TransactionService's ImportStatement RPC.
+	TransactionServiceImportStatementProcedure = "/transaction.v1.TransactionService/ImportStatement"
+	// TransactionServiceCreateStatementMappingProcedure is the fully-qualified name of the
+	// TransactionService's CreateStatementMapping RPC.
+	TransactionServiceCreateStatementMappingProcedure = "/transaction.v1.TransactionService/CreateStatementMapping"
+	// TransactionServiceUpdateStatementMappingProcedure is the fully-qualified name of the
+	// TransactionService's UpdateStatementMapping RPC.
+	TransactionServiceUpdateStatementMappingProcedure = "/transaction.v1.TransactionService/UpdateStatementMapping"
+	// TransactionServiceDeleteStatementMappingProcedure is the fully-qualified name of the
+	// TransactionService's DeleteStatementMapping RPC.
+	TransactionServiceDeleteStatementMappingProcedure = "/transaction.v1.TransactionService/DeleteStatementMapping"
+	// TransactionServiceListStatementMappingsProcedure is the fully-qualified name of the
+	// TransactionService's ListStatementMappings RPC.
+	TransactionServiceListStatementMappingsProcedure = "/transaction.v1.TransactionService/ListStatementMappings"
 )
 
 // TransactionServiceClient is a client for the transaction.v1.TransactionService service.
@@ -49,6 +148,48 @@ type TransactionServiceClient interface {
 	GetAccounts(context.Context, *connect.Request[v1.GetAccountsRequest]) (*connect.Response[v1.GetAccountsResponse], error)
 	GetSimplefinAccounts(context.Context, *connect.Request[v1.GetSimplefinAccountsRequest]) (*connect.Response[v1.GetSimplefinAccountsResponse], error)
 	AddAccount(context.Context, *connect.Request[v1.AddAccountRequest]) (*connect.Response[v1.AddAccountResponse], error)
+	UpdateAccount(context.Context, *connect.Request[v1.UpdateAccountRequest]) (*connect.Response[v1.UpdateAccountResponse], error)
+	RemoveAccount(context.Context, *connect.Request[v1.RemoveAccountRequest]) (*connect.Response[v1.RemoveAccountResponse], error)
+	RelinkAccount(context.Context, *connect.Request[v1.RelinkAccountRequest]) (*connect.Response[v1.RelinkAccountResponse], error)
+	// SimpleFIN connection endpoints
+	ConnectSimplefin(context.Context, *connect.Request[v1.ConnectSimplefinRequest]) (*connect.Response[v1.ConnectSimplefinResponse], error)
+	DisconnectSimplefin(context.Context, *connect.Request[v1.DisconnectSimplefinRequest]) (*connect.Response[v1.DisconnectSimplefinResponse], error)
+	GetSimplefinConnection(context.Context, *connect.Request[v1.GetSimplefinConnectionRequest]) (*connect.Response[v1.GetSimplefinConnectionResponse], error)
+	// Transaction endpoints
+	ListTransactions(context.Context, *connect.Request[v1.ListTransactionsRequest]) (*connect.Response[v1.ListTransactionsResponse], error)
+	GetTransaction(context.Context, *connect.Request[v1.GetTransactionRequest]) (*connect.Response[v1.GetTransactionResponse], error)
+	UpdateTransaction(context.Context, *connect.Request[v1.UpdateTransactionRequest]) (*connect.Response[v1.UpdateTransactionResponse], error)
+	SetTransactionSplits(context.Context, *connect.Request[v1.SetTransactionSplitsRequest]) (*connect.Response[v1.SetTransactionSplitsResponse], error)
+	// Bill matching endpoints
+	MatchTransactions(context.Context, *connect.Request[v1.MatchTransactionsRequest]) (*connect.Response[v1.MatchTransactionsResponse], error)
+	ListMatchReviews(context.Context, *connect.Request[v1.ListMatchReviewsRequest]) (*connect.Response[v1.ListMatchReviewsResponse], error)
+	ResolveMatchReview(context.Context, *connect.Request[v1.ResolveMatchReviewRequest]) (*connect.Response[v1.ResolveMatchReviewResponse], error)
+	// Bank sync endpoints
+	SyncNow(context.Context, *connect.Request[v1.SyncNowRequest]) (*connect.Response[v1.SyncNowResponse], error)
+	GetSyncStatus(context.Context, *connect.Request[v1.GetSyncStatusRequest]) (*connect.Response[v1.GetSyncStatusResponse], error)
+	// Balance history endpoints
+	GetAccountBalances(context.Context, *connect.Request[v1.GetAccountBalancesRequest]) (*connect.Response[v1.GetAccountBalancesResponse], error)
+	GetNetWorthHistory(context.Context, *connect.Request[v1.GetNetWorthHistoryRequest]) (*connect.Response[v1.GetNetWorthHistoryResponse], error)
+	// Rule endpoints
+	CreateRule(context.Context, *connect.Request[v1.CreateRuleRequest]) (*connect.Response[v1.CreateRuleResponse], error)
+	UpdateRule(context.Context, *connect.Request[v1.UpdateRuleRequest]) (*connect.Response[v1.UpdateRuleResponse], error)
+	DeleteRule(context.Context, *connect.Request[v1.DeleteRuleRequest]) (*connect.Response[v1.DeleteRuleResponse], error)
+	ListRules(context.Context, *connect.Request[v1.ListRulesRequest]) (*connect.Response[v1.ListRulesResponse], error)
+	ApplyRules(context.Context, *connect.Request[v1.ApplyRulesRequest]) (*connect.Response[v1.ApplyRulesResponse], error)
+	PreviewRule(context.Context, *connect.Request[v1.PreviewRuleRequest]) (*connect.Response[v1.PreviewRuleResponse], error)
+	// Category suggestion endpoints
+	SuggestCategories(context.Context, *connect.Request[v1.SuggestCategoriesRequest]) (*connect.Response[v1.SuggestCategoriesResponse], error)
+	// Transfer endpoints
+	DetectTransfers(context.Context, *connect.Request[v1.DetectTransfersRequest]) (*connect.Response[v1.DetectTransfersResponse], error)
+	ListTransferPairs(context.Context, *connect.Request[v1.ListTransferPairsRequest]) (*connect.Response[v1.ListTransferPairsResponse], error)
+	ConfirmTransferPair(context.Context, *connect.Request[v1.ConfirmTransferPairRequest]) (*connect.Response[v1.ConfirmTransferPairResponse], error)
+	SplitTransferPair(context.Context, *connect.Request[v1.SplitTransferPairRequest]) (*connect.Response[v1.SplitTransferPairResponse], error)
+	// Statement import
+	ImportStatement(context.Context, *connect.Request[v1.ImportStatementRequest]) (*connect.Response[v1.ImportStatementResponse], error)
+	CreateStatementMapping(context.Context, *connect.Request[v1.CreateStatementMappingRequest]) (*connect.Response[v1.CreateStatementMappingResponse], error)
+	UpdateStatementMapping(context.Context, *connect.Request[v1.UpdateStatementMappingRequest]) (*connect.Response[v1.UpdateStatementMappingResponse], error)
+	DeleteStatementMapping(context.Context, *connect.Request[v1.DeleteStatementMappingRequest]) (*connect.Response[v1.DeleteStatementMappingResponse], error)
+	ListStatementMappings(context.Context, *connect.Request[v1.ListStatementMappingsRequest]) (*connect.Response[v1.ListStatementMappingsResponse], error)
 }
 
 // NewTransactionServiceClient constructs a client for the transaction.v1.TransactionService
@@ -80,14 +221,245 @@ func NewTransactionServiceClient(httpClient connect.HTTPClient, baseURL string,
 			connect.WithSchema(transactionServiceMethods.ByName("AddAccount")),
 			connect.WithClientOptions(opts...),
 		),
+		updateAccount: connect.NewClient[v1.UpdateAccountRequest, v1.UpdateAccountResponse](
+			httpClient,
+			baseURL+TransactionServiceUpdateAccountProcedure,
+			connect.WithSchema(transactionServiceMethods.ByName("UpdateAccount")),
+			connect.WithClientOptions(opts...),
+		),
+		removeAccount: connect.NewClient[v1.RemoveAccountRequest, v1.RemoveAccountResponse](
+			httpClient,
+			baseURL+TransactionServiceRemoveAccountProcedure,
+			connect.WithSchema(transactionServiceMethods.ByName("RemoveAccount")),
+			connect.WithClientOptions(opts...),
+		),
+		relinkAccount: connect.NewClient[v1.RelinkAccountRequest, v1.RelinkAccountResponse](
+			httpClient,
+			baseURL+TransactionServiceRelinkAccountProcedure,
+			connect.WithSchema(transactionServiceMethods.ByName("RelinkAccount")),
+			connect.WithClientOptions(opts...),
+		),
+		connectSimplefin: connect.NewClient[v1.ConnectSimplefinRequest, v1.ConnectSimplefinResponse](
+			httpClient,
+			baseURL+TransactionServiceConnectSimplefinProcedure,
+			connect.WithSchema(transactionServiceMethods.ByName("ConnectSimplefin")),
+			connect.WithClientOptions(opts...),
+		),
+		disconnectSimplefin: connect.NewClient[v1.DisconnectSimplefinRequest, v1.DisconnectSimplefinResponse](
+			httpClient,
+			baseURL+TransactionServiceDisconnectSimplefinProcedure,
+			connect.WithSchema(transactionServiceMethods.ByName("DisconnectSimplefin")),
+			connect.WithClientOptions(opts...),
+		),
+		getSimplefinConnection: connect.NewClient[v1.GetSimplefinConnectionRequest, v1.GetSimplefinConnectionResponse](
+			httpClient,
+			baseURL+TransactionServiceGetSimplefinConnectionProcedure,
+			connect.WithSchema(transactionServiceMethods.ByName("GetSimplefinConnection")),
+			connect.WithClientOptions(opts...),
+		),
+		listTransactions: connect.NewClient[v1.ListTransactionsRequest, v1.ListTransactionsResponse](
+			httpClient,
+			baseURL+TransactionServiceListTransactionsProcedure,
+			connect.WithSchema(transactionServiceMethods.ByName("ListTransactions")),
+			connect.WithClientOptions(opts...),
+		),
+		getTransaction: connect.NewClient[v1.GetTransactionRequest, v1.GetTransactionResponse](
+			httpClient,
+			baseURL+TransactionServiceGetTransactionProcedure,
+			connect.WithSchema(transactionServiceMethods.ByName("GetTransaction")),
+			connect.WithClientOptions(opts...),
+		),
+		updateTransaction: connect.NewClient[v1.UpdateTransactionRequest, v1.UpdateTransactionResponse](
+			httpClient,
+			baseURL+TransactionServiceUpdateTransactionProcedure,
+			connect.WithSchema(transactionServiceMethods.ByName("UpdateTransaction")),
+			connect.WithClientOptions(opts...),
+		),
+		setTransactionSplits: connect.NewClient[v1.SetTransactionSplitsRequest, v1.SetTransactionSplitsResponse](
+			httpClient,
+			baseURL+TransactionServiceSetTransactionSplitsProcedure,
+			connect.WithSchema(transactionServiceMethods.ByName("SetTransactionSplits")),
+			connect.WithClientOptions(opts...),
+		),
+		matchTransactions: connect.NewClient[v1.MatchTransactionsRequest, v1.MatchTransactionsResponse](
+			httpClient,
+			baseURL+TransactionServiceMatchTransactionsProcedure,
+			connect.WithSchema(transactionServiceMethods.ByName("MatchTransactions")),
+			connect.WithClientOptions(opts...),
+		),
+		listMatchReviews: connect.NewClient[v1.ListMatchReviewsRequest, v1.ListMatchReviewsResponse](
+			httpClient,
+			baseURL+TransactionServiceListMatchReviewsProcedure,
+			connect.WithSchema(transactionServiceMethods.ByName("ListMatchReviews")),
+			connect.WithClientOptions(opts...),
+		),
+		resolveMatchReview: connect.NewClient[v1.ResolveMatchReviewRequest, v1.ResolveMatchReviewResponse](
+			httpClient,
+			baseURL+TransactionServiceResolveMatchReviewProcedure,
+			connect.WithSchema(transactionServiceMethods.ByName("ResolveMatchReview")),
+			connect.WithClientOptions(opts...),
+		),
+		syncNow: connect.NewClient[v1.SyncNowRequest, v1.SyncNowResponse](
+			httpClient,
+			baseURL+TransactionServiceSyncNowProcedure,
+			connect.WithSchema(transactionServiceMethods.ByName("SyncNow")),
+			connect.WithClientOptions(opts...),
+		),
+		getSyncStatus: connect.NewClient[v1.GetSyncStatusRequest, v1.GetSyncStatusResponse](
+			httpClient,
+			baseURL+TransactionServiceGetSyncStatusProcedure,
+			connect.WithSchema(transactionServiceMethods.ByName("GetSyncStatus")),
+			connect.WithClientOptions(opts...),
+		),
+		getAccountBalances: connect.NewClient[v1.GetAccountBalancesRequest, v1.GetAccountBalancesResponse](
+			httpClient,
+			baseURL+TransactionServiceGetAccountBalancesProcedure,
+			connect.WithSchema(transactionServiceMethods.ByName("GetAccountBalances")),
+			connect.WithClientOptions(opts...),
+		),
+		getNetWorthHistory: connect.NewClient[v1.GetNetWorthHistoryRequest, v1.GetNetWorthHistoryResponse](
+			httpClient,
+			baseURL+TransactionServiceGetNetWorthHistoryProcedure,
+			connect.WithSchema(transactionServiceMethods.ByName("GetNetWorthHistory")),
+			connect.WithClientOptions(opts...),
+		),
+		createRule: connect.NewClient[v1.CreateRuleRequest, v1.CreateRuleResponse](
+			httpClient,
+			baseURL+TransactionServiceCreateRuleProcedure,
+			connect.WithSchema(transactionServiceMethods.ByName("CreateRule")),
+			connect.WithClientOptions(opts...),
+		),
+		updateRule: connect.NewClient[v1.UpdateRuleRequest, v1.UpdateRuleResponse](
+			httpClient,
+			baseURL+TransactionServiceUpdateRuleProcedure,
+			connect.WithSchema(transactionServiceMethods.ByName("UpdateRule")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteRule: connect.NewClient[v1.DeleteRuleRequest, v1.DeleteRuleResponse](
+			httpClient,
+			baseURL+TransactionServiceDeleteRuleProcedure,
+			connect.WithSchema(transactionServiceMethods.ByName("DeleteRule")),
+			connect.WithClientOptions(opts...),
+		),
+		listRules: connect.NewClient[v1.ListRulesRequest, v1.ListRulesResponse](
+			httpClient,
+			baseURL+TransactionServiceListRulesProcedure,
+			connect.WithSchema(transactionServiceMethods.ByName("ListRules")),
+			connect.WithClientOptions(opts...),
+		),
+		applyRules: connect.NewClient[v1.ApplyRulesRequest, v1.ApplyRulesResponse](
+			httpClient,
+			baseURL+TransactionServiceApplyRulesProcedure,
+			connect.WithSchema(transactionServiceMethods.ByName("ApplyRules")),
+			connect.WithClientOptions(opts...),
+		),
+		previewRule: connect.NewClient[v1.PreviewRuleRequest, v1.PreviewRuleResponse](
+			httpClient,
+			baseURL+TransactionServicePreviewRuleProcedure,
+			connect.WithSchema(transactionServiceMethods.ByName("PreviewRule")),
+			connect.WithClientOptions(opts...),
+		),
+		suggestCategories: connect.NewClient[v1.SuggestCategoriesRequest, v1.SuggestCategoriesResponse](
+			httpClient,
+			baseURL+TransactionServiceSuggestCategoriesProcedure,
+			connect.WithSchema(transactionServiceMethods.ByName("SuggestCategories")),
+			connect.WithClientOptions(opts...),
+		),
+		detectTransfers: connect.NewClient[v1.DetectTransfersRequest, v1.DetectTransfersResponse](
+			httpClient,
+			baseURL+TransactionServiceDetectTransfersProcedure,
+			connect.WithSchema(transactionServiceMethods.ByName("DetectTransfers")),
+			connect.WithClientOptions(opts...),
+		),
+		listTransferPairs: connect.NewClient[v1.ListTransferPairsRequest, v1.ListTransferPairsResponse](
+			httpClient,
+			baseURL+TransactionServiceListTransferPairsProcedure,
+			connect.WithSchema(transactionServiceMethods.ByName("ListTransferPairs")),
+			connect.WithClientOptions(opts...),
+		),
+		confirmTransferPair: connect.NewClient[v1.ConfirmTransferPairRequest, v1.ConfirmTransferPairResponse](
+			httpClient,
+			baseURL+TransactionServiceConfirmTransferPairProcedure,
+			connect.WithSchema(transactionServiceMethods.ByName("ConfirmTransferPair")),
+			connect.WithClientOptions(opts...),
+		),
+		splitTransferPair: connect.NewClient[v1.SplitTransferPairRequest, v1.SplitTransferPairResponse](
+			httpClient,
+			baseURL+TransactionServiceSplitTransferPairProcedure,
+			connect.WithSchema(transactionServiceMethods.ByName("SplitTransferPair")),
+			connect.WithClientOptions(opts...),
+		),
+		importStatement: connect.NewClient[v1.ImportStatementRequest, v1.ImportStatementResponse](
+			httpClient,
+			baseURL+TransactionServiceImportStatementProcedure,
+			connect.WithSchema(transactionServiceMethods.ByName("ImportStatement")),
+			connect.WithClientOptions(opts...),
+		),
+		createStatementMapping: connect.NewClient[v1.CreateStatementMappingRequest, v1.CreateStatementMappingResponse](
+			httpClient,
+			baseURL+TransactionServiceCreateStatementMappingProcedure,
+			connect.WithSchema(transactionServiceMethods.ByName("CreateStatementMapping")),
+			connect.WithClientOptions(opts...),
+		),
+		updateStatementMapping: connect.NewClient[v1.UpdateStatementMappingRequest, v1.UpdateStatementMappingResponse](
+			httpClient,
+			baseURL+TransactionServiceUpdateStatementMappingProcedure,
+			connect.WithSchema(transactionServiceMethods.ByName("UpdateStatementMapping")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteStatementMapping: connect.NewClient[v1.DeleteStatementMappingRequest, v1.DeleteStatementMappingResponse](
+			httpClient,
+			baseURL+TransactionServiceDeleteStatementMappingProcedure,
+			connect.WithSchema(transactionServiceMethods.ByName("DeleteStatementMapping")),
+			connect.WithClientOptions(opts...),
+		),
+		listStatementMappings: connect.NewClient[v1.ListStatementMappingsRequest, v1.ListStatementMappingsResponse](
+			httpClient,
+			baseURL+TransactionServiceListStatementMappingsProcedure,
+			connect.WithSchema(transactionServiceMethods.ByName("ListStatementMappings")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // transactionServiceClient implements TransactionServiceClient.
 type transactionServiceClient struct {
-	getAccounts          *connect.Client[v1.GetAccountsRequest, v1.GetAccountsResponse]
-	getSimplefinAccounts *connect.Client[v1.GetSimplefinAccountsRequest, v1.GetSimplefinAccountsResponse]
-	addAccount           *connect.Client[v1.AddAccountRequest, v1.AddAccountResponse]
+	getAccounts            *connect.Client[v1.GetAccountsRequest, v1.GetAccountsResponse]
+	getSimplefinAccounts   *connect.Client[v1.GetSimplefinAccountsRequest, v1.GetSimplefinAccountsResponse]
+	addAccount             *connect.Client[v1.AddAccountRequest, v1.AddAccountResponse]
+	updateAccount          *connect.Client[v1.UpdateAccountRequest, v1.UpdateAccountResponse]
+	removeAccount          *connect.Client[v1.RemoveAccountRequest, v1.RemoveAccountResponse]
+	relinkAccount          *connect.Client[v1.RelinkAccountRequest, v1.RelinkAccountResponse]
+	connectSimplefin       *connect.Client[v1.ConnectSimplefinRequest, v1.ConnectSimplefinResponse]
+	disconnectSimplefin    *connect.Client[v1.DisconnectSimplefinRequest, v1.DisconnectSimplefinResponse]
+	getSimplefinConnection *connect.Client[v1.GetSimplefinConnectionRequest, v1.GetSimplefinConnectionResponse]
+	listTransactions       *connect.Client[v1.ListTransactionsRequest, v1.ListTransactionsResponse]
+	getTransaction         *connect.Client[v1.GetTransactionRequest, v1.GetTransactionResponse]
+	updateTransaction      *connect.Client[v1.UpdateTransactionRequest, v1.UpdateTransactionResponse]
+	setTransactionSplits   *connect.Client[v1.SetTransactionSplitsRequest, v1.SetTransactionSplitsResponse]
+	matchTransactions      *connect.Client[v1.MatchTransactionsRequest, v1.MatchTransactionsResponse]
+	listMatchReviews       *connect.Client[v1.ListMatchReviewsRequest, v1.ListMatchReviewsResponse]
+	resolveMatchReview     *connect.Client[v1.ResolveMatchReviewRequest, v1.ResolveMatchReviewResponse]
+	syncNow                *connect.Client[v1.SyncNowRequest, v1.SyncNowResponse]
+	getSyncStatus          *connect.Client[v1.GetSyncStatusRequest, v1.GetSyncStatusResponse]
+	getAccountBalances     *connect.Client[v1.GetAccountBalancesRequest, v1.GetAccountBalancesResponse]
+	getNetWorthHistory     *connect.Client[v1.GetNetWorthHistoryRequest, v1.GetNetWorthHistoryResponse]
+	createRule             *connect.Client[v1.CreateRuleRequest, v1.CreateRuleResponse]
+	updateRule             *connect.Client[v1.UpdateRuleRequest, v1.UpdateRuleResponse]
+	deleteRule             *connect.Client[v1.DeleteRuleRequest, v1.DeleteRuleResponse]
+	listRules              *connect.Client[v1.ListRulesRequest, v1.ListRulesResponse]
+	applyRules             *connect.Client[v1.ApplyRulesRequest, v1.ApplyRulesResponse]
+	previewRule            *connect.Client[v1.PreviewRuleRequest, v1.PreviewRuleResponse]
+	suggestCategories      *connect.Client[v1.SuggestCategoriesRequest, v1.SuggestCategoriesResponse]
+	detectTransfers        *connect.Client[v1.DetectTransfersRequest, v1.DetectTransfersResponse]
+	listTransferPairs      *connect.Client[v1.ListTransferPairsRequest, v1.ListTransferPairsResponse]
+	confirmTransferPair    *connect.Client[v1.ConfirmTransferPairRequest, v1.ConfirmTransferPairResponse]
+	splitTransferPair      *connect.Client[v1.SplitTransferPairRequest, v1.SplitTransferPairResponse]
+	importStatement        *connect.Client[v1.ImportStatementRequest, v1.ImportStatementResponse]
+	createStatementMapping *connect.Client[v1.CreateStatementMappingRequest, v1.CreateStatementMappingResponse]
+	updateStatementMapping *connect.Client[v1.UpdateStatementMappingRequest, v1.UpdateStatementMappingResponse]
+	deleteStatementMapping *connect.Client[v1.DeleteStatementMappingRequest, v1.DeleteStatementMappingResponse]
+	listStatementMappings  *connect.Client[v1.ListStatementMappingsRequest, v1.ListStatementMappingsResponse]
 }
 
 // GetAccounts calls transaction.v1.TransactionService.GetAccounts.
@@ -105,11 +477,218 @@ func (c *transactionServiceClient) AddAccount(ctx context.Context, req *connect.
 	return c.addAccount.CallUnary(ctx, req)
 }
 
+// UpdateAccount calls transaction.v1.TransactionService.UpdateAccount.
+func (c *transactionServiceClient) UpdateAccount(ctx context.Context, req *connect.Request[v1.UpdateAccountRequest]) (*connect.Response[v1.UpdateAccountResponse], error) {
+	return c.updateAccount.CallUnary(ctx, req)
+}
+
+// RemoveAccount calls transaction.v1.TransactionService.RemoveAccount.
+func (c *transactionServiceClient) RemoveAccount(ctx context.Context, req *connect.Request[v1.RemoveAccountRequest]) (*connect.Response[v1.RemoveAccountResponse], error) {
+	return c.removeAccount.CallUnary(ctx, req)
+}
+
+// RelinkAccount calls transaction.v1.TransactionService.RelinkAccount.
+func (c *transactionServiceClient) RelinkAccount(ctx context.Context, req *connect.Request[v1.RelinkAccountRequest]) (*connect.Response[v1.RelinkAccountResponse], error) {
+	return c.relinkAccount.CallUnary(ctx, req)
+}
+
+// ConnectSimplefin calls transaction.v1.TransactionService.ConnectSimplefin.
+func (c *transactionServiceClient) ConnectSimplefin(ctx context.Context, req *connect.Request[v1.ConnectSimplefinRequest]) (*connect.Response[v1.ConnectSimplefinResponse], error) {
+	return c.connectSimplefin.CallUnary(ctx, req)
+}
+
+// DisconnectSimplefin calls transaction.v1.TransactionService.DisconnectSimplefin.
+func (c *transactionServiceClient) DisconnectSimplefin(ctx context.Context, req *connect.Request[v1.DisconnectSimplefinRequest]) (*connect.Response[v1.DisconnectSimplefinResponse], error) {
+	return c.disconnectSimplefin.CallUnary(ctx, req)
+}
+
+// GetSimplefinConnection calls transaction.v1.TransactionService.GetSimplefinConnection.
+func (c *transactionServiceClient) GetSimplefinConnection(ctx context.Context, req *connect.Request[v1.GetSimplefinConnectionRequest]) (*connect.Response[v1.GetSimplefinConnectionResponse], error) {
+	return c.getSimplefinConnection.CallUnary(ctx, req)
+}
+
+// ListTransactions calls transaction.v1.TransactionService.ListTransactions.
+func (c *transactionServiceClient) ListTransactions(ctx context.Context, req *connect.Request[v1.ListTransactionsRequest]) (*connect.Response[v1.ListTransactionsResponse], error) {
+	return c.listTransactions.CallUnary(ctx, req)
+}
+
+// GetTransaction calls transaction.v1.TransactionService.GetTransaction.
+func (c *transactionServiceClient) GetTransaction(ctx context.Context, req *connect.Request[v1.GetTransactionRequest]) (*connect.Response[v1.GetTransactionResponse], error) {
+	return c.getTransaction.CallUnary(ctx, req)
+}
+
+// UpdateTransaction calls transaction.v1.TransactionService.UpdateTransaction.
+func (c *transactionServiceClient) UpdateTransaction(ctx context.Context, req *connect.Request[v1.UpdateTransactionRequest]) (*connect.Response[v1.UpdateTransactionResponse], error) {
+	return c.updateTransaction.CallUnary(ctx, req)
+}
+
+// SetTransactionSplits calls transaction.v1.TransactionService.SetTransactionSplits.
+func (c *transactionServiceClient) SetTransactionSplits(ctx context.Context, req *connect.Request[v1.SetTransactionSplitsRequest]) (*connect.Response[v1.SetTransactionSplitsResponse], error) {
+	return c.setTransactionSplits.CallUnary(ctx, req)
+}
+
+// MatchTransactions calls transaction.v1.TransactionService.MatchTransactions.
+func (c *transactionServiceClient) MatchTransactions(ctx context.Context, req *connect.Request[v1.MatchTransactionsRequest]) (*connect.Response[v1.MatchTransactionsResponse], error) {
+	return c.matchTransactions.CallUnary(ctx, req)
+}
+
+// ListMatchReviews calls transaction.v1.TransactionService.ListMatchReviews.
+func (c *transactionServiceClient) ListMatchReviews(ctx context.Context, req *connect.Request[v1.ListMatchReviewsRequest]) (*connect.Response[v1.ListMatchReviewsResponse], error) {
+	return c.listMatchReviews.CallUnary(ctx, req)
+}
+
+// ResolveMatchReview calls transaction.v1.TransactionService.ResolveMatchReview.
+func (c *transactionServiceClient) ResolveMatchReview(ctx context.Context, req *connect.Request[v1.ResolveMatchReviewRequest]) (*connect.Response[v1.ResolveMatchReviewResponse], error) {
+	return c.resolveMatchReview.CallUnary(ctx, req)
+}
+
+// SyncNow calls transaction.v1.TransactionService.SyncNow.
+func (c *transactionServiceClient) SyncNow(ctx context.Context, req *connect.Request[v1.SyncNowRequest]) (*connect.Response[v1.SyncNowResponse], error) {
+	return c.syncNow.CallUnary(ctx, req)
+}
+
+// GetSyncStatus calls transaction.v1.TransactionService.GetSyncStatus.
+func (c *transactionServiceClient) GetSyncStatus(ctx context.Context, req *connect.Request[v1.GetSyncStatusRequest]) (*connect.Response[v1.GetSyncStatusResponse], error) {
+	return c.getSyncStatus.CallUnary(ctx, req)
+}
+
+// GetAccountBalances calls transaction.v1.TransactionService.GetAccountBalances.
+func (c *transactionServiceClient) GetAccountBalances(ctx context.Context, req *connect.Request[v1.GetAccountBalancesRequest]) (*connect.Response[v1.GetAccountBalancesResponse], error) {
+	return c.getAccountBalances.CallUnary(ctx, req)
+}
+
+// GetNetWorthHistory calls transaction.v1.TransactionService.GetNetWorthHistory.
+func (c *transactionServiceClient) GetNetWorthHistory(ctx context.Context, req *connect.Request[v1.GetNetWorthHistoryRequest]) (*connect.Response[v1.GetNetWorthHistoryResponse], error) {
+	return c.getNetWorthHistory.CallUnary(ctx, req)
+}
+
+// CreateRule calls transaction.v1.TransactionService.CreateRule.
+func (c *transactionServiceClient) CreateRule(ctx context.Context, req *connect.Request[v1.CreateRuleRequest]) (*connect.Response[v1.CreateRuleResponse], error) {
+	return c.createRule.CallUnary(ctx, req)
+}
+
+// UpdateRule calls transaction.v1.TransactionService.UpdateRule.
+func (c *transactionServiceClient) UpdateRule(ctx context.Context, req *connect.Request[v1.UpdateRuleRequest]) (*connect.Response[v1.UpdateRuleResponse], error) {
+	return c.updateRule.CallUnary(ctx, req)
+}
+
+// DeleteRule calls transaction.v1.TransactionService.DeleteRule.
+func (c *transactionServiceClient) DeleteRule(ctx context.Context, req *connect.Request[v1.DeleteRuleRequest]) (*connect.Response[v1.DeleteRuleResponse], error) {
+	return c.deleteRule.CallUnary(ctx, req)
+}
+
+// ListRules calls transaction.v1.TransactionService.ListRules.
+func (c *transactionServiceClient) ListRules(ctx context.Context, req *connect.Request[v1.ListRulesRequest]) (*connect.Response[v1.ListRulesResponse], error) {
+	return c.listRules.CallUnary(ctx, req)
+}
+
+// ApplyRules calls transaction.v1.TransactionService.ApplyRules.
+func (c *transactionServiceClient) ApplyRules(ctx context.Context, req *connect.Request[v1.ApplyRulesRequest]) (*connect.Response[v1.ApplyRulesResponse], error) {
+	return c.applyRules.CallUnary(ctx, req)
+}
+
+// PreviewRule calls transaction.v1.TransactionService.PreviewRule.
+func (c *transactionServiceClient) PreviewRule(ctx context.Context, req *connect.Request[v1.PreviewRuleRequest]) (*connect.Response[v1.PreviewRuleResponse], error) {
+	return c.previewRule.CallUnary(ctx, req)
+}
+
+// SuggestCategories calls transaction.v1.TransactionService.SuggestCategories.
+func (c *transactionServiceClient) SuggestCategories(ctx context.Context, req *connect.Request[v1.SuggestCategoriesRequest]) (*connect.Response[v1.SuggestCategoriesResponse], error) {
+	return c.suggestCategories.CallUnary(ctx, req)
+}
+
+// DetectTransfers calls transaction.v1.TransactionService.DetectTransfers.
+func (c *transactionServiceClient) DetectTransfers(ctx context.Context, req *connect.Request[v1.DetectTransfersRequest]) (*connect.Response[v1.DetectTransfersResponse], error) {
+	return c.detectTransfers.CallUnary(ctx, req)
+}
+
+// ListTransferPairs calls transaction.v1.TransactionService.ListTransferPairs.
+func (c *transactionServiceClient) ListTransferPairs(ctx context.Context, req *connect.Request[v1.ListTransferPairsRequest]) (*connect.Response[v1.ListTransferPairsResponse], error) {
+	return c.listTransferPairs.CallUnary(ctx, req)
+}
+
+// ConfirmTransferPair calls transaction.v1.TransactionService.ConfirmTransferPair.
+func (c *transactionServiceClient) ConfirmTransferPair(ctx context.Context, req *connect.Request[v1.ConfirmTransferPairRequest]) (*connect.Response[v1.ConfirmTransferPairResponse], error) {
+	return c.confirmTransferPair.CallUnary(ctx, req)
+}
+
+// SplitTransferPair calls transaction.v1.TransactionService.SplitTransferPair.
+func (c *transactionServiceClient) SplitTransferPair(ctx context.Context, req *connect.Request[v1.SplitTransferPairRequest]) (*connect.Response[v1.SplitTransferPairResponse], error) {
+	return c.splitTransferPair.CallUnary(ctx, req)
+}
+
+// ImportStatement calls transaction.v1.TransactionService.ImportStatement.
+func (c *transactionServiceClient) ImportStatement(ctx context.Context, req *connect.Request[v1.ImportStatementRequest]) (*connect.Response[v1.ImportStatementResponse], error) {
+	return c.importStatement.CallUnary(ctx, req)
+}
+
+// CreateStatementMapping calls transaction.v1.TransactionService.CreateStatementMapping.
+func (c *transactionServiceClient) CreateStatementMapping(ctx context.Context, req *connect.Request[v1.CreateStatementMappingRequest]) (*connect.Response[v1.CreateStatementMappingResponse], error) {
+	return c.createStatementMapping.CallUnary(ctx, req)
+}
+
+// UpdateStatementMapping calls transaction.v1.TransactionService.UpdateStatementMapping.
+func (c *transactionServiceClient) UpdateStatementMapping(ctx context.Context, req *connect.Request[v1.UpdateStatementMappingRequest]) (*connect.Response[v1.UpdateStatementMappingResponse], error) {
+	return c.updateStatementMapping.CallUnary(ctx, req)
+}
+
+// DeleteStatementMapping calls transaction.v1.TransactionService.DeleteStatementMapping.
+func (c *transactionServiceClient) DeleteStatementMapping(ctx context.Context, req *connect.Request[v1.DeleteStatementMappingRequest]) (*connect.Response[v1.DeleteStatementMappingResponse], error) {
+	return c.deleteStatementMapping.CallUnary(ctx, req)
+}
+
+// ListStatementMappings calls transaction.v1.TransactionService.ListStatementMappings.
+func (c *transactionServiceClient) ListStatementMappings(ctx context.Context, req *connect.Request[v1.ListStatementMappingsRequest]) (*connect.Response[v1.ListStatementMappingsResponse], error) {
+	return c.listStatementMappings.CallUnary(ctx, req)
+}
+
 // TransactionServiceHandler is an implementation of the transaction.v1.TransactionService service.
 type TransactionServiceHandler interface {
 	GetAccounts(context.Context, *connect.Request[v1.GetAccountsRequest]) (*connect.Response[v1.GetAccountsResponse], error)
 	GetSimplefinAccounts(context.Context, *connect.Request[v1.GetSimplefinAccountsRequest]) (*connect.Response[v1.GetSimplefinAccountsResponse], error)
 	AddAccount(context.Context, *connect.Request[v1.AddAccountRequest]) (*connect.Response[v1.AddAccountResponse], error)
+	UpdateAccount(context.Context, *connect.Request[v1.UpdateAccountRequest]) (*connect.Response[v1.UpdateAccountResponse], error)
+	RemoveAccount(context.Context, *connect.Request[v1.RemoveAccountRequest]) (*connect.Response[v1.RemoveAccountResponse], error)
+	RelinkAccount(context.Context, *connect.Request[v1.RelinkAccountRequest]) (*connect.Response[v1.RelinkAccountResponse], error)
+	// SimpleFIN connection endpoints
+	ConnectSimplefin(context.Context, *connect.Request[v1.ConnectSimplefinRequest]) (*connect.Response[v1.ConnectSimplefinResponse], error)
+	DisconnectSimplefin(context.Context, *connect.Request[v1.DisconnectSimplefinRequest]) (*connect.Response[v1.DisconnectSimplefinResponse], error)
+	GetSimplefinConnection(context.Context, *connect.Request[v1.GetSimplefinConnectionRequest]) (*connect.Response[v1.GetSimplefinConnectionResponse], error)
+	// Transaction endpoints
+	ListTransactions(context.Context, *connect.Request[v1.ListTransactionsRequest]) (*connect.Response[v1.ListTransactionsResponse], error)
+	GetTransaction(context.Context, *connect.Request[v1.GetTransactionRequest]) (*connect.Response[v1.GetTransactionResponse], error)
+	UpdateTransaction(context.Context, *connect.Request[v1.UpdateTransactionRequest]) (*connect.Response[v1.UpdateTransactionResponse], error)
+	SetTransactionSplits(context.Context, *connect.Request[v1.SetTransactionSplitsRequest]) (*connect.Response[v1.SetTransactionSplitsResponse], error)
+	// Bill matching endpoints
+	MatchTransactions(context.Context, *connect.Request[v1.MatchTransactionsRequest]) (*connect.Response[v1.MatchTransactionsResponse], error)
+	ListMatchReviews(context.Context, *connect.Request[v1.ListMatchReviewsRequest]) (*connect.Response[v1.ListMatchReviewsResponse], error)
+	ResolveMatchReview(context.Context, *connect.Request[v1.ResolveMatchReviewRequest]) (*connect.Response[v1.ResolveMatchReviewResponse], error)
+	// Bank sync endpoints
+	SyncNow(context.Context, *connect.Request[v1.SyncNowRequest]) (*connect.Response[v1.SyncNowResponse], error)
+	GetSyncStatus(context.Context, *connect.Request[v1.GetSyncStatusRequest]) (*connect.Response[v1.GetSyncStatusResponse], error)
+	// Balance history endpoints
+	GetAccountBalances(context.Context, *connect.Request[v1.GetAccountBalancesRequest]) (*connect.Response[v1.GetAccountBalancesResponse], error)
+	GetNetWorthHistory(context.Context, *connect.Request[v1.GetNetWorthHistoryRequest]) (*connect.Response[v1.GetNetWorthHistoryResponse], error)
+	// Rule endpoints
+	CreateRule(context.Context, *connect.Request[v1.CreateRuleRequest]) (*connect.Response[v1.CreateRuleResponse], error)
+	UpdateRule(context.Context, *connect.Request[v1.UpdateRuleRequest]) (*connect.Response[v1.UpdateRuleResponse], error)
+	DeleteRule(context.Context, *connect.Request[v1.DeleteRuleRequest]) (*connect.Response[v1.DeleteRuleResponse], error)
+	ListRules(context.Context, *connect.Request[v1.ListRulesRequest]) (*connect.Response[v1.ListRulesResponse], error)
+	ApplyRules(context.Context, *connect.Request[v1.ApplyRulesRequest]) (*connect.Response[v1.ApplyRulesResponse], error)
+	PreviewRule(context.Context, *connect.Request[v1.PreviewRuleRequest]) (*connect.Response[v1.PreviewRuleResponse], error)
+	// Category suggestion endpoints
+	SuggestCategories(context.Context, *connect.Request[v1.SuggestCategoriesRequest]) (*connect.Response[v1.SuggestCategoriesResponse], error)
+	// Transfer endpoints
+	DetectTransfers(context.Context, *connect.Request[v1.DetectTransfersRequest]) (*connect.Response[v1.DetectTransfersResponse], error)
+	ListTransferPairs(context.Context, *connect.Request[v1.ListTransferPairsRequest]) (*connect.Response[v1.ListTransferPairsResponse], error)
+	ConfirmTransferPair(context.Context, *connect.Request[v1.ConfirmTransferPairRequest]) (*connect.Response[v1.ConfirmTransferPairResponse], error)
+	SplitTransferPair(context.Context, *connect.Request[v1.SplitTransferPairRequest]) (*connect.Response[v1.SplitTransferPairResponse], error)
+	// Statement import
+	ImportStatement(context.Context, *connect.Request[v1.ImportStatementRequest]) (*connect.Response[v1.ImportStatementResponse], error)
+	CreateStatementMapping(context.Context, *connect.Request[v1.CreateStatementMappingRequest]) (*connect.Response[v1.CreateStatementMappingResponse], error)
+	UpdateStatementMapping(context.Context, *connect.Request[v1.UpdateStatementMappingRequest]) (*connect.Response[v1.UpdateStatementMappingResponse], error)
+	DeleteStatementMapping(context.Context, *connect.Request[v1.DeleteStatementMappingRequest]) (*connect.Response[v1.DeleteStatementMappingResponse], error)
+	ListStatementMappings(context.Context, *connect.Request[v1.ListStatementMappingsRequest]) (*connect.Response[v1.ListStatementMappingsResponse], error)
 }
 
 // NewTransactionServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -137,6 +716,204 @@ func NewTransactionServiceHandler(svc TransactionServiceHandler, opts ...connect
 		connect.WithSchema(transactionServiceMethods.ByName("AddAccount")),
 		connect.WithHandlerOptions(opts...),
 	)
+	transactionServiceUpdateAccountHandler := connect.NewUnaryHandler(
+		TransactionServiceUpdateAccountProcedure,
+		svc.UpdateAccount,
+		connect.WithSchema(transactionServiceMethods.ByName("UpdateAccount")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transactionServiceRemoveAccountHandler := connect.NewUnaryHandler(
+		TransactionServiceRemoveAccountProcedure,
+		svc.RemoveAccount,
+		connect.WithSchema(transactionServiceMethods.ByName("RemoveAccount")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transactionServiceRelinkAccountHandler := connect.NewUnaryHandler(
+		TransactionServiceRelinkAccountProcedure,
+		svc.RelinkAccount,
+		connect.WithSchema(transactionServiceMethods.ByName("RelinkAccount")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transactionServiceConnectSimplefinHandler := connect.NewUnaryHandler(
+		TransactionServiceConnectSimplefinProcedure,
+		svc.ConnectSimplefin,
+		connect.WithSchema(transactionServiceMethods.ByName("ConnectSimplefin")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transactionServiceDisconnectSimplefinHandler := connect.NewUnaryHandler(
+		TransactionServiceDisconnectSimplefinProcedure,
+		svc.DisconnectSimplefin,
+		connect.WithSchema(transactionServiceMethods.ByName("DisconnectSimplefin")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transactionServiceGetSimplefinConnectionHandler := connect.NewUnaryHandler(
+		TransactionServiceGetSimplefinConnectionProcedure,
+		svc.GetSimplefinConnection,
+		connect.WithSchema(transactionServiceMethods.ByName("GetSimplefinConnection")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transactionServiceListTransactionsHandler := connect.NewUnaryHandler(
+		TransactionServiceListTransactionsProcedure,
+		svc.ListTransactions,
+		connect.WithSchema(transactionServiceMethods.ByName("ListTransactions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transactionServiceGetTransactionHandler := connect.NewUnaryHandler(
+		TransactionServiceGetTransactionProcedure,
+		svc.GetTransaction,
+		connect.WithSchema(transactionServiceMethods.ByName("GetTransaction")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transactionServiceUpdateTransactionHandler := connect.NewUnaryHandler(
+		TransactionServiceUpdateTransactionProcedure,
+		svc.UpdateTransaction,
+		connect.WithSchema(transactionServiceMethods.ByName("UpdateTransaction")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transactionServiceSetTransactionSplitsHandler := connect.NewUnaryHandler(
+		TransactionServiceSetTransactionSplitsProcedure,
+		svc.SetTransactionSplits,
+		connect.WithSchema(transactionServiceMethods.ByName("SetTransactionSplits")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transactionServiceMatchTransactionsHandler := connect.NewUnaryHandler(
+		TransactionServiceMatchTransactionsProcedure,
+		svc.MatchTransactions,
+		connect.WithSchema(transactionServiceMethods.ByName("MatchTransactions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transactionServiceListMatchReviewsHandler := connect.NewUnaryHandler(
+		TransactionServiceListMatchReviewsProcedure,
+		svc.ListMatchReviews,
+		connect.WithSchema(transactionServiceMethods.ByName("ListMatchReviews")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transactionServiceResolveMatchReviewHandler := connect.NewUnaryHandler(
+		TransactionServiceResolveMatchReviewProcedure,
+		svc.ResolveMatchReview,
+		connect.WithSchema(transactionServiceMethods.ByName("ResolveMatchReview")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transactionServiceSyncNowHandler := connect.NewUnaryHandler(
+		TransactionServiceSyncNowProcedure,
+		svc.SyncNow,
+		connect.WithSchema(transactionServiceMethods.ByName("SyncNow")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transactionServiceGetSyncStatusHandler := connect.NewUnaryHandler(
+		TransactionServiceGetSyncStatusProcedure,
+		svc.GetSyncStatus,
+		connect.WithSchema(transactionServiceMethods.ByName("GetSyncStatus")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transactionServiceGetAccountBalancesHandler := connect.NewUnaryHandler(
+		TransactionServiceGetAccountBalancesProcedure,
+		svc.GetAccountBalances,
+		connect.WithSchema(transactionServiceMethods.ByName("GetAccountBalances")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transactionServiceGetNetWorthHistoryHandler := connect.NewUnaryHandler(
+		TransactionServiceGetNetWorthHistoryProcedure,
+		svc.GetNetWorthHistory,
+		connect.WithSchema(transactionServiceMethods.ByName("GetNetWorthHistory")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transactionServiceCreateRuleHandler := connect.NewUnaryHandler(
+		TransactionServiceCreateRuleProcedure,
+		svc.CreateRule,
+		connect.WithSchema(transactionServiceMethods.ByName("CreateRule")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transactionServiceUpdateRuleHandler := connect.NewUnaryHandler(
+		TransactionServiceUpdateRuleProcedure,
+		svc.UpdateRule,
+		connect.WithSchema(transactionServiceMethods.ByName("UpdateRule")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transactionServiceDeleteRuleHandler := connect.NewUnaryHandler(
+		TransactionServiceDeleteRuleProcedure,
+		svc.DeleteRule,
+		connect.WithSchema(transactionServiceMethods.ByName("DeleteRule")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transactionServiceListRulesHandler := connect.NewUnaryHandler(
+		TransactionServiceListRulesProcedure,
+		svc.ListRules,
+		connect.WithSchema(transactionServiceMethods.ByName("ListRules")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transactionServiceApplyRulesHandler := connect.NewUnaryHandler(
+		TransactionServiceApplyRulesProcedure,
+		svc.ApplyRules,
+		connect.WithSchema(transactionServiceMethods.ByName("ApplyRules")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transactionServicePreviewRuleHandler := connect.NewUnaryHandler(
+		TransactionServicePreviewRuleProcedure,
+		svc.PreviewRule,
+		connect.WithSchema(transactionServiceMethods.ByName("PreviewRule")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transactionServiceSuggestCategoriesHandler := connect.NewUnaryHandler(
+		TransactionServiceSuggestCategoriesProcedure,
+		svc.SuggestCategories,
+		connect.WithSchema(transactionServiceMethods.ByName("SuggestCategories")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transactionServiceDetectTransfersHandler := connect.NewUnaryHandler(
+		TransactionServiceDetectTransfersProcedure,
+		svc.DetectTransfers,
+		connect.WithSchema(transactionServiceMethods.ByName("DetectTransfers")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transactionServiceListTransferPairsHandler := connect.NewUnaryHandler(
+		TransactionServiceListTransferPairsProcedure,
+		svc.ListTransferPairs,
+		connect.WithSchema(transactionServiceMethods.ByName("ListTransferPairs")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transactionServiceConfirmTransferPairHandler := connect.NewUnaryHandler(
+		TransactionServiceConfirmTransferPairProcedure,
+		svc.ConfirmTransferPair,
+		connect.WithSchema(transactionServiceMethods.ByName("ConfirmTransferPair")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transactionServiceSplitTransferPairHandler := connect.NewUnaryHandler(
+		TransactionServiceSplitTransferPairProcedure,
+		svc.SplitTransferPair,
+		connect.WithSchema(transactionServiceMethods.ByName("SplitTransferPair")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transactionServiceImportStatementHandler := connect.NewUnaryHandler(
+		TransactionServiceImportStatementProcedure,
+		svc.ImportStatement,
+		connect.WithSchema(transactionServiceMethods.ByName("ImportStatement")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transactionServiceCreateStatementMappingHandler := connect.NewUnaryHandler(
+		TransactionServiceCreateStatementMappingProcedure,
+		svc.CreateStatementMapping,
+		connect.WithSchema(transactionServiceMethods.ByName("CreateStatementMapping")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transactionServiceUpdateStatementMappingHandler := connect.NewUnaryHandler(
+		TransactionServiceUpdateStatementMappingProcedure,
+		svc.UpdateStatementMapping,
+		connect.WithSchema(transactionServiceMethods.ByName("UpdateStatementMapping")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transactionServiceDeleteStatementMappingHandler := connect.NewUnaryHandler(
+		TransactionServiceDeleteStatementMappingProcedure,
+		svc.DeleteStatementMapping,
+		connect.WithSchema(transactionServiceMethods.ByName("DeleteStatementMapping")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transactionServiceListStatementMappingsHandler := connect.NewUnaryHandler(
+		TransactionServiceListStatementMappingsProcedure,
+		svc.ListStatementMappings,
+		connect.WithSchema(transactionServiceMethods.ByName("ListStatementMappings")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/transaction.v1.TransactionService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case TransactionServiceGetAccountsProcedure:
@@ -145,6 +922,72 @@ func NewTransactionServiceHandler(svc TransactionServiceHandler, opts ...connect
 			transactionServiceGetSimplefinAccountsHandler.ServeHTTP(w, r)
 		case TransactionServiceAddAccountProcedure:
 			transactionServiceAddAccountHandler.ServeHTTP(w, r)
+		case TransactionServiceUpdateAccountProcedure:
+			transactionServiceUpdateAccountHandler.ServeHTTP(w, r)
+		case TransactionServiceRemoveAccountProcedure:
+			transactionServiceRemoveAccountHandler.ServeHTTP(w, r)
+		case TransactionServiceRelinkAccountProcedure:
+			transactionServiceRelinkAccountHandler.ServeHTTP(w, r)
+		case TransactionServiceConnectSimplefinProcedure:
+			transactionServiceConnectSimplefinHandler.ServeHTTP(w, r)
+		case TransactionServiceDisconnectSimplefinProcedure:
+			transactionServiceDisconnectSimplefinHandler.ServeHTTP(w, r)
+		case TransactionServiceGetSimplefinConnectionProcedure:
+			transactionServiceGetSimplefinConnectionHandler.ServeHTTP(w, r)
+		case TransactionServiceListTransactionsProcedure:
+			transactionServiceListTransactionsHandler.ServeHTTP(w, r)
+		case TransactionServiceGetTransactionProcedure:
+			transactionServiceGetTransactionHandler.ServeHTTP(w, r)
+		case TransactionServiceUpdateTransactionProcedure:
+			transactionServiceUpdateTransactionHandler.ServeHTTP(w, r)
+		case TransactionServiceSetTransactionSplitsProcedure:
+			transactionServiceSetTransactionSplitsHandler.ServeHTTP(w, r)
+		case TransactionServiceMatchTransactionsProcedure:
+			transactionServiceMatchTransactionsHandler.ServeHTTP(w, r)
+		case TransactionServiceListMatchReviewsProcedure:
+			transactionServiceListMatchReviewsHandler.ServeHTTP(w, r)
+		case TransactionServiceResolveMatchReviewProcedure:
+			transactionServiceResolveMatchReviewHandler.ServeHTTP(w, r)
+		case TransactionServiceSyncNowProcedure:
+			transactionServiceSyncNowHandler.ServeHTTP(w, r)
+		case TransactionServiceGetSyncStatusProcedure:
+			transactionServiceGetSyncStatusHandler.ServeHTTP(w, r)
+		case TransactionServiceGetAccountBalancesProcedure:
+			transactionServiceGetAccountBalancesHandler.ServeHTTP(w, r)
+		case TransactionServiceGetNetWorthHistoryProcedure:
+			transactionServiceGetNetWorthHistoryHandler.ServeHTTP(w, r)
+		case TransactionServiceCreateRuleProcedure:
+			transactionServiceCreateRuleHandler.ServeHTTP(w, r)
+		case TransactionServiceUpdateRuleProcedure:
+			transactionServiceUpdateRuleHandler.ServeHTTP(w, r)
+		case TransactionServiceDeleteRuleProcedure:
+			transactionServiceDeleteRuleHandler.ServeHTTP(w, r)
+		case TransactionServiceListRulesProcedure:
+			transactionServiceListRulesHandler.ServeHTTP(w, r)
+		case TransactionServiceApplyRulesProcedure:
+			transactionServiceApplyRulesHandler.ServeHTTP(w, r)
+		case TransactionServicePreviewRuleProcedure:
+			transactionServicePreviewRuleHandler.ServeHTTP(w, r)
+		case TransactionServiceSuggestCategoriesProcedure:
+			transactionServiceSuggestCategoriesHandler.ServeHTTP(w, r)
+		case TransactionServiceDetectTransfersProcedure:
+			transactionServiceDetectTransfersHandler.ServeHTTP(w, r)
+		case TransactionServiceListTransferPairsProcedure:
+			transactionServiceListTransferPairsHandler.ServeHTTP(w, r)
+		case TransactionServiceConfirmTransferPairProcedure:
+			transactionServiceConfirmTransferPairHandler.ServeHTTP(w, r)
+		case TransactionServiceSplitTransferPairProcedure:
+			transactionServiceSplitTransferPairHandler.ServeHTTP(w, r)
+		case TransactionServiceImportStatementProcedure:
+			transactionServiceImportStatementHandler.ServeHTTP(w, r)
+		case TransactionServiceCreateStatementMappingProcedure:
+			transactionServiceCreateStatementMappingHandler.ServeHTTP(w, r)
+		case TransactionServiceUpdateStatementMappingProcedure:
+			transactionServiceUpdateStatementMappingHandler.ServeHTTP(w, r)
+		case TransactionServiceDeleteStatementMappingProcedure:
+			transactionServiceDeleteStatementMappingHandler.ServeHTTP(w, r)
+		case TransactionServiceListStatementMappingsProcedure:
+			transactionServiceListStatementMappingsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -165,3 +1008,135 @@ func (UnimplementedTransactionServiceHandler) GetSimplefinAccounts(context.Conte
 func (UnimplementedTransactionServiceHandler) AddAccount(context.Context, *connect.Request[v1.AddAccountRequest]) (*connect.Response[v1.AddAccountResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("transaction.v1.TransactionService.AddAccount is not implemented"))
 }
+
+func (UnimplementedTransactionServiceHandler) UpdateAccount(context.Context, *connect.Request[v1.UpdateAccountRequest]) (*connect.Response[v1.UpdateAccountResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("transaction.v1.TransactionService.UpdateAccount is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) RemoveAccount(context.Context, *connect.Request[v1.RemoveAccountRequest]) (*connect.Response[v1.RemoveAccountResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("transaction.v1.TransactionService.RemoveAccount is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) RelinkAccount(context.Context, *connect.Request[v1.RelinkAccountRequest]) (*connect.Response[v1.RelinkAccountResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("transaction.v1.TransactionService.RelinkAccount is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) ConnectSimplefin(context.Context, *connect.Request[v1.ConnectSimplefinRequest]) (*connect.Response[v1.ConnectSimplefinResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("transaction.v1.TransactionService.ConnectSimplefin is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) DisconnectSimplefin(context.Context, *connect.Request[v1.DisconnectSimplefinRequest]) (*connect.Response[v1.DisconnectSimplefinResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("transaction.v1.TransactionService.DisconnectSimplefin is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) GetSimplefinConnection(context.Context, *connect.Request[v1.GetSimplefinConnectionRequest]) (*connect.Response[v1.GetSimplefinConnectionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("transaction.v1.TransactionService.GetSimplefinConnection is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) ListTransactions(context.Context, *connect.Request[v1.ListTransactionsRequest]) (*connect.Response[v1.ListTransactionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("transaction.v1.TransactionService.ListTransactions is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) GetTransaction(context.Context, *connect.Request[v1.GetTransactionRequest]) (*connect.Response[v1.GetTransactionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("transaction.v1.TransactionService.GetTransaction is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) UpdateTransaction(context.Context, *connect.Request[v1.UpdateTransactionRequest]) (*connect.Response[v1.UpdateTransactionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("transaction.v1.TransactionService.UpdateTransaction is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) SetTransactionSplits(context.Context, *connect.Request[v1.SetTransactionSplitsRequest]) (*connect.Response[v1.SetTransactionSplitsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("transaction.v1.TransactionService.SetTransactionSplits is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) MatchTransactions(context.Context, *connect.Request[v1.MatchTransactionsRequest]) (*connect.Response[v1.MatchTransactionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("transaction.v1.TransactionService.MatchTransactions is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) ListMatchReviews(context.Context, *connect.Request[v1.ListMatchReviewsRequest]) (*connect.Response[v1.ListMatchReviewsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("transaction.v1.TransactionService.ListMatchReviews is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) ResolveMatchReview(context.Context, *connect.Request[v1.ResolveMatchReviewRequest]) (*connect.Response[v1.ResolveMatchReviewResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("transaction.v1.TransactionService.ResolveMatchReview is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) SyncNow(context.Context, *connect.Request[v1.SyncNowRequest]) (*connect.Response[v1.SyncNowResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("transaction.v1.TransactionService.SyncNow is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) GetSyncStatus(context.Context, *connect.Request[v1.GetSyncStatusRequest]) (*connect.Response[v1.GetSyncStatusResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("transaction.v1.TransactionService.GetSyncStatus is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) GetAccountBalances(context.Context, *connect.Request[v1.GetAccountBalancesRequest]) (*connect.Response[v1.GetAccountBalancesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("transaction.v1.TransactionService.GetAccountBalances is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) GetNetWorthHistory(context.Context, *connect.Request[v1.GetNetWorthHistoryRequest]) (*connect.Response[v1.GetNetWorthHistoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("transaction.v1.TransactionService.GetNetWorthHistory is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) CreateRule(context.Context, *connect.Request[v1.CreateRuleRequest]) (*connect.Response[v1.CreateRuleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("transaction.v1.TransactionService.CreateRule is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) UpdateRule(context.Context, *connect.Request[v1.UpdateRuleRequest]) (*connect.Response[v1.UpdateRuleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("transaction.v1.TransactionService.UpdateRule is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) DeleteRule(context.Context, *connect.Request[v1.DeleteRuleRequest]) (*connect.Response[v1.DeleteRuleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("transaction.v1.TransactionService.DeleteRule is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) ListRules(context.Context, *connect.Request[v1.ListRulesRequest]) (*connect.Response[v1.ListRulesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("transaction.v1.TransactionService.ListRules is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) ApplyRules(context.Context, *connect.Request[v1.ApplyRulesRequest]) (*connect.Response[v1.ApplyRulesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("transaction.v1.TransactionService.ApplyRules is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) PreviewRule(context.Context, *connect.Request[v1.PreviewRuleRequest]) (*connect.Response[v1.PreviewRuleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("transaction.v1.TransactionService.PreviewRule is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) SuggestCategories(context.Context, *connect.Request[v1.SuggestCategoriesRequest]) (*connect.Response[v1.SuggestCategoriesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("transaction.v1.TransactionService.SuggestCategories is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) DetectTransfers(context.Context, *connect.Request[v1.DetectTransfersRequest]) (*connect.Response[v1.DetectTransfersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("transaction.v1.TransactionService.DetectTransfers is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) ListTransferPairs(context.Context, *connect.Request[v1.ListTransferPairsRequest]) (*connect.Response[v1.ListTransferPairsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("transaction.v1.TransactionService.ListTransferPairs is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) ConfirmTransferPair(context.Context, *connect.Request[v1.ConfirmTransferPairRequest]) (*connect.Response[v1.ConfirmTransferPairResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("transaction.v1.TransactionService.ConfirmTransferPair is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) SplitTransferPair(context.Context, *connect.Request[v1.SplitTransferPairRequest]) (*connect.Response[v1.SplitTransferPairResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("transaction.v1.TransactionService.SplitTransferPair is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) ImportStatement(context.Context, *connect.Request[v1.ImportStatementRequest]) (*connect.Response[v1.ImportStatementResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("transaction.v1.TransactionService.ImportStatement is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) CreateStatementMapping(context.Context, *connect.Request[v1.CreateStatementMappingRequest]) (*connect.Response[v1.CreateStatementMappingResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("transaction.v1.TransactionService.CreateStatementMapping is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) UpdateStatementMapping(context.Context, *connect.Request[v1.UpdateStatementMappingRequest]) (*connect.Response[v1.UpdateStatementMappingResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("transaction.v1.TransactionService.UpdateStatementMapping is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) DeleteStatementMapping(context.Context, *connect.Request[v1.DeleteStatementMappingRequest]) (*connect.Response[v1.DeleteStatementMappingResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("transaction.v1.TransactionService.DeleteStatementMapping is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) ListStatementMappings(context.Context, *connect.Request[v1.ListStatementMappingsRequest]) (*connect.Response[v1.ListStatementMappingsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("transaction.v1.TransactionService.ListStatementMappings is not implemented"))
+}
@@ -0,0 +1,17 @@
+-- name: UpsertBalanceSnapshot :exec
+INSERT INTO balance_snapshots (account_id, snapshot_date, balance_cents, available_balance_cents, balance_date, created_at)
+VALUES (?, ?, ?, ?, ?, ?)
+ON CONFLICT (account_id, snapshot_date) DO UPDATE
+SET balance_cents = excluded.balance_cents, available_balance_cents = excluded.available_balance_cents,
+    balance_date = excluded.balance_date, created_at = excluded.created_at;
+
+-- name: ListBalanceSnapshots :many
+SELECT * FROM balance_snapshots
+WHERE snapshot_date <= sqlc.arg('end_date')
+ORDER BY account_id ASC, snapshot_date ASC;
+
+-- name: DeleteBalanceSnapshotsByAccount :exec
+DELETE FROM balance_snapshots WHERE account_id = ?;
+
+-- name: ListAllBalanceSnapshots :many
+SELECT * FROM balance_snapshots ORDER BY account_id ASC, snapshot_date ASC;
@@ -0,0 +1,32 @@
+-- name: UpsertCategoryBudget :one
+INSERT INTO category_budgets (category_id, amount_cents, rollover, start_month, created_at, updated_at)
+VALUES (?, ?, ?, ?, ?, ?)
+ON CONFLICT (category_id) DO UPDATE
+SET amount_cents = excluded.amount_cents, rollover = excluded.rollover,
+    start_month = excluded.start_month, updated_at = excluded.updated_at
+RETURNING *;
+
+-- name: GetCategoryBudget :one
+SELECT * FROM category_budgets WHERE category_id = ?;
+
+-- name: ListCategoryBudgets :many
+SELECT * FROM category_budgets ORDER BY category_id ASC;
+
+-- name: DeleteCategoryBudget :exec
+DELETE FROM category_budgets WHERE category_id = ?;
+
+-- name: ListBudgetTransactions :many
+SELECT t.category_id, t.posted_date, t.amount_cents
+FROM transactions t
+JOIN accounts a ON a.id = t.account_id
+WHERE a.include_in_budget = TRUE AND t.is_transfer = FALSE
+  AND t.posted_date >= sqlc.arg('start_date') AND t.posted_date < sqlc.arg('end_date')
+  AND NOT EXISTS (SELECT 1 FROM transaction_splits s WHERE s.transaction_id = t.id)
+UNION ALL
+SELECT s.category_id, t.posted_date, s.amount_cents
+FROM transaction_splits s
+JOIN transactions t ON t.id = s.transaction_id
+JOIN accounts a ON a.id = t.account_id
+WHERE a.include_in_budget = TRUE AND t.is_transfer = FALSE
+  AND t.posted_date >= sqlc.arg('start_date') AND t.posted_date < sqlc.arg('end_date')
+ORDER BY posted_date ASC;
@@ -1,6 +1,6 @@
 -- name: CreateCategory :one
-INSERT INTO categories (id, name, description, color, icon, created_at, updated_at)
-VALUES (?, ?, ?, ?, ?, ?, ?)
+INSERT INTO categories (name, description, color, icon, created_at, updated_at)
+VALUES (?, ?, ?, ?, ?, ?)
 RETURNING *;
 
 -- name: GetCategoryByID :one
@@ -0,0 +1,19 @@
+-- name: CreateFamilyDataKey :one
+INSERT INTO family_data_keys (wrapped_key, master_key_id, active, created_at)
+VALUES (?, ?, ?, ?)
+RETURNING *;
+
+-- name: GetActiveFamilyDataKey :one
+SELECT * FROM family_data_keys
+WHERE active = TRUE
+ORDER BY id DESC
+LIMIT 1;
+
+-- name: GetFamilyDataKey :one
+SELECT * FROM family_data_keys WHERE id = ?;
+
+-- name: DeactivateFamilyDataKeys :exec
+UPDATE family_data_keys SET active = FALSE WHERE active = TRUE;
+
+-- name: DeleteInactiveFamilyDataKeys :exec
+DELETE FROM family_data_keys WHERE active = FALSE;
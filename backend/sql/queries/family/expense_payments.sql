@@ -0,0 +1,69 @@
+-- name: UpsertExpensePayment :one
+INSERT INTO expense_payments (expense_id, scheduled_date, status, paid_date, amount, paid_by, note, is_automatic, created_at, updated_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT (expense_id, scheduled_date) DO UPDATE
+SET status = excluded.status, paid_date = excluded.paid_date, amount = excluded.amount,
+    paid_by = excluded.paid_by, note = excluded.note, is_automatic = excluded.is_automatic,
+    updated_at = excluded.updated_at
+RETURNING *;
+
+-- name: CreateAutomaticPayment :execrows
+INSERT INTO expense_payments (expense_id, scheduled_date, status, paid_date, amount, is_automatic, created_at, updated_at)
+VALUES (?, ?, 'paid', ?, ?, TRUE, ?, ?)
+ON CONFLICT (expense_id, scheduled_date) DO NOTHING;
+
+-- name: RecordTransactionPayment :one
+INSERT INTO expense_payments (expense_id, scheduled_date, status, paid_date, amount, transaction_id, is_automatic, created_at, updated_at)
+VALUES (?, ?, 'paid', ?, ?, ?, FALSE, ?, ?)
+ON CONFLICT (expense_id, scheduled_date) DO UPDATE
+SET status = 'paid', paid_date = excluded.paid_date, amount = excluded.amount,
+    transaction_id = excluded.transaction_id, is_automatic = FALSE, updated_at = excluded.updated_at
+RETURNING *;
+
+-- name: GetExpensePayment :one
+SELECT * FROM expense_payments
+WHERE expense_id = ? AND scheduled_date = ?;
+
+-- name: GetExpensePaymentByID :one
+SELECT * FROM expense_payments WHERE id = ?;
+
+-- name: DeleteExpensePayment :execrows
+DELETE FROM expense_payments
+WHERE expense_id = ? AND scheduled_date = ?;
+
+-- name: DeletePaymentsByExpense :exec
+DELETE FROM expense_payments WHERE expense_id = ?;
+
+-- name: ListPaymentsByScheduledDate :many
+SELECT * FROM expense_payments
+WHERE scheduled_date >= sqlc.arg('from_date') AND scheduled_date <= sqlc.arg('to_date')
+ORDER BY scheduled_date ASC, id ASC;
+
+-- name: ListExpensePayments :many
+SELECT * FROM expense_payments
+WHERE status = 'paid'
+  AND (sqlc.narg('expense_id') IS NULL OR expense_id = sqlc.narg('expense_id'))
+  AND (sqlc.narg('from_date') IS NULL OR scheduled_date >= sqlc.narg('from_date'))
+  AND (sqlc.narg('to_date') IS NULL OR scheduled_date <= sqlc.narg('to_date'))
+  AND (
+    sqlc.narg('before_date') IS NULL
+    OR scheduled_date < sqlc.narg('before_date')
+    OR (scheduled_date = sqlc.narg('before_date') AND id < sqlc.arg('before_id'))
+  )
+ORDER BY scheduled_date DESC, id DESC
+LIMIT sqlc.arg('limit');
+
+-- name: DeletePaymentByTransaction :exec
+DELETE FROM expense_payments WHERE transaction_id = ?;
+
+-- name: DetachPaymentsFromAccount :exec
+UPDATE expense_payments SET transaction_id = NULL
+WHERE transaction_id IN (SELECT id FROM transactions WHERE account_id = sqlc.arg('account_id'));
+
+-- name: ListAllExpensePayments :many
+SELECT * FROM expense_payments ORDER BY id ASC;
+
+-- name: RestoreExpensePayment :one
+INSERT INTO expense_payments (expense_id, scheduled_date, status, paid_date, amount, paid_by, note, is_automatic, created_at, updated_at, transaction_id)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+RETURNING *;
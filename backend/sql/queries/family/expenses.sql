@@ -1,6 +1,6 @@
 -- name: CreateExpense :one
-INSERT INTO expenses (category_id, amount, name, day_of_month_due, is_autopay, created_at, updated_at)
-VALUES (?, ?, ?, ?, ?, ?, ?)
+INSERT INTO expenses (category_id, amount, name, day_of_month_due, is_autopay, created_at, updated_at, frequency, interval_count, anchor_date, end_date, max_occurrences, business_day_adjustment, autopay_since)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 RETURNING *;
 
 -- name: GetExpenseByID :one
@@ -8,7 +8,9 @@ SELECT * FROM expenses WHERE id = ?;
 
 -- name: UpdateExpense :one
 UPDATE expenses 
-SET category_id = ?, amount = ?, name = ?, day_of_month_due = ?, is_autopay = ?, updated_at = ?
+SET category_id = ?, amount = ?, name = ?, day_of_month_due = ?, is_autopay = ?, updated_at = ?,
+    frequency = ?, interval_count = ?, anchor_date = ?, end_date = ?, max_occurrences = ?,
+    business_day_adjustment = ?, autopay_since = ?
 WHERE id = ?
 RETURNING *;
 
@@ -16,9 +18,18 @@ RETURNING *;
 DELETE FROM expenses WHERE id = ?;
 
 -- name: ListExpenses :many
-SELECT * FROM expenses 
-ORDER BY created_at DESC
-LIMIT ? OFFSET ?;
+SELECT * FROM expenses
+WHERE (sqlc.narg('category_id') IS NULL OR category_id = sqlc.narg('category_id'))
+  AND (sqlc.narg('is_autopay') IS NULL OR is_autopay = sqlc.narg('is_autopay'))
+  AND (sqlc.narg('min_day') IS NULL OR day_of_month_due >= sqlc.narg('min_day'))
+  AND (sqlc.narg('max_day') IS NULL OR day_of_month_due <= sqlc.narg('max_day'))
+  AND (
+    sqlc.narg('after_day') IS NULL
+    OR day_of_month_due > sqlc.narg('after_day')
+    OR (day_of_month_due = sqlc.narg('after_day') AND id > sqlc.arg('after_id'))
+  )
+ORDER BY day_of_month_due ASC, id ASC
+LIMIT sqlc.arg('limit');
 
 -- name: ListExpensesByCategory :many
 SELECT * FROM expenses 
@@ -26,9 +37,24 @@ WHERE category_id = ?
 ORDER BY created_at DESC;
 
 -- name: CountExpenses :one
-SELECT COUNT(*) FROM expenses;
+SELECT COUNT(*) FROM expenses
+WHERE (sqlc.narg('category_id') IS NULL OR category_id = sqlc.narg('category_id'))
+  AND (sqlc.narg('is_autopay') IS NULL OR is_autopay = sqlc.narg('is_autopay'))
+  AND (sqlc.narg('min_day') IS NULL OR day_of_month_due >= sqlc.narg('min_day'))
+  AND (sqlc.narg('max_day') IS NULL OR day_of_month_due <= sqlc.narg('max_day'));
 
 -- name: GetExpensesByDateRange :many
 SELECT * FROM expenses
 WHERE day_of_month_due BETWEEN ? AND ?
 ORDER BY day_of_month_due ASC;
+
+-- name: CountExpensesByCategory :one
+SELECT COUNT(*) FROM expenses WHERE category_id = ?;
+
+-- name: ReassignExpensesCategory :execrows
+UPDATE expenses
+SET category_id = sqlc.narg('new_category_id')
+WHERE category_id = sqlc.narg('old_category_id');
+
+-- name: ListAllExpenses :many
+SELECT * FROM expenses ORDER BY id ASC;
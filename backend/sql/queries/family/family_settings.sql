@@ -18,3 +18,7 @@ RETURNING *;
 
 -- name: DeleteFamilySetting :exec
 DELETE FROM family_settings WHERE id = ?;
+
+-- name: GetFamilySettingByID :one
+SELECT * FROM family_settings
+WHERE id = ?;
@@ -0,0 +1,26 @@
+-- name: CreateIncomeSource :one
+INSERT INTO income_sources (name, description, member_id, pay_frequency, anchor_date, gross_cents, net_cents, is_active, created_at, updated_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+RETURNING *;
+
+-- name: GetIncomeSourceByID :one
+SELECT * FROM income_sources WHERE id = ?;
+
+-- name: GetIncomeSourceByName :one
+SELECT * FROM income_sources WHERE name = ? ORDER BY id ASC LIMIT 1;
+
+-- name: ListIncomeSources :many
+SELECT * FROM income_sources ORDER BY id ASC;
+
+-- name: UpdateIncomeSource :one
+UPDATE income_sources
+SET name = ?, description = ?, member_id = ?, pay_frequency = ?, anchor_date = ?,
+    gross_cents = ?, net_cents = ?, is_active = ?, updated_at = ?
+WHERE id = ?
+RETURNING *;
+
+-- name: DeleteIncomeSource :exec
+DELETE FROM income_sources WHERE id = ?;
+
+-- name: DeleteAllIncomeSources :exec
+DELETE FROM income_sources;
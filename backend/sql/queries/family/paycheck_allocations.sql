@@ -0,0 +1,24 @@
+-- name: ListPaycheckAllocations :many
+SELECT * FROM paycheck_allocations
+WHERE scheduled_date BETWEEN sqlc.arg('start_date') AND sqlc.arg('end_date')
+ORDER BY scheduled_date ASC, expense_id ASC;
+
+-- name: UpsertPaycheckAllocation :one
+INSERT INTO paycheck_allocations (expense_id, scheduled_date, income_source_id, pay_date, pinned, created_at, updated_at)
+VALUES (?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT (expense_id, scheduled_date) DO UPDATE
+SET income_source_id = excluded.income_source_id, pay_date = excluded.pay_date,
+    pinned = excluded.pinned, updated_at = excluded.updated_at
+RETURNING *;
+
+-- name: DeletePaycheckAllocation :exec
+DELETE FROM paycheck_allocations WHERE expense_id = ? AND scheduled_date = ?;
+
+-- name: DeletePaycheckAllocationsByExpense :exec
+DELETE FROM paycheck_allocations WHERE expense_id = ?;
+
+-- name: DeletePaycheckAllocationsByIncomeSource :exec
+DELETE FROM paycheck_allocations WHERE income_source_id = ?;
+
+-- name: ListAllPaycheckAllocations :many
+SELECT * FROM paycheck_allocations ORDER BY id ASC;
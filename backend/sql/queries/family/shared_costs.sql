@@ -0,0 +1,67 @@
+-- name: CreateSharedCost :one
+INSERT INTO shared_costs (expense_payment_id, transaction_id, paid_by, split_method, created_at, updated_at)
+VALUES (?, ?, ?, ?, ?, ?)
+RETURNING *;
+
+-- name: UpdateSharedCost :one
+UPDATE shared_costs
+SET paid_by = ?, split_method = ?, updated_at = ?
+WHERE id = ?
+RETURNING *;
+
+-- name: GetSharedCostByPayment :one
+SELECT * FROM shared_costs WHERE expense_payment_id = ?;
+
+-- name: GetSharedCostByTransaction :one
+SELECT * FROM shared_costs WHERE transaction_id = ?;
+
+-- name: DeleteSharedCost :exec
+DELETE FROM shared_costs WHERE id = ?;
+
+-- name: DeleteSharedCostsByExpense :exec
+DELETE FROM shared_costs
+WHERE expense_payment_id IN (SELECT id FROM expense_payments WHERE expense_id = sqlc.arg('expense_id'));
+
+-- name: DeleteSharedCostsByAccount :exec
+DELETE FROM shared_costs
+WHERE transaction_id IN (SELECT id FROM transactions WHERE account_id = sqlc.arg('account_id'));
+
+-- name: ListSharedCostAmounts :many
+SELECT c.id, c.paid_by, c.split_method,
+       t.amount_cents AS transaction_amount_cents,
+       COALESCE(p.amount, e.amount) AS payment_amount
+FROM shared_costs c
+LEFT JOIN transactions t ON t.id = c.transaction_id
+LEFT JOIN expense_payments p ON p.id = c.expense_payment_id AND p.status = 'paid'
+LEFT JOIN expenses e ON e.id = p.expense_id
+ORDER BY c.id ASC;
+
+-- name: CreateSharedCostShare :exec
+INSERT INTO shared_cost_shares (shared_cost_id, member_id, position, value)
+VALUES (?, ?, ?, ?);
+
+-- name: ListSharedCostShares :many
+SELECT * FROM shared_cost_shares
+WHERE shared_cost_id = ?
+ORDER BY position ASC;
+
+-- name: ListAllSharedCostShares :many
+SELECT * FROM shared_cost_shares ORDER BY shared_cost_id ASC, position ASC;
+
+-- name: DeleteSharedCostShares :exec
+DELETE FROM shared_cost_shares WHERE shared_cost_id = ?;
+
+-- name: DeleteOrphanedSharedCostShares :exec
+DELETE FROM shared_cost_shares
+WHERE shared_cost_id NOT IN (SELECT id FROM shared_costs);
+
+-- name: CreateSettlement :one
+INSERT INTO settlements (from_member_id, to_member_id, amount_cents, note, recorded_by, settled_at)
+VALUES (?, ?, ?, ?, ?, ?)
+RETURNING *;
+
+-- name: ListSettlements :many
+SELECT * FROM settlements ORDER BY settled_at ASC, id ASC;
+
+-- name: ListAllSharedCosts :many
+SELECT * FROM shared_costs ORDER BY id ASC;
@@ -0,0 +1,21 @@
+-- name: CreateStatementMapping :one
+INSERT INTO statement_mappings (name, delimiter, has_header, skip_rows, date_column, date_format, description_column, payee_column, amount_column, debit_column, credit_column, id_column, negate_amounts, created_at, updated_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+RETURNING *;
+
+-- name: GetStatementMapping :one
+SELECT * FROM statement_mappings WHERE id = ?;
+
+-- name: ListStatementMappings :many
+SELECT * FROM statement_mappings ORDER BY name ASC, id ASC;
+
+-- name: UpdateStatementMapping :one
+UPDATE statement_mappings
+SET name = ?, delimiter = ?, has_header = ?, skip_rows = ?, date_column = ?, date_format = ?,
+    description_column = ?, payee_column = ?, amount_column = ?, debit_column = ?, credit_column = ?,
+    id_column = ?, negate_amounts = ?, updated_at = ?
+WHERE id = ?
+RETURNING *;
+
+-- name: DeleteStatementMapping :exec
+DELETE FROM statement_mappings WHERE id = ?;
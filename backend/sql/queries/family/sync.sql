@@ -0,0 +1,31 @@
+-- name: GetAccountSyncState :one
+SELECT * FROM account_sync_state WHERE account_id = ?;
+
+-- name: ListAccountSyncStates :many
+SELECT * FROM account_sync_state ORDER BY account_id ASC;
+
+-- name: UpsertAccountSyncState :exec
+INSERT INTO account_sync_state (account_id, sync_cursor, last_attempt_at, last_success_at, last_error, transactions_added)
+VALUES (?, ?, ?, ?, ?, ?)
+ON CONFLICT (account_id) DO UPDATE
+SET sync_cursor = excluded.sync_cursor, last_attempt_at = excluded.last_attempt_at,
+    last_success_at = excluded.last_success_at, last_error = excluded.last_error,
+    transactions_added = excluded.transactions_added;
+
+-- name: CreateSyncError :exec
+INSERT INTO sync_errors (account_id, message, created_at)
+VALUES (?, ?, ?);
+
+-- name: ListRecentSyncErrors :many
+SELECT * FROM sync_errors
+ORDER BY created_at DESC, id DESC
+LIMIT ?;
+
+-- name: DeleteSyncErrorsBefore :exec
+DELETE FROM sync_errors WHERE created_at < ?;
+
+-- name: DeleteAccountSyncState :exec
+DELETE FROM account_sync_state WHERE account_id = ?;
+
+-- name: DeleteSyncErrorsByAccount :exec
+DELETE FROM sync_errors WHERE account_id = ?;
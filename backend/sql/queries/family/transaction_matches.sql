@@ -0,0 +1,44 @@
+-- name: CreateTransactionMatch :execrows
+INSERT INTO transaction_matches (transaction_id, expense_id, scheduled_date, score, status, created_at, updated_at)
+VALUES (?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT (transaction_id, expense_id, scheduled_date) DO NOTHING;
+
+-- name: UpsertTransactionMatch :one
+INSERT INTO transaction_matches (transaction_id, expense_id, scheduled_date, score, status, created_at, updated_at)
+VALUES (?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT (transaction_id, expense_id, scheduled_date) DO UPDATE
+SET score = excluded.score, status = excluded.status, updated_at = excluded.updated_at
+RETURNING *;
+
+-- name: GetTransactionMatch :one
+SELECT * FROM transaction_matches WHERE id = ?;
+
+-- name: GetTransactionMatchByPair :one
+SELECT * FROM transaction_matches
+WHERE transaction_id = ? AND expense_id = ? AND scheduled_date = ?;
+
+-- name: UpdateTransactionMatchStatus :exec
+UPDATE transaction_matches
+SET status = ?, updated_at = ?
+WHERE id = ?;
+
+-- name: RejectPendingTransactionMatches :exec
+UPDATE transaction_matches
+SET status = 'rejected', updated_at = sqlc.arg('updated_at')
+WHERE transaction_id = sqlc.arg('transaction_id') AND status = 'pending';
+
+-- name: ListPendingTransactionMatches :many
+SELECT * FROM transaction_matches
+WHERE status = 'pending' AND id > sqlc.arg('after_id')
+ORDER BY id ASC
+LIMIT sqlc.arg('limit');
+
+-- name: CountPendingTransactionMatches :one
+SELECT COUNT(*) FROM transaction_matches WHERE status = 'pending';
+
+-- name: DeleteTransactionMatchesByAccount :exec
+DELETE FROM transaction_matches
+WHERE transaction_id IN (SELECT id FROM transactions WHERE account_id = sqlc.arg('account_id'));
+
+-- name: ListAllTransactionMatches :many
+SELECT * FROM transaction_matches ORDER BY id ASC;
@@ -0,0 +1,35 @@
+-- name: CreateTransactionRule :one
+INSERT INTO transaction_rules (name, position, is_active, match_field, match_type, pattern, min_amount_cents, max_amount_cents, account_id, set_category_id, set_payee, link_expense_id, mark_transfer, created_at, updated_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+RETURNING *;
+
+-- name: GetTransactionRule :one
+SELECT * FROM transaction_rules WHERE id = ?;
+
+-- name: ListTransactionRules :many
+SELECT * FROM transaction_rules ORDER BY position ASC, id ASC;
+
+-- name: UpdateTransactionRule :one
+UPDATE transaction_rules
+SET name = ?, position = ?, is_active = ?, match_field = ?, match_type = ?, pattern = ?,
+    min_amount_cents = ?, max_amount_cents = ?, account_id = ?,
+    set_category_id = ?, set_payee = ?, link_expense_id = ?, mark_transfer = ?, updated_at = ?
+WHERE id = ?
+RETURNING *;
+
+-- name: DeleteTransactionRule :exec
+DELETE FROM transaction_rules WHERE id = ?;
+
+-- name: CountRulesByCategory :one
+SELECT COUNT(*) FROM transaction_rules WHERE set_category_id = ?;
+
+-- name: ReassignRulesCategory :exec
+UPDATE transaction_rules
+SET set_category_id = sqlc.narg('new_category_id')
+WHERE set_category_id = sqlc.narg('old_category_id');
+
+-- name: ClearRulesExpense :exec
+UPDATE transaction_rules SET link_expense_id = NULL WHERE link_expense_id = ?;
+
+-- name: DeleteTransactionRulesByAccount :exec
+DELETE FROM transaction_rules WHERE account_id = ?;
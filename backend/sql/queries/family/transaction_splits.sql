@@ -0,0 +1,35 @@
+-- name: CreateTransactionSplit :one
+INSERT INTO transaction_splits (transaction_id, position, amount_cents, category_id, note, member_id, created_at)
+VALUES (?, ?, ?, ?, ?, ?, ?)
+RETURNING *;
+
+-- name: ListTransactionSplits :many
+SELECT * FROM transaction_splits
+WHERE transaction_id = ?
+ORDER BY position ASC;
+
+-- name: ListTransactionSplitsByPostedDate :many
+SELECT * FROM transaction_splits
+WHERE transaction_id IN (
+    SELECT id FROM transactions
+    WHERE posted_date >= sqlc.arg('start_date') AND posted_date <= sqlc.arg('end_date')
+)
+ORDER BY transaction_id ASC, position ASC;
+
+-- name: DeleteTransactionSplits :exec
+DELETE FROM transaction_splits WHERE transaction_id = ?;
+
+-- name: CountSplitsByCategory :one
+SELECT COUNT(*) FROM transaction_splits WHERE category_id = ?;
+
+-- name: DeleteTransactionSplitsByAccount :exec
+DELETE FROM transaction_splits
+WHERE transaction_id IN (SELECT id FROM transactions WHERE account_id = sqlc.arg('account_id'));
+
+-- name: ReassignSplitsCategory :exec
+UPDATE transaction_splits
+SET category_id = sqlc.narg('new_category_id')
+WHERE category_id = sqlc.narg('old_category_id');
+
+-- name: ListAllTransactionSplits :many
+SELECT * FROM transaction_splits ORDER BY transaction_id ASC, position ASC;
@@ -1,18 +1,202 @@
 -- name: CreateAccount :one
-INSERT INTO accounts (account_id,name)
-VALUES (?,?)
+INSERT INTO accounts (account_id,name,account_type,source)
+VALUES (?,?,?,?)
 RETURNING *;
 
 -- name: GetAccounts :many
 SELECT * FROM accounts;
 
+-- name: GetAccountByID :one
+SELECT * FROM accounts WHERE id = ?;
+
+-- name: GetAccountBySimplefinID :one
+SELECT * FROM accounts WHERE account_id = ?
+ORDER BY id ASC
+LIMIT 1;
+
+-- name: UpdateAccount :one
+UPDATE accounts
+SET name = ?, account_type = ?, hidden = ?, include_in_budget = ?
+WHERE id = ?
+RETURNING *;
+
+-- name: UnlinkAccount :exec
+UPDATE accounts SET unlinked_at = ? WHERE id = ?;
+
+-- name: RelinkAccount :one
+UPDATE accounts
+SET account_id = ?, source = 'simplefin', unlinked_at = NULL, relinked_at = ?
+WHERE id = ?
+RETURNING *;
+
 -- name: DeleteAccount :exec
 DELETE FROM accounts where id = ?;
 
 -- name: CreateTransaction :one
-INSERT INTO transactions (account_id,posted_date,description,payee)
-VALUES (?,?,?,?)
+INSERT INTO transactions (account_id,posted_date,description,payee,amount_cents,external_id,pending,transacted_at)
+VALUES (?,?,?,?,?,?,?,?)
 RETURNING *;
 
 -- name: GetTransactionsByAccount :many
 SELECT * FROM transactions WHERE account_id = ?;
+
+-- name: GetTransactionByID :one
+SELECT * FROM transactions WHERE id = ?;
+
+-- name: ListUnmatchedTransactions :many
+SELECT * FROM transactions
+WHERE matched_expense_id IS NULL AND amount_cents < 0 AND pending = FALSE AND posted_date >= sqlc.arg('since')
+ORDER BY posted_date ASC, id ASC;
+
+-- name: LinkTransactionToExpense :exec
+UPDATE transactions
+SET matched_expense_id = ?, matched_scheduled_date = ?
+WHERE id = ?;
+
+-- name: GetTransactionByExternalID :one
+SELECT * FROM transactions
+WHERE account_id = ? AND external_id = ?;
+
+-- name: UpdateSyncedTransaction :exec
+UPDATE transactions
+SET posted_date = ?, description = ?, payee = ?, amount_cents = ?, pending = ?, transacted_at = ?
+WHERE id = ?;
+
+-- name: ListTransactions :many
+SELECT * FROM transactions
+WHERE (sqlc.narg('account_id') IS NULL OR account_id = sqlc.narg('account_id'))
+  AND (sqlc.narg('start_date') IS NULL OR posted_date >= sqlc.narg('start_date'))
+  AND (sqlc.narg('end_date') IS NULL OR posted_date < sqlc.narg('end_date'))
+  AND (sqlc.narg('min_amount_cents') IS NULL OR amount_cents >= sqlc.narg('min_amount_cents'))
+  AND (sqlc.narg('max_amount_cents') IS NULL OR amount_cents <= sqlc.narg('max_amount_cents'))
+  AND (sqlc.narg('pending') IS NULL OR pending = sqlc.narg('pending'))
+  AND (
+    sqlc.narg('category_id') IS NULL
+    OR (category_id = sqlc.narg('category_id') AND id NOT IN (SELECT transaction_id FROM transaction_splits))
+    OR id IN (SELECT transaction_id FROM transaction_splits WHERE category_id = sqlc.narg('category_id'))
+  )
+  AND (
+    sqlc.narg('search') IS NULL
+    OR instr(lower(description), lower(sqlc.narg('search'))) > 0
+    OR instr(lower(payee), lower(sqlc.narg('search'))) > 0
+    OR instr(lower(coalesce(display_payee, '')), lower(sqlc.narg('search'))) > 0
+    OR instr(lower(coalesce(note, '')), lower(sqlc.narg('search'))) > 0
+  )
+  AND (
+    sqlc.narg('before_date') IS NULL
+    OR posted_date < sqlc.narg('before_date')
+    OR (posted_date = sqlc.narg('before_date') AND id < sqlc.arg('before_id'))
+  )
+ORDER BY posted_date DESC, id DESC
+LIMIT sqlc.arg('limit');
+
+-- name: CountTransactions :one
+SELECT COUNT(*) FROM transactions
+WHERE (sqlc.narg('account_id') IS NULL OR account_id = sqlc.narg('account_id'))
+  AND (sqlc.narg('start_date') IS NULL OR posted_date >= sqlc.narg('start_date'))
+  AND (sqlc.narg('end_date') IS NULL OR posted_date < sqlc.narg('end_date'))
+  AND (sqlc.narg('min_amount_cents') IS NULL OR amount_cents >= sqlc.narg('min_amount_cents'))
+  AND (sqlc.narg('max_amount_cents') IS NULL OR amount_cents <= sqlc.narg('max_amount_cents'))
+  AND (sqlc.narg('pending') IS NULL OR pending = sqlc.narg('pending'))
+  AND (
+    sqlc.narg('category_id') IS NULL
+    OR (category_id = sqlc.narg('category_id') AND id NOT IN (SELECT transaction_id FROM transaction_splits))
+    OR id IN (SELECT transaction_id FROM transaction_splits WHERE category_id = sqlc.narg('category_id'))
+  )
+  AND (
+    sqlc.narg('search') IS NULL
+    OR instr(lower(description), lower(sqlc.narg('search'))) > 0
+    OR instr(lower(payee), lower(sqlc.narg('search'))) > 0
+    OR instr(lower(coalesce(display_payee, '')), lower(sqlc.narg('search'))) > 0
+    OR instr(lower(coalesce(note, '')), lower(sqlc.narg('search'))) > 0
+  );
+
+-- name: UpdateTransactionDetails :one
+UPDATE transactions
+SET category_id = ?, note = ?, display_payee = ?, is_transfer = ?
+WHERE id = ?
+RETURNING *;
+
+-- name: ReassignTransactionsCategory :execrows
+UPDATE transactions
+SET category_id = sqlc.narg('new_category_id')
+WHERE category_id = sqlc.narg('old_category_id');
+
+-- name: CountTransactionsByCategory :one
+SELECT COUNT(*) FROM transactions WHERE category_id = ?;
+
+-- name: CountCategorySuggestions :one
+SELECT COUNT(*) FROM transactions WHERE suggested_category_id = ?;
+
+-- name: DeleteTransactionsByAccount :exec
+DELETE FROM transactions WHERE account_id = ?;
+
+-- name: ListTransactionsForAdoption :many
+SELECT * FROM transactions
+WHERE account_id = ? AND posted_date = ? AND amount_cents = ?
+ORDER BY id ASC;
+
+-- name: ListAccountTransactionsBetween :many
+SELECT * FROM transactions
+WHERE account_id = sqlc.arg('account_id') AND posted_date >= sqlc.arg('start_date') AND posted_date < sqlc.arg('end_date')
+ORDER BY id ASC;
+
+-- name: SetTransactionExternalID :exec
+UPDATE transactions SET external_id = ? WHERE id = ?;
+
+-- name: ListTransactionsBetween :many
+SELECT * FROM transactions
+WHERE (sqlc.narg('start_date') IS NULL OR posted_date >= sqlc.narg('start_date'))
+  AND (sqlc.narg('end_date') IS NULL OR posted_date < sqlc.narg('end_date'))
+ORDER BY posted_date ASC, id ASC;
+
+-- name: ListCategoryTrainingTransactions :many
+SELECT description, payee, category_id FROM transactions
+WHERE category_id IS NOT NULL AND is_transfer = FALSE
+  AND (suggested_category_id IS NULL OR suggested_category_id != category_id)
+ORDER BY posted_date DESC, id DESC
+LIMIT sqlc.arg('limit');
+
+-- name: SetTransactionSuggestion :one
+UPDATE transactions
+SET category_id = ?, suggested_category_id = ?, suggestion_confidence = ?
+WHERE id = ?
+RETURNING *;
+
+-- name: ClearTransactionSuggestion :exec
+UPDATE transactions
+SET suggested_category_id = NULL, suggestion_confidence = NULL
+WHERE id = ?;
+
+-- name: ClearCategorySuggestions :exec
+UPDATE transactions
+SET suggested_category_id = NULL, suggestion_confidence = NULL
+WHERE suggested_category_id = ?;
+
+-- name: ListUncategorizedTransactions :many
+SELECT * FROM transactions
+WHERE category_id IS NULL AND is_transfer = FALSE
+  AND id NOT IN (SELECT transaction_id FROM transaction_splits)
+  AND (sqlc.narg('start_date') IS NULL OR posted_date >= sqlc.narg('start_date'))
+  AND (sqlc.narg('end_date') IS NULL OR posted_date < sqlc.narg('end_date'))
+ORDER BY posted_date ASC, id ASC;
+
+-- name: SetTransactionTransfer :exec
+UPDATE transactions SET is_transfer = ? WHERE id = ?;
+
+-- name: ListAllTransactions :many
+SELECT * FROM transactions ORDER BY id ASC;
+
+-- name: RestoreAccount :one
+INSERT INTO accounts (account_id, name, account_type, hidden, include_in_budget, unlinked_at, relinked_at, source)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+RETURNING *;
+
+-- name: RestoreTransaction :one
+INSERT INTO transactions (
+    account_id, posted_date, description, payee, amount_cents, matched_expense_id, matched_scheduled_date,
+    external_id, pending, category_id, note, transacted_at, display_payee, is_transfer,
+    suggested_category_id, suggestion_confidence
+)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+RETURNING *;
@@ -0,0 +1,56 @@
+-- name: CreateTransferPair :one
+INSERT INTO transfer_pairs (outflow_transaction_id, inflow_transaction_id, status, created_at, updated_at)
+VALUES (?, ?, ?, ?, ?)
+RETURNING *;
+
+-- name: GetTransferPair :one
+SELECT * FROM transfer_pairs WHERE id = ?;
+
+-- name: ListTransferPairs :many
+SELECT * FROM transfer_pairs
+WHERE (sqlc.narg('status') IS NULL OR status = sqlc.narg('status'))
+  AND id > sqlc.arg('after_id')
+ORDER BY id ASC
+LIMIT sqlc.arg('limit');
+
+-- name: CountTransferPairs :one
+SELECT COUNT(*) FROM transfer_pairs
+WHERE (sqlc.narg('status') IS NULL OR status = sqlc.narg('status'));
+
+-- name: ListTransferPairsSince :many
+SELECT * FROM transfer_pairs
+WHERE outflow_transaction_id IN (SELECT id FROM transactions WHERE posted_date >= sqlc.arg('start_date'))
+   OR inflow_transaction_id IN (SELECT id FROM transactions WHERE posted_date >= sqlc.arg('start_date'));
+
+-- name: UpdateTransferPairStatus :exec
+UPDATE transfer_pairs
+SET status = ?, updated_at = ?
+WHERE id = ?;
+
+-- name: ListTransferCandidates :many
+SELECT * FROM transactions
+WHERE pending = FALSE AND amount_cents != 0
+  AND posted_date >= sqlc.arg('start_date')
+  AND account_id IN (SELECT id FROM accounts WHERE unlinked_at IS NULL)
+ORDER BY posted_date ASC, id ASC;
+
+-- name: UnmarkTransferPartnersByAccount :exec
+UPDATE transactions
+SET is_transfer = FALSE
+WHERE id IN (
+    SELECT p.inflow_transaction_id FROM transfer_pairs p
+    JOIN transactions o ON o.id = p.outflow_transaction_id
+    WHERE o.account_id = sqlc.arg('account_id') AND p.status != 'split'
+    UNION
+    SELECT p.outflow_transaction_id FROM transfer_pairs p
+    JOIN transactions i ON i.id = p.inflow_transaction_id
+    WHERE i.account_id = sqlc.arg('account_id') AND p.status != 'split'
+);
+
+-- name: DeleteTransferPairsByAccount :exec
+DELETE FROM transfer_pairs
+WHERE outflow_transaction_id IN (SELECT id FROM transactions WHERE account_id = sqlc.arg('account_id'))
+   OR inflow_transaction_id IN (SELECT id FROM transactions WHERE account_id = sqlc.arg('account_id'));
+
+-- name: ListAllTransferPairs :many
+SELECT * FROM transfer_pairs ORDER BY id ASC;